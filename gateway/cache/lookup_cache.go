@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// LookupCache keeps scanned-code lookups per shop. Entries are keyed by a
+// per-shop generation, so a catalog change drops all of a shop's entries at
+// once by starting a new generation.
+type LookupCache struct {
+	rdb *RedisCache
+	ttl time.Duration
+}
+
+func NewLookupCache(rdb *RedisCache, ttl time.Duration) *LookupCache {
+	return &LookupCache{rdb: rdb, ttl: ttl}
+}
+
+func (c *LookupCache) generationKey(shopID string) string {
+	return "products:lookup:gen:" + shopID
+}
+
+func (c *LookupCache) key(shopID, generation, code string) string {
+	return "products:lookup:" + shopID + ":" + generation + ":" + code
+}
+
+func (c *LookupCache) generation(ctx context.Context, shopID string) string {
+	gen, err := c.rdb.Get(ctx, c.generationKey(shopID))
+	if err != nil {
+		return "0"
+	}
+	return gen
+}
+
+func (c *LookupCache) Get(ctx context.Context, shopID, code string) (string, bool) {
+	val, err := c.rdb.Get(ctx, c.key(shopID, c.generation(ctx, shopID), code))
+	if err != nil {
+		return "", false
+	}
+	return val, true
+}
+
+func (c *LookupCache) Set(ctx context.Context, shopID, code, value string) {
+	_ = c.rdb.Set(ctx, c.key(shopID, c.generation(ctx, shopID), code), value, c.ttl)
+}
+
+// Invalidate drops every cached lookup of the shop; the old entries expire on
+// their own.
+func (c *LookupCache) Invalidate(ctx context.Context, shopID string) {
+	gen := strconv.FormatInt(time.Now().UnixNano(), 10)
+	_ = c.rdb.Set(ctx, c.generationKey(shopID), gen, 24*time.Hour)
+}
@@ -12,7 +12,10 @@ import (
 )
 
 func main() {
-	app := fiber.New()
+	app := fiber.New(fiber.Config{
+		// Product imports upload whole catalog files.
+		BodyLimit: 32 << 20,
+	})
 	app.Use(middleware.ResponseFilter())
 
 	// Redis
@@ -3,8 +3,12 @@ package grpc
 import (
 	"authservice/proto/authpb"
 	"fmt"
+	"orderservice/proto/orderpb"
 	"paymentservice/proto/paymentpb"
-	"productservice/proto/productpb"
+	"productservice/proto/v1/categorypb"
+	productpb "productservice/proto/v1/productpb"
+	"productservice/proto/v1/tagpb"
+	"productservice/proto/v1/variantpb"
 	"shopservice/proto/shoppb"
 	"userservice/proto/userpb"
 
@@ -15,11 +19,15 @@ import (
 )
 
 type GRPCClients struct {
-	Auth    authpb.AuthServiceClient
-	User    userpb.UserServiceClient
-	Product productpb.ProductServiceClient
-	Payment paymentpb.PaymentServiceClient
-	Shop    shoppb.ShopServiceClient
+	Auth     authpb.AuthServiceClient
+	User     userpb.UserServiceClient
+	Product  productpb.ProductServiceClient
+	Category categorypb.CategoryServiceClient
+	Tag      tagpb.TagServiceClient
+	Variant  variantpb.VariantServiceClient
+	Payment  paymentpb.PaymentServiceClient
+	Shop     shoppb.ShopServiceClient
+	Order    orderpb.OrderServiceClient
 }
 
 // NewGRPCClients initializes all gRPC clients with connection pooling
@@ -55,19 +63,40 @@ func NewGRPCClients() (*GRPCClients, error) {
 	productConn, err := grpc.Dial(":50051", grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(
 		interceptor.UserMetadataUnaryInterceptor(),
 		interceptor.ShopMetadataUnaryClientInterceptor(),
+	), grpc.WithChainStreamInterceptor(
+		interceptor.UserMetadataStreamInterceptor(),
+		interceptor.ShopMetadataStreamClientInterceptor(),
 	))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to product service: %v", err)
 	}
 	clients.Product = productpb.NewProductServiceClient(productConn)
+	clients.Category = categorypb.NewCategoryServiceClient(productConn)
+	clients.Tag = tagpb.NewTagServiceClient(productConn)
+	clients.Variant = variantpb.NewVariantServiceClient(productConn)
 
 	// // Payment Service
-	paymentConn, err := grpc.Dial(":50053", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	paymentConn, err := grpc.Dial(":50053", grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(
+		interceptor.UserMetadataUnaryInterceptor(),
+		interceptor.ShopMetadataUnaryClientInterceptor(),
+		interceptor.IdempotencyKeyUnaryClientInterceptor(),
+	))
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to payment service: %v", err)
 	}
 	clients.Payment = paymentpb.NewPaymentServiceClient(paymentConn)
 
+	// Order Service
+	orderConn, err := grpc.Dial(":50052", grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(
+		interceptor.UserMetadataUnaryInterceptor(),
+		interceptor.ShopMetadataUnaryClientInterceptor(),
+		interceptor.IdempotencyKeyUnaryClientInterceptor(),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to order service: %v", err)
+	}
+	clients.Order = orderpb.NewOrderServiceClient(orderConn)
+
 	return clients, nil
 }
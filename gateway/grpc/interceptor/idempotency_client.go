@@ -0,0 +1,40 @@
+package interceptor
+
+import (
+	"context"
+
+	ctxkey "hpkg/grpc"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// IdempotencyKeyUnaryClientInterceptor sends the request's idempotency key,
+// if any, as metadata. It must come after UserMetadataUnaryInterceptor, which
+// replaces the outgoing metadata.
+func IdempotencyKeyUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+
+		key, ok := ctx.Value(ctxkey.IdempotencyKey).(string)
+		if !ok || key == "" {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if !ok {
+			md = metadata.New(nil)
+		}
+
+		md.Set(ctxkey.IdempotencyMetadataKey, key)
+
+		ctx = metadata.NewOutgoingContext(ctx, md)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
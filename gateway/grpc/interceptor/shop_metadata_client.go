@@ -35,3 +35,32 @@ func ShopMetadataUnaryClientInterceptor() grpc.UnaryClientInterceptor {
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+// ShopMetadataStreamClientInterceptor is ShopMetadataUnaryClientInterceptor
+// for streaming calls.
+func ShopMetadataStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+
+		shopID, ok := ctx.Value(ctxkey.ShopIDKey).(string)
+		if !ok || shopID == "" {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if !ok {
+			md = metadata.New(nil)
+		}
+
+		md.Set("x-shop-id", shopID)
+
+		ctx = metadata.NewOutgoingContext(ctx, md)
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
@@ -32,8 +32,37 @@ func UserMetadataUnaryInterceptor() grpc.UnaryClientInterceptor {
 			"x-user-id": auth.UserID,
 			"x-roles":   auth.Role,
 		})
+		md.Append("x-permissions", auth.Permissions...)
 
 		ctx = metadata.NewOutgoingContext(ctx, md)
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+// UserMetadataStreamInterceptor is UserMetadataUnaryInterceptor for
+// streaming calls.
+func UserMetadataStreamInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+
+		auth, ok := ctx.Value(ctxkey.UserIDKey).(*cache.AuthResp)
+		if !ok || auth == nil {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		md := metadata.New(map[string]string{
+			"x-user-id": auth.UserID,
+			"x-roles":   auth.Role,
+		})
+		md.Append("x-permissions", auth.Permissions...)
+
+		ctx = metadata.NewOutgoingContext(ctx, md)
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
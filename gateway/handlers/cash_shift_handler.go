@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"hpkg/constants/responses"
+	paymentpb "paymentservice/proto/paymentpb"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// OpenCashShift opens a cash drawer shift for the caller, e.g.
+// {"opening_float": 100, "currency": "USD"}.
+func (h *PaymentHandler) OpenCashShift(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req paymentpb.OpenCashShiftRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Payment.OpenCashShift(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusCreated, resp)
+}
+
+// RecordCashMovement records cash put into or taken out of the drawer, e.g.
+// {"kind": "cash_out", "amount": 500, "reason": "bank drop"}.
+func (h *PaymentHandler) RecordCashMovement(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req paymentpb.RecordCashMovementRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.ShiftId = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Payment.RecordCashMovement(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusCreated, resp)
+}
+
+// CloseCashShift closes the shift with the cash counted in the drawer, e.g.
+// {"counted_amount": 1234.5}, and returns its Z-report.
+func (h *PaymentHandler) CloseCashShift(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req paymentpb.CloseCashShiftRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.ShiftId = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Payment.CloseCashShift(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// GetCashShiftReport returns the X-report of an open shift or the Z-report of
+// a closed one. Without an :id it reports on the caller's open shift in the
+// currency query parameter.
+func (h *PaymentHandler) GetCashShiftReport(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Payment.GetCashShiftReport(ctx, &paymentpb.GetCashShiftReportRequest{
+		ShiftId:  c.Params("id"),
+		Currency: c.Query("currency", ""),
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// ListCashShifts returns the shop's latest shifts. Query parameters: status
+// (open or closed) and limit.
+func (h *PaymentHandler) ListCashShifts(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	req := &paymentpb.ListCashShiftsRequest{Status: c.Query("status", "")}
+	if v := c.Query("limit", ""); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+		}
+		req.Limit = int32(limit)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Payment.ListCashShifts(ctx, req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
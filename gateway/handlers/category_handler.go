@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"context"
+	"gateway/grpc"
+	"hpkg/constants/responses"
+	"productservice/proto/v1/categorypb"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type CategoryHandler struct {
+	clients *grpc.GRPCClients
+}
+
+func NewCategoryHandler(clients *grpc.GRPCClients) *CategoryHandler {
+	return &CategoryHandler{clients: clients}
+}
+
+// ListCategories endpoint. Query parameters: parent_id (empty for the top
+// level), search, include_inactive, include_product_count, page and
+// page_size.
+func (h *CategoryHandler) ListCategories(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	page, _ := strconv.ParseInt(c.Query("page", "1"), 10, 32)
+	pageSize, _ := strconv.ParseInt(c.Query("page_size", "20"), 10, 32)
+
+	req := &categorypb.ListCategoriesRequest{
+		IncludeInactive:     c.Query("include_inactive", "") == "true",
+		IncludeProductCount: c.Query("include_product_count", "") == "true",
+		Page:                int32(page),
+		PageSize:            int32(pageSize),
+	}
+	if c.Request().URI().QueryArgs().Has("parent_id") {
+		parentID := c.Query("parent_id", "")
+		req.ParentId = &parentID
+	}
+	if v := c.Query("search", ""); v != "" {
+		req.Search = &v
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Category.List(ctx, req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// GetCategoryTree endpoint. Returns the shop's categories nested under their
+// parents.
+func (h *CategoryHandler) GetCategoryTree(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Category.GetTree(ctx, &categorypb.GetCategoryTreeRequest{
+		IncludeInactive:     c.Query("include_inactive", "") == "true",
+		IncludeProductCount: c.Query("include_product_count", "") == "true",
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// GetCategory endpoint. include picks any of parent, children and
+// product_count.
+func (h *CategoryHandler) GetCategory(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	include := queryIncludes(c, "")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Category.Get(ctx, &categorypb.GetCategoryRequest{
+		Id:                  c.Params("id"),
+		IncludeParent:       include["parent"],
+		IncludeChildren:     include["children"],
+		IncludeProductCount: include["product_count"],
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp.Category)
+}
+
+// GetCategoryProducts endpoint. Lists the products in the category and its
+// subcategories, with page and page_size.
+func (h *CategoryHandler) GetCategoryProducts(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	page, _ := strconv.ParseInt(c.Query("page", "1"), 10, 32)
+	pageSize, _ := strconv.ParseInt(c.Query("page_size", "20"), 10, 32)
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Category.GetWithProducts(ctx, &categorypb.GetCategoryWithProductsRequest{
+		Id:       c.Params("id"),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp.Data)
+}
+
+func (h *CategoryHandler) CreateCategory(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req categorypb.CreateCategoryRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Category.Create(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusCreated, resp.Category)
+}
+
+// UpdateCategory endpoint. Only the fields present in the body are changed;
+// "parent_id": "" moves the category to the top level.
+func (h *CategoryHandler) UpdateCategory(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req categorypb.UpdateCategoryRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.Id = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Category.Update(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp.Category)
+}
+
+// DeleteCategory endpoint. Subcategories move up to the deleted category's
+// parent and its products are left without a category.
+func (h *CategoryHandler) DeleteCategory(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err = h.clients.Category.Delete(ctx, &categorypb.DeleteCategoryRequest{
+		Id: c.Params("id"),
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return responses.Success(c, fiber.StatusOK, fiber.Map{"message": "category deleted successfully"})
+}
@@ -0,0 +1,270 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"gateway/grpc"
+	"hpkg/constants/responses"
+	"orderservice/proto/orderpb"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type OrderHandler struct {
+	clients *grpc.GRPCClients
+}
+
+func NewOrderHandler(clients *grpc.GRPCClients) *OrderHandler {
+	return &OrderHandler{clients: clients}
+}
+
+// CreateOrder places an order. With an Idempotency-Key header, a retry returns
+// the first response instead of placing the order twice.
+func (h *OrderHandler) CreateOrder(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req orderpb.CreateOrderRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Order.CreateOrder(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusCreated, resp)
+}
+
+func (h *OrderHandler) GetOrder(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	orderID := c.Params("id")
+	if orderID == "" {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Order.GetOrder(ctx, &orderpb.GetOrderRequest{OrderId: orderID})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// ListOrders pages through the shop's orders, newest first. Pass the
+// next_cursor of a response as ?cursor= to fetch the page after it.
+func (h *OrderHandler) ListOrders(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	limit, _ := strconv.ParseInt(c.Query("limit", "20"), 10, 32)
+
+	req := &orderpb.ListOrdersRequest{
+		UserId:   c.Query("user_id", ""),
+		Cursor:   c.Query("cursor", ""),
+		PageSize: int32(limit),
+	}
+	if s := c.Query("status", ""); s != "" {
+		status, ok := parseOrderStatus(s)
+		if !ok {
+			return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+		}
+		req.StatusFilter = status
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Order.ListOrders(ctx, req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+func (h *OrderHandler) UpdateOrderStatus(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	orderID := c.Params("id")
+	if orderID == "" {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	var body struct {
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+	if err := c.Bind().Body(&body); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	status, ok := parseOrderStatus(body.Status)
+	if !ok {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Order.UpdateOrderStatus(ctx, &orderpb.UpdateOrderStatusRequest{
+		OrderId:   orderID,
+		NewStatus: status,
+		Reason:    body.Reason,
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+func (h *OrderHandler) CancelOrder(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	orderID := c.Params("id")
+	if orderID == "" {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	// The reason is optional, so an empty body is fine.
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if len(c.Body()) > 0 {
+		if err := c.Bind().Body(&body); err != nil {
+			return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Order.CancelOrder(ctx, &orderpb.CancelOrderRequest{
+		OrderId: orderID,
+		Reason:  body.Reason,
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+func (h *OrderHandler) TrackOrder(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	orderID := c.Params("id")
+	if orderID == "" {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Order.TrackOrder(ctx, &orderpb.TrackOrderRequest{OrderId: orderID})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// ListExchangeRates returns the exchange rates set for the shop.
+func (h *OrderHandler) ListExchangeRates(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Order.ListExchangeRates(ctx, &orderpb.ListExchangeRatesRequest{})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// SetExchangeRate sets the rate of a currency pair for the shop, e.g.
+// {"base_currency": "USD", "quote_currency": "KHR", "rate": "4100"}.
+func (h *OrderHandler) SetExchangeRate(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req orderpb.SetExchangeRateRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Order.SetExchangeRate(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// parseOrderStatus accepts a status as "shipped" or "ORDER_STATUS_SHIPPED".
+func parseOrderStatus(s string) (orderpb.OrderStatus, bool) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if !strings.HasPrefix(name, "ORDER_STATUS_") {
+		name = "ORDER_STATUS_" + name
+	}
+	v, ok := orderpb.OrderStatus_value[name]
+	if !ok || v == int32(orderpb.OrderStatus_ORDER_STATUS_UNSPECIFIED) {
+		return orderpb.OrderStatus_ORDER_STATUS_UNSPECIFIED, false
+	}
+	return orderpb.OrderStatus(v), true
+}
+
+// bindProto decodes a JSON request body into msg, accepting enum names and
+// both snake_case and camelCase field names.
+func bindProto(c fiber.Ctx, msg proto.Message) error {
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(c.Body(), msg)
+}
+
+// sendProto writes msg in the response envelope in its proto JSON form, so
+// enums read as names rather than numbers and zero values are kept.
+func sendProto(c fiber.Ctx, status int, msg proto.Message) error {
+	b, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(msg)
+	if err != nil {
+		return responses.Error(c, fiber.StatusInternalServerError, responses.ErrInternalCode)
+	}
+	return responses.Success(c, status, json.RawMessage(b))
+}
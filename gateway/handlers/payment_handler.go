@@ -3,10 +3,12 @@ package handler
 import (
 	"context"
 	"gateway/grpc"
+	"hpkg/constants/responses"
 	paymentpb "paymentservice/proto/paymentpb"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PaymentHandler struct {
@@ -17,9 +19,15 @@ func NewPaymentHandler(clients *grpc.GRPCClients) *PaymentHandler {
 	return &PaymentHandler{clients: clients}
 }
 
-// ProcessPayment endpoint
+// ProcessPayment endpoint. Send an Idempotency-Key header to make retries
+// safe: a repeated key returns the first response instead of charging again.
 func (h *PaymentHandler) ProcessPayment(c fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	req := new(paymentpb.ProcessPaymentRequest)
@@ -29,33 +37,144 @@ func (h *PaymentHandler) ProcessPayment(c fiber.Ctx) error {
 
 	resp, err := h.clients.Payment.ProcessPayment(ctx, req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return responses.FromGRPC[any](c, err)
 	}
 
 	return c.JSON(resp)
 }
 
+// TokenizeCard endpoint. Exchanges card details for a card_token to pay
+// with, so the card itself is sent only once.
+func (h *PaymentHandler) TokenizeCard(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := new(paymentpb.TokenizeCardRequest)
+	if err := c.Bind().Body(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+	}
+
+	resp, err := h.clients.Payment.TokenizeCard(ctx, req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(resp)
+}
+
 // GetPayment endpoint
 func (h *PaymentHandler) GetPayment(c fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Payment.GetPayment(ctx, &paymentpb.GetPaymentRequest{
+		PaymentId: c.Params("payment_id"),
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return c.JSON(resp)
+}
+
+// RefundPayment endpoint. Omit refund_amount to refund all that is left of
+// the payment; reason_code defaults to "other".
+func (h *PaymentHandler) RefundPayment(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	req := new(paymentpb.GetPaymentRequest)
-	if err := c.Bind().Body(req); err != nil { // can also use BodyParser
+	req := new(paymentpb.RefundPaymentRequest)
+	if err := c.Bind().Body(req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
 	}
+	req.PaymentId = c.Params("payment_id")
+
+	resp, err := h.clients.Payment.RefundPayment(ctx, req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return c.JSON(resp)
+}
+
+// ListRefunds endpoint
+func (h *PaymentHandler) ListRefunds(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
 
-	resp, err := h.clients.Payment.GetPayment(ctx, req)
+	resp, err := h.clients.Payment.ListRefunds(ctx, &paymentpb.ListRefundsRequest{
+		PaymentId: c.Params("payment_id"),
+	})
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return responses.FromGRPC[any](c, err)
 	}
 
 	return c.JSON(resp)
 }
 
+// GetPaymentStats reports on the shop's payments. Query parameters: period
+// (daily, weekly or monthly), from and to (RFC 3339) and time_zone (IANA name).
+func (h *PaymentHandler) GetPaymentStats(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	req := &paymentpb.GetPaymentStatsRequest{
+		Period:   c.Query("period", ""),
+		TimeZone: c.Query("time_zone", ""),
+	}
+	for param, field := range map[string]**timestamppb.Timestamp{"from": &req.From, "to": &req.To} {
+		v := c.Query(param, "")
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+		}
+		*field = timestamppb.New(t)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Payment.GetPaymentStats(ctx, req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
 // VerifyPayment endpoint
 func (h *PaymentHandler) VerifyPayment(c fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	req := new(paymentpb.VerifyPaymentRequest)
@@ -65,7 +184,7 @@ func (h *PaymentHandler) VerifyPayment(c fiber.Ctx) error {
 
 	resp, err := h.clients.Payment.VerifyPayment(ctx, req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return responses.FromGRPC[any](c, err)
 	}
 
 	return c.JSON(resp)
@@ -73,7 +192,12 @@ func (h *PaymentHandler) VerifyPayment(c fiber.Ctx) error {
 
 // ValidatePayment endpoint
 func (h *PaymentHandler) ValidatePayment(c fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	req := new(paymentpb.ValidatePaymentRequest)
@@ -83,7 +207,7 @@ func (h *PaymentHandler) ValidatePayment(c fiber.Ctx) error {
 
 	resp, err := h.clients.Payment.ValidatePayment(ctx, req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return responses.FromGRPC[any](c, err)
 	}
 
 	return c.JSON(resp)
@@ -7,26 +7,33 @@ import (
 	"gateway/cache"
 	"gateway/grpc"
 	"hpkg/constants/responses"
-	"productservice/proto/productpb"
+	productpb "productservice/proto/v1/productpb"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type RequestHandler struct {
 	clients *grpc.GRPCClients
 	cache   *cache.RedisCache
+	lookups *cache.LookupCache
 }
 
 func NewProductHandler(
 	clients *grpc.GRPCClients,
-	cache *cache.RedisCache,
+	redisCache *cache.RedisCache,
 ) *RequestHandler {
-	return &RequestHandler{
+	h := &RequestHandler{
 		clients: clients,
-		cache:   cache,
+		cache:   redisCache,
 	}
+	if redisCache != nil {
+		h.lookups = cache.NewLookupCache(redisCache, time.Minute)
+	}
+	return h
 }
 
 func getAuthContext(c fiber.Ctx) (context.Context, *cache.AuthResp, error) {
@@ -40,6 +47,10 @@ func getAuthContext(c fiber.Ctx) (context.Context, *cache.AuthResp, error) {
 	return ctx, auth, nil
 }
 
+// ListProductsByShop endpoint. Query parameters: category_id, search,
+// is_active, is_featured, tag_ids (comma separated), min_price, max_price,
+// low_stock, sort_by, sort_order, page, page_size and include (any of
+// category, media, tags).
 func (h *RequestHandler) ListProductsByShop(c fiber.Ctx) error {
 	ctx, _, err := getAuthContext(c)
 	if err != nil {
@@ -51,57 +62,74 @@ func (h *RequestHandler) ListProductsByShop(c fiber.Ctx) error {
 		return responses.Error(c, fiber.StatusForbidden, responses.ErrForbiddenCode)
 	}
 
-	limitStr := c.Query("limit", "20")
-	limit, _ := strconv.ParseInt(limitStr, 10, 32)
-
-	cursor := c.Query("cursor", "")
-	sort := c.Query("az", "za", "old", "new")
+	req := &productpb.ListRequest{
+		ShopId:       shopID,
+		SortBy:       c.Query("sort_by", ""),
+		SortOrder:    c.Query("sort_order", ""),
+		LowStockOnly: c.Query("low_stock", "") == "true",
+	}
+	if v := c.Query("category_id", ""); v != "" {
+		req.CategoryId = &v
+	}
+	if v := c.Query("search", ""); v != "" {
+		req.Search = &v
+	}
+	if v := c.Query("tag_ids", ""); v != "" {
+		req.TagIds = strings.Split(v, ",")
+	}
+	if req.IsActive, err = queryBool(c, "is_active"); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	if req.IsFeatured, err = queryBool(c, "is_featured"); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	if req.MinPrice, err = queryFloat(c, "min_price"); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	if req.MaxPrice, err = queryFloat(c, "max_price"); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	page, _ := strconv.ParseInt(c.Query("page", "1"), 10, 32)
+	pageSize, _ := strconv.ParseInt(c.Query("page_size", "20"), 10, 32)
+	req.Page = int32(page)
+	req.PageSize = int32(pageSize)
 
-	// New unified search & filter
-	search := c.Query("search", "") // matches title/name
-	filter := c.Query("filter", "") // matches category
+	include := queryIncludes(c, "category,media,tags")
+	req.IncludeCategory = include["category"]
+	req.IncludeMedia = include["media"]
+	req.IncludeTags = include["tags"]
 
-	cacheKey := fmt.Sprintf(
-		"products:shop:%s:cursor:%s:limit:%d:sort:%s:search:%s:filter:%s",
-		shopID, cursor, limit, sort, search, filter,
-	)
+	cacheKey := fmt.Sprintf("products:shop:%s:%s", shopID, string(c.Request().URI().QueryString()))
 
 	// Redis cache
-	var cached string
 	if h.cache != nil {
-		cached, _ = h.cache.Get(ctx, cacheKey)
-		if cached != "" {
-			var result fiber.Map
-			if err := json.Unmarshal([]byte(cached), &result); err == nil {
-				return responses.Success(c, fiber.StatusOK, result)
-			}
+		if cached, _ := h.cache.Get(ctx, cacheKey); cached != "" {
+			return responses.Success(c, fiber.StatusOK, json.RawMessage(cached))
 		}
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	resp, err := h.clients.Product.ListProductsByShop(ctx, &productpb.ListProductsByShopRequest{
-		ShopId: shopID,
-		Limit:  int32(limit),
-		Cursor: cursor,
-		Search: search,
-		Filter: filter,
-		Sort:   sort,
-	})
+	resp, err := h.clients.Product.List(ctx, req)
 	if err != nil {
 		return responses.FromGRPC[any](c, err)
 	}
 
+	b, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		return responses.Error(c, fiber.StatusInternalServerError, responses.ErrInternalCode)
+	}
+
 	if h.cache != nil {
-		if jsonData, err := json.Marshal(resp); err == nil {
-			_ = h.cache.Set(ctx, cacheKey, string(jsonData), 30*time.Second)
-		}
+		_ = h.cache.Set(ctx, cacheKey, string(b), 30*time.Second)
 	}
 
-	return responses.Success(c, fiber.StatusOK, resp)
+	return responses.Success(c, fiber.StatusOK, json.RawMessage(b))
 }
 
+// GetProductByID endpoint. Returns the product with its category (and its
+// parent), media, variants, tags and shop; include narrows those down.
 func (h *RequestHandler) GetProductByID(c fiber.Ctx) error {
 	ctx, _, err := getAuthContext(c)
 	if err != nil {
@@ -113,17 +141,25 @@ func (h *RequestHandler) GetProductByID(c fiber.Ctx) error {
 		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
 	}
 
+	include := queryIncludes(c, "category,category_parent,media,variants,tags,shop")
+
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	resp, err := h.clients.Product.GetProductByID(ctx, &productpb.GetProductRequest{
-		ProductId: productID,
+	resp, err := h.clients.Product.GetDetail(ctx, &productpb.GetDetailRequest{
+		Id:                    productID,
+		IncludeCategory:       include["category"],
+		IncludeCategoryParent: include["category_parent"],
+		IncludeMedia:          include["media"],
+		IncludeVariants:       include["variants"],
+		IncludeTags:           include["tags"],
+		IncludeShop:           include["shop"],
 	})
 	if err != nil {
-		return err
+		return responses.FromGRPC[any](c, err)
 	}
 
-	return c.JSON(resp.Product)
+	return sendProto(c, fiber.StatusOK, resp.Product)
 }
 
 func (h *RequestHandler) CreateProduct(c fiber.Ctx) error {
@@ -132,25 +168,24 @@ func (h *RequestHandler) CreateProduct(c fiber.Ctx) error {
 		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
 	}
 
-	var req productpb.CreateProductRequest
-	if err := c.Bind().Body(&req); err != nil {
+	var req productpb.CreateRequest
+	if err := bindProto(c, &req); err != nil {
 		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	resp, err := h.clients.Product.CreateProduct(ctx, &req)
+	resp, err := h.clients.Product.Create(ctx, &req)
 	if err != nil {
 		return responses.FromGRPC[any](c, err)
 	}
+	invalidateLookups(c, ctx, h.lookups)
 
-	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-		"message": "product created",
-		"product": resp.Product,
-	})
+	return sendProto(c, fiber.StatusCreated, resp.Product)
 }
 
+// UpdateProduct endpoint. Only the fields present in the body are changed.
 func (h *RequestHandler) UpdateProduct(c fiber.Ctx) error {
 	ctx, _, err := getAuthContext(c)
 	if err != nil {
@@ -162,27 +197,26 @@ func (h *RequestHandler) UpdateProduct(c fiber.Ctx) error {
 		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
 	}
 
-	var req productpb.UpdateProductRequest
-	if err := c.Bind().Body(&req); err != nil {
+	var req productpb.UpdateRequest
+	if err := bindProto(c, &req); err != nil {
 		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
 	}
 
-	req.ProductId = productID
+	req.Id = productID
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	resp, err := h.clients.Product.UpdateProduct(ctx, &req)
+	resp, err := h.clients.Product.Update(ctx, &req)
 	if err != nil {
 		return responses.FromGRPC[any](c, err)
 	}
+	invalidateLookups(c, ctx, h.lookups)
 
-	return c.JSON(fiber.Map{
-		"message": "product updated",
-		"product": resp.Product,
-	})
+	return sendProto(c, fiber.StatusOK, resp.Product)
 }
 
+// DeleteProduct endpoint. Products are soft deleted unless ?hard=true.
 func (h *RequestHandler) DeleteProduct(c fiber.Ctx) error {
 	ctx, _, err := getAuthContext(c)
 	if err != nil {
@@ -197,13 +231,167 @@ func (h *RequestHandler) DeleteProduct(c fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	resp, err := h.clients.Product.DeleteProduct(ctx, &productpb.DeleteProductRequest{
-		ProductId: productID,
+	_, err = h.clients.Product.Delete(ctx, &productpb.DeleteRequest{
+		Id:         productID,
+		SoftDelete: c.Query("hard", "") != "true",
 	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+	invalidateLookups(c, ctx, h.lookups)
+
+	return responses.Success(c, fiber.StatusOK, fiber.Map{"message": "product deleted successfully"})
+}
+
+// UpdateStock endpoint, e.g. {"quantity_change": -3, "reason": "damaged"}.
+func (h *RequestHandler) UpdateStock(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req productpb.StockRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.ProductId = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Product.UpdateStock(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+	invalidateLookups(c, ctx, h.lookups)
+
+	return sendProto(c, fiber.StatusOK, resp.Product)
+}
+
+// BulkUpdateStock endpoint, e.g. {"updates": [{"product_id": "...",
+// "quantity_change": 10, "reason": "delivery"}]}. Updates that fail are
+// listed in errors without stopping the rest.
+func (h *RequestHandler) BulkUpdateStock(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req productpb.BulkStockRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Product.BulkUpdateStock(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+	invalidateLookups(c, ctx, h.lookups)
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// LookupByCode endpoint for scanner checkout, e.g. ?code=4006381333931.
+// include_inactive=true also finds inactive products and variants. Answers
+// are cached for a minute, or until the shop's products or variants change
+// through the gateway; stock levels in a cached answer may lag behind.
+func (h *RequestHandler) LookupByCode(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	shopID, ok := c.Locals("shop_id").(string)
+	if !ok || shopID == "" {
+		return responses.Error(c, fiber.StatusForbidden, responses.ErrForbiddenCode)
+	}
+
+	code := strings.TrimSpace(c.Query("code", ""))
+	if code == "" {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	includeInactive := c.Query("include_inactive", "") == "true"
+
+	cacheKey := code
+	if includeInactive {
+		cacheKey += ":all"
+	}
+	if h.lookups != nil {
+		if cached, ok := h.lookups.Get(ctx, shopID, cacheKey); ok && cached != "" {
+			return responses.Success(c, fiber.StatusOK, json.RawMessage(cached))
+		}
+	}
 
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Product.LookupByCode(ctx, &productpb.LookupByCodeRequest{
+		ShopId:          shopID,
+		Code:            code,
+		IncludeInactive: includeInactive,
+	})
 	if err != nil {
 		return responses.FromGRPC[any](c, err)
 	}
 
-	return responses.FromGRPC[any](c, err, resp)
+	b, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		return responses.Error(c, fiber.StatusInternalServerError, responses.ErrInternalCode)
+	}
+
+	if h.lookups != nil {
+		h.lookups.Set(ctx, shopID, cacheKey, string(b))
+	}
+
+	return responses.Success(c, fiber.StatusOK, json.RawMessage(b))
+}
+
+// invalidateLookups drops the shop's cached code lookups after its catalog
+// changed.
+func invalidateLookups(c fiber.Ctx, ctx context.Context, lookups *cache.LookupCache) {
+	if lookups == nil {
+		return
+	}
+	if shopID, ok := c.Locals("shop_id").(string); ok && shopID != "" {
+		lookups.Invalidate(ctx, shopID)
+	}
+}
+
+// queryIncludes reads the comma separated include parameter, falling back to
+// def when it is not given.
+func queryIncludes(c fiber.Ctx, def string) map[string]bool {
+	include := make(map[string]bool)
+	for _, name := range strings.Split(c.Query("include", def), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			include[name] = true
+		}
+	}
+	return include
+}
+
+func queryBool(c fiber.Ctx, key string) (*bool, error) {
+	v := c.Query(key, "")
+	if v == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func queryFloat(c fiber.Ctx, key string) (*float64, error) {
+	v := c.Query(key, "")
+	if v == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
 }
@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"hpkg/constants/responses"
+	"io"
+	"log"
+	"path/filepath"
+	"productservice/proto/v1/productpb"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	importChunkSize = 64 << 10
+	// Imports write every row in a transaction of its own, and exports
+	// stream the whole catalog, so both get more time than a single call.
+	importTimeout = 5 * time.Minute
+	exportTimeout = 5 * time.Minute
+)
+
+var exportContentTypes = map[string]string{
+	"csv":  "text/csv",
+	"xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// ImportProducts endpoint. A multipart upload with the file in "file" and
+// the optional fields format (csv or xlsx, else taken from the file name),
+// dry_run, sheet and column_map, a JSON object such as {"Item Code": "sku"}.
+// Answers with the per-row report.
+func (h *RequestHandler) ImportProducts(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	format := strings.ToLower(c.FormValue("format"))
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(file.Filename)), ".")
+	}
+	if _, ok := exportContentTypes[format]; !ok {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrImportInvalidCode)
+	}
+
+	opts := &productpb.ImportOptions{
+		Format: format,
+		DryRun: c.FormValue("dry_run") == "true",
+	}
+	if sheet := c.FormValue("sheet"); sheet != "" {
+		opts.Sheet = &sheet
+	}
+	if m := c.FormValue("column_map"); m != "" {
+		if err := json.Unmarshal([]byte(m), &opts.ColumnMap); err != nil {
+			return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+		}
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	defer f.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, importTimeout)
+	defer cancel()
+
+	stream, err := h.clients.Product.ImportProducts(ctx)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	// A failed Send means the service ended the call; CloseAndRecv then
+	// returns its status.
+	err = stream.Send(&productpb.ImportProductsRequest{
+		Payload: &productpb.ImportProductsRequest_Options{Options: opts},
+	})
+	buf := make([]byte, importChunkSize)
+	for err == nil {
+		n, readErr := f.Read(buf)
+		if n > 0 {
+			err = stream.Send(&productpb.ImportProductsRequest{
+				Payload: &productpb.ImportProductsRequest_Chunk{Chunk: buf[:n]},
+			})
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+	if !resp.DryRun && resp.Created+resp.Updated > 0 {
+		invalidateLookups(c, ctx, h.lookups)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// ExportProducts endpoint. Query parameters: format (csv, the default, or
+// xlsx) and include_inactive. Sends the shop's catalog as a file download in
+// the layout ImportProducts reads.
+func (h *RequestHandler) ExportProducts(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	format := strings.ToLower(c.Query("format", "csv"))
+	contentType, ok := exportContentTypes[format]
+	if !ok {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrImportInvalidCode)
+	}
+
+	// The call outlives the handler: the file is streamed after it returns.
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+
+	stream, err := h.clients.Product.ExportProducts(ctx, &productpb.ExportProductsRequest{
+		Format:          format,
+		IncludeInactive: c.Query("include_inactive", "") == "true",
+	})
+	if err != nil {
+		cancel()
+		return responses.FromGRPC[any](c, err)
+	}
+
+	// Wait for the first chunk, so that a refused export still gets an
+	// error status rather than an empty download.
+	first, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		cancel()
+		return responses.FromGRPC[any](c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Attachment("products-" + time.Now().Format("20060102") + "." + format)
+
+	return c.SendStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		if _, err := w.Write(first.GetData()); err != nil {
+			return
+		}
+		for {
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				w.Flush()
+				return
+			}
+			if err != nil {
+				// The status line is gone already; the client gets a
+				// truncated file.
+				log.Printf("product export failed mid-stream: %v", err)
+				return
+			}
+			if _, err := w.Write(chunk.Data); err != nil {
+				return
+			}
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"context"
+	"gateway/grpc"
+	"hpkg/constants/responses"
+	"productservice/proto/v1/tagpb"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type TagHandler struct {
+	clients *grpc.GRPCClients
+}
+
+func NewTagHandler(clients *grpc.GRPCClients) *TagHandler {
+	return &TagHandler{clients: clients}
+}
+
+// ListTags endpoint. Query parameters: search, include_product_count, page
+// and page_size.
+func (h *TagHandler) ListTags(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	page, _ := strconv.ParseInt(c.Query("page", "1"), 10, 32)
+	pageSize, _ := strconv.ParseInt(c.Query("page_size", "20"), 10, 32)
+
+	req := &tagpb.TagListRequest{
+		IncludeProductCount: c.Query("include_product_count", "") == "true",
+		Page:                int32(page),
+		PageSize:            int32(pageSize),
+	}
+	if v := c.Query("search", ""); v != "" {
+		req.Search = &v
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Tag.List(ctx, req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+func (h *TagHandler) GetTag(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Tag.Get(ctx, &tagpb.TagGetRequest{
+		Id:                  c.Params("id"),
+		IncludeProductCount: c.Query("include_product_count", "") == "true",
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp.Tag)
+}
+
+// GetTagProducts endpoint. Lists the tagged products, with page and
+// page_size.
+func (h *TagHandler) GetTagProducts(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	page, _ := strconv.ParseInt(c.Query("page", "1"), 10, 32)
+	pageSize, _ := strconv.ParseInt(c.Query("page_size", "20"), 10, 32)
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Tag.GetDetail(ctx, &tagpb.TagGetDetailRequest{
+		Id:       c.Params("id"),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp.TagDetail)
+}
+
+func (h *TagHandler) CreateTag(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req tagpb.TagCreateRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Tag.Create(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusCreated, resp.Tag)
+}
+
+func (h *TagHandler) UpdateTag(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req tagpb.TagUpdateRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.Id = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Tag.Update(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp.Tag)
+}
+
+func (h *TagHandler) DeleteTag(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err = h.clients.Tag.Delete(ctx, &tagpb.TagDeleteRequest{
+		Id: c.Params("id"),
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return responses.Success(c, fiber.StatusOK, fiber.Map{"message": "tag deleted successfully"})
+}
+
+// GetProductTags endpoint. Lists the tags on a product.
+func (h *TagHandler) GetProductTags(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Tag.GetByProduct(ctx, &tagpb.TagGetByProductRequest{
+		ProductId: c.Params("id"),
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// AssignProductTags endpoint, e.g. {"tag_ids": ["..."], "replace_existing":
+// true}. Returns the product's tags afterwards.
+func (h *TagHandler) AssignProductTags(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req tagpb.TagAssignRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.ProductId = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Tag.AssignToProduct(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// RemoveProductTags endpoint, e.g. {"tag_ids": ["..."]}.
+func (h *TagHandler) RemoveProductTags(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req tagpb.TagRemoveRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.ProductId = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err = h.clients.Tag.RemoveFromProduct(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return responses.Success(c, fiber.StatusOK, fiber.Map{"message": "tags removed from product"})
+}
@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"context"
+	"gateway/cache"
+	"gateway/grpc"
+	"hpkg/constants/responses"
+	"productservice/proto/v1/variantpb"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type VariantHandler struct {
+	clients *grpc.GRPCClients
+	lookups *cache.LookupCache
+}
+
+func NewVariantHandler(clients *grpc.GRPCClients, redisCache *cache.RedisCache) *VariantHandler {
+	h := &VariantHandler{clients: clients}
+	if redisCache != nil {
+		h.lookups = cache.NewLookupCache(redisCache, time.Minute)
+	}
+	return h
+}
+
+// ListOptions endpoint. Lists the options the product varies by.
+func (h *VariantHandler) ListOptions(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Variant.ListOptions(ctx, &variantpb.ListVariantOptionsRequest{
+		ProductId: c.Params("id"),
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// SetOptions endpoint, e.g. {"options": [{"name": "Size", "values": ["S",
+// "M"]}, {"name": "Color", "values": ["Red", "Blue"]}]}. Replaces the
+// product's options.
+func (h *VariantHandler) SetOptions(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req variantpb.SetVariantOptionsRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.ProductId = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Variant.SetOptions(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// ListVariants endpoint. Query parameters: is_active and include_product.
+func (h *VariantHandler) ListVariants(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	req := &variantpb.ListVariantsRequest{
+		ProductId:      c.Params("id"),
+		IncludeProduct: c.Query("include_product", "") == "true",
+	}
+	if req.IsActive, err = queryBool(c, "is_active"); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Variant.List(ctx, req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+func (h *VariantHandler) CreateVariant(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req variantpb.CreateVariantRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.ProductId = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Variant.Create(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+	invalidateLookups(c, ctx, h.lookups)
+
+	return sendProto(c, fiber.StatusCreated, resp.Variant)
+}
+
+// BulkCreateVariants endpoint, e.g. {"variants": [...]}. Creates all of the
+// variants or none.
+func (h *VariantHandler) BulkCreateVariants(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req variantpb.BulkCreateRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.ProductId = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Variant.BulkCreate(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+	invalidateLookups(c, ctx, h.lookups)
+
+	return sendProto(c, fiber.StatusCreated, resp)
+}
+
+// GenerateVariants endpoint, e.g. {"sku_prefix": "TSHIRT", "price": 19.99,
+// "stock_quantity": 10}. Creates the variants missing from the product's
+// option matrix.
+func (h *VariantHandler) GenerateVariants(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req variantpb.GenerateVariantsRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.ProductId = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Variant.Generate(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+	invalidateLookups(c, ctx, h.lookups)
+
+	return sendProto(c, fiber.StatusCreated, resp)
+}
+
+// GetVariant endpoint. include_product=true adds the variant's product.
+func (h *VariantHandler) GetVariant(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Variant.Get(ctx, &variantpb.GetVariantRequest{
+		Id:             c.Params("id"),
+		IncludeProduct: c.Query("include_product", "") == "true",
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp.Variant)
+}
+
+// UpdateVariant endpoint. Only the fields present in the body are changed.
+func (h *VariantHandler) UpdateVariant(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req variantpb.UpdateVariantRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.Id = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Variant.Update(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+	invalidateLookups(c, ctx, h.lookups)
+
+	return sendProto(c, fiber.StatusOK, resp.Variant)
+}
+
+func (h *VariantHandler) DeleteVariant(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err = h.clients.Variant.Delete(ctx, &variantpb.DeleteVariantRequest{
+		Id: c.Params("id"),
+	})
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+	invalidateLookups(c, ctx, h.lookups)
+
+	return responses.Success(c, fiber.StatusOK, fiber.Map{"message": "variant deleted successfully"})
+}
+
+// UpdateVariantStock endpoint, e.g. {"quantity_change": -2, "reason":
+// "damaged"}.
+func (h *VariantHandler) UpdateVariantStock(c fiber.Ctx) error {
+	ctx, _, err := getAuthContext(c)
+	if err != nil {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.ErrUnauthorizedCode)
+	}
+
+	var req variantpb.VariantStockRequest
+	if err := bindProto(c, &req); err != nil {
+		return responses.Error(c, fiber.StatusBadRequest, responses.ErrBadRequestCode)
+	}
+	req.VariantId = c.Params("id")
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := h.clients.Variant.UpdateStock(ctx, &req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+	invalidateLookups(c, ctx, h.lookups)
+
+	return sendProto(c, fiber.StatusOK, resp.Variant)
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"gateway/grpc"
+	"hpkg/constants/responses"
+	paymentpb "paymentservice/proto/paymentpb"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WebhookSignatureHeader carries a provider's signature of a webhook, in the
+// form "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">". A provider
+// rotating its secret may send more than one v1.
+const WebhookSignatureHeader = "X-Webhook-Signature"
+
+// DefaultWebhookTolerance is how far a webhook's timestamp may be from the
+// gateway's clock before it is taken for a replay.
+const DefaultWebhookTolerance = 5 * time.Minute
+
+// permPaymentWebhook lets payment-service accept an event from this route.
+const permPaymentWebhook = "PermPaymentWebhook"
+
+type WebhookHandler struct {
+	clients   *grpc.GRPCClients
+	secrets   map[string]string
+	tolerance time.Duration
+	now       func() time.Time
+}
+
+// NewWebhookHandler returns a handler for provider callbacks signed with the
+// provider's secret in secrets. Providers without a secret are refused.
+func NewWebhookHandler(clients *grpc.GRPCClients, secrets map[string]string, tolerance time.Duration) *WebhookHandler {
+	if tolerance <= 0 {
+		tolerance = DefaultWebhookTolerance
+	}
+	return &WebhookHandler{
+		clients:   clients,
+		secrets:   secrets,
+		tolerance: tolerance,
+		now:       time.Now,
+	}
+}
+
+// ParseWebhookSecrets reads provider secrets written as
+// "simulator=secret1,bank_transfer=secret2".
+func ParseWebhookSecrets(s string) map[string]string {
+	secrets := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		name, secret, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || name == "" || secret == "" {
+			continue
+		}
+		secrets[name] = secret
+	}
+	return secrets
+}
+
+// webhookEvent is the body a provider posts.
+type webhookEvent struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		Reference string `json:"reference"`
+		Message   string `json:"message"`
+	} `json:"data"`
+}
+
+// HandlePaymentWebhook endpoint. Checks the provider's signature and passes
+// the event on to payment-service, which ignores events it has seen.
+func (h *WebhookHandler) HandlePaymentWebhook(c fiber.Ctx) error {
+	provider := c.Params("provider")
+	secret, ok := h.secrets[provider]
+	if !ok {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.WebhookSignatureInvalidCode)
+	}
+
+	body := c.Body()
+	if !h.verifySignature(c.Get(WebhookSignatureHeader), secret, body) {
+		return responses.Error(c, fiber.StatusUnauthorized, responses.WebhookSignatureInvalidCode)
+	}
+
+	var event webhookEvent
+	if err := json.Unmarshal(body, &event); err != nil || event.ID == "" || event.Type == "" || event.Data.Reference == "" {
+		return responses.Error(c, fiber.StatusBadRequest, responses.WebhookEventInvalidCode)
+	}
+
+	req := &paymentpb.HandleWebhookEventRequest{
+		Provider:  provider,
+		EventId:   event.ID,
+		EventType: event.Type,
+		Reference: event.Data.Reference,
+		Message:   event.Data.Message,
+	}
+	if event.Created > 0 {
+		req.OccurredAt = timestamppb.New(time.Unix(event.Created, 0))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-permissions", permPaymentWebhook)
+
+	resp, err := h.clients.Payment.HandleWebhookEvent(ctx, req)
+	if err != nil {
+		return responses.FromGRPC[any](c, err)
+	}
+
+	return sendProto(c, fiber.StatusOK, resp)
+}
+
+// verifySignature checks header against body and rejects signatures whose
+// timestamp is outside the tolerance, so a captured request cannot be sent
+// again later.
+func (h *WebhookHandler) verifySignature(header, secret string, body []byte) bool {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return false
+	}
+
+	t, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	age := h.now().Sub(time.Unix(t, 0))
+	if age > h.tolerance || age < -h.tolerance {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+
+	for _, s := range signatures {
+		sig, err := hex.DecodeString(s)
+		if err != nil {
+			continue
+		}
+		if hmac.Equal(sig, expected) {
+			return true
+		}
+	}
+	return false
+}
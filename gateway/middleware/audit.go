@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"hpkg/redact"
+
 	"github.com/gofiber/fiber/v3"
 	"github.com/redis/go-redis/v9"
 )
@@ -66,10 +68,10 @@ func AuditMiddleware(config AuditConfig) fiber.Handler {
 			username = fmt.Sprintf("%v", uname)
 		}
 
-		// Capture request body if needed
+		// Capture request body if needed, with card data masked
 		var bodyStr string
 		if config.LogBody && c.Method() != fiber.MethodGet {
-			bodyStr = string(c.Body())
+			bodyStr = string(redact.JSON(c.Body()))
 			// Limit body size to 1KB for storage
 			if len(bodyStr) > 1024 {
 				bodyStr = bodyStr[:1024] + "..."
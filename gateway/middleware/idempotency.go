@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"context"
+
+	errors "hpkg/constants/responses"
+	ctxkey "hpkg/grpc"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// IdempotencyMiddleware passes the client's Idempotency-Key header on to the
+// service, which replays its first response for any retry with the same key.
+// The header is optional. Must come after AuthMiddleware.
+func IdempotencyMiddleware() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		key := c.Get("Idempotency-Key")
+		if key == "" {
+			return c.Next()
+		}
+		if len(key) > ctxkey.MaxIdempotencyKeyLength {
+			return errors.Error(c, fiber.StatusBadRequest, errors.IdempotencyKeyInvalidCode, errors.IdempotencyKeyInvalidMsg)
+		}
+
+		ctx, ok := c.Locals("ctx").(context.Context)
+		if !ok || ctx == nil {
+			return errors.Error(c, fiber.StatusUnauthorized, errors.ErrUnauthorizedCode, errors.ErrUnauthorizedMsg)
+		}
+
+		c.Locals("ctx", context.WithValue(ctx, ctxkey.IdempotencyKey, key))
+		return c.Next()
+	}
+}
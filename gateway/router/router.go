@@ -1,6 +1,8 @@
 package router
 
 import (
+	"log"
+	"os"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
@@ -31,8 +33,14 @@ func Setup(app *fiber.App, clients *grpc.GRPCClients, redisCache *cache.RedisCac
 	RegisterShopRoutes(app, clients, redisCache)
 	// register for product route
 	RegisterProductRoutes(app, clients, redisCache)
+	// category and tag routes
+	RegisterCategoryRoutes(app, clients, redisCache)
+	RegisterTagRoutes(app, clients, redisCache)
+	RegisterVariantRoutes(app, clients, redisCache)
+	// order route
+	RegisterOrderRoutes(app, clients, redisCache)
 	// payment route
-	// RegisterPaymentRoutes(app, clients, auth, redisCache, redisCache)
+	RegisterPaymentRoutes(app, clients, redisCache)
 
 	app.Use(func(c fiber.Ctx) error {
 		return errs.Error(c, fiber.StatusUnauthorized, errs.ErrNotFoundCode, errs.ErrNotFoundMsg)
@@ -117,27 +125,186 @@ func RegisterProductRoutes(app *fiber.App, clients *grpc.GRPCClients, redisCache
 	api := app.Group("/api/products")
 
 	api.Get("", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), h.ListProductsByShop)
+	api.Get("/lookup", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), h.LookupByCode)
+	api.Get("/export", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), h.ExportProducts)
 	api.Get("/:id", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), h.GetProductByID)
-	api.Post("", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), h.CreateProduct)
-	api.Put("/:id", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), h.UpdateProduct)
-	api.Delete("/:id", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), h.DeleteProduct)
+	api.Post("", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermProductCreate"), h.CreateProduct)
+	api.Put("/:id", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermProductUpdate"), h.UpdateProduct)
+	api.Delete("/:id", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermProductDelete"), h.DeleteProduct)
+	api.Post("/stock", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermProductUpdate"), h.BulkUpdateStock)
+	// Import both creates and updates products
+	api.Post("/import", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermProductCreate", "PermProductUpdate"), h.ImportProducts)
+	api.Post("/:id/stock", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermProductUpdate"), h.UpdateStock)
+}
+
+func RegisterCategoryRoutes(app *fiber.App, clients *grpc.GRPCClients, redisCache *cache.RedisCache) {
+	h := handler.NewCategoryHandler(clients)
+	authCache := cache.NewAuthCache(redisCache, 10*time.Minute)
+	shopCache := cache.NewShopCache(redisCache, 10*time.Minute)
+
+	categories := app.Group("/api/categories",
+		mdw.AuthMiddleware(clients, authCache),
+		mdw.ShopMiddleware(clients.Shop, shopCache),
+	)
+
+	categories.Get("", h.ListCategories)
+	categories.Get("/tree", h.GetCategoryTree)
+	categories.Get("/:id", h.GetCategory)
+	categories.Get("/:id/products", h.GetCategoryProducts)
+	categories.Post("", mdw.PermissionMiddleware("PermProductCreate"), h.CreateCategory)
+	categories.Put("/:id", mdw.PermissionMiddleware("PermProductUpdate"), h.UpdateCategory)
+	categories.Delete("/:id", mdw.PermissionMiddleware("PermProductDelete"), h.DeleteCategory)
+}
+
+func RegisterTagRoutes(app *fiber.App, clients *grpc.GRPCClients, redisCache *cache.RedisCache) {
+	h := handler.NewTagHandler(clients)
+	authCache := cache.NewAuthCache(redisCache, 10*time.Minute)
+	shopCache := cache.NewShopCache(redisCache, 10*time.Minute)
+
+	tags := app.Group("/api/tags",
+		mdw.AuthMiddleware(clients, authCache),
+		mdw.ShopMiddleware(clients.Shop, shopCache),
+	)
+
+	tags.Get("", h.ListTags)
+	tags.Get("/:id", h.GetTag)
+	tags.Get("/:id/products", h.GetTagProducts)
+	tags.Post("", mdw.PermissionMiddleware("PermProductCreate"), h.CreateTag)
+	tags.Put("/:id", mdw.PermissionMiddleware("PermProductUpdate"), h.UpdateTag)
+	tags.Delete("/:id", mdw.PermissionMiddleware("PermProductDelete"), h.DeleteTag)
+
+	// A product's tags
+	products := app.Group("/api/products/:id/tags",
+		mdw.AuthMiddleware(clients, authCache),
+		mdw.ShopMiddleware(clients.Shop, shopCache),
+	)
+
+	products.Get("", h.GetProductTags)
+	products.Post("", mdw.PermissionMiddleware("PermProductUpdate"), h.AssignProductTags)
+	products.Delete("", mdw.PermissionMiddleware("PermProductUpdate"), h.RemoveProductTags)
+}
+
+func RegisterVariantRoutes(app *fiber.App, clients *grpc.GRPCClients, redisCache *cache.RedisCache) {
+	h := handler.NewVariantHandler(clients, redisCache)
+	authCache := cache.NewAuthCache(redisCache, 10*time.Minute)
+	shopCache := cache.NewShopCache(redisCache, 10*time.Minute)
+
+	// A product's options, e.g. size and color
+	options := app.Group("/api/products/:id/options",
+		mdw.AuthMiddleware(clients, authCache),
+		mdw.ShopMiddleware(clients.Shop, shopCache),
+	)
+
+	options.Get("", h.ListOptions)
+	options.Put("", mdw.PermissionMiddleware("PermProductUpdate"), h.SetOptions)
+
+	// A product's variants
+	products := app.Group("/api/products/:id/variants",
+		mdw.AuthMiddleware(clients, authCache),
+		mdw.ShopMiddleware(clients.Shop, shopCache),
+	)
+
+	products.Get("", h.ListVariants)
+	products.Post("", mdw.PermissionMiddleware("PermProductUpdate"), h.CreateVariant)
+	products.Post("/bulk", mdw.PermissionMiddleware("PermProductUpdate"), h.BulkCreateVariants)
+	products.Post("/generate", mdw.PermissionMiddleware("PermProductUpdate"), h.GenerateVariants)
+
+	variants := app.Group("/api/variants",
+		mdw.AuthMiddleware(clients, authCache),
+		mdw.ShopMiddleware(clients.Shop, shopCache),
+	)
+
+	variants.Get("/:id", h.GetVariant)
+	variants.Put("/:id", mdw.PermissionMiddleware("PermProductUpdate"), h.UpdateVariant)
+	variants.Delete("/:id", mdw.PermissionMiddleware("PermProductUpdate"), h.DeleteVariant)
+	variants.Post("/:id/stock", mdw.PermissionMiddleware("PermProductUpdate"), h.UpdateVariantStock)
+}
+
+func RegisterOrderRoutes(app *fiber.App, clients *grpc.GRPCClients, redisCache *cache.RedisCache) {
+	h := handler.NewOrderHandler(clients)
+	authCache := cache.NewAuthCache(redisCache, 10*time.Minute)
+	shopCache := cache.NewShopCache(redisCache, 10*time.Minute)
+
+	// Every order route is scoped to the shop in the X-Shop-Id header.
+	orders := app.Group("/api/orders",
+		mdw.AuthMiddleware(clients, authCache),
+		mdw.ShopMiddleware(clients.Shop, shopCache),
+	)
+
+	orders.Post("", mdw.PermissionMiddleware("PermOrderCreate"), mdw.IdempotencyMiddleware(), h.CreateOrder)
+	orders.Get("", mdw.PermissionMiddleware("PermOrderRead"), h.ListOrders)
+	orders.Get("/:id", mdw.PermissionMiddleware("PermOrderRead"), h.GetOrder)
+	orders.Patch("/:id/status", mdw.PermissionMiddleware("PermOrderCreate"), h.UpdateOrderStatus)
+	orders.Post("/:id/cancel", mdw.PermissionMiddleware("PermOrderCreate"), h.CancelOrder)
+	orders.Get("/:id/track", mdw.PermissionMiddleware("PermOrderRead"), h.TrackOrder)
+
+	// Shop exchange rates used to price and pay orders in other currencies
+	rates := app.Group("/api/exchange-rates",
+		mdw.AuthMiddleware(clients, authCache),
+		mdw.ShopMiddleware(clients.Shop, shopCache),
+	)
+
+	rates.Get("", mdw.PermissionMiddleware("PermOrderRead"), h.ListExchangeRates)
+	rates.Put("", mdw.PermissionMiddleware("PermExchangeRateUpdate"), h.SetExchangeRate)
 }
 
 func RegisterPaymentRoutes(app *fiber.App, clients *grpc.GRPCClients, redisCache *cache.RedisCache) {
 	authCache := cache.NewAuthCache(redisCache, 10*time.Minute)
+	shopCache := cache.NewShopCache(redisCache, 10*time.Minute)
 	h := handler.NewPaymentHandler(clients)
 
 	// Group for /payments
 	payments := app.Group("/api/payments")
 
-	payments.Post("/", mdw.AuthMiddleware(clients, authCache), mdw.PermissionMiddleware("PermPaymentCreate"), h.ProcessPayment)
+	payments.Post("/", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermPaymentCreate"), mdw.IdempotencyMiddleware(), h.ProcessPayment)
+
+	// Card tokenization; pay with the returned card_token
+	payments.Post("/cards", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermPaymentCreate"), h.TokenizeCard)
+
+	// Shop payment statistics; registered before /:payment_id so "stats" is
+	// not taken for an ID
+	payments.Get("/stats", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermPaymentStatsRead"), h.GetPaymentStats)
 
 	// Get payment info
-	payments.Get("/:payment_id", h.GetPayment)
+	payments.Get("/:payment_id", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermPaymentRead"), h.GetPayment)
+
+	// Refunds, full or partial, of a payment
+	payments.Post("/:payment_id/refunds", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermPaymentRefund"), h.RefundPayment)
+	payments.Get("/:payment_id/refunds", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermPaymentRead"), h.ListRefunds)
 
 	// Verify payment
-	payments.Post("/verify", mdw.AuthMiddleware(clients, authCache), h.VerifyPayment)
+	payments.Post("/verify", mdw.AuthMiddleware(clients, authCache), mdw.ShopMiddleware(clients.Shop, shopCache), mdw.PermissionMiddleware("PermPaymentRead"), h.VerifyPayment)
 
 	// Validate payment
 	payments.Post("/validate", mdw.AuthMiddleware(clients, authCache), h.ValidatePayment)
+
+	// Cash drawer shifts, scoped to the shop in the X-Shop-Id header. Reports
+	// check their permission in the payment service, as staff may read their
+	// own shift without PermCashShiftRead.
+	shifts := app.Group("/api/cash-shifts",
+		mdw.AuthMiddleware(clients, authCache),
+		mdw.ShopMiddleware(clients.Shop, shopCache),
+	)
+
+	shifts.Post("", mdw.PermissionMiddleware("PermCashShiftManage"), h.OpenCashShift)
+	shifts.Get("", mdw.PermissionMiddleware("PermCashShiftRead"), h.ListCashShifts)
+	// registered before /:id so "current" is not taken for an ID
+	shifts.Get("/current/report", h.GetCashShiftReport)
+	shifts.Get("/:id/report", h.GetCashShiftReport)
+	shifts.Post("/:id/movements", mdw.PermissionMiddleware("PermCashShiftManage"), h.RecordCashMovement)
+	shifts.Post("/:id/close", mdw.PermissionMiddleware("PermCashShiftManage"), h.CloseCashShift)
+
+	// Provider callbacks; authenticated by the provider's signature rather
+	// than a user token. Secrets come from PAYMENT_WEBHOOK_SECRETS, e.g.
+	// "simulator=secret1,bank_transfer=secret2".
+	tolerance := handler.DefaultWebhookTolerance
+	if v := os.Getenv("PAYMENT_WEBHOOK_TOLERANCE"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("invalid PAYMENT_WEBHOOK_TOLERANCE %q: %v", v, err)
+		}
+		tolerance = d
+	}
+	wh := handler.NewWebhookHandler(clients, handler.ParseWebhookSecrets(os.Getenv("PAYMENT_WEBHOOK_SECRETS")), tolerance)
+	app.Post("/api/webhooks/payments/:provider", wh.HandlePaymentWebhook)
 }
@@ -54,7 +54,7 @@ func ToGRPC(err error) HTTPError {
 		httpStatus = http.StatusBadRequest
 		code = extractMessage(st.Message()).Code
 		message = extractMessage(st.Message()).Message
-	case codes.AlreadyExists:
+	case codes.AlreadyExists, codes.Aborted:
 		httpStatus = http.StatusConflict
 		code = extractMessage(st.Message()).Code
 		message = extractMessage(st.Message()).Message
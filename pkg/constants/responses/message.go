@@ -134,6 +134,267 @@ const (
 
 	ErrProductCategoryNotFoundCode = "PRODUCT_CATEGORY_NOT_FOUND"
 	ErrProductCategoryNotFoundMsg  = "Product category not found"
+
+	ErrStockReservationNotFoundCode = "STOCK_RESERVATION_NOT_FOUND"
+	ErrStockReservationNotFoundMsg  = "Stock reservation not found"
+
+	ErrStockReservationExpiredCode = "STOCK_RESERVATION_EXPIRED"
+	ErrStockReservationExpiredMsg  = "Stock reservation has expired"
+
+	ErrStockReservationClosedCode = "STOCK_RESERVATION_CLOSED"
+	ErrStockReservationClosedMsg  = "Stock reservation was already released"
+
+	ErrCategoryInvalidCode = "CATEGORY_INVALID"
+	ErrCategoryInvalidMsg  = "Invalid category data provided"
+
+	ErrCategoryConflictCode = "CATEGORY_CONFLICT"
+	ErrCategoryConflictMsg  = "A category with this slug already exists"
+
+	ErrCategoryParentInvalidCode = "CATEGORY_PARENT_INVALID"
+	ErrCategoryParentInvalidMsg  = "A category cannot be placed under itself or one of its subcategories"
+
+	ErrTagNotFoundCode = "TAG_NOT_FOUND"
+	ErrTagNotFoundMsg  = "Tag not found"
+
+	ErrTagInvalidCode = "TAG_INVALID"
+	ErrTagInvalidMsg  = "Invalid tag data provided"
+
+	ErrTagConflictCode = "TAG_CONFLICT"
+	ErrTagConflictMsg  = "A tag with this slug already exists"
+
+	ErrVariantNotFoundCode = "VARIANT_NOT_FOUND"
+	ErrVariantNotFoundMsg  = "Product variant not found"
+
+	ErrVariantInvalidCode = "VARIANT_INVALID"
+	ErrVariantInvalidMsg  = "Invalid variant data provided"
+
+	ErrVariantConflictCode = "VARIANT_CONFLICT"
+	ErrVariantConflictMsg  = "A variant with this SKU or barcode already exists"
+
+	ErrVariantAttributesInvalidCode = "VARIANT_ATTRIBUTES_INVALID"
+	ErrVariantAttributesInvalidMsg  = "Variant attributes must give one value for each of the product's options"
+
+	ErrVariantAttributesConflictCode = "VARIANT_ATTRIBUTES_CONFLICT"
+	ErrVariantAttributesConflictMsg  = "A variant with these attributes already exists"
+
+	ErrVariantOptionInvalidCode = "VARIANT_OPTION_INVALID"
+	ErrVariantOptionInvalidMsg  = "Each option needs a unique name and at least one distinct value; a product takes up to 3 options of up to 50 values and 100 generated variants"
+
+	ErrBarcodeInvalidCode = "BARCODE_INVALID"
+	ErrBarcodeInvalidMsg  = "Barcode check digit is wrong, please scan again"
+
+	ErrImportInvalidCode = "IMPORT_INVALID"
+	ErrImportInvalidMsg  = "Format must be csv or xlsx, and an import needs a column mapped to sku"
+
+	ErrImportFileInvalidCode = "IMPORT_FILE_INVALID"
+	ErrImportFileInvalidMsg  = "File could not be read as CSV or XLSX"
+
+	ErrImportTooLargeCode = "IMPORT_TOO_LARGE"
+	ErrImportTooLargeMsg  = "File is too large to import, please split it"
+
+	// Row errors of an import report.
+	ErrImportSKUMissingMsg   = "SKU is required"
+	ErrImportSKUDuplicateMsg = "SKU appears more than once in the file"
+	ErrImportIncompleteMsg   = "Name and price are required for a new product"
+	ErrImportValueInvalidMsg = "Value is not valid for this column"
+)
+
+// ===== Order Errors =====
+const (
+	OrderNotFoundCode = "ORDER_NOT_FOUND"
+	OrderNotFoundMsg  = "Order not found"
+
+	OrderItemsRequiredCode = "ORDER_ITEMS_REQUIRED"
+	OrderItemsRequiredMsg  = "An order must contain at least one item"
+
+	OrderInvalidItemCode = "ORDER_INVALID_ITEM"
+	OrderInvalidItemMsg  = "Order item has an invalid product or quantity"
+
+	OrderPriceMismatchCode = "ORDER_PRICE_MISMATCH"
+	OrderPriceMismatchMsg  = "Submitted price does not match the catalog price"
+
+	OrderCreateFailedCode = "ORDER_CREATE_FAILED"
+	OrderCreateFailedMsg  = "Failed to create order"
+
+	OrderFetchFailedCode = "ORDER_FETCH_FAILED"
+	OrderFetchFailedMsg  = "Failed to fetch order"
+
+	OrderListFailedCode = "ORDER_LIST_FAILED"
+	OrderListFailedMsg  = "Failed to retrieve orders"
+
+	OrderUpdateFailedCode = "ORDER_UPDATE_FAILED"
+	OrderUpdateFailedMsg  = "Failed to update order"
+
+	OrderDeleteFailedCode = "ORDER_DELETE_FAILED"
+	OrderDeleteFailedMsg  = "Failed to delete order"
+
+	OrderInvalidStatusCode = "ORDER_INVALID_STATUS"
+	OrderInvalidStatusMsg  = "Unknown order status"
+
+	OrderCancelNotAllowedCode = "ORDER_CANCEL_NOT_ALLOWED"
+	OrderCancelNotAllowedMsg  = "Order can no longer be cancelled"
+
+	OrderInvalidTransitionCode = "ORDER_INVALID_TRANSITION"
+	OrderInvalidTransitionMsg  = "Order cannot move to the requested status"
+
+	OrderStatusConflictCode = "ORDER_STATUS_CONFLICT"
+	OrderStatusConflictMsg  = "Order status was changed by another request. Please retry"
+
+	OrderTaxFailedCode = "ORDER_TAX_FAILED"
+	OrderTaxFailedMsg  = "Failed to calculate tax for the order"
+
+	OrderAlreadyPaidCode = "ORDER_ALREADY_PAID"
+	OrderAlreadyPaidMsg  = "Order has already been paid in full"
+
+	OrderPaymentNotAllowedCode = "ORDER_PAYMENT_NOT_ALLOWED"
+	OrderPaymentNotAllowedMsg  = "Payments cannot be taken for a cancelled order"
+
+	OrderOverpaymentCode = "ORDER_OVERPAYMENT"
+	OrderOverpaymentMsg  = "Only cash may be tendered above the balance due"
+
+	OrderBalanceChangedCode = "ORDER_BALANCE_CHANGED"
+	OrderBalanceChangedMsg  = "Another payment reduced the balance due while this one was taken, so it was given back. Please retry"
+
+	OrderReturnNotAllowedCode = "ORDER_RETURN_NOT_ALLOWED"
+	OrderReturnNotAllowedMsg  = "Only confirmed, shipped or delivered orders can be returned"
+
+	OrderReturnQuantityExceededCode = "ORDER_RETURN_QUANTITY_EXCEEDED"
+	OrderReturnQuantityExceededMsg  = "Return quantity exceeds what is left to return on the order line"
+
+	OrderReturnFailedCode = "ORDER_RETURN_FAILED"
+	OrderReturnFailedMsg  = "Failed to create return"
+
+	ReturnNotFoundCode = "RETURN_NOT_FOUND"
+	ReturnNotFoundMsg  = "Return not found"
+
+	ReturnFetchFailedCode = "RETURN_FETCH_FAILED"
+	ReturnFetchFailedMsg  = "Failed to fetch returns"
+
+	StoreCreditNotFoundCode = "STORE_CREDIT_NOT_FOUND"
+	StoreCreditNotFoundMsg  = "Store credit not found"
+
+	StoreCreditInsufficientCode = "STORE_CREDIT_INSUFFICIENT"
+	StoreCreditInsufficientMsg  = "Store credit balance does not cover the amount"
+
+	OrderReceiptNotAvailableCode = "ORDER_RECEIPT_NOT_AVAILABLE"
+	OrderReceiptNotAvailableMsg  = "A receipt is only available once the order has been paid"
+
+	ReceiptTemplateInvalidCode = "RECEIPT_TEMPLATE_INVALID"
+	ReceiptTemplateInvalidMsg  = "Receipt template could not be parsed"
+
+	ReceiptRenderFailedCode = "RECEIPT_RENDER_FAILED"
+	ReceiptRenderFailedMsg  = "Failed to render receipt"
+
+	CheckoutFailedCode = "CHECKOUT_FAILED"
+	CheckoutFailedMsg  = "Checkout could not be completed and has been rolled back"
+)
+
+// ===== Payment Errors =====
+const (
+	ErrPaymentServiceCode = "PAYMENT_SERVICE_ERROR"
+	ErrPaymentServiceMsg  = "Payment service is unavailable. Please try again later"
+
+	PaymentNotFoundCode = "PAYMENT_NOT_FOUND"
+	PaymentNotFoundMsg  = "Payment not found"
+
+	PaymentInvalidCode = "PAYMENT_INVALID"
+	PaymentInvalidMsg  = "Payment request is missing an order, amount or method"
+
+	PaymentCreateFailedCode = "PAYMENT_CREATE_FAILED"
+	PaymentCreateFailedMsg  = "Failed to record payment"
+
+	PaymentDeclinedCode = "PAYMENT_DECLINED"
+	PaymentDeclinedMsg  = "Payment was not completed"
+
+	PaymentRefundNotAllowedCode = "PAYMENT_REFUND_NOT_ALLOWED"
+	PaymentRefundNotAllowedMsg  = "Payment cannot be refunded for that amount"
+
+	PaymentRefundReasonInvalidCode = "PAYMENT_REFUND_REASON_INVALID"
+	PaymentRefundReasonInvalidMsg  = "Refund reason code is not recognised"
+
+	PaymentMethodUnsupportedCode = "PAYMENT_METHOD_UNSUPPORTED"
+	PaymentMethodUnsupportedMsg  = "Payment method is not supported"
+
+	PaymentProviderUnavailableCode = "PAYMENT_PROVIDER_UNAVAILABLE"
+	PaymentProviderUnavailableMsg  = "Payment provider did not respond. Please try again later"
+
+	PaymentInvalidTransitionCode = "PAYMENT_INVALID_TRANSITION"
+	PaymentInvalidTransitionMsg  = "Payment cannot move to the requested status"
+
+	PaymentAuthorizationExpiredCode = "PAYMENT_AUTHORIZATION_EXPIRED"
+	PaymentAuthorizationExpiredMsg  = "Payment authorization has expired"
+
+	PaymentCaptureExceedsAuthorizationCode = "PAYMENT_CAPTURE_EXCEEDS_AUTHORIZATION"
+	PaymentCaptureExceedsAuthorizationMsg  = "Capture amount is more than the authorization allows"
+
+	LedgerMovementInvalidCode = "LEDGER_MOVEMENT_INVALID"
+	LedgerMovementInvalidMsg  = "Movement needs a known kind, a reference and a positive amount"
+
+	LedgerPostFailedCode = "LEDGER_POST_FAILED"
+	LedgerPostFailedMsg  = "Failed to record the movement in the ledger"
+
+	LedgerPeriodInvalidCode = "LEDGER_PERIOD_INVALID"
+	LedgerPeriodInvalidMsg  = "Reconciliation period must end after it starts"
+
+	CurrencyUnsupportedCode = "CURRENCY_UNSUPPORTED"
+	CurrencyUnsupportedMsg  = "Currency is not supported"
+
+	ExchangeRateInvalidCode = "EXCHANGE_RATE_INVALID"
+	ExchangeRateInvalidMsg  = "Exchange rate must be a positive decimal between two different currencies"
+
+	ExchangeRateNotFoundCode = "EXCHANGE_RATE_NOT_FOUND"
+	ExchangeRateNotFoundMsg  = "No exchange rate is set for this currency pair"
+
+	WebhookSignatureInvalidCode = "WEBHOOK_SIGNATURE_INVALID"
+	WebhookSignatureInvalidMsg  = "Webhook signature is missing, invalid or too old"
+
+	WebhookEventInvalidCode = "WEBHOOK_EVENT_INVALID"
+	WebhookEventInvalidMsg  = "Webhook event is malformed or from an unknown provider"
+
+	WebhookEventInProgressCode = "WEBHOOK_EVENT_IN_PROGRESS"
+	WebhookEventInProgressMsg  = "This webhook event is still being processed. Please retry"
+
+	CardInvalidCode = "CARD_INVALID"
+	CardInvalidMsg  = "Card number, expiry date or security code is invalid"
+
+	CardRequiredCode = "CARD_REQUIRED"
+	CardRequiredMsg  = "Card payments need a card token"
+
+	CardTokenNotFoundCode = "CARD_TOKEN_NOT_FOUND"
+	CardTokenNotFoundMsg  = "Card token not found"
+
+	PaymentStatsQueryInvalidCode = "PAYMENT_STATS_QUERY_INVALID"
+	PaymentStatsQueryInvalidMsg  = "Statistics need a daily, weekly or monthly period, a known time zone and a range of at most 366 periods"
+)
+
+// ===== Cash Drawer Errors =====
+const (
+	CashShiftInvalidCode = "CASH_SHIFT_INVALID"
+	CashShiftInvalidMsg  = "Cash shift needs a known currency and amounts of zero or more"
+
+	CashShiftAlreadyOpenCode = "CASH_SHIFT_ALREADY_OPEN"
+	CashShiftAlreadyOpenMsg  = "You already have a cash shift open in this currency"
+
+	CashShiftNotFoundCode = "CASH_SHIFT_NOT_FOUND"
+	CashShiftNotFoundMsg  = "Cash shift not found"
+
+	CashShiftClosedCode = "CASH_SHIFT_CLOSED"
+	CashShiftClosedMsg  = "Cash shift is already closed"
+
+	CashMovementInvalidCode = "CASH_MOVEMENT_INVALID"
+	CashMovementInvalidMsg  = "Cash movement needs cash_in or cash_out and a positive amount"
+)
+
+// ===== Idempotency Errors =====
+const (
+	IdempotencyKeyInvalidCode = "IDEMPOTENCY_KEY_INVALID"
+	IdempotencyKeyInvalidMsg  = "Idempotency key must be between 1 and 255 characters"
+
+	IdempotencyKeyMismatchCode = "IDEMPOTENCY_KEY_MISMATCH"
+	IdempotencyKeyMismatchMsg  = "Idempotency key was already used with a different request"
+
+	IdempotencyKeyInProgressCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IdempotencyKeyInProgressMsg  = "A request with this idempotency key is still being processed"
 )
 
 // ===== Success Responses =====
@@ -2,11 +2,15 @@ package grpc
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 
 	err "hpkg/constants/responses"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func MustGetUserID(ctx context.Context) (string, error) {
@@ -26,3 +30,42 @@ func MustGetShopID(ctx context.Context) (string, error) {
 
 	return shopID, nil
 }
+
+// HasPermission reports whether the caller was granted perm by the gateway
+func HasPermission(ctx context.Context, perm string) bool {
+	perms, _ := ctx.Value(PermissionsKey).([]string)
+	for _, p := range perms {
+		if p == perm {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetIdempotencyKey returns the idempotency key sent with the call, or "" when
+// the caller did not send one.
+func GetIdempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	keys := md.Get(IdempotencyMetadataKey)
+	if len(keys) == 0 {
+		return ""
+	}
+
+	return keys[0]
+}
+
+// RequestHash fingerprints a request, so that a reused idempotency key can be
+// told apart from a genuine retry of the same request.
+func RequestHash(req proto.Message) (string, error) {
+	b, e := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if e != nil {
+		return "", e
+	}
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -9,4 +9,17 @@ const (
 
 	// UserIDKey can be used to store user ID if needed
 	UserIDKey contextKey = "user_id"
+
+	// PermissionsKey stores the caller's permissions forwarded by the gateway
+	PermissionsKey contextKey = "permissions"
+
+	// IdempotencyKey stores the client's Idempotency-Key header in the gateway
+	IdempotencyKey contextKey = "idempotency_key"
 )
+
+// IdempotencyMetadataKey is the gRPC metadata key carrying an idempotency key.
+// It applies to a single call and is not forwarded to downstream services.
+const IdempotencyMetadataKey = "idempotency-key"
+
+// MaxIdempotencyKeyLength is the longest idempotency key services accept.
+const MaxIdempotencyKeyLength = 255
@@ -0,0 +1,37 @@
+package interceptor
+
+import (
+	"context"
+
+	ctxkey "hpkg/grpc"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ContextUnaryServerInterceptor attaches the shop ID, user ID and permissions
+// forwarded in metadata to the context, like ShopUnaryServerInterceptor, but
+// without requiring any of them. It suits services called both for a shop and
+// without one; handlers that need a shop check with MustGetShopID.
+func ContextUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if shopIDs := md.Get("x-shop-id"); len(shopIDs) > 0 && shopIDs[0] != "" {
+			ctx = context.WithValue(ctx, ctxkey.ShopIDKey, shopIDs[0])
+		}
+		if userIDs := md.Get("x-user-id"); len(userIDs) > 0 && userIDs[0] != "" {
+			ctx = context.WithValue(ctx, ctxkey.UserIDKey, userIDs[0])
+		}
+		if perms := md.Get("x-permissions"); len(perms) > 0 {
+			ctx = context.WithValue(ctx, ctxkey.PermissionsKey, perms)
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"log"
 	"time"
 
+	"hpkg/redact"
+
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 // Logger interface for dependency injection
@@ -29,11 +32,14 @@ func LoggingUnaryInterceptor() grpc.UnaryServerInterceptor {
 		duration := time.Since(start)
 
 		if err != nil {
+			// The request helps tell why a call failed; card fields are
+			// masked so card data never reaches the logs.
 			log.Printf(
-				"❌ %s | %v | %v",
+				"❌ %s | %v | %v | %v",
 				info.FullMethod,
 				duration,
 				err,
+				redactedRequest(req),
 			)
 		} else {
 			log.Printf(
@@ -46,3 +52,10 @@ func LoggingUnaryInterceptor() grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+func redactedRequest(req any) any {
+	if m, ok := req.(proto.Message); ok {
+		return redact.Proto(m)
+	}
+	return "-"
+}
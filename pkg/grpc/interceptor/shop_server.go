@@ -52,7 +52,10 @@ func ShopUnaryServerInterceptor(logger Logger) grpc.UnaryServerInterceptor {
 		if userID != "" {
 			ctx = context.WithValue(ctx, ctxkey.UserIDKey, userID)
 		}
-		ctx = metadata.NewOutgoingContext(ctx, md) // preserve metadata
+		if perms := md.Get("x-permissions"); len(perms) > 0 {
+			ctx = context.WithValue(ctx, ctxkey.PermissionsKey, perms)
+		}
+		ctx = metadata.NewOutgoingContext(ctx, forwardedMetadata(md)) // preserve metadata
 
 		// Call the actual handler
 		resp, err := handler(ctx, req)
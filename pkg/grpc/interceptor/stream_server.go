@@ -0,0 +1,148 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	ctxkey "hpkg/grpc"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// contextStream is a server stream whose Context carries what the stream
+// interceptors attached.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// UserStreamServerInterceptor is UserUnaryServerInterceptor for streaming
+// RPCs.
+func UserStreamServerInterceptor(logger Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+
+		startTime := time.Now()
+		method := info.FullMethod
+		ctx := ss.Context()
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			logger.Warn("missing metadata", "method", method)
+			return status.Error(codes.Unauthenticated, "missing metadata")
+		}
+
+		userIDs := md.Get("x-user-id")
+		if len(userIDs) == 0 || userIDs[0] == "" {
+			logger.Warn("missing user ID", "method", method)
+			return status.Error(codes.Unauthenticated, "user not authenticated")
+		}
+		userID := userIDs[0]
+
+		ctx = context.WithValue(ctx, ctxkey.UserIDKey, userID)
+		if perms := md.Get("x-permissions"); len(perms) > 0 {
+			ctx = context.WithValue(ctx, ctxkey.PermissionsKey, perms)
+		}
+		ctx = metadata.NewOutgoingContext(ctx, forwardedMetadata(md))
+
+		err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+
+		duration := time.Since(startTime)
+		if err != nil {
+			st, _ := status.FromError(err)
+			logger.Error("interceptor: user stream failed",
+				"method", method,
+				"user_id", userID,
+				"error", err.Error(),
+				"code", st.Code().String(),
+				"duration_ms", duration.Milliseconds(),
+			)
+			return err
+		}
+
+		logger.Info("interceptor: user stream completed",
+			"method", method,
+			"user_id", userID,
+			"duration_ms", duration.Milliseconds(),
+		)
+
+		return nil
+	}
+}
+
+// ShopStreamServerInterceptor is ShopUnaryServerInterceptor for streaming
+// RPCs.
+func ShopStreamServerInterceptor(logger Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+
+		startTime := time.Now()
+		method := info.FullMethod
+		ctx := ss.Context()
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			logger.Warn("missing metadata", "method", method)
+			return status.Error(codes.Unauthenticated, "missing metadata")
+		}
+
+		shopIDs := md.Get("x-shop-id")
+		if len(shopIDs) == 0 || shopIDs[0] == "" {
+			logger.Warn("missing shop ID", "method", method)
+			return status.Error(codes.Unauthenticated, "shop not authenticated")
+		}
+		shopID := shopIDs[0]
+
+		userID := ""
+		if userIDs := md.Get("x-user-id"); len(userIDs) > 0 {
+			userID = userIDs[0]
+		}
+
+		logger.Info("interceptor: incoming stream",
+			"method", method,
+			"shop_id", shopID,
+			"user_id", userID,
+		)
+
+		ctx = context.WithValue(ctx, ctxkey.ShopIDKey, shopID)
+		if userID != "" {
+			ctx = context.WithValue(ctx, ctxkey.UserIDKey, userID)
+		}
+		if perms := md.Get("x-permissions"); len(perms) > 0 {
+			ctx = context.WithValue(ctx, ctxkey.PermissionsKey, perms)
+		}
+		ctx = metadata.NewOutgoingContext(ctx, forwardedMetadata(md))
+
+		err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+
+		duration := time.Since(startTime)
+		if err != nil {
+			st, _ := status.FromError(err)
+			logger.Error("interceptor: stream failed",
+				"method", method,
+				"shop_id", shopID,
+				"user_id", userID,
+				"error", err.Error(),
+				"code", st.Code().String(),
+				"duration_ms", duration.Milliseconds(),
+			)
+			return err
+		}
+
+		logger.Info("interceptor: stream completed",
+			"method", method,
+			"shop_id", shopID,
+			"user_id", userID,
+			"duration_ms", duration.Milliseconds(),
+		)
+
+		return nil
+	}
+}
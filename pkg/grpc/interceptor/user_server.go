@@ -37,7 +37,10 @@ func UserUnaryServerInterceptor(logger Logger) grpc.UnaryServerInterceptor {
 
 		// Attach userID to context
 		ctx = context.WithValue(ctx, ctxkey.UserIDKey, userID)
-		ctx = metadata.NewOutgoingContext(ctx, md)
+		if perms := md.Get("x-permissions"); len(perms) > 0 {
+			ctx = context.WithValue(ctx, ctxkey.PermissionsKey, perms)
+		}
+		ctx = metadata.NewOutgoingContext(ctx, forwardedMetadata(md))
 
 		logger.Debug("interceptor: user validated",
 			"method", method,
@@ -70,3 +73,12 @@ func UserUnaryServerInterceptor(logger Logger) grpc.UnaryServerInterceptor {
 		return resp, nil
 	}
 }
+
+// forwardedMetadata is the incoming metadata minus what applies to this call
+// only. An idempotency key belongs to the call it came with; forwarding it
+// would make the service's own downstream calls look like retries.
+func forwardedMetadata(md metadata.MD) metadata.MD {
+	md = md.Copy()
+	md.Delete(ctxkey.IdempotencyMetadataKey)
+	return md
+}
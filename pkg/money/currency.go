@@ -0,0 +1,52 @@
+package money
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrUnknownCurrency is returned for a currency code missing from the table.
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// Currency describes how amounts of a currency are counted and rounded.
+type Currency struct {
+	Code string
+	// Exponent is the number of minor unit digits ISO 4217 gives the
+	// currency: 2 for USD cents, 0 for JPY.
+	Exponent int
+	// Increment is the smallest amount, in minor units, that changes hands.
+	// Totals and converted amounts are rounded to it. It is 1 for most
+	// currencies; riel has no coins, so KHR rounds to 100 riel.
+	Increment int64
+}
+
+var currencies = map[string]Currency{
+	"USD": {Code: "USD", Exponent: 2, Increment: 1},
+	"EUR": {Code: "EUR", Exponent: 2, Increment: 1},
+	"GBP": {Code: "GBP", Exponent: 2, Increment: 1},
+	"AUD": {Code: "AUD", Exponent: 2, Increment: 1},
+	"SGD": {Code: "SGD", Exponent: 2, Increment: 1},
+	"CNY": {Code: "CNY", Exponent: 2, Increment: 1},
+	"THB": {Code: "THB", Exponent: 2, Increment: 1},
+	"KHR": {Code: "KHR", Exponent: 2, Increment: 100 * 100},
+	"VND": {Code: "VND", Exponent: 0, Increment: 1},
+	"JPY": {Code: "JPY", Exponent: 0, Increment: 1},
+}
+
+// Lookup returns the currency with the given ISO 4217 code, in any case.
+func Lookup(code string) (Currency, error) {
+	c, ok := currencies[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, ErrUnknownCurrency
+	}
+	return c, nil
+}
+
+// scale returns 10^Exponent, the number of minor units in one major unit.
+func (c Currency) scale() int64 {
+	s := int64(1)
+	for i := 0; i < c.Exponent; i++ {
+		s *= 10
+	}
+	return s
+}
@@ -0,0 +1,186 @@
+// Package money does exact arithmetic on amounts of money. An amount is held
+// as a whole number of the currency's minor units, so adding up prices never
+// drifts the way float64 does; rounding happens only where the currency's
+// rules say it must.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"hpkg/money/moneypb"
+)
+
+var (
+	// ErrCurrencyMismatch is returned when amounts in different currencies
+	// are combined.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	// ErrInvalidAmount is returned for an amount that is not a decimal number.
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrInvalidRate is returned for an exchange rate that is not a positive
+	// decimal number.
+	ErrInvalidRate = errors.New("invalid exchange rate")
+)
+
+// Money is Amount minor units of Currency, e.g. {1050, "USD"} is $10.50.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// New returns amount minor units of currency.
+func New(amount int64, currency string) (Money, error) {
+	c, err := Lookup(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: c.Code}, nil
+}
+
+// Parse reads a decimal amount in major units, such as "10.50", rounding it
+// half away from zero to the currency's minor unit.
+func Parse(amount, currency string) (Money, error) {
+	c, err := Lookup(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok {
+		return Money{}, ErrInvalidAmount
+	}
+	return Money{Amount: roundRat(r.Mul(r, big.NewRat(c.scale(), 1)), 1), Currency: c.Code}, nil
+}
+
+// FromFloat converts an amount held as a float64 in major units, as the
+// older double fields carry it. The float is read at its shortest decimal
+// form, so 0.1 is 10 cents and not a hair under.
+func FromFloat(amount float64, currency string) (Money, error) {
+	return Parse(strconv.FormatFloat(amount, 'f', -1, 64), currency)
+}
+
+// Float returns the amount in major units, for the double fields kept for
+// older clients. Do not do arithmetic on the result.
+func (m Money) Float() float64 {
+	c, err := Lookup(m.Currency)
+	if err != nil {
+		return 0
+	}
+	f, _ := new(big.Rat).SetFrac64(m.Amount, c.scale()).Float64()
+	return f
+}
+
+// Decimal returns the amount in major units with all its minor digits, e.g.
+// "10.50".
+func (m Money) Decimal() string {
+	c, err := Lookup(m.Currency)
+	if err != nil {
+		return strconv.FormatInt(m.Amount, 10)
+	}
+	return new(big.Rat).SetFrac64(m.Amount, c.scale()).FloatString(c.Exponent)
+}
+
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Add returns m + o. Both must be in the same currency.
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+	return Money{Amount: m.Amount + o.Amount, Currency: m.Currency}, nil
+}
+
+// Sub returns m - o. Both must be in the same currency.
+func (m Money) Sub(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+	return Money{Amount: m.Amount - o.Amount, Currency: m.Currency}, nil
+}
+
+// Mul returns m times n, e.g. a unit price times a quantity.
+func (m Money) Mul(n int64) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
+
+// Round rounds m half away from zero to its currency's increment, e.g. KHR
+// to the nearest 100 riel. Amounts in most currencies are returned as they are.
+func (m Money) Round() Money {
+	c, err := Lookup(m.Currency)
+	if err != nil || c.Increment <= 1 {
+		return m
+	}
+	return Money{Amount: roundRat(big.NewRat(m.Amount, 1), c.Increment), Currency: m.Currency}
+}
+
+// Convert returns m in currency to, at rate units of to per unit of m's
+// currency, rounded by to's rules.
+func (m Money) Convert(to string, rate *big.Rat) (Money, error) {
+	from, err := Lookup(m.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+	target, err := Lookup(to)
+	if err != nil {
+		return Money{}, err
+	}
+	if rate == nil || rate.Sign() <= 0 {
+		return Money{}, ErrInvalidRate
+	}
+
+	r := new(big.Rat).SetFrac64(m.Amount, from.scale())
+	r.Mul(r, rate)
+	r.Mul(r, big.NewRat(target.scale(), 1))
+	return Money{Amount: roundRat(r, target.Increment), Currency: target.Code}, nil
+}
+
+// ParseRate reads an exchange rate such as "4100" or "0.000244".
+func ParseRate(s string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok || r.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+	return r, nil
+}
+
+// roundRat rounds r half away from zero to a multiple of increment.
+func roundRat(r *big.Rat, increment int64) int64 {
+	q := new(big.Rat).Quo(r, big.NewRat(increment, 1))
+	num, den := q.Num(), q.Denom()
+
+	whole, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	// Round up when twice the remainder reaches the denominator.
+	if new(big.Int).Abs(new(big.Int).Mul(rem, big.NewInt(2))).Cmp(den) >= 0 {
+		if num.Sign() < 0 {
+			whole.Sub(whole, big.NewInt(1))
+		} else {
+			whole.Add(whole, big.NewInt(1))
+		}
+	}
+	return whole.Int64() * increment
+}
+
+// ToProto returns m as a money.Money message.
+func ToProto(m Money) *moneypb.Money {
+	return &moneypb.Money{Amount: m.Amount, Currency: m.Currency}
+}
+
+// FromProto reads a money.Money message, checking its currency.
+func FromProto(p *moneypb.Money) (Money, error) {
+	if p == nil {
+		return Money{}, ErrInvalidAmount
+	}
+	return New(p.Amount, p.Currency)
+}
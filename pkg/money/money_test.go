@@ -0,0 +1,137 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		want     Money
+		err      error
+	}{
+		{"cents", "10.50", "USD", Money{1050, "USD"}, nil},
+		{"lower case code", "1", "usd", Money{100, "USD"}, nil},
+		{"half rounds up", "0.005", "USD", Money{1, "USD"}, nil},
+		{"below half rounds down", "0.0049", "USD", Money{0, "USD"}, nil},
+		{"negative half rounds away from zero", "-0.005", "USD", Money{-1, "USD"}, nil},
+		{"no minor unit", "1500.4", "JPY", Money{1500, "JPY"}, nil},
+		{"riel keeps its minor digits", "4100.25", "KHR", Money{410025, "KHR"}, nil},
+		{"not a number", "ten", "USD", Money{}, ErrInvalidAmount},
+		{"unknown currency", "1", "XXX", Money{}, ErrUnknownCurrency},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.amount, tt.currency)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Parse(%q, %q) error = %v, want %v", tt.amount, tt.currency, err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q, %q) = %v, want %v", tt.amount, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		currency string
+		want     int64
+	}{
+		{0.1, "USD", 10},
+		{0.1 + 0.2, "USD", 30},
+		{19.99, "USD", 1999},
+		{1.005, "USD", 101},
+		{2.675, "USD", 268},
+		{1234.5, "JPY", 1235},
+	}
+	for _, tt := range tests {
+		got, err := FromFloat(tt.amount, tt.currency)
+		if err != nil {
+			t.Fatalf("FromFloat(%v, %q): %v", tt.amount, tt.currency, err)
+		}
+		if got.Amount != tt.want {
+			t.Errorf("FromFloat(%v, %q) = %d, want %d", tt.amount, tt.currency, got.Amount, tt.want)
+		}
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Money
+		want Money
+	}{
+		{"dollars are left alone", Money{1234, "USD"}, Money{1234, "USD"}},
+		{"riel down to 100", Money{414900, "KHR"}, Money{410000, "KHR"}},
+		{"riel half up to 100", Money{415000, "KHR"}, Money{420000, "KHR"}},
+		{"riel above half", Money{415001, "KHR"}, Money{420000, "KHR"}},
+		{"riel already whole", Money{1000000, "KHR"}, Money{1000000, "KHR"}},
+		{"negative riel away from zero", Money{-415000, "KHR"}, Money{-420000, "KHR"}},
+		{"unknown currency is left alone", Money{12345, "XXX"}, Money{12345, "XXX"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.in.Round(); got != tt.want {
+				t.Errorf("%v.Round() = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Money
+		to   string
+		rate string
+		want Money
+	}{
+		{"dollars to riel rounds to 100 riel", Money{1234, "USD"}, "KHR", "4100", Money{5060000, "KHR"}},
+		{"riel to dollars rounds to the cent", Money{1000000, "KHR"}, "USD", "0.000244", Money{244, "USD"}},
+		{"dollars to yen", Money{1050, "USD"}, "JPY", "150.5", Money{1580, "JPY"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := ParseRate(tt.rate)
+			if err != nil {
+				t.Fatalf("ParseRate(%q): %v", tt.rate, err)
+			}
+			got, err := tt.in.Convert(tt.to, rate)
+			if err != nil {
+				t.Fatalf("Convert: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("%v.Convert(%q, %s) = %v, want %v", tt.in, tt.to, tt.rate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddCurrencyMismatch(t *testing.T) {
+	_, err := Money{100, "USD"}.Add(Money{100, "EUR"})
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add across currencies error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		in   Money
+		want string
+	}{
+		{Money{1050, "USD"}, "10.50"},
+		{Money{5, "USD"}, "0.05"},
+		{Money{-5, "USD"}, "-0.05"},
+		{Money{1500, "JPY"}, "1500"},
+	}
+	for _, tt := range tests {
+		if got := tt.in.Decimal(); got != tt.want {
+			t.Errorf("%#v.Decimal() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Package redact masks card data before requests are logged or audited.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Mask replaces the value of every redacted field.
+const Mask = "[REDACTED]"
+
+// fields are the names of card fields, lower-cased with underscores and
+// dashes removed so card_number, cardNumber and card-number all match.
+var fields = map[string]bool{
+	"cardnumber":   true,
+	"cardholder":   true,
+	"expirymonth":  true,
+	"expiryyear":   true,
+	"cvv":          true,
+	"cvv2":         true,
+	"cvc":          true,
+	"pan":          true,
+	"securitycode": true,
+}
+
+// IsSensitive reports whether a field called name holds card data.
+func IsSensitive(name string) bool {
+	return fields[normalize(name)]
+}
+
+func normalize(name string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+}
+
+// Proto returns a copy of m with its card fields masked, at any depth. String
+// fields are set to Mask, so a log still shows the field was sent; other
+// fields are cleared. m itself is left untouched.
+func Proto(m proto.Message) proto.Message {
+	if m == nil {
+		return nil
+	}
+	c := proto.Clone(m)
+	redactMessage(c.ProtoReflect())
+	return c
+}
+
+func redactMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if IsSensitive(string(fd.Name())) {
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+				m.Set(fd, protoreflect.ValueOfString(Mask))
+			} else {
+				m.Clear(fd)
+			}
+			return true
+		}
+
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redactMessage(mv.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			redactMessage(v.Message())
+		}
+		return true
+	})
+}
+
+// JSON returns body with the values of its card fields masked, at any depth.
+// A body that is not JSON is returned as it is, unless it mentions a card
+// field, in which case only Mask is returned.
+func JSON(body []byte) []byte {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		lower := bytes.ToLower(body)
+		for _, name := range []string{"card", "cvv", "cvc"} {
+			if bytes.Contains(lower, []byte(name)) {
+				return []byte(Mask)
+			}
+		}
+		return body
+	}
+
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return []byte(Mask)
+	}
+	return b
+}
+
+func redactValue(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, fv := range t {
+			if IsSensitive(k) {
+				t[k] = Mask
+				continue
+			}
+			t[k] = redactValue(fv)
+		}
+	case []any:
+		for i, e := range t {
+			t[i] = redactValue(e)
+		}
+	}
+	return v
+}
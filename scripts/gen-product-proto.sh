@@ -61,16 +61,22 @@ fi
 # Generate Code
 # -----------------------------------------
 
-echo "🔧 Generating Product, Variant, and Tag protos..."
-# We include all three files together because they are in the same package 
-# and reference each other.
+echo "🔧 Generating Product v1, Category, Tag and Variant protos..."
 protoc -I="$PROTO_DIR" \
   --go_out="$ROOT_DIR/services/product-service" \
   --go-grpc_out="$ROOT_DIR/services/product-service" \
-   "$PROTO_DIR/product/tag.dev.proto"
-  # "$PROTO_DIR/product/product.dev.proto"
-  # "$PROTO_DIR/product/variant.dev.proto" \
-  # "$PROTO_DIR/product/category.dev.proto" \
-  # "$PROTO_DIR/product/tag.dev.proto"
+  "$PROTO_DIR/product/product.dev.proto" \
+  "$PROTO_DIR/product/category.dev.proto"
+
+# The mapping tells the protos that import product.dev.proto where its Go
+# package lives.
+PRODUCT_V1="Mproduct/product.dev.proto=productservice/proto/v1/productpb"
+protoc -I="$PROTO_DIR" \
+  --go_out="$ROOT_DIR/services/product-service" \
+  --go_opt="$PRODUCT_V1" \
+  --go-grpc_out="$ROOT_DIR/services/product-service" \
+  --go-grpc_opt="$PRODUCT_V1" \
+  "$PROTO_DIR/product/tag.dev.proto" \
+  "$PROTO_DIR/product/variant.dev.proto"
 
 echo "✅ Proto product generation complete"
\ No newline at end of file
@@ -10,9 +10,16 @@ var RolePermissions = map[string][]string{
 		"PermPaymentCreate",
 		"PermPaymentRead",
 		"PermPaymentRefund",
+		"PermPaymentStatsRead",
+		"PermCashShiftManage",
+		"PermCashShiftRead",
 
 		"PermOrderCreate",
 		"PermOrderRead",
+		"PermOrderPriceOverride",
+		"PermReceiptTemplateUpdate",
+		"PermExchangeRateUpdate",
+		"PermOrderReturn",
 	},
 
 	"MERCHANT": {
@@ -21,7 +28,13 @@ var RolePermissions = map[string][]string{
 		"PermProductUpdate",
 
 		"PermPaymentRead",
+		"PermPaymentStatsRead",
+		"PermCashShiftManage",
+		"PermCashShiftRead",
 		"PermOrderRead",
+		"PermReceiptTemplateUpdate",
+		"PermExchangeRateUpdate",
+		"PermOrderReturn",
 	},
 
 	"USER": {
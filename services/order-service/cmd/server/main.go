@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"net"
+	"os"
+	"time"
+
+	"orderservice/grpc"
+	"orderservice/internal/handler"
+	"orderservice/internal/infrastructure/persistence"
+	"orderservice/internal/service"
+	"orderservice/internal/tax"
+	"orderservice/proto/orderpb"
+
+	"hpkg/db"
+
+	grpcpkg "google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"hpkg/grpc/interceptor"
+)
+
+func main() {
+	listener, err := net.Listen("tcp", ":50052")
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+
+	db := db.ConnectPostgreSQLDB()
+	defer db.Close()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, nil))
+
+	products, err := grpc.NewProductClient("localhost:50051")
+	if err != nil {
+		log.Fatalf("Failed to connect to product service: %v", err)
+	}
+
+	payments, err := grpc.NewPaymentClient("localhost:50053")
+	if err != nil {
+		log.Fatalf("Failed to connect to payment service: %v", err)
+	}
+
+	shops, err := grpc.NewShopClient("localhost:50058")
+	if err != nil {
+		log.Fatalf("Failed to connect to shop service: %v", err)
+	}
+
+	grpcServer := grpcpkg.NewServer(grpcpkg.ChainUnaryInterceptor(
+		interceptor.RecoveryUnaryInterceptor(),
+		interceptor.LoggingUnaryInterceptor(),
+		interceptor.ShopUnaryServerInterceptor(logger),
+	))
+
+	// dependencies
+	repo := persistence.NewPostgresOrderRepository(db, logger)
+	checkouts := persistence.NewPostgresCheckoutRepository(db, logger)
+	receipts := persistence.NewPostgresReceiptTemplateRepository(db, logger)
+	returns := persistence.NewPostgresReturnRepository(db, logger)
+	idempotency := persistence.NewPostgresIdempotencyRepository(db, logger)
+	rates := persistence.NewPostgresExchangeRateRepository(db, logger)
+
+	// tax rules come from TAX_RULES_FILE when set, otherwise from the database
+	var taxStore tax.Store = persistence.NewPostgresTaxRepository(db, logger)
+	if path := os.Getenv("TAX_RULES_FILE"); path != "" {
+		fileStore, err := tax.NewFileStore(path)
+		if err != nil {
+			log.Fatalf("Failed to load tax rules: %v", err)
+		}
+		taxStore = fileStore
+	}
+
+	svc := service.NewOrderService(repo, checkouts, receipts, returns, idempotency, rates, products, products, payments, shops, tax.NewEngine(taxStore), logger)
+	h := handler.NewOrderHandler(svc)
+
+	// finish or roll back checkouts interrupted by the last shutdown
+	svc.ResumeCheckouts(context.Background())
+	go svc.PurgeIdempotencyKeys(context.Background(), time.Hour)
+
+	// Register service
+	orderpb.RegisterOrderServiceServer(grpcServer, h)
+
+	//Enable reflection (dev only)
+	reflection.Register(grpcServer)
+
+	log.Println("Order Service listening on :50052")
+
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+}
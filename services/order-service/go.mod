@@ -3,6 +3,7 @@ module orderservice
 go 1.25.5
 
 require (
+	github.com/google/uuid v1.6.0
 	google.golang.org/grpc v1.78.0
 	google.golang.org/protobuf v1.36.11
 )
@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	reqCtx "hpkg/grpc"
+	paymentpb "paymentservice/proto/paymentpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const permPaymentRefund = "PermPaymentRefund"
+
+type PaymentClient struct {
+	client paymentpb.PaymentServiceClient
+}
+
+func NewPaymentClient(addr string) (*PaymentClient, error) {
+	conn, err := grpc.Dial(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PaymentClient{
+		client: paymentpb.NewPaymentServiceClient(conn),
+	}, nil
+}
+
+func (p *PaymentClient) ProcessPayment(ctx context.Context, req *paymentpb.ProcessPaymentRequest) (*paymentpb.ProcessPaymentResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	ctx = forwardCaller(ctx)
+	return p.client.ProcessPayment(ctx, req)
+}
+
+// RefundPayment refunds amount of a payment, or all that is left of it when
+// amount is zero.
+//
+// The order service refunds on its own authority, as part of a return or
+// cancellation the caller was allowed to make or while undoing a checkout, so
+// it grants the call the refund permission the caller may lack.
+func (p *PaymentClient) RefundPayment(ctx context.Context, paymentID string, amount float64, reasonCode, reason string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	ctx = forwardCaller(ctx)
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-permissions", permPaymentRefund)
+	_, err := p.client.RefundPayment(ctx, &paymentpb.RefundPaymentRequest{
+		PaymentId:    paymentID,
+		RefundAmount: amount,
+		Reason:       reason,
+		ReasonCode:   reasonCode,
+	})
+	return err
+}
+
+// RecordStoreCreditMovement posts a store credit movement to the payment
+// ledger. amount is in currency. Recording the same kind and reference again
+// is a no-op.
+func (p *PaymentClient) RecordStoreCreditMovement(ctx context.Context, kind, referenceID, orderID string, amount float64, currency string) error {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	ctx = forwardCaller(ctx)
+	_, err := p.client.RecordStoreCreditMovement(ctx, &paymentpb.RecordStoreCreditMovementRequest{
+		Kind:        kind,
+		ReferenceId: referenceID,
+		OrderId:     orderID,
+		Amount:      amount,
+		Currency:    currency,
+	})
+	return err
+}
+
+func (p *PaymentClient) ListOrderPayments(ctx context.Context, orderID string) ([]*paymentpb.Payment, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	ctx = forwardCaller(ctx)
+	resp, err := p.client.ListOrderPayments(ctx, &paymentpb.ListOrderPaymentsRequest{
+		OrderId: orderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payments, nil
+}
+
+// forwardCaller passes the shop and user the order service is acting for on
+// to the payment service, which scopes payments to the shop and counts cash
+// taken by a staff member towards their open cash shift. Metadata already set
+// for the call, e.g. by a resumed checkout, is left as it is.
+func forwardCaller(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	if shopID, ok := ctx.Value(reqCtx.ShopIDKey).(string); ok && shopID != "" && len(md.Get("x-shop-id")) == 0 {
+		md.Set("x-shop-id", shopID)
+	}
+	if userID, ok := ctx.Value(reqCtx.UserIDKey).(string); ok && userID != "" && len(md.Get("x-user-id")) == 0 {
+		md.Set("x-user-id", userID)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
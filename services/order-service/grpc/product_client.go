@@ -36,3 +36,84 @@ func (p *ProductClient) GetProduct(ctx context.Context, productID string) (*prod
 		ProductId: productID,
 	})
 }
+
+// BatchGetProducts resolves the catalog entries for productIDs and
+// variantIDs in a single round trip, keyed by ID. Products and variants that
+// do not exist in the caller's shop are simply absent from the result.
+func (p *ProductClient) BatchGetProducts(ctx context.Context, productIDs, variantIDs []string) (map[string]*productpb.CatalogItem, map[string]*productpb.CatalogVariant, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	resp, err := p.client.BatchGetProducts(ctx, &productpb.BatchGetProductsRequest{
+		ProductIds: productIDs,
+		VariantIds: variantIDs,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	products := make(map[string]*productpb.CatalogItem, len(resp.Products))
+	for _, product := range resp.Products {
+		products[product.Id] = product
+	}
+	variants := make(map[string]*productpb.CatalogVariant, len(resp.Variants))
+	for _, variant := range resp.Variants {
+		variants[variant.Id] = variant
+	}
+
+	return products, variants, nil
+}
+
+// ReserveStock holds stock for every line of an order. Calling it again for
+// the same order returns the existing reservation.
+func (p *ProductClient) ReserveStock(ctx context.Context, orderID string, items []*productpb.StockItem) (*productpb.StockReservation, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := p.client.ReserveStock(ctx, &productpb.ReserveStockRequest{
+		OrderId: orderID,
+		Items:   items,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Reservation, nil
+}
+
+// ReleaseStock puts the stock reserved for an order back on the shelf.
+func (p *ProductClient) ReleaseStock(ctx context.Context, orderID string, reason string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := p.client.ReleaseStock(ctx, &productpb.ReleaseStockRequest{
+		OrderId: orderID,
+		Reason:  reason,
+	})
+	return err
+}
+
+// RestockItems puts returned goods back on the shelf. referenceID makes the
+// call idempotent.
+func (p *ProductClient) RestockItems(ctx context.Context, referenceID string, items []*productpb.StockItem, reason string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := p.client.RestockItems(ctx, &productpb.RestockItemsRequest{
+		ReferenceId: referenceID,
+		Items:       items,
+		Reason:      reason,
+	})
+	return err
+}
+
+// CommitStock makes the stock reserved for an order permanent.
+func (p *ProductClient) CommitStock(ctx context.Context, orderID string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := p.client.CommitStock(ctx, &productpb.CommitStockRequest{
+		OrderId: orderID,
+	})
+	return err
+}
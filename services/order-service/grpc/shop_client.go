@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"shopservice/proto/shoppb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type ShopClient struct {
+	client shoppb.ShopServiceClient
+}
+
+func NewShopClient(addr string) (*ShopClient, error) {
+	conn, err := grpc.Dial(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ShopClient{
+		client: shoppb.NewShopServiceClient(conn),
+	}, nil
+}
+
+func (s *ShopClient) GetShop(ctx context.Context, shopID string) (*shoppb.ShopResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	return s.client.GetShop(ctx, &shoppb.GetShopRequest{ShopId: shopID})
+}
@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+// Checkout saga steps, in order. Step is the last step that completed.
+const (
+	CheckoutStepStarted          = "started"
+	CheckoutStepOrderCreated     = "order_created"
+	CheckoutStepStockReserved    = "stock_reserved"
+	CheckoutStepPaymentStarted   = "payment_started"
+	CheckoutStepPaymentCompleted = "payment_completed"
+	CheckoutStepConfirmed        = "confirmed"
+)
+
+const (
+	CheckoutStatusRunning      = "running"
+	CheckoutStatusCompleted    = "completed"
+	CheckoutStatusCompensating = "compensating"
+	CheckoutStatusCompensated  = "compensated"
+)
+
+// CheckoutSagaDTO is the persisted progress of one checkout, enough to finish
+// or undo it after a restart. Card details are never part of it.
+type CheckoutSagaDTO struct {
+	ID            string    `json:"id"`
+	ShopID        string    `json:"shop_id"`
+	OrderID       string    `json:"order_id"`
+	ActorID       *string   `json:"actor_id,omitempty"`
+	Step          string    `json:"step"`
+	Status        string    `json:"status"`
+	PaymentID     *string   `json:"payment_id,omitempty"`
+	Amount        float64   `json:"amount"`
+	Currency      string    `json:"currency"`
+	PaymentMethod *string   `json:"payment_method,omitempty"`
+	LastError     *string   `json:"last_error,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+// IdempotencyKeyDTO is the first request a shop made with a client's key, so
+// a retry gets the original response instead of being processed again.
+type IdempotencyKeyDTO struct {
+	ShopID      string `json:"shop_id"`
+	Operation   string `json:"operation"`
+	Key         string `json:"key"`
+	RequestHash string `json:"request_hash"`
+	// Response is the marshalled response, or nil while the first request is
+	// still in flight.
+	Response  []byte    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
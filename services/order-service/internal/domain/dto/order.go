@@ -0,0 +1,116 @@
+package dto
+
+import "time"
+
+const (
+	OrderStatusPending   = "pending"
+	OrderStatusConfirmed = "confirmed"
+	OrderStatusShipped   = "shipped"
+	OrderStatusDelivered = "delivered"
+	OrderStatusCancelled = "cancelled"
+)
+
+const (
+	OrderPaymentUnpaid        = "unpaid"
+	OrderPaymentPartiallyPaid = "partially_paid"
+	OrderPaymentPaid          = "paid"
+)
+
+type OrderDTO struct {
+	ID              string          `json:"id"`
+	ShopID          string          `json:"shop_id"`
+	UserID          *string         `json:"user_id,omitempty"`
+	Status          string          `json:"status"`
+	Subtotal        float64         `json:"subtotal"`
+	Tax             float64         `json:"tax"`
+	Discount        float64         `json:"discount"`
+	TotalAmount     float64         `json:"total_amount"`
+	Currency        string          `json:"currency"`
+	TaxInclusive    bool            `json:"prices_include_tax"`
+	AmountPaid      float64         `json:"amount_paid"`
+	AmountRefunded  float64         `json:"amount_refunded"`
+	ChangeDue       float64         `json:"change_due"`
+	PaymentStatus   string          `json:"payment_status"`
+	PaymentMethod   *string         `json:"payment_method,omitempty"`
+	ShippingAddress *string         `json:"shipping_address,omitempty"`
+	CancelReason    *string         `json:"cancel_reason,omitempty"`
+	Items           []*OrderItemDTO `json:"items"`
+	Tenders         []*TenderDTO    `json:"tenders,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+type OrderItemDTO struct {
+	ID               string  `json:"id"`
+	OrderID          string  `json:"order_id"`
+	ProductID        string  `json:"product_id"`
+	ProductName      string  `json:"product_name"`
+	VariantID        string  `json:"variant_id,omitempty"`
+	VariantName      string  `json:"variant_name,omitempty"`
+	Quantity         int32   `json:"quantity"`
+	UnitPrice        float64 `json:"unit_price"`
+	Subtotal         float64 `json:"subtotal"`
+	CatalogPrice     float64 `json:"catalog_price"`
+	PriceOverridden  bool    `json:"price_overridden"`
+	IsTaxable        bool    `json:"is_taxable"`
+	TaxRate          float64 `json:"tax_rate"`
+	TaxAmount        float64 `json:"tax_amount"`
+	ReturnedQuantity int32   `json:"returned_quantity"`
+}
+
+// TenderDTO is one payment taken towards an order. Amount is what was applied
+// to the balance; Tendered - Amount was handed back as change. All three are
+// in the order's currency. PaymentAmount is what was taken in the tender's
+// Currency, at ExchangeRate units of the order's currency per unit.
+type TenderDTO struct {
+	ID            string    `json:"id"`
+	OrderID       string    `json:"order_id"`
+	PaymentID     string    `json:"payment_id"`
+	PaymentMethod string    `json:"payment_method"`
+	Amount        float64   `json:"amount"`
+	Tendered      float64   `json:"tendered"`
+	ChangeDue     float64   `json:"change_due"`
+	Refunded      float64   `json:"refunded_amount"`
+	Currency      string    `json:"currency"`
+	PaymentAmount float64   `json:"payment_amount"`
+	ExchangeRate  string    `json:"exchange_rate"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ExchangeRateDTO is how many units of QuoteCurrency one unit of
+// BaseCurrency buys in a shop. Rate is a decimal string so it stays exact.
+type ExchangeRateDTO struct {
+	ShopID        string    `json:"shop_id"`
+	BaseCurrency  string    `json:"base_currency"`
+	QuoteCurrency string    `json:"quote_currency"`
+	Rate          string    `json:"rate"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// OrderStatusChangeDTO is one row of an order's status history. FromStatus is
+// nil for the entry written when the order is created.
+type OrderStatusChangeDTO struct {
+	ID         string    `json:"id"`
+	OrderID    string    `json:"order_id"`
+	FromStatus *string   `json:"from_status,omitempty"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    *string   `json:"actor_id,omitempty"`
+	Reason     *string   `json:"reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// OrderFilter selects a page of orders, newest first. When After is set the
+// page starts after that order and Page is ignored.
+type OrderFilter struct {
+	UserID   string
+	Status   string
+	After    *OrderCursor
+	Page     int
+	PageSize int
+}
+
+type OrderCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
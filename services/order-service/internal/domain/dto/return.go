@@ -0,0 +1,85 @@
+package dto
+
+import "time"
+
+const (
+	ReturnStatusPending      = "pending"
+	ReturnStatusCompleted    = "completed"
+	ReturnStatusRefundFailed = "refund_failed"
+)
+
+const (
+	RefundToOriginalTender = "original_tender"
+	RefundToStoreCredit    = "store_credit"
+)
+
+const (
+	DispositionRestock  = "restock"
+	DispositionWriteOff = "write_off"
+)
+
+// ReturnDTO is a return of some lines of an order. RefundAmount is what the
+// customer is owed; Refunds records what was actually paid back to each
+// tender.
+type ReturnDTO struct {
+	ID              string             `json:"id"`
+	ShopID          string             `json:"shop_id"`
+	OrderID         string             `json:"order_id"`
+	ExchangeOrderID *string            `json:"exchange_order_id,omitempty"`
+	Reason          string             `json:"reason"`
+	RefundMethod    string             `json:"refund_method"`
+	RefundAmount    float64            `json:"refund_amount"`
+	Status          string             `json:"status"`
+	StoreCreditID   *string            `json:"store_credit_id,omitempty"`
+	StoreCredit     *StoreCreditDTO    `json:"store_credit,omitempty"`
+	ActorID         *string            `json:"actor_id,omitempty"`
+	LastError       *string            `json:"last_error,omitempty"`
+	Items           []*ReturnItemDTO   `json:"items"`
+	Refunds         []*ReturnRefundDTO `json:"refunds"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+type ReturnItemDTO struct {
+	ID           string  `json:"id"`
+	ReturnID     string  `json:"return_id"`
+	OrderItemID  string  `json:"order_item_id"`
+	ProductID    string  `json:"product_id"`
+	ProductName  string  `json:"product_name"`
+	VariantID    string  `json:"variant_id,omitempty"`
+	Quantity     int32   `json:"quantity"`
+	RefundAmount float64 `json:"refund_amount"`
+	Disposition  string  `json:"disposition"`
+}
+
+type ReturnRefundDTO struct {
+	ID            string    `json:"id"`
+	ReturnID      string    `json:"return_id"`
+	TenderID      string    `json:"tender_id"`
+	PaymentID     string    `json:"payment_id"`
+	PaymentMethod string    `json:"payment_method"`
+	Amount        float64   `json:"amount"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// StoreCreditDTO is credit issued to a customer, spendable as a tender with
+// its code until the balance runs out.
+type StoreCreditDTO struct {
+	ID         string    `json:"id"`
+	ShopID     string    `json:"shop_id"`
+	Code       string    `json:"code"`
+	CustomerID *string   `json:"customer_id,omitempty"`
+	Amount     float64   `json:"amount"`
+	Balance    float64   `json:"balance"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type ReturnFilter struct {
+	OrderID  string
+	Reason   string
+	From     *time.Time
+	To       *time.Time
+	Page     int
+	PageSize int
+}
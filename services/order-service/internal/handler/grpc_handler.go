@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"orderservice/internal/service"
+	"orderservice/proto/orderpb"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type OrderHandler struct {
+	orderpb.UnimplementedOrderServiceServer
+	svc *service.OrderService
+}
+
+func NewOrderHandler(svc *service.OrderService) *OrderHandler {
+	return &OrderHandler{svc: svc}
+}
+
+func (h *OrderHandler) CreateOrder(ctx context.Context, req *orderpb.CreateOrderRequest) (*orderpb.CreateOrderResponse, error) {
+	return h.svc.CreateOrder(ctx, req)
+}
+
+func (h *OrderHandler) GetOrder(ctx context.Context, req *orderpb.GetOrderRequest) (*orderpb.GetOrderResponse, error) {
+	return h.svc.GetOrder(ctx, req)
+}
+
+func (h *OrderHandler) ListOrders(ctx context.Context, req *orderpb.ListOrdersRequest) (*orderpb.ListOrdersResponse, error) {
+	return h.svc.ListOrders(ctx, req)
+}
+
+func (h *OrderHandler) UpdateOrderStatus(ctx context.Context, req *orderpb.UpdateOrderStatusRequest) (*orderpb.UpdateOrderStatusResponse, error) {
+	return h.svc.UpdateOrderStatus(ctx, req)
+}
+
+func (h *OrderHandler) CancelOrder(ctx context.Context, req *orderpb.CancelOrderRequest) (*orderpb.CancelOrderResponse, error) {
+	return h.svc.CancelOrder(ctx, req)
+}
+
+func (h *OrderHandler) TrackOrder(ctx context.Context, req *orderpb.TrackOrderRequest) (*orderpb.TrackOrderResponse, error) {
+	return h.svc.TrackOrder(ctx, req)
+}
+
+func (h *OrderHandler) DeleteOrder(ctx context.Context, req *orderpb.GetOrderRequest) (*emptypb.Empty, error) {
+	if err := h.svc.DeleteOrder(ctx, req); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *OrderHandler) Checkout(ctx context.Context, req *orderpb.CheckoutRequest) (*orderpb.CheckoutResponse, error) {
+	return h.svc.Checkout(ctx, req)
+}
+
+func (h *OrderHandler) CalculateTax(ctx context.Context, req *orderpb.CalculateTaxRequest) (*orderpb.CalculateTaxResponse, error) {
+	return h.svc.CalculateTax(ctx, req)
+}
+
+func (h *OrderHandler) AddPayment(ctx context.Context, req *orderpb.AddPaymentRequest) (*orderpb.AddPaymentResponse, error) {
+	return h.svc.AddPayment(ctx, req)
+}
+
+func (h *OrderHandler) GetReceipt(ctx context.Context, req *orderpb.GetReceiptRequest) (*orderpb.GetReceiptResponse, error) {
+	return h.svc.GetReceipt(ctx, req)
+}
+
+func (h *OrderHandler) GetReceiptTemplate(ctx context.Context, req *orderpb.GetReceiptTemplateRequest) (*orderpb.ReceiptTemplate, error) {
+	return h.svc.GetReceiptTemplate(ctx, req)
+}
+
+func (h *OrderHandler) SetReceiptTemplate(ctx context.Context, req *orderpb.SetReceiptTemplateRequest) (*orderpb.ReceiptTemplate, error) {
+	return h.svc.SetReceiptTemplate(ctx, req)
+}
+
+func (h *OrderHandler) CreateReturn(ctx context.Context, req *orderpb.CreateReturnRequest) (*orderpb.CreateReturnResponse, error) {
+	return h.svc.CreateReturn(ctx, req)
+}
+
+func (h *OrderHandler) GetReturn(ctx context.Context, req *orderpb.GetReturnRequest) (*orderpb.OrderReturn, error) {
+	return h.svc.GetReturn(ctx, req)
+}
+
+func (h *OrderHandler) ListReturns(ctx context.Context, req *orderpb.ListReturnsRequest) (*orderpb.ListReturnsResponse, error) {
+	return h.svc.ListReturns(ctx, req)
+}
+
+func (h *OrderHandler) GetStoreCredit(ctx context.Context, req *orderpb.GetStoreCreditRequest) (*orderpb.StoreCredit, error) {
+	return h.svc.GetStoreCredit(ctx, req)
+}
+
+func (h *OrderHandler) SetExchangeRate(ctx context.Context, req *orderpb.SetExchangeRateRequest) (*orderpb.ExchangeRate, error) {
+	return h.svc.SetExchangeRate(ctx, req)
+}
+
+func (h *OrderHandler) ListExchangeRates(ctx context.Context, req *orderpb.ListExchangeRatesRequest) (*orderpb.ListExchangeRatesResponse, error) {
+	return h.svc.ListExchangeRates(ctx, req)
+}
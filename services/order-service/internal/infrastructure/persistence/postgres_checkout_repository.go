@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"orderservice/internal/domain/dto"
+)
+
+type CheckoutRepository interface {
+	CreateSaga(ctx context.Context, saga *dto.CheckoutSagaDTO) error
+	SaveSaga(ctx context.Context, saga *dto.CheckoutSagaDTO) error
+	ListUnfinished(ctx context.Context) ([]*dto.CheckoutSagaDTO, error)
+}
+
+type PostgresCheckoutRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewPostgresCheckoutRepository(db *sql.DB, logger *slog.Logger) *PostgresCheckoutRepository {
+	return &PostgresCheckoutRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const (
+	sagaColumns = `
+		id, shop_id, order_id, actor_id, step, status, payment_id,
+		amount, currency, payment_method, last_error, created_at, updated_at
+	`
+	queryCreateSaga = `
+		INSERT INTO checkout_sagas (id, shop_id, order_id, actor_id, step, status, payment_id,
+			amount, currency, payment_method, last_error, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $12)
+	`
+	querySaveSaga = `
+		UPDATE checkout_sagas
+		SET step = $2, status = $3, payment_id = $4, last_error = $5, updated_at = $6
+		WHERE id = $1
+	`
+	queryUnfinishedSagas = `
+		SELECT ` + sagaColumns + `
+		FROM checkout_sagas
+		WHERE status IN ('running', 'compensating')
+		ORDER BY created_at
+	`
+)
+
+func (r *PostgresCheckoutRepository) CreateSaga(ctx context.Context, saga *dto.CheckoutSagaDTO) error {
+	_, err := r.db.ExecContext(ctx, queryCreateSaga,
+		saga.ID, saga.ShopID, saga.OrderID, nullStr(saga.ActorID), saga.Step, saga.Status,
+		nullStr(saga.PaymentID), saga.Amount, saga.Currency, nullStr(saga.PaymentMethod),
+		nullStr(saga.LastError), saga.CreatedAt,
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert checkout saga",
+			slog.String("saga_id", saga.ID),
+			slog.String("order_id", saga.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
+
+// SaveSaga persists the saga's progress: step, status, payment and last error.
+func (r *PostgresCheckoutRepository) SaveSaga(ctx context.Context, saga *dto.CheckoutSagaDTO) error {
+	_, err := r.db.ExecContext(ctx, querySaveSaga,
+		saga.ID, saga.Step, saga.Status, nullStr(saga.PaymentID), nullStr(saga.LastError), saga.UpdatedAt,
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to save checkout saga",
+			slog.String("saga_id", saga.ID),
+			slog.String("step", saga.Step),
+			slog.String("status", saga.Status),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresCheckoutRepository) ListUnfinished(ctx context.Context) ([]*dto.CheckoutSagaDTO, error) {
+	rows, err := r.db.QueryContext(ctx, queryUnfinishedSagas)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query unfinished checkout sagas",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	sagas := make([]*dto.CheckoutSagaDTO, 0)
+	for rows.Next() {
+		var s dto.CheckoutSagaDTO
+		if err := rows.Scan(
+			&s.ID, &s.ShopID, &s.OrderID, &s.ActorID, &s.Step, &s.Status, &s.PaymentID,
+			&s.Amount, &s.Currency, &s.PaymentMethod, &s.LastError, &s.CreatedAt, &s.UpdatedAt,
+		); err != nil {
+			r.logger.ErrorContext(ctx, "failed to scan checkout saga row",
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+		sagas = append(sagas, &s)
+	}
+	return sagas, rows.Err()
+}
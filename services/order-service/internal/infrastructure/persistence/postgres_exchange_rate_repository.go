@@ -0,0 +1,119 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"orderservice/internal/domain/dto"
+)
+
+type ExchangeRateRepository interface {
+	// GetRate returns sql.ErrNoRows when the shop has no rate for the pair.
+	GetRate(ctx context.Context, shopID, base, quote string) (*dto.ExchangeRateDTO, error)
+	SaveRate(ctx context.Context, rate *dto.ExchangeRateDTO) (*dto.ExchangeRateDTO, error)
+	ListRates(ctx context.Context, shopID string) ([]*dto.ExchangeRateDTO, error)
+}
+
+type PostgresExchangeRateRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewPostgresExchangeRateRepository(db *sql.DB, logger *slog.Logger) *PostgresExchangeRateRepository {
+	return &PostgresExchangeRateRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const (
+	exchangeRateColumns = `shop_id, base_currency, quote_currency, rate::text, created_at, updated_at`
+	queryExchangeRate   = `
+		SELECT ` + exchangeRateColumns + `
+		FROM shop_exchange_rates
+		WHERE shop_id = $1 AND base_currency = $2 AND quote_currency = $3
+	`
+	querySaveExchangeRate = `
+		INSERT INTO shop_exchange_rates (shop_id, base_currency, quote_currency, rate, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		ON CONFLICT (shop_id, base_currency, quote_currency)
+		DO UPDATE SET rate = EXCLUDED.rate, updated_at = EXCLUDED.updated_at
+		RETURNING ` + exchangeRateColumns
+	queryExchangeRates = `
+		SELECT ` + exchangeRateColumns + `
+		FROM shop_exchange_rates
+		WHERE shop_id = $1
+		ORDER BY base_currency, quote_currency
+	`
+)
+
+func (r *PostgresExchangeRateRepository) GetRate(ctx context.Context, shopID, base, quote string) (*dto.ExchangeRateDTO, error) {
+	rate, err := scanExchangeRate(r.db.QueryRowContext(ctx, queryExchangeRate, shopID, base, quote))
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			r.logger.ErrorContext(ctx, "failed to query exchange rate",
+				slog.String("shop_id", shopID),
+				slog.String("base_currency", base),
+				slog.String("quote_currency", quote),
+				slog.String("error", err.Error()),
+			)
+		}
+		return nil, err
+	}
+	return rate, nil
+}
+
+func (r *PostgresExchangeRateRepository) SaveRate(ctx context.Context, rate *dto.ExchangeRateDTO) (*dto.ExchangeRateDTO, error) {
+	saved, err := scanExchangeRate(r.db.QueryRowContext(ctx, querySaveExchangeRate,
+		rate.ShopID, rate.BaseCurrency, rate.QuoteCurrency, rate.Rate, rate.UpdatedAt,
+	))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to save exchange rate",
+			slog.String("shop_id", rate.ShopID),
+			slog.String("base_currency", rate.BaseCurrency),
+			slog.String("quote_currency", rate.QuoteCurrency),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return saved, nil
+}
+
+func (r *PostgresExchangeRateRepository) ListRates(ctx context.Context, shopID string) ([]*dto.ExchangeRateDTO, error) {
+	rows, err := r.db.QueryContext(ctx, queryExchangeRates, shopID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query exchange rates",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rates []*dto.ExchangeRateDTO
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to scan exchange rate row",
+				slog.String("shop_id", shopID),
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return rates, rows.Err()
+}
+
+func scanExchangeRate(row interface{ Scan(...interface{}) error }) (*dto.ExchangeRateDTO, error) {
+	var rate dto.ExchangeRateDTO
+	err := row.Scan(
+		&rate.ShopID, &rate.BaseCurrency, &rate.QuoteCurrency, &rate.Rate,
+		&rate.CreatedAt, &rate.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
@@ -0,0 +1,142 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"orderservice/internal/domain/dto"
+)
+
+type IdempotencyRepository interface {
+	// ClaimKey records key as in flight. It reports true when the caller
+	// claimed the key; otherwise it returns the earlier request's record, or
+	// sql.ErrNoRows if that record disappeared while being read. A key past
+	// its expiry is claimed afresh.
+	ClaimKey(ctx context.Context, shopID, operation, key, requestHash string, expiresAt time.Time) (*dto.IdempotencyKeyDTO, bool, error)
+	SaveResponse(ctx context.Context, shopID, operation, key string, response []byte) error
+	ReleaseKey(ctx context.Context, shopID, operation, key string) error
+	// PurgeExpired deletes expired keys and returns how many were deleted.
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+type PostgresIdempotencyRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewPostgresIdempotencyRepository(db *sql.DB, logger *slog.Logger) *PostgresIdempotencyRepository {
+	return &PostgresIdempotencyRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const (
+	queryClaimIdempotencyKey = `
+		INSERT INTO idempotency_keys (shop_id, operation, idempotency_key, request_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (shop_id, operation, idempotency_key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+			response = NULL,
+			created_at = CURRENT_TIMESTAMP,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= CURRENT_TIMESTAMP
+		RETURNING operation
+	`
+	queryIdempotencyKey = `
+		SELECT shop_id, operation, idempotency_key, request_hash, response, created_at, expires_at
+		FROM idempotency_keys
+		WHERE shop_id = $1 AND operation = $2 AND idempotency_key = $3
+	`
+	querySaveIdempotentResponse = `
+		UPDATE idempotency_keys SET response = $4
+		WHERE shop_id = $1 AND operation = $2 AND idempotency_key = $3
+	`
+	queryReleaseIdempotencyKey = `
+		DELETE FROM idempotency_keys
+		WHERE shop_id = $1 AND operation = $2 AND idempotency_key = $3
+	`
+	queryPurgeIdempotencyKeys = `
+		DELETE FROM idempotency_keys WHERE expires_at <= CURRENT_TIMESTAMP
+	`
+)
+
+func (r *PostgresIdempotencyRepository) ClaimKey(
+	ctx context.Context,
+	shopID, operation, key, requestHash string,
+	expiresAt time.Time,
+) (*dto.IdempotencyKeyDTO, bool, error) {
+	var claimed string
+	err := r.db.QueryRowContext(ctx, queryClaimIdempotencyKey, shopID, operation, key, requestHash, expiresAt).Scan(&claimed)
+	if err == nil {
+		return nil, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		r.logger.ErrorContext(ctx, "failed to claim idempotency key",
+			slog.String("shop_id", shopID),
+			slog.String("operation", operation),
+			slog.String("error", err.Error()),
+		)
+		return nil, false, err
+	}
+
+	var k dto.IdempotencyKeyDTO
+	err = r.db.QueryRowContext(ctx, queryIdempotencyKey, shopID, operation, key).Scan(
+		&k.ShopID,
+		&k.Operation,
+		&k.Key,
+		&k.RequestHash,
+		&k.Response,
+		&k.CreatedAt,
+		&k.ExpiresAt,
+	)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			r.logger.ErrorContext(ctx, "failed to query idempotency key",
+				slog.String("shop_id", shopID),
+				slog.String("operation", operation),
+				slog.String("error", err.Error()),
+			)
+		}
+		return nil, false, err
+	}
+	return &k, false, nil
+}
+
+func (r *PostgresIdempotencyRepository) SaveResponse(ctx context.Context, shopID, operation, key string, response []byte) error {
+	if _, err := r.db.ExecContext(ctx, querySaveIdempotentResponse, shopID, operation, key, response); err != nil {
+		r.logger.ErrorContext(ctx, "failed to save idempotent response",
+			slog.String("shop_id", shopID),
+			slog.String("operation", operation),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresIdempotencyRepository) ReleaseKey(ctx context.Context, shopID, operation, key string) error {
+	if _, err := r.db.ExecContext(ctx, queryReleaseIdempotencyKey, shopID, operation, key); err != nil {
+		r.logger.ErrorContext(ctx, "failed to release idempotency key",
+			slog.String("shop_id", shopID),
+			slog.String("operation", operation),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresIdempotencyRepository) PurgeExpired(ctx context.Context) (int64, error) {
+	res, err := r.db.ExecContext(ctx, queryPurgeIdempotencyKeys)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to purge idempotency keys",
+			slog.String("error", err.Error()),
+		)
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,655 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"orderservice/internal/domain/dto"
+	"time"
+)
+
+var (
+	// ErrRefundExceedsTender is returned when a refund would give back more
+	// than a tender took.
+	ErrRefundExceedsTender = errors.New("refund exceeds tender amount")
+	// ErrTenderExceedsBalance is returned when a tender would pay more than
+	// is left of an order's total, e.g. because another tender was recorded
+	// since the balance was read.
+	ErrTenderExceedsBalance = errors.New("tender exceeds order balance")
+)
+
+type OrderRepository interface {
+	CreateOrder(ctx context.Context, order *dto.OrderDTO, initial *dto.OrderStatusChangeDTO) error
+	GetByID(ctx context.Context, shopID string, orderID string) (*dto.OrderDTO, error)
+	ListByShop(ctx context.Context, shopID string, filter dto.OrderFilter) ([]*dto.OrderDTO, int, error)
+	TransitionStatus(ctx context.Context, shopID string, change *dto.OrderStatusChangeDTO) (*dto.OrderDTO, error)
+	ListStatusHistory(ctx context.Context, orderID string) ([]*dto.OrderStatusChangeDTO, error)
+	RecordTender(ctx context.Context, shopID string, tender *dto.TenderDTO) (*dto.OrderDTO, error)
+	ListTenders(ctx context.Context, orderID string) ([]*dto.TenderDTO, error)
+	RecordTenderRefund(ctx context.Context, shopID string, tender *dto.TenderDTO, amount float64) error
+	DeleteOrder(ctx context.Context, shopID string, orderID string) (int64, error)
+}
+
+type PostgresOrderRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewPostgresOrderRepository(db *sql.DB, logger *slog.Logger) *PostgresOrderRepository {
+	return &PostgresOrderRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const (
+	orderColumns = `
+		id, shop_id, user_id, status, subtotal, tax, discount, total_amount, currency, prices_include_tax,
+		amount_paid, amount_refunded, change_due, payment_status,
+		payment_method, shipping_address, cancel_reason, created_at, updated_at
+	`
+	queryCreateOrder = `
+		INSERT INTO orders (id, shop_id, user_id, status, subtotal, tax, discount, total_amount, currency,
+			prices_include_tax, payment_status, payment_method, shipping_address, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $14)
+	`
+	queryCreateOrderItem = `
+		INSERT INTO order_items (id, order_id, product_id, product_name, quantity, unit_price, subtotal,
+			catalog_price, price_overridden, is_taxable, tax_rate, tax_amount, variant_id, variant_name)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NULLIF($13, '')::uuid, NULLIF($14, ''))
+	`
+	queryOrderByID = `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE shop_id = $1 AND id = $2 AND deleted_at IS NULL
+	`
+	queryOrderItems = `
+		SELECT id, order_id, product_id, product_name, quantity, unit_price, subtotal,
+			catalog_price, price_overridden, is_taxable, tax_rate, tax_amount, returned_quantity,
+			COALESCE(variant_id::text, ''), COALESCE(variant_name, '')
+		FROM order_items
+		WHERE order_id = $1
+		ORDER BY created_at, id
+	`
+	// queryTransitionOrderStatus only matches while the order is still in the
+	// status the transition was validated against, so concurrent updates
+	// cannot skip a step of the state machine.
+	queryTransitionOrderStatus = `
+		UPDATE orders
+		SET status = $1, cancel_reason = COALESCE($2, cancel_reason), updated_at = $3
+		WHERE shop_id = $4 AND id = $5 AND status = $6 AND deleted_at IS NULL
+		RETURNING ` + orderColumns
+	queryCreateStatusChange = `
+		INSERT INTO order_status_history (id, order_id, from_status, to_status, actor_id, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	queryStatusHistory = `
+		SELECT id, order_id, from_status, to_status, actor_id, reason, created_at
+		FROM order_status_history
+		WHERE order_id = $1
+		ORDER BY created_at, id
+	`
+	// queryCreateTender ignores a payment that is already recorded, so
+	// recording the same tender twice does not pay the order twice.
+	queryCreateTender = `
+		INSERT INTO order_tenders (id, order_id, payment_id, payment_method, amount, tendered, change_due,
+			currency, payment_amount, exchange_rate, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (payment_id) DO NOTHING
+	`
+	queryLockOrder = `
+		SELECT id FROM orders
+		WHERE shop_id = $1 AND id = $2 AND deleted_at IS NULL
+		FOR UPDATE
+	`
+	// queryApplyTender never lets the amount paid pass the total; cash
+	// beyond the balance is change, not payment.
+	queryApplyTender = `
+		UPDATE orders
+		SET amount_paid = amount_paid + $1,
+			change_due = change_due + $2,
+			payment_status = CASE
+				WHEN amount_paid + $1 >= total_amount THEN 'paid'
+				WHEN amount_paid + $1 > 0 THEN 'partially_paid'
+				ELSE 'unpaid'
+			END,
+			updated_at = $3
+		WHERE shop_id = $4 AND id = $5 AND deleted_at IS NULL
+			AND ($1 = 0 OR amount_paid + $1 <= total_amount)
+		RETURNING ` + orderColumns
+	queryTenders = `
+		SELECT id, order_id, payment_id, payment_method, amount, tendered, change_due, refunded_amount,
+			currency, payment_amount, exchange_rate, created_at
+		FROM order_tenders
+		WHERE order_id = $1
+		ORDER BY created_at, id
+	`
+	// queryRefundTender never lets a tender give back more than it took.
+	queryRefundTender = `
+		UPDATE order_tenders
+		SET refunded_amount = refunded_amount + $1
+		WHERE id = $2 AND order_id = $3 AND refunded_amount + $1 <= amount
+	`
+	queryApplyRefund = `
+		UPDATE orders
+		SET amount_refunded = amount_refunded + $1, updated_at = $2
+		WHERE shop_id = $3 AND id = $4 AND deleted_at IS NULL
+	`
+	queryDeleteOrder = `
+		UPDATE orders SET deleted_at = now()
+		WHERE shop_id = $1 AND id = $2 AND deleted_at IS NULL
+	`
+)
+
+func (r *PostgresOrderRepository) CreateOrder(ctx context.Context, order *dto.OrderDTO, initial *dto.OrderStatusChangeDTO) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to begin order transaction",
+			slog.String("shop_id", order.ShopID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, queryCreateOrder,
+		order.ID, order.ShopID, nullStr(order.UserID), order.Status,
+		order.Subtotal, order.Tax, order.Discount, order.TotalAmount, order.Currency, order.TaxInclusive,
+		order.PaymentStatus, nullStr(order.PaymentMethod), nullStr(order.ShippingAddress), order.CreatedAt,
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert order",
+			slog.String("shop_id", order.ShopID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	for _, item := range order.Items {
+		_, err = tx.ExecContext(ctx, queryCreateOrderItem,
+			item.ID, order.ID, item.ProductID, item.ProductName,
+			item.Quantity, item.UnitPrice, item.Subtotal,
+			item.CatalogPrice, item.PriceOverridden, item.IsTaxable, item.TaxRate, item.TaxAmount,
+			item.VariantID, item.VariantName,
+		)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to insert order item",
+				slog.String("order_id", order.ID),
+				slog.String("product_id", item.ProductID),
+				slog.String("error", err.Error()),
+			)
+			return err
+		}
+	}
+
+	if err := insertStatusChange(ctx, tx, initial); err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert order status history",
+			slog.String("order_id", order.ID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.ErrorContext(ctx, "failed to commit order",
+			slog.String("order_id", order.ID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	r.logger.InfoContext(ctx, "order created successfully",
+		slog.String("order_id", order.ID),
+		slog.String("shop_id", order.ShopID),
+	)
+	return nil
+}
+
+func (r *PostgresOrderRepository) GetByID(ctx context.Context, shopID string, orderID string) (*dto.OrderDTO, error) {
+	order, err := scanOrder(r.db.QueryRowContext(ctx, queryOrderByID, shopID, orderID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.logger.DebugContext(ctx, "order not found",
+				slog.String("order_id", orderID),
+			)
+			return nil, err
+		}
+		r.logger.ErrorContext(ctx, "failed to query order",
+			slog.String("order_id", orderID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	items, err := r.listItems(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	order.Items = items
+
+	return order, nil
+}
+
+// ListByShop returns up to filter.PageSize+1 orders matching filter, newest
+// first, along with the number of matching orders.
+func (r *PostgresOrderRepository) ListByShop(ctx context.Context, shopID string, filter dto.OrderFilter) ([]*dto.OrderDTO, int, error) {
+	where := " FROM orders WHERE shop_id = $1 AND deleted_at IS NULL"
+	args := []any{shopID}
+
+	if filter.UserID != "" {
+		args = append(args, filter.UserID)
+		where += fmt.Sprintf(" AND user_id = $%d", len(args))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(1)"+where, args...).Scan(&total); err != nil {
+		r.logger.ErrorContext(ctx, "failed to count orders",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, 0, err
+	}
+
+	listArgs := append([]any{}, args...)
+	listWhere := where
+	offset := (filter.Page - 1) * filter.PageSize
+	if filter.After != nil {
+		listArgs = append(listArgs, filter.After.CreatedAt, filter.After.ID)
+		listWhere += fmt.Sprintf(" AND (created_at, id) < ($%d, $%d)", len(listArgs)-1, len(listArgs))
+		offset = 0
+	}
+	// One extra row tells the caller whether another page follows.
+	listArgs = append(listArgs, filter.PageSize+1, offset)
+	listQuery := "SELECT " + orderColumns + listWhere +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", len(listArgs)-1, len(listArgs))
+
+	rows, err := r.db.QueryContext(ctx, listQuery, listArgs...)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query orders",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	orders := make([]*dto.OrderDTO, 0)
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to scan order row",
+				slog.String("shop_id", shopID),
+				slog.String("error", err.Error()),
+			)
+			return nil, 0, err
+		}
+		orders = append(orders, order)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.ErrorContext(ctx, "rows iteration error",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, 0, err
+	}
+
+	for _, order := range orders {
+		items, err := r.listItems(ctx, order.ID)
+		if err != nil {
+			return nil, 0, err
+		}
+		order.Items = items
+	}
+
+	return orders, total, nil
+}
+
+func (r *PostgresOrderRepository) TransitionStatus(ctx context.Context, shopID string, change *dto.OrderStatusChangeDTO) (*dto.OrderDTO, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to begin status transaction",
+			slog.String("order_id", change.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var cancelReason *string
+	if change.ToStatus == dto.OrderStatusCancelled {
+		cancelReason = change.Reason
+	}
+
+	updated, err := scanOrder(tx.QueryRowContext(ctx, queryTransitionOrderStatus,
+		change.ToStatus, nullStr(cancelReason), change.CreatedAt,
+		shopID, change.OrderID, nullStr(change.FromStatus),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.logger.WarnContext(ctx, "order not in expected status for transition",
+				slog.String("order_id", change.OrderID),
+				slog.String("to_status", change.ToStatus),
+			)
+			return nil, err
+		}
+		r.logger.ErrorContext(ctx, "failed to update order status",
+			slog.String("order_id", change.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	if err := insertStatusChange(ctx, tx, change); err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert order status history",
+			slog.String("order_id", change.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.ErrorContext(ctx, "failed to commit order status",
+			slog.String("order_id", change.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	updated.Items, err = r.listItems(ctx, updated.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "order status updated",
+		slog.String("order_id", change.OrderID),
+		slog.String("from_status", ptrOrEmpty(change.FromStatus)),
+		slog.String("to_status", change.ToStatus),
+	)
+	return updated, nil
+}
+
+func (r *PostgresOrderRepository) ListStatusHistory(ctx context.Context, orderID string) ([]*dto.OrderStatusChangeDTO, error) {
+	rows, err := r.db.QueryContext(ctx, queryStatusHistory, orderID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query order status history",
+			slog.String("order_id", orderID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]*dto.OrderStatusChangeDTO, 0)
+	for rows.Next() {
+		var c dto.OrderStatusChangeDTO
+		if err := rows.Scan(
+			&c.ID, &c.OrderID, &c.FromStatus, &c.ToStatus, &c.ActorID, &c.Reason, &c.CreatedAt,
+		); err != nil {
+			r.logger.ErrorContext(ctx, "failed to scan order status history row",
+				slog.String("order_id", orderID),
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+		history = append(history, &c)
+	}
+	return history, rows.Err()
+}
+
+// RecordTender stores a payment taken towards an order and adds it to the
+// order's amount paid, moving its payment status on as the balance is
+// covered. A tender that was already recorded leaves the order unchanged.
+// It returns sql.ErrNoRows when the order does not exist in the shop, and
+// ErrTenderExceedsBalance when the tender is more than is left to pay; in
+// either case nothing is stored.
+func (r *PostgresOrderRepository) RecordTender(ctx context.Context, shopID string, tender *dto.TenderDTO) (*dto.OrderDTO, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to begin tender transaction",
+			slog.String("order_id", tender.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Tenders of the order wait for each other here, so the balance each
+	// one is checked against includes the ones before it.
+	var orderID string
+	if err := tx.QueryRowContext(ctx, queryLockOrder, shopID, tender.OrderID).Scan(&orderID); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			r.logger.ErrorContext(ctx, "failed to lock order for tender",
+				slog.String("order_id", tender.OrderID),
+				slog.String("error", err.Error()),
+			)
+		}
+		return nil, err
+	}
+
+	res, err := tx.ExecContext(ctx, queryCreateTender,
+		tender.ID, tender.OrderID, tender.PaymentID, tender.PaymentMethod,
+		tender.Amount, tender.Tendered, tender.ChangeDue,
+		tender.Currency, tender.PaymentAmount, tender.ExchangeRate, tender.CreatedAt,
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert order tender",
+			slog.String("order_id", tender.OrderID),
+			slog.String("payment_id", tender.PaymentID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	var amount, change float64
+	if inserted > 0 {
+		amount, change = tender.Amount, tender.ChangeDue
+	}
+	updated, err := scanOrder(tx.QueryRowContext(ctx, queryApplyTender,
+		amount, change, tender.CreatedAt, shopID, tender.OrderID,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		// The order is locked, so only the balance guard can have failed.
+		return nil, ErrTenderExceedsBalance
+	}
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to apply order tender",
+			slog.String("order_id", tender.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.ErrorContext(ctx, "failed to commit order tender",
+			slog.String("order_id", tender.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	updated.Items, err = r.listItems(ctx, updated.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if inserted > 0 {
+		r.logger.InfoContext(ctx, "order tender recorded",
+			slog.String("order_id", tender.OrderID),
+			slog.String("payment_id", tender.PaymentID),
+			slog.String("payment_status", updated.PaymentStatus),
+		)
+	}
+	return updated, nil
+}
+
+func (r *PostgresOrderRepository) ListTenders(ctx context.Context, orderID string) ([]*dto.TenderDTO, error) {
+	rows, err := r.db.QueryContext(ctx, queryTenders, orderID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query order tenders",
+			slog.String("order_id", orderID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	tenders := make([]*dto.TenderDTO, 0)
+	for rows.Next() {
+		var t dto.TenderDTO
+		if err := rows.Scan(
+			&t.ID, &t.OrderID, &t.PaymentID, &t.PaymentMethod,
+			&t.Amount, &t.Tendered, &t.ChangeDue, &t.Refunded,
+			&t.Currency, &t.PaymentAmount, &t.ExchangeRate, &t.CreatedAt,
+		); err != nil {
+			r.logger.ErrorContext(ctx, "failed to scan order tender row",
+				slog.String("order_id", orderID),
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+		tenders = append(tenders, &t)
+	}
+	return tenders, rows.Err()
+}
+
+// RecordTenderRefund adds amount to what a tender has given back and to the
+// order's amount refunded. It returns ErrRefundExceedsTender when the tender
+// does not have that much left to refund.
+func (r *PostgresOrderRepository) RecordTenderRefund(ctx context.Context, shopID string, tender *dto.TenderDTO, amount float64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to begin tender refund transaction",
+			slog.String("order_id", tender.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := refundTender(ctx, tx, tender, amount); err != nil {
+		r.logger.ErrorContext(ctx, "failed to record tender refund",
+			slog.String("order_id", tender.OrderID),
+			slog.String("tender_id", tender.ID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, queryApplyRefund, amount, time.Now(), shopID, tender.OrderID); err != nil {
+		r.logger.ErrorContext(ctx, "failed to apply order refund",
+			slog.String("order_id", tender.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *PostgresOrderRepository) DeleteOrder(ctx context.Context, shopID string, orderID string) (int64, error) {
+	res, err := r.db.ExecContext(ctx, queryDeleteOrder, shopID, orderID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete order",
+			slog.String("order_id", orderID),
+			slog.String("error", err.Error()),
+		)
+		return 0, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to get rows affected",
+			slog.String("order_id", orderID),
+			slog.String("error", err.Error()),
+		)
+		return 0, err
+	}
+	if affected > 0 {
+		r.logger.InfoContext(ctx, "order deleted successfully",
+			slog.String("order_id", orderID),
+		)
+	}
+	return affected, nil
+}
+
+func (r *PostgresOrderRepository) listItems(ctx context.Context, orderID string) ([]*dto.OrderItemDTO, error) {
+	rows, err := r.db.QueryContext(ctx, queryOrderItems, orderID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query order items",
+			slog.String("order_id", orderID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*dto.OrderItemDTO, 0)
+	for rows.Next() {
+		var item dto.OrderItemDTO
+		if err := rows.Scan(
+			&item.ID, &item.OrderID, &item.ProductID, &item.ProductName,
+			&item.Quantity, &item.UnitPrice, &item.Subtotal,
+			&item.CatalogPrice, &item.PriceOverridden, &item.IsTaxable, &item.TaxRate, &item.TaxAmount,
+			&item.ReturnedQuantity, &item.VariantID, &item.VariantName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+	return items, rows.Err()
+}
+
+func refundTender(ctx context.Context, tx *sql.Tx, tender *dto.TenderDTO, amount float64) error {
+	res, err := tx.ExecContext(ctx, queryRefundTender, amount, tender.ID, tender.OrderID)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrRefundExceedsTender
+	}
+	return nil
+}
+
+func insertStatusChange(ctx context.Context, tx *sql.Tx, c *dto.OrderStatusChangeDTO) error {
+	_, err := tx.ExecContext(ctx, queryCreateStatusChange,
+		c.ID, c.OrderID, nullStr(c.FromStatus), c.ToStatus, nullStr(c.ActorID), nullStr(c.Reason), c.CreatedAt,
+	)
+	return err
+}
+
+func scanOrder(row interface{ Scan(...interface{}) error }) (*dto.OrderDTO, error) {
+	var o dto.OrderDTO
+	err := row.Scan(
+		&o.ID, &o.ShopID, &o.UserID, &o.Status,
+		&o.Subtotal, &o.Tax, &o.Discount, &o.TotalAmount, &o.Currency, &o.TaxInclusive,
+		&o.AmountPaid, &o.AmountRefunded, &o.ChangeDue, &o.PaymentStatus,
+		&o.PaymentMethod, &o.ShippingAddress, &o.CancelReason,
+		&o.CreatedAt, &o.UpdatedAt,
+	)
+	return &o, err
+}
+
+func nullStr(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
+
+func ptrOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
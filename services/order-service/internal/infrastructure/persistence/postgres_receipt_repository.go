@@ -0,0 +1,82 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+type ReceiptTemplateRepository interface {
+	// GetTemplate returns sql.ErrNoRows when the shop uses the default template.
+	GetTemplate(ctx context.Context, shopID string) (string, time.Time, error)
+	SaveTemplate(ctx context.Context, shopID string, template string, updatedAt time.Time) error
+	DeleteTemplate(ctx context.Context, shopID string) error
+}
+
+type PostgresReceiptTemplateRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewPostgresReceiptTemplateRepository(db *sql.DB, logger *slog.Logger) *PostgresReceiptTemplateRepository {
+	return &PostgresReceiptTemplateRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const (
+	queryReceiptTemplate = `
+		SELECT template, updated_at
+		FROM receipt_templates
+		WHERE shop_id = $1
+	`
+	querySaveReceiptTemplate = `
+		INSERT INTO receipt_templates (shop_id, template, created_at, updated_at)
+		VALUES ($1, $2, $3, $3)
+		ON CONFLICT (shop_id) DO UPDATE SET template = EXCLUDED.template, updated_at = EXCLUDED.updated_at
+	`
+	queryDeleteReceiptTemplate = `
+		DELETE FROM receipt_templates WHERE shop_id = $1
+	`
+)
+
+func (r *PostgresReceiptTemplateRepository) GetTemplate(ctx context.Context, shopID string) (string, time.Time, error) {
+	var template string
+	var updatedAt time.Time
+	err := r.db.QueryRowContext(ctx, queryReceiptTemplate, shopID).Scan(&template, &updatedAt)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			r.logger.ErrorContext(ctx, "failed to query receipt template",
+				slog.String("shop_id", shopID),
+				slog.String("error", err.Error()),
+			)
+		}
+		return "", time.Time{}, err
+	}
+	return template, updatedAt, nil
+}
+
+func (r *PostgresReceiptTemplateRepository) SaveTemplate(ctx context.Context, shopID string, template string, updatedAt time.Time) error {
+	if _, err := r.db.ExecContext(ctx, querySaveReceiptTemplate, shopID, template, updatedAt); err != nil {
+		r.logger.ErrorContext(ctx, "failed to save receipt template",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresReceiptTemplateRepository) DeleteTemplate(ctx context.Context, shopID string) error {
+	if _, err := r.db.ExecContext(ctx, queryDeleteReceiptTemplate, shopID); err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete receipt template",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
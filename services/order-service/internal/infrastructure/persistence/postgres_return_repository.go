@@ -0,0 +1,595 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"orderservice/internal/domain/dto"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrReturnQuantityExceeded is returned when a return asks for more of an
+	// order line than is still left to return.
+	ErrReturnQuantityExceeded = errors.New("return quantity exceeds quantity left on order line")
+	// ErrRefundExceedsOrder is returned when a return would refund more than
+	// the order has left to refund.
+	ErrRefundExceedsOrder = errors.New("refund exceeds amount left to refund on order")
+	// ErrStoreCreditInsufficient is returned when a store credit's balance does
+	// not cover the amount being redeemed.
+	ErrStoreCreditInsufficient = errors.New("store credit balance is insufficient")
+)
+
+type ReturnRepository interface {
+	// CreateReturn stores a pending return, marking its quantities returned on
+	// the order lines and reserving its refund against the order.
+	CreateReturn(ctx context.Context, ret *dto.ReturnDTO) error
+	RecordReturnRefund(ctx context.Context, tender *dto.TenderDTO, refund *dto.ReturnRefundDTO) error
+	FinishReturn(ctx context.Context, ret *dto.ReturnDTO) error
+	GetReturn(ctx context.Context, shopID string, returnID string) (*dto.ReturnDTO, error)
+	ListReturns(ctx context.Context, shopID string, filter dto.ReturnFilter) ([]*dto.ReturnDTO, int, float64, error)
+
+	CreateStoreCredit(ctx context.Context, credit *dto.StoreCreditDTO) error
+	GetStoreCredit(ctx context.Context, shopID string, code string) (*dto.StoreCreditDTO, error)
+	// RedeemStoreCredit takes amount off a store credit for an order and
+	// returns the ID of the transaction, which identifies the redemption.
+	RedeemStoreCredit(ctx context.Context, shopID string, code string, orderID string, amount float64) (string, error)
+	// RestoreStoreCredit gives amount of a redemption back to its store
+	// credit and returns the ID of the restoring transaction.
+	RestoreStoreCredit(ctx context.Context, transactionID string, amount float64) (string, error)
+}
+
+type PostgresReturnRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewPostgresReturnRepository(db *sql.DB, logger *slog.Logger) *PostgresReturnRepository {
+	return &PostgresReturnRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const (
+	returnColumns = `
+		id, shop_id, order_id, exchange_order_id, reason, refund_method, refund_amount, status,
+		store_credit_id, actor_id, last_error, created_at, updated_at
+	`
+	storeCreditColumns = `
+		id, shop_id, code, customer_id, amount, balance, created_at, updated_at
+	`
+	// queryReturnOrderItem only matches while the line has enough left to
+	// return, so concurrent returns cannot return the same unit twice.
+	queryReturnOrderItem = `
+		UPDATE order_items
+		SET returned_quantity = returned_quantity + $1
+		WHERE id = $2 AND order_id = $3 AND returned_quantity + $1 <= quantity
+	`
+	queryReserveOrderRefund = `
+		UPDATE orders
+		SET amount_refunded = amount_refunded + $1, updated_at = $2
+		WHERE shop_id = $3 AND id = $4 AND deleted_at IS NULL AND amount_refunded + $1 <= amount_paid
+	`
+	queryCreateReturn = `
+		INSERT INTO order_returns (id, shop_id, order_id, exchange_order_id, reason, refund_method,
+			refund_amount, status, actor_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+	`
+	queryCreateReturnItem = `
+		INSERT INTO order_return_items (id, return_id, order_item_id, product_id, product_name,
+			quantity, refund_amount, disposition, variant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, '')::uuid)
+	`
+	queryCreateReturnRefund = `
+		INSERT INTO order_return_refunds (id, return_id, tender_id, payment_id, payment_method, amount, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	queryFinishReturn = `
+		UPDATE order_returns
+		SET status = $1, store_credit_id = $2, last_error = $3, updated_at = $4
+		WHERE shop_id = $5 AND id = $6
+	`
+	queryReturnByID = `
+		SELECT ` + returnColumns + `
+		FROM order_returns
+		WHERE shop_id = $1 AND id = $2
+	`
+	queryReturnItems = `
+		SELECT id, return_id, order_item_id, product_id, product_name, quantity, refund_amount, disposition,
+			COALESCE(variant_id::text, '')
+		FROM order_return_items
+		WHERE return_id = $1
+		ORDER BY id
+	`
+	queryReturnRefunds = `
+		SELECT id, return_id, tender_id, payment_id, payment_method, amount, created_at
+		FROM order_return_refunds
+		WHERE return_id = $1
+		ORDER BY created_at, id
+	`
+	queryCreateStoreCredit = `
+		INSERT INTO store_credits (id, shop_id, code, customer_id, amount, balance, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5, $6, $6)
+	`
+	queryCreateStoreCreditTransaction = `
+		INSERT INTO store_credit_transactions (id, store_credit_id, order_id, amount, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	queryStoreCreditByCode = `
+		SELECT ` + storeCreditColumns + `
+		FROM store_credits
+		WHERE shop_id = $1 AND code = $2
+	`
+	queryStoreCreditByID = `
+		SELECT ` + storeCreditColumns + `
+		FROM store_credits
+		WHERE id = $1
+	`
+	queryRedeemStoreCredit = `
+		UPDATE store_credits
+		SET balance = balance - $1, updated_at = $2
+		WHERE shop_id = $3 AND code = $4 AND balance >= $1
+		RETURNING id
+	`
+	// queryRestoreStoreCredit never gives back more than the redemption took.
+	queryRestoreStoreCredit = `
+		UPDATE store_credits c
+		SET balance = c.balance + $1, updated_at = $2
+		FROM store_credit_transactions t
+		WHERE t.id = $3 AND c.id = t.store_credit_id AND t.amount < 0
+			AND c.balance + $1 <= c.amount
+		RETURNING c.id, t.order_id
+	`
+)
+
+func (r *PostgresReturnRepository) CreateReturn(ctx context.Context, ret *dto.ReturnDTO) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to begin return transaction",
+			slog.String("order_id", ret.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, item := range ret.Items {
+		res, err := tx.ExecContext(ctx, queryReturnOrderItem, item.Quantity, item.OrderItemID, ret.OrderID)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to mark order item returned",
+				slog.String("order_id", ret.OrderID),
+				slog.String("order_item_id", item.OrderItemID),
+				slog.String("error", err.Error()),
+			)
+			return err
+		}
+		if affected, err := res.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return ErrReturnQuantityExceeded
+		}
+	}
+
+	res, err := tx.ExecContext(ctx, queryReserveOrderRefund, ret.RefundAmount, ret.CreatedAt, ret.ShopID, ret.OrderID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to reserve order refund",
+			slog.String("order_id", ret.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrRefundExceedsOrder
+	}
+
+	_, err = tx.ExecContext(ctx, queryCreateReturn,
+		ret.ID, ret.ShopID, ret.OrderID, nullStr(ret.ExchangeOrderID), ret.Reason, ret.RefundMethod,
+		ret.RefundAmount, ret.Status, nullStr(ret.ActorID), ret.CreatedAt,
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert return",
+			slog.String("order_id", ret.OrderID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	for _, item := range ret.Items {
+		_, err = tx.ExecContext(ctx, queryCreateReturnItem,
+			item.ID, ret.ID, item.OrderItemID, item.ProductID, item.ProductName,
+			item.Quantity, item.RefundAmount, item.Disposition, item.VariantID,
+		)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to insert return item",
+				slog.String("return_id", ret.ID),
+				slog.String("error", err.Error()),
+			)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.ErrorContext(ctx, "failed to commit return",
+			slog.String("return_id", ret.ID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	r.logger.InfoContext(ctx, "return created",
+		slog.String("return_id", ret.ID),
+		slog.String("order_id", ret.OrderID),
+	)
+	return nil
+}
+
+// RecordReturnRefund stores money given back for a return against the tender
+// it was taken on. It returns ErrRefundExceedsTender when the tender does not
+// have that much left to refund.
+func (r *PostgresReturnRepository) RecordReturnRefund(ctx context.Context, tender *dto.TenderDTO, refund *dto.ReturnRefundDTO) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to begin return refund transaction",
+			slog.String("return_id", refund.ReturnID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := refundTender(ctx, tx, tender, refund.Amount); err != nil {
+		r.logger.ErrorContext(ctx, "failed to record tender refund",
+			slog.String("return_id", refund.ReturnID),
+			slog.String("tender_id", tender.ID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	_, err = tx.ExecContext(ctx, queryCreateReturnRefund,
+		refund.ID, refund.ReturnID, refund.TenderID, refund.PaymentID, refund.PaymentMethod,
+		refund.Amount, refund.CreatedAt,
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert return refund",
+			slog.String("return_id", refund.ReturnID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *PostgresReturnRepository) FinishReturn(ctx context.Context, ret *dto.ReturnDTO) error {
+	_, err := r.db.ExecContext(ctx, queryFinishReturn,
+		ret.Status, nullStr(ret.StoreCreditID), nullStr(ret.LastError), ret.UpdatedAt, ret.ShopID, ret.ID,
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to finish return",
+			slog.String("return_id", ret.ID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	r.logger.InfoContext(ctx, "return finished",
+		slog.String("return_id", ret.ID),
+		slog.String("status", ret.Status),
+	)
+	return nil
+}
+
+func (r *PostgresReturnRepository) GetReturn(ctx context.Context, shopID string, returnID string) (*dto.ReturnDTO, error) {
+	ret, err := scanReturn(r.db.QueryRowContext(ctx, queryReturnByID, shopID, returnID))
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			r.logger.ErrorContext(ctx, "failed to query return",
+				slog.String("return_id", returnID),
+				slog.String("error", err.Error()),
+			)
+		}
+		return nil, err
+	}
+	if err := r.loadReturn(ctx, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ListReturns returns a page of the shop's returns matching filter, newest
+// first, along with the number of matching returns and the total they refund.
+func (r *PostgresReturnRepository) ListReturns(ctx context.Context, shopID string, filter dto.ReturnFilter) ([]*dto.ReturnDTO, int, float64, error) {
+	where := " FROM order_returns WHERE shop_id = $1"
+	args := []any{shopID}
+
+	if filter.OrderID != "" {
+		args = append(args, filter.OrderID)
+		where += fmt.Sprintf(" AND order_id = $%d", len(args))
+	}
+	if filter.Reason != "" {
+		args = append(args, filter.Reason)
+		where += fmt.Sprintf(" AND lower(reason) = lower($%d)", len(args))
+	}
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		where += fmt.Sprintf(" AND created_at >= $%d", len(args))
+	}
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		where += fmt.Sprintf(" AND created_at < $%d", len(args))
+	}
+
+	var total int
+	var refunded float64
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(1), COALESCE(SUM(refund_amount), 0)"+where, args...).Scan(&total, &refunded)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to count returns",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, 0, 0, err
+	}
+
+	listArgs := append(args, filter.PageSize, (filter.Page-1)*filter.PageSize)
+	listQuery := "SELECT " + returnColumns + where +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+
+	rows, err := r.db.QueryContext(ctx, listQuery, listArgs...)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query returns",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, 0, 0, err
+	}
+	defer rows.Close()
+
+	returns := make([]*dto.ReturnDTO, 0)
+	for rows.Next() {
+		ret, err := scanReturn(rows)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to scan return row",
+				slog.String("shop_id", shopID),
+				slog.String("error", err.Error()),
+			)
+			return nil, 0, 0, err
+		}
+		returns = append(returns, ret)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, 0, err
+	}
+
+	for _, ret := range returns {
+		if err := r.loadReturn(ctx, ret); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+	return returns, total, refunded, nil
+}
+
+// CreateStoreCredit issues a store credit with its full amount as balance.
+func (r *PostgresReturnRepository) CreateStoreCredit(ctx context.Context, credit *dto.StoreCreditDTO) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, queryCreateStoreCredit,
+		credit.ID, credit.ShopID, credit.Code, nullStr(credit.CustomerID), credit.Amount, credit.CreatedAt,
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert store credit",
+			slog.String("shop_id", credit.ShopID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	_, err = tx.ExecContext(ctx, queryCreateStoreCreditTransaction,
+		uuid.New().String(), credit.ID, nil, credit.Amount, credit.CreatedAt,
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert store credit transaction",
+			slog.String("store_credit_id", credit.ID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	credit.Balance = credit.Amount
+	credit.UpdatedAt = credit.CreatedAt
+	r.logger.InfoContext(ctx, "store credit issued",
+		slog.String("store_credit_id", credit.ID),
+		slog.String("shop_id", credit.ShopID),
+	)
+	return nil
+}
+
+func (r *PostgresReturnRepository) GetStoreCredit(ctx context.Context, shopID string, code string) (*dto.StoreCreditDTO, error) {
+	credit, err := scanStoreCredit(r.db.QueryRowContext(ctx, queryStoreCreditByCode, shopID, code))
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			r.logger.ErrorContext(ctx, "failed to query store credit",
+				slog.String("shop_id", shopID),
+				slog.String("error", err.Error()),
+			)
+		}
+		return nil, err
+	}
+	return credit, nil
+}
+
+// RedeemStoreCredit returns sql.ErrNoRows when the shop has no store credit
+// with code, and ErrStoreCreditInsufficient when its balance is too low.
+func (r *PostgresReturnRepository) RedeemStoreCredit(ctx context.Context, shopID string, code string, orderID string, amount float64) (string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	var creditID string
+	err = tx.QueryRowContext(ctx, queryRedeemStoreCredit, amount, now, shopID, code).Scan(&creditID)
+	if errors.Is(err, sql.ErrNoRows) {
+		if _, err := r.GetStoreCredit(ctx, shopID, code); err != nil {
+			return "", err
+		}
+		return "", ErrStoreCreditInsufficient
+	}
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to redeem store credit",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return "", err
+	}
+
+	transactionID := uuid.New().String()
+	_, err = tx.ExecContext(ctx, queryCreateStoreCreditTransaction, transactionID, creditID, orderID, -amount, now)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert store credit transaction",
+			slog.String("store_credit_id", creditID),
+			slog.String("error", err.Error()),
+		)
+		return "", err
+	}
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	r.logger.InfoContext(ctx, "store credit redeemed",
+		slog.String("store_credit_id", creditID),
+		slog.String("order_id", orderID),
+	)
+	return transactionID, nil
+}
+
+// RestoreStoreCredit returns sql.ErrNoRows when transactionID is not a
+// redemption or the credit would end up above its original amount.
+func (r *PostgresReturnRepository) RestoreStoreCredit(ctx context.Context, transactionID string, amount float64) (string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	var creditID string
+	var orderID sql.NullString
+	err = tx.QueryRowContext(ctx, queryRestoreStoreCredit, amount, now, transactionID).Scan(&creditID, &orderID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			r.logger.ErrorContext(ctx, "failed to restore store credit",
+				slog.String("transaction_id", transactionID),
+				slog.String("error", err.Error()),
+			)
+		}
+		return "", err
+	}
+	restoreID := uuid.New().String()
+	_, err = tx.ExecContext(ctx, queryCreateStoreCreditTransaction, restoreID, creditID, orderID, amount, now)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert store credit transaction",
+			slog.String("store_credit_id", creditID),
+			slog.String("error", err.Error()),
+		)
+		return "", err
+	}
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	return restoreID, nil
+}
+
+func (r *PostgresReturnRepository) loadReturn(ctx context.Context, ret *dto.ReturnDTO) error {
+	itemRows, err := r.db.QueryContext(ctx, queryReturnItems, ret.ID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query return items",
+			slog.String("return_id", ret.ID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	defer itemRows.Close()
+
+	ret.Items = make([]*dto.ReturnItemDTO, 0)
+	for itemRows.Next() {
+		var item dto.ReturnItemDTO
+		if err := itemRows.Scan(
+			&item.ID, &item.ReturnID, &item.OrderItemID, &item.ProductID, &item.ProductName,
+			&item.Quantity, &item.RefundAmount, &item.Disposition, &item.VariantID,
+		); err != nil {
+			return err
+		}
+		ret.Items = append(ret.Items, &item)
+	}
+	if err := itemRows.Err(); err != nil {
+		return err
+	}
+
+	refundRows, err := r.db.QueryContext(ctx, queryReturnRefunds, ret.ID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query return refunds",
+			slog.String("return_id", ret.ID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	defer refundRows.Close()
+
+	ret.Refunds = make([]*dto.ReturnRefundDTO, 0)
+	for refundRows.Next() {
+		var refund dto.ReturnRefundDTO
+		if err := refundRows.Scan(
+			&refund.ID, &refund.ReturnID, &refund.TenderID, &refund.PaymentID, &refund.PaymentMethod,
+			&refund.Amount, &refund.CreatedAt,
+		); err != nil {
+			return err
+		}
+		ret.Refunds = append(ret.Refunds, &refund)
+	}
+	if err := refundRows.Err(); err != nil {
+		return err
+	}
+
+	if ret.StoreCreditID != nil {
+		ret.StoreCredit, err = scanStoreCredit(r.db.QueryRowContext(ctx, queryStoreCreditByID, *ret.StoreCreditID))
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to query return store credit",
+				slog.String("return_id", ret.ID),
+				slog.String("error", err.Error()),
+			)
+			return err
+		}
+	}
+	return nil
+}
+
+func scanReturn(row interface{ Scan(...interface{}) error }) (*dto.ReturnDTO, error) {
+	var ret dto.ReturnDTO
+	err := row.Scan(
+		&ret.ID, &ret.ShopID, &ret.OrderID, &ret.ExchangeOrderID, &ret.Reason, &ret.RefundMethod,
+		&ret.RefundAmount, &ret.Status, &ret.StoreCreditID, &ret.ActorID, &ret.LastError,
+		&ret.CreatedAt, &ret.UpdatedAt,
+	)
+	return &ret, err
+}
+
+func scanStoreCredit(row interface{ Scan(...interface{}) error }) (*dto.StoreCreditDTO, error) {
+	var credit dto.StoreCreditDTO
+	err := row.Scan(
+		&credit.ID, &credit.ShopID, &credit.Code, &credit.CustomerID,
+		&credit.Amount, &credit.Balance, &credit.CreatedAt, &credit.UpdatedAt,
+	)
+	return &credit, err
+}
@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"orderservice/internal/tax"
+)
+
+// PostgresTaxRepository is the tax.Store backed by the shop_tax_settings and
+// tax_rules tables.
+type PostgresTaxRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewPostgresTaxRepository(db *sql.DB, logger *slog.Logger) *PostgresTaxRepository {
+	return &PostgresTaxRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const (
+	queryShopTaxSettings = `
+		SELECT shop_id, country, COALESCE(state, ''), prices_include_tax, rounding_mode, rounding_level
+		FROM shop_tax_settings
+		WHERE shop_id = $1
+	`
+	queryTaxRules = `
+		SELECT id, COALESCE(shop_id::text, ''), country, COALESCE(state, ''), COALESCE(category_id::text, ''),
+			name, rate, use_product_rate, compound, sequence
+		FROM tax_rules
+		WHERE is_active
+		  AND (shop_id IS NULL OR shop_id = $1)
+		  AND UPPER(country) = UPPER($2)
+		  AND (state IS NULL OR UPPER(state) = UPPER($3))
+		ORDER BY sequence, name
+	`
+)
+
+func (r *PostgresTaxRepository) Settings(ctx context.Context, shopID string) (*tax.Settings, error) {
+	var s tax.Settings
+	err := r.db.QueryRowContext(ctx, queryShopTaxSettings, shopID).Scan(
+		&s.ShopID, &s.Country, &s.State, &s.PricesIncludeTax, &s.RoundingMode, &s.RoundingLevel,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.ErrorContext(ctx, "failed to query shop tax settings",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	if err := tax.ValidateSettings(&s); err != nil {
+		r.logger.ErrorContext(ctx, "invalid shop tax settings",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (r *PostgresTaxRepository) Rules(ctx context.Context, shopID, country, state string) ([]tax.Rule, error) {
+	rows, err := r.db.QueryContext(ctx, queryTaxRules, shopID, country, state)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query tax rules",
+			slog.String("shop_id", shopID),
+			slog.String("country", country),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]tax.Rule, 0)
+	for rows.Next() {
+		var t tax.Rule
+		if err := rows.Scan(
+			&t.ID, &t.ShopID, &t.Country, &t.State, &t.CategoryID,
+			&t.Name, &t.Rate, &t.UseProductRate, &t.Compound, &t.Sequence,
+		); err != nil {
+			return nil, err
+		}
+		rules = append(rules, t)
+	}
+	return rules, rows.Err()
+}
@@ -0,0 +1,72 @@
+package receipt
+
+import (
+	"bytes"
+	"image"
+	"strings"
+)
+
+// ESC/POS command bytes.
+var (
+	escInit        = []byte{0x1b, 0x40}       // ESC @
+	escCodePage437 = []byte{0x1b, 0x74, 0x00} // ESC t 0
+	escAlignLeft   = []byte{0x1b, 0x61, 0x00} // ESC a 0
+	escAlignCenter = []byte{0x1b, 0x61, 0x01} // ESC a 1
+	escFeed4       = []byte{0x1b, 0x64, 0x04} // ESC d 4
+	gsPartialCut   = []byte{0x1d, 0x56, 0x42, 0x00}
+)
+
+// ESCPOS encodes a rendered receipt for a thermal printer: the logo as a
+// raster image, the text, then a feed and a partial cut. Characters outside
+// ASCII are printed as '?'.
+func ESCPOS(text string, r *Receipt, paper Paper) []byte {
+	var buf bytes.Buffer
+	buf.Write(escInit)
+	buf.Write(escCodePage437)
+
+	if r.Logo != nil {
+		buf.Write(escAlignCenter)
+		writeRaster(&buf, grayscale(r.Logo, paper.Dots()/2))
+		buf.WriteByte('\n')
+		buf.Write(escAlignLeft)
+	}
+
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		buf.WriteString(ascii(line))
+		buf.WriteByte('\n')
+	}
+
+	buf.Write(escFeed4)
+	buf.Write(gsPartialCut)
+	return buf.Bytes()
+}
+
+// writeRaster prints img with GS v 0, one bit per dot, dark pixels black.
+func writeRaster(buf *bytes.Buffer, img *image.Gray) {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	if w == 0 || h == 0 {
+		return
+	}
+	rowBytes := (w + 7) / 8
+
+	buf.Write([]byte{0x1d, 0x76, 0x30, 0x00,
+		byte(rowBytes), byte(rowBytes >> 8), byte(h), byte(h >> 8)})
+	for y := 0; y < h; y++ {
+		row := make([]byte, rowBytes)
+		for x := 0; x < w; x++ {
+			if img.GrayAt(x, y).Y < 128 {
+				row[x/8] |= 0x80 >> (x % 8)
+			}
+		}
+		buf.Write(row)
+	}
+}
+
+func ascii(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r > 0x7e || (r < 0x20 && r != '\t') {
+			return '?'
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,74 @@
+package receipt
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxLogoBytes caps the size of a downloaded logo.
+const maxLogoBytes = 2 << 20
+
+var logoClient = &http.Client{Timeout: 5 * time.Second}
+
+// FetchLogo downloads and decodes the image at url, e.g. a shop's logo. Only
+// http and https URLs are fetched.
+func FetchLogo(ctx context.Context, url string) (image.Image, error) {
+	if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+		return nil, fmt.Errorf("fetch logo: unsupported url %q", url)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := logoClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch logo: %s", resp.Status)
+	}
+
+	img, _, err := image.Decode(io.LimitReader(resp.Body, maxLogoBytes))
+	return img, err
+}
+
+// grayscale scales img down to at most width pixels wide, keeping its aspect
+// ratio, and converts it to grayscale. Transparent pixels become white.
+func grayscale(img image.Image, width int) *image.Gray {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > width {
+		h = h * width / w
+		w = width
+	}
+	if w <= 0 || h <= 0 {
+		return image.NewGray(image.Rect(0, 0, 0, 0))
+	}
+
+	out := image.NewGray(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			sx := b.Min.X + x*b.Dx()/w
+			sy := b.Min.Y + y*b.Dy()/h
+			r, g, bl, a := img.At(sx, sy).RGBA()
+			// composite onto white
+			lum := (299*r + 587*g + 114*bl) / 1000
+			lum = lum*a/0xffff + (0xffff - a)
+			out.SetGray(x, y, color.Gray{Y: uint8(lum >> 8)})
+		}
+	}
+	return out
+}
@@ -0,0 +1,123 @@
+package receipt
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+)
+
+const (
+	pointsPerMM  = 72 / 25.4
+	pdfMargin    = 8.0 // points
+	courierWidth = 0.6 // glyph advance as a fraction of the font size
+	lineSpacing  = 1.2
+)
+
+// PDF lays a rendered receipt out on a single page as wide as the paper roll
+// and as long as the receipt, in Courier so the template's columns line up.
+// Characters outside Latin-1 are printed as '?'.
+func PDF(text string, r *Receipt, paper Paper) ([]byte, error) {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+
+	pageWidth := paper.Millimetres() * pointsPerMM
+	fontSize := (pageWidth - 2*pdfMargin) / (float64(paper.Columns()) * courierWidth)
+	leading := fontSize * lineSpacing
+
+	var logoW, logoH float64
+	var logo []byte
+	var logoPx [2]int
+	if r.Logo != nil {
+		gray := grayscale(r.Logo, paper.Dots()/2)
+		logoPx = [2]int{gray.Bounds().Dx(), gray.Bounds().Dy()}
+		if logoPx[0] > 0 && logoPx[1] > 0 {
+			var z bytes.Buffer
+			zw := zlib.NewWriter(&z)
+			for y := 0; y < logoPx[1]; y++ {
+				zw.Write(gray.Pix[y*gray.Stride : y*gray.Stride+logoPx[0]])
+			}
+			if err := zw.Close(); err != nil {
+				return nil, err
+			}
+			logo = z.Bytes()
+			logoW = (pageWidth - 2*pdfMargin) / 2
+			logoH = logoW * float64(logoPx[1]) / float64(logoPx[0])
+		}
+	}
+
+	pageHeight := 2*pdfMargin + float64(len(lines))*leading
+	if logo != nil {
+		pageHeight += logoH + leading
+	}
+
+	var content bytes.Buffer
+	if logo != nil {
+		fmt.Fprintf(&content, "q %.2f 0 0 %.2f %.2f %.2f cm /Logo Do Q\n",
+			logoW, logoH, (pageWidth-logoW)/2, pageHeight-pdfMargin-logoH)
+	}
+	// ' moves down one line before showing text, so start a line higher
+	top := pageHeight - pdfMargin - fontSize + leading
+	if logo != nil {
+		top -= logoH + leading
+	}
+	fmt.Fprintf(&content, "BT /F1 %.2f Tf %.2f TL %.2f %.2f Td\n", fontSize, leading, pdfMargin, top)
+	for _, line := range lines {
+		fmt.Fprintf(&content, "(%s) '\n", pdfString(line))
+	}
+	content.WriteString("ET\n")
+
+	resources := "/Font << /F1 4 0 R >>"
+	if logo != nil {
+		resources += " /XObject << /Logo 6 0 R >>"
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << %s >> /Contents 5 0 R >>",
+			pageWidth, pageHeight, resources),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+	}
+	if logo != nil {
+		objects = append(objects, fmt.Sprintf(
+			"<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /FlateDecode /Length %d >>\nstream\n%s\nendstream",
+			logoPx[0], logoPx[1], len(logo), logo))
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return out.Bytes(), nil
+}
+
+// pdfString escapes s for a PDF literal string in WinAnsiEncoding.
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r <= 0x7e:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
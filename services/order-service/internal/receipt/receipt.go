@@ -0,0 +1,87 @@
+// Package receipt renders orders as printable receipts. A receipt is first
+// rendered to monospaced text by a shop's template, which is then output as
+// is, laid out on a PDF page or encoded as ESC/POS commands for a thermal
+// printer.
+package receipt
+
+import (
+	"image"
+	"time"
+)
+
+// Paper is the roll width of a thermal printer.
+type Paper int
+
+const (
+	Paper80mm Paper = iota
+	Paper58mm
+)
+
+// Columns is the number of Font A characters that fit on a line.
+func (p Paper) Columns() int {
+	if p == Paper58mm {
+		return 32
+	}
+	return 48
+}
+
+// Dots is the printable width in dots at 203 dpi.
+func (p Paper) Dots() int {
+	if p == Paper58mm {
+		return 384
+	}
+	return 576
+}
+
+// Millimetres is the width of the roll.
+func (p Paper) Millimetres() float64 {
+	if p == Paper58mm {
+		return 58
+	}
+	return 80
+}
+
+// Receipt is everything a template can print. Amounts are in the order's
+// currency.
+type Receipt struct {
+	ShopName         string
+	ShopLogo         string
+	Logo             image.Image
+	OrderID          string
+	Number           string
+	Status           string
+	Date             time.Time
+	Items            []Item
+	Subtotal         float64
+	Discount         float64
+	Taxes            []Tax
+	Tax              float64
+	Total            float64
+	PricesIncludeTax bool
+	Tenders          []Tender
+	AmountPaid       float64
+	BalanceDue       float64
+	ChangeDue        float64
+}
+
+type Item struct {
+	Name      string
+	Quantity  int32
+	UnitPrice float64
+	Amount    float64
+	TaxRate   float64
+}
+
+// Tax is the tax charged at one rate.
+type Tax struct {
+	Name   string
+	Rate   float64
+	Amount float64
+}
+
+type Tender struct {
+	Method   string
+	Amount   float64
+	Tendered float64
+	Change   float64
+}
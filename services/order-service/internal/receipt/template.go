@@ -0,0 +1,147 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultTemplate is used by shops that have not set their own. Templates are
+// Go text/template documents executed with a View; see Funcs for the helpers
+// available to lay out columns.
+const DefaultTemplate = `{{center .ShopName}}
+{{rule "="}}
+{{lr "Order" .Number}}
+{{lr "Date" (.Date.Format "2006-01-02 15:04")}}
+{{rule "-"}}
+{{range .Items}}{{wrap .Name}}
+{{lr (printf "  %d x %s" .Quantity (money .UnitPrice)) (money .Amount)}}
+{{end}}{{rule "-"}}
+{{lr "Subtotal" (money .Subtotal)}}
+{{if .Discount}}{{lr "Discount" (printf "-%s" (money .Discount))}}
+{{end}}{{range .Taxes}}{{lr (printf "%s %s%%" .Name (rate .Rate)) (money .Amount)}}
+{{end}}{{if .PricesIncludeTax}}{{center "(prices include tax)"}}
+{{end}}{{rule "="}}
+{{lr "TOTAL" (money .Total)}}
+{{rule "="}}
+{{range .Tenders}}{{lr (title .Method) (money .Tendered)}}
+{{end}}{{if .ChangeDue}}{{lr "Change" (money .ChangeDue)}}
+{{end}}{{if .BalanceDue}}{{lr "Balance due" (money .BalanceDue)}}
+{{end}}
+{{center "Thank you!"}}
+`
+
+// View is the data a template is executed with.
+type View struct {
+	*Receipt
+	Width int
+}
+
+// Funcs are the helpers available to templates, for a paper width in
+// characters:
+//
+//	center s       s centred on the line
+//	lr l r         l and r aligned to the left and right edges
+//	rule c         a line of c
+//	wrap s         s broken into lines at word boundaries
+//	money v        v with two decimals
+//	rate v         a tax rate without trailing zeros
+//	title s        s with underscores as spaces and the first letter upper case
+func Funcs(width int) template.FuncMap {
+	return template.FuncMap{
+		"center": func(s string) string {
+			n := utf8.RuneCountInString(s)
+			if n >= width {
+				return s
+			}
+			return strings.Repeat(" ", (width-n)/2) + s
+		},
+		"lr": func(l, r string) string {
+			gap := width - utf8.RuneCountInString(l) - utf8.RuneCountInString(r)
+			if gap < 1 {
+				return l + "\n" + strings.Repeat(" ", max(width-utf8.RuneCountInString(r), 0)) + r
+			}
+			return l + strings.Repeat(" ", gap) + r
+		},
+		"rule": func(c string) string {
+			if c == "" {
+				c = "-"
+			}
+			return strings.Repeat(c, width/utf8.RuneCountInString(c))
+		},
+		"wrap": func(s string) string {
+			var lines []string
+			line := ""
+			for _, word := range strings.Fields(s) {
+				if line != "" && utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) > width {
+					lines = append(lines, line)
+					line = ""
+				}
+				if line != "" {
+					line += " "
+				}
+				line += word
+			}
+			return strings.Join(append(lines, line), "\n")
+		},
+		"money": func(v float64) string {
+			return fmt.Sprintf("%.2f", v)
+		},
+		"rate": func(v float64) string {
+			return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.4f", v), "0"), ".")
+		},
+		"title": func(s string) string {
+			s = strings.ReplaceAll(s, "_", " ")
+			if s == "" {
+				return s
+			}
+			return strings.ToUpper(s[:1]) + s[1:]
+		},
+	}
+}
+
+// Validate checks that text parses and renders a sample receipt, so that
+// templates referring to unknown fields are rejected when they are saved
+// rather than when a receipt is printed.
+func Validate(text string) error {
+	sample := &Receipt{
+		ShopName: "Shop",
+		Number:   "00000000",
+		Date:     time.Now(),
+		Items:    []Item{{Name: "Item", Quantity: 1, UnitPrice: 1, Amount: 1}},
+		Subtotal: 1,
+		Taxes:    []Tax{{Name: "Tax", Rate: 10, Amount: 0.1}},
+		Tax:      0.1,
+		Total:    1.1,
+		Tenders:  []Tender{{Method: "cash", Amount: 1.1, Tendered: 2, Change: 0.9}},
+	}
+	for _, paper := range []Paper{Paper80mm, Paper58mm} {
+		if _, err := Text(text, sample, paper); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Text renders r with the template text for paper. An empty text selects
+// DefaultTemplate.
+func Text(text string, r *Receipt, paper Paper) (string, error) {
+	if text == "" {
+		text = DefaultTemplate
+	}
+
+	width := paper.Columns()
+	tmpl, err := template.New("receipt").Funcs(Funcs(width)).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, View{Receipt: r, Width: width}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,421 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"orderservice/internal/domain/dto"
+	"orderservice/proto/orderpb"
+	"paymentservice/proto/paymentpb"
+)
+
+const (
+	defaultCurrency        = "USD"
+	paymentStatusCompleted = "completed"
+	paymentStatusPending   = "pending"
+	// paymentStatusPartiallyRefunded payments still have money to give back.
+	paymentStatusPartiallyRefunded = "partially_refunded"
+	rollbackReason                 = "checkout rolled back"
+)
+
+// Refund reason codes understood by the payment service.
+const (
+	refundReasonCheckoutFailed = "checkout_failed"
+	refundReasonOrderCancelled = "order_cancelled"
+	refundReasonItemReturned   = "item_returned"
+	refundReasonOther          = "other"
+)
+
+// Payments takes and refunds payments for orders on the payment service.
+type Payments interface {
+	ProcessPayment(ctx context.Context, req *paymentpb.ProcessPaymentRequest) (*paymentpb.ProcessPaymentResponse, error)
+	RefundPayment(ctx context.Context, paymentID string, amount float64, reasonCode, reason string) error
+	ListOrderPayments(ctx context.Context, orderID string) ([]*paymentpb.Payment, error)
+	RecordStoreCreditMovement(ctx context.Context, kind, referenceID, orderID string, amount float64, currency string) error
+}
+
+// Checkout runs the checkout saga: create the order, reserve its stock, take
+// payment and confirm the order. Progress is persisted after every step so
+// ResumeCheckouts can finish or undo it after a crash. When a step fails the
+// completed ones are compensated (refund, release stock, cancel order) and the
+// error of the failed step is returned.
+func (s *OrderService) Checkout(ctx context.Context, req *orderpb.CheckoutRequest) (*orderpb.CheckoutResponse, error) {
+	if req.Order == nil || req.Order.PaymentMethod == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+	// The saga keeps the user it runs for: compensating it, also after a
+	// restart, calls the product service, which takes no call without one.
+	actor := actorID(ctx)
+	if actor == nil {
+		return nil, errors.GRPC(codes.Unauthenticated, errors.ErrUnauthorizedCode, errors.ErrUnauthorizedMsg)
+	}
+
+	// The order is paid in full here, so it is priced in the currency it
+	// is paid in.
+	orderReq := req.Order
+	if req.Currency != "" {
+		if orderReq.Currency != "" && !strings.EqualFold(orderReq.Currency, req.Currency) {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.CurrencyUnsupportedCode, errors.CurrencyUnsupportedMsg)
+		}
+		orderReq = proto.Clone(req.Order).(*orderpb.CreateOrderRequest)
+		orderReq.Currency = req.Currency
+	}
+
+	order, err := s.buildOrder(ctx, orderReq)
+	if err != nil {
+		return nil, err
+	}
+	currency := order.Currency
+
+	now := time.Now()
+	saga := &dto.CheckoutSagaDTO{
+		ID:            uuid.New().String(),
+		ShopID:        order.ShopID,
+		OrderID:       order.ID,
+		ActorID:       actor,
+		Step:          dto.CheckoutStepStarted,
+		Status:        dto.CheckoutStatusRunning,
+		Amount:        order.TotalAmount,
+		Currency:      currency,
+		PaymentMethod: order.PaymentMethod,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := s.checkouts.CreateSaga(ctx, saga); err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.OrderCreateFailedCode, errors.OrderCreateFailedMsg)
+	}
+
+	if err := s.saveOrder(ctx, order); err != nil {
+		return nil, s.abortCheckout(ctx, saga, err)
+	}
+	if err := s.advance(ctx, saga, dto.CheckoutStepOrderCreated); err != nil {
+		return nil, s.abortCheckout(ctx, saga, err)
+	}
+
+	if err := s.reserveStock(ctx, order); err != nil {
+		return nil, s.abortCheckout(ctx, saga, err)
+	}
+	if err := s.advance(ctx, saga, dto.CheckoutStepStockReserved); err != nil {
+		return nil, s.abortCheckout(ctx, saga, err)
+	}
+
+	// Recorded before calling out so that a crash mid-call is resolved by
+	// asking the payment service rather than by guessing.
+	if err := s.advance(ctx, saga, dto.CheckoutStepPaymentStarted); err != nil {
+		return nil, s.abortCheckout(ctx, saga, err)
+	}
+	payment, err := s.payments.ProcessPayment(ctx, &paymentpb.ProcessPaymentRequest{
+		OrderId:       order.ID,
+		UserId:        ptrOrEmpty(order.UserID),
+		Amount:        order.TotalAmount,
+		Currency:      currency,
+		AmountMoney:   moneyProto(order.TotalAmount, currency),
+		PaymentMethod: req.Order.PaymentMethod,
+		CardToken:     req.CardToken,
+	})
+	if err != nil {
+		s.logger.WarnContext(ctx, "checkout payment failed",
+			slog.String("order_id", order.ID),
+			slog.String("error", err.Error()),
+		)
+		return nil, s.abortCheckout(ctx, saga, paymentError(err))
+	}
+	saga.PaymentID = &payment.PaymentId
+	if payment.Status != paymentStatusCompleted {
+		return nil, s.abortCheckout(ctx, saga,
+			errors.GRPC(codes.FailedPrecondition, errors.PaymentDeclinedCode, errors.PaymentDeclinedMsg))
+	}
+	if err := s.advance(ctx, saga, dto.CheckoutStepPaymentCompleted); err != nil {
+		return nil, s.abortCheckout(ctx, saga, err)
+	}
+
+	confirmed, err := s.confirmCheckout(ctx, saga)
+	if err != nil {
+		return nil, s.abortCheckout(ctx, saga, err)
+	}
+
+	return &orderpb.CheckoutResponse{
+		CheckoutId:  saga.ID,
+		OrderId:     confirmed.ID,
+		PaymentId:   payment.PaymentId,
+		Status:      statusToProto(confirmed.Status),
+		TotalAmount: confirmed.TotalAmount,
+		Message:     "checkout completed",
+	}, nil
+}
+
+// ResumeCheckouts finishes or undoes checkouts that were interrupted by a
+// restart. It must run before the server accepts requests, since it assumes
+// no other goroutine is driving the sagas it loads.
+func (s *OrderService) ResumeCheckouts(ctx context.Context) {
+	sagas, err := s.checkouts.ListUnfinished(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, saga := range sagas {
+		s.logger.InfoContext(ctx, "resuming checkout",
+			slog.String("saga_id", saga.ID),
+			slog.String("order_id", saga.OrderID),
+			slog.String("step", saga.Step),
+			slog.String("status", saga.Status),
+		)
+		s.resumeCheckout(sagaContext(ctx, saga), saga)
+	}
+}
+
+func (s *OrderService) resumeCheckout(ctx context.Context, saga *dto.CheckoutSagaDTO) {
+	if saga.Status == dto.CheckoutStatusCompensating {
+		s.compensate(ctx, saga)
+		return
+	}
+
+	switch saga.Step {
+	case dto.CheckoutStepPaymentStarted:
+		payments, err := s.payments.ListOrderPayments(ctx, saga.OrderID)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to look up checkout payment, will retry on next start",
+				slog.String("saga_id", saga.ID),
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+		for _, p := range payments {
+			if p.Status == paymentStatusCompleted {
+				saga.PaymentID = &p.Id
+				break
+			}
+		}
+		if saga.PaymentID == nil {
+			s.abortCheckout(ctx, saga, fmt.Errorf("checkout interrupted during payment"))
+			return
+		}
+		if err := s.advance(ctx, saga, dto.CheckoutStepPaymentCompleted); err != nil {
+			return
+		}
+		fallthrough
+
+	case dto.CheckoutStepPaymentCompleted:
+		if _, err := s.confirmCheckout(ctx, saga); err != nil {
+			s.abortCheckout(ctx, saga, err)
+		}
+
+	default:
+		// The card details needed to take payment are never stored, so a
+		// checkout interrupted before payment can only be undone.
+		s.abortCheckout(ctx, saga, fmt.Errorf("checkout interrupted at step %s", saga.Step))
+	}
+}
+
+// confirmCheckout confirms the paid order, which also commits its stock.
+// Confirming an order that is already confirmed only completes the saga.
+func (s *OrderService) confirmCheckout(ctx context.Context, saga *dto.CheckoutSagaDTO) (*dto.OrderDTO, error) {
+	order, err := s.repo.GetByID(ctx, saga.ShopID, saga.OrderID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.OrderNotFoundCode, errors.OrderNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.OrderFetchFailedCode, errors.OrderFetchFailedMsg)
+	}
+
+	if saga.PaymentID != nil {
+		if err := s.recordCheckoutTender(ctx, saga); err != nil {
+			return nil, err
+		}
+	}
+
+	if order.Status != dto.OrderStatusConfirmed {
+		if !canTransition(order.Status, dto.OrderStatusConfirmed) {
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.OrderInvalidTransitionCode, errors.OrderInvalidTransitionMsg)
+		}
+		if order, err = s.transition(ctx, order, dto.OrderStatusConfirmed, "payment received"); err != nil {
+			return nil, err
+		}
+	}
+
+	saga.Step = dto.CheckoutStepConfirmed
+	saga.Status = dto.CheckoutStatusCompleted
+	s.saveSaga(ctx, saga)
+
+	return order, nil
+}
+
+// abortCheckout marks the saga as compensating, undoes its completed steps
+// and returns cause. If compensation fails the saga stays compensating and is
+// retried by ResumeCheckouts.
+func (s *OrderService) abortCheckout(ctx context.Context, saga *dto.CheckoutSagaDTO, cause error) error {
+	s.logger.WarnContext(ctx, "rolling back checkout",
+		slog.String("saga_id", saga.ID),
+		slog.String("order_id", saga.OrderID),
+		slog.String("step", saga.Step),
+		slog.String("error", cause.Error()),
+	)
+
+	msg := cause.Error()
+	saga.LastError = &msg
+	saga.Status = dto.CheckoutStatusCompensating
+	s.saveSaga(ctx, saga)
+
+	s.compensate(ctx, saga)
+
+	return cause
+}
+
+// compensate refunds the payment, releases the stock and cancels the order.
+// Every action tolerates having already been done, so it is safe to repeat.
+func (s *OrderService) compensate(ctx context.Context, saga *dto.CheckoutSagaDTO) {
+	fail := func(action string, err error) {
+		s.logger.ErrorContext(ctx, "checkout compensation failed",
+			slog.String("saga_id", saga.ID),
+			slog.String("order_id", saga.OrderID),
+			slog.String("action", action),
+			slog.String("error", err.Error()),
+		)
+		msg := action + ": " + err.Error()
+		saga.LastError = &msg
+		s.saveSaga(ctx, saga)
+	}
+
+	if err := s.refundCheckout(ctx, saga); err != nil {
+		fail("refund payment", err)
+		return
+	}
+
+	if err := s.inventory.ReleaseStock(ctx, saga.OrderID, rollbackReason); err != nil && status.Code(err) != codes.NotFound {
+		fail("release stock", err)
+		return
+	}
+
+	order, err := s.repo.GetByID(ctx, saga.ShopID, saga.OrderID)
+	switch {
+	case err == sql.ErrNoRows:
+		// the order was never written
+	case err != nil:
+		fail("load order", err)
+		return
+	case canTransition(order.Status, dto.OrderStatusCancelled):
+		if _, err := s.transition(ctx, order, dto.OrderStatusCancelled, rollbackReason); err != nil {
+			fail("cancel order", err)
+			return
+		}
+	}
+
+	saga.Status = dto.CheckoutStatusCompensated
+	s.saveSaga(ctx, saga)
+}
+
+// refundCheckout gives back what a checkout was paid. A saga that failed
+// while taking payment may not know its payment, e.g. when the call timed out
+// after the charge went through, so the order's payments are looked up. It
+// returns an error, leaving the saga to be retried, unless every payment of
+// the checkout is confirmed to have nothing left to give back.
+func (s *OrderService) refundCheckout(ctx context.Context, saga *dto.CheckoutSagaDTO) error {
+	if saga.PaymentID == nil && saga.Step != dto.CheckoutStepPaymentStarted {
+		return nil
+	}
+
+	payments, err := s.checkoutPayments(ctx, saga)
+	if err != nil {
+		return err
+	}
+	recheck := false
+	for _, p := range payments {
+		if p.Status == paymentStatusPending {
+			// It may still go through; wait for it to settle.
+			return fmt.Errorf("payment %s is still %s", p.Id, p.Status)
+		}
+		if !refundablePayment(p.Status) {
+			continue
+		}
+		err := s.payments.RefundPayment(ctx, p.Id, 0, refundReasonCheckoutFailed, rollbackReason)
+		switch {
+		case status.Code(err) == codes.FailedPrecondition:
+			// Refunded by an earlier attempt, or not refundable at
+			// all; only the payment's status tells which.
+			recheck = true
+		case err != nil:
+			return err
+		}
+	}
+	if !recheck {
+		return nil
+	}
+
+	if payments, err = s.checkoutPayments(ctx, saga); err != nil {
+		return err
+	}
+	for _, p := range payments {
+		if refundablePayment(p.Status) {
+			return fmt.Errorf("payment %s is still %s", p.Id, p.Status)
+		}
+	}
+	return nil
+}
+
+// checkoutPayments returns the payments a checkout took: its own when it
+// knows it, else every payment on its order.
+func (s *OrderService) checkoutPayments(ctx context.Context, saga *dto.CheckoutSagaDTO) ([]*paymentpb.Payment, error) {
+	payments, err := s.payments.ListOrderPayments(ctx, saga.OrderID)
+	if err != nil || saga.PaymentID == nil {
+		return payments, err
+	}
+	for _, p := range payments {
+		if p.Id == *saga.PaymentID {
+			return []*paymentpb.Payment{p}, nil
+		}
+	}
+	return nil, fmt.Errorf("payment %s not found", *saga.PaymentID)
+}
+
+func refundablePayment(status string) bool {
+	return status == paymentStatusCompleted || status == paymentStatusPartiallyRefunded
+}
+
+func (s *OrderService) advance(ctx context.Context, saga *dto.CheckoutSagaDTO, step string) error {
+	saga.Step = step
+	if err := s.saveSaga(ctx, saga); err != nil {
+		return errors.GRPC(codes.Internal, errors.OrderUpdateFailedCode, errors.OrderUpdateFailedMsg)
+	}
+	return nil
+}
+
+func (s *OrderService) saveSaga(ctx context.Context, saga *dto.CheckoutSagaDTO) error {
+	saga.UpdatedAt = time.Now()
+	return s.checkouts.SaveSaga(ctx, saga)
+}
+
+// sagaContext rebuilds the request context a saga was started with, so the
+// calls made while resuming it are scoped to the same shop and user.
+func sagaContext(ctx context.Context, saga *dto.CheckoutSagaDTO) context.Context {
+	md := metadata.Pairs("x-shop-id", saga.ShopID)
+	ctx = context.WithValue(ctx, reqCtx.ShopIDKey, saga.ShopID)
+	if saga.ActorID != nil {
+		md.Set("x-user-id", *saga.ActorID)
+		ctx = context.WithValue(ctx, reqCtx.UserIDKey, *saga.ActorID)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// paymentError passes declines and validation errors from the payment
+// service through unchanged and reports anything else as it being unavailable.
+func paymentError(err error) error {
+	switch status.Code(err) {
+	case codes.FailedPrecondition, codes.InvalidArgument, codes.NotFound:
+		return err
+	default:
+		return errors.GRPC(codes.Unavailable, errors.ErrPaymentServiceCode, errors.ErrPaymentServiceMsg)
+	}
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+	"hpkg/money"
+	"hpkg/money/moneypb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"orderservice/internal/domain/dto"
+	"orderservice/proto/orderpb"
+)
+
+// permExchangeRateUpdate lets a caller change the shop's exchange rates.
+const permExchangeRateUpdate = "PermExchangeRateUpdate"
+
+// SetExchangeRate sets how many units of the quote currency one unit of the
+// base currency buys in the caller's shop. The inverse pair needs no rate of
+// its own; it is derived when only this one is set.
+func (s *OrderService) SetExchangeRate(ctx context.Context, req *orderpb.SetExchangeRateRequest) (*orderpb.ExchangeRate, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reqCtx.HasPermission(ctx, permExchangeRateUpdate) {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+
+	base, err := currencyCode(req.BaseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := currencyCode(req.QuoteCurrency)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := money.ParseRate(req.Rate)
+	if err != nil || base == quote {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ExchangeRateInvalidCode, errors.ExchangeRateInvalidMsg)
+	}
+
+	saved, err := s.rates.SaveRate(ctx, &dto.ExchangeRateDTO{
+		ShopID:        shopID,
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		Rate:          formatRate(rate),
+		UpdatedAt:     time.Now(),
+	})
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	s.logger.InfoContext(ctx, "exchange rate updated",
+		slog.String("shop_id", shopID),
+		slog.String("base_currency", base),
+		slog.String("quote_currency", quote),
+		slog.String("rate", saved.Rate),
+	)
+
+	return toExchangeRate(saved), nil
+}
+
+// ListExchangeRates returns the rates set for the caller's shop.
+func (s *OrderService) ListExchangeRates(ctx context.Context, req *orderpb.ListExchangeRatesRequest) (*orderpb.ListExchangeRatesResponse, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := s.rates.ListRates(ctx, shopID)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	resp := &orderpb.ListExchangeRatesResponse{Rates: make([]*orderpb.ExchangeRate, 0, len(rates))}
+	for _, r := range rates {
+		resp.Rates = append(resp.Rates, toExchangeRate(r))
+	}
+	return resp, nil
+}
+
+// exchangeRate returns how many units of to one unit of from buys in the
+// shop, using the inverse of the to/from rate when only that one is set.
+func (s *OrderService) exchangeRate(ctx context.Context, shopID, from, to string) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	inverse := false
+	r, err := s.rates.GetRate(ctx, shopID, from, to)
+	if err == sql.ErrNoRows {
+		inverse = true
+		r, err = s.rates.GetRate(ctx, shopID, to, from)
+	}
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.ExchangeRateNotFoundCode, errors.ExchangeRateNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	rate, err := money.ParseRate(r.Rate)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "invalid stored exchange rate",
+			slog.String("shop_id", shopID),
+			slog.String("base_currency", r.BaseCurrency),
+			slog.String("quote_currency", r.QuoteCurrency),
+			slog.String("rate", r.Rate),
+		)
+		return nil, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+	}
+	if inverse {
+		rate.Inv(rate)
+	}
+	return rate, nil
+}
+
+// currencyCode checks a currency code sent by a client and returns it in its
+// canonical form. An empty code is the default currency.
+func currencyCode(code string) (string, error) {
+	if code == "" {
+		return defaultCurrency, nil
+	}
+	c, err := money.Lookup(code)
+	if err != nil {
+		return "", errors.GRPC(codes.InvalidArgument, errors.CurrencyUnsupportedCode, errors.CurrencyUnsupportedMsg)
+	}
+	return c.Code, nil
+}
+
+// toMoney returns an amount held as a float in major units as exact money.
+// Currencies are checked when they enter the service, so one money does not
+// know is treated as the default.
+func toMoney(amount float64, currency string) money.Money {
+	m, err := money.FromFloat(amount, currency)
+	if err != nil {
+		m, _ = money.FromFloat(amount, defaultCurrency)
+	}
+	return m
+}
+
+// fromMinor returns minor units of currency as money, with the same fallback
+// as toMoney.
+func fromMinor(amount int64, currency string) money.Money {
+	m, err := money.New(amount, currency)
+	if err != nil {
+		m, _ = money.New(amount, defaultCurrency)
+	}
+	return m
+}
+
+// minorUnits returns an amount held as a float in major units as a whole
+// number of currency's minor units, which order amounts are added up in.
+func minorUnits(amount float64, currency string) int64 {
+	return toMoney(amount, currency).Amount
+}
+
+// majorUnits returns minor units of currency as the float in major units
+// that orders are stored and sent with.
+func majorUnits(amount int64, currency string) float64 {
+	return fromMinor(amount, currency).Float()
+}
+
+func moneyProto(amount float64, currency string) *moneypb.Money {
+	return money.ToProto(toMoney(amount, currency))
+}
+
+// formatRate writes a rate as a decimal with no trailing zeros, e.g. "4100"
+// or "0.000244".
+func formatRate(r *big.Rat) string {
+	s := r.FloatString(10)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+// normalizeRate tidies a rate read back from the database, e.g.
+// "4100.0000000000" to "4100".
+func normalizeRate(s string) string {
+	r, err := money.ParseRate(s)
+	if err != nil {
+		return s
+	}
+	return formatRate(r)
+}
+
+func toExchangeRate(r *dto.ExchangeRateDTO) *orderpb.ExchangeRate {
+	return &orderpb.ExchangeRate{
+		BaseCurrency:  r.BaseCurrency,
+		QuoteCurrency: r.QuoteCurrency,
+		Rate:          normalizeRate(r.Rate),
+		UpdatedAt:     timestamppb.New(r.UpdatedAt),
+	}
+}
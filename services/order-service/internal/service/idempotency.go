@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotencyKeyTTL is how long a response is kept for replay.
+const idempotencyKeyTTL = 24 * time.Hour
+
+// beginIdempotent claims the call's idempotency key for operation within the
+// caller's shop. It returns the key, "" when the caller sent none, and whether
+// resp was filled in from an earlier request made with the same key and
+// payload.
+func (s *OrderService) beginIdempotent(ctx context.Context, operation string, req, resp proto.Message) (string, bool, error) {
+	key := reqCtx.GetIdempotencyKey(ctx)
+	if key == "" {
+		return "", false, nil
+	}
+	if len(key) > reqCtx.MaxIdempotencyKeyLength {
+		return "", false, errors.GRPC(codes.InvalidArgument, errors.IdempotencyKeyInvalidCode, errors.IdempotencyKeyInvalidMsg)
+	}
+
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	hash, err := reqCtx.RequestHash(req)
+	if err != nil {
+		return "", false, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+	}
+
+	existing, claimed, err := s.idempotency.ClaimKey(ctx, shopID, operation, key, hash, time.Now().Add(idempotencyKeyTTL))
+	switch {
+	case err == sql.ErrNoRows:
+		// The first request failed and released the key while we looked.
+		return "", false, errors.GRPC(codes.Aborted, errors.IdempotencyKeyInProgressCode, errors.IdempotencyKeyInProgressMsg)
+	case err != nil:
+		return "", false, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	case claimed:
+		return key, false, nil
+	case existing.RequestHash != hash:
+		return "", false, errors.GRPC(codes.FailedPrecondition, errors.IdempotencyKeyMismatchCode, errors.IdempotencyKeyMismatchMsg)
+	case existing.Response == nil:
+		return "", false, errors.GRPC(codes.Aborted, errors.IdempotencyKeyInProgressCode, errors.IdempotencyKeyInProgressMsg)
+	}
+
+	if err := proto.Unmarshal(existing.Response, resp); err != nil {
+		return "", false, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+	}
+	return key, true, nil
+}
+
+// finishIdempotent stores resp for replay under key. When the request failed
+// the key is released instead, so the client can retry with it.
+func (s *OrderService) finishIdempotent(ctx context.Context, operation, key string, resp proto.Message, callErr error) {
+	if key == "" {
+		return
+	}
+	// The client may have given up waiting; the outcome must be kept anyway.
+	ctx = context.WithoutCancel(ctx)
+	shopID, _ := reqCtx.MustGetShopID(ctx)
+
+	if callErr != nil {
+		// The repository logs the failure; the key expires on its own.
+		_ = s.idempotency.ReleaseKey(ctx, shopID, operation, key)
+		return
+	}
+
+	b, err := proto.Marshal(resp)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to marshal idempotent response",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	_ = s.idempotency.SaveResponse(ctx, shopID, operation, key, b)
+}
+
+// PurgeIdempotencyKeys periodically deletes expired idempotency keys. It
+// blocks until ctx is cancelled.
+func (s *OrderService) PurgeIdempotencyKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if n, err := s.idempotency.PurgeExpired(ctx); err == nil && n > 0 {
+				s.logger.InfoContext(ctx, "purged expired idempotency keys", slog.Int64("count", n))
+			}
+		}
+	}
+}
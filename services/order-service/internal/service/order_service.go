@@ -0,0 +1,565 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	pagination "hpkg/constants"
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+	"hpkg/money"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"orderservice/internal/domain/dto"
+	"orderservice/internal/infrastructure/persistence"
+	"orderservice/internal/tax"
+	"orderservice/proto/orderpb"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type OrderService struct {
+	repo        persistence.OrderRepository
+	checkouts   persistence.CheckoutRepository
+	receipts    persistence.ReceiptTemplateRepository
+	returns     persistence.ReturnRepository
+	idempotency persistence.IdempotencyRepository
+	rates       persistence.ExchangeRateRepository
+	products    ProductCatalog
+	inventory   Inventory
+	payments    Payments
+	shops       Shops
+	taxes       *tax.Engine
+	logger      *slog.Logger
+}
+
+func NewOrderService(
+	repo persistence.OrderRepository,
+	checkouts persistence.CheckoutRepository,
+	receipts persistence.ReceiptTemplateRepository,
+	returns persistence.ReturnRepository,
+	idempotency persistence.IdempotencyRepository,
+	rates persistence.ExchangeRateRepository,
+	products ProductCatalog,
+	inventory Inventory,
+	payments Payments,
+	shops Shops,
+	taxes *tax.Engine,
+	logger *slog.Logger,
+) *OrderService {
+	return &OrderService{
+		repo:        repo,
+		checkouts:   checkouts,
+		receipts:    receipts,
+		returns:     returns,
+		idempotency: idempotency,
+		rates:       rates,
+		products:    products,
+		inventory:   inventory,
+		payments:    payments,
+		shops:       shops,
+		taxes:       taxes,
+		logger:      logger,
+	}
+}
+
+// CreateOrder places an order. A retry sent with the same idempotency key gets
+// the original response instead of a second order.
+func (s *OrderService) CreateOrder(ctx context.Context, req *orderpb.CreateOrderRequest) (*orderpb.CreateOrderResponse, error) {
+	resp := &orderpb.CreateOrderResponse{}
+	key, replayed, err := s.beginIdempotent(ctx, "CreateOrder", req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if replayed {
+		return resp, nil
+	}
+
+	resp, err = s.createOrder(ctx, req)
+	s.finishIdempotent(ctx, "CreateOrder", key, resp, err)
+	return resp, err
+}
+
+func (s *OrderService) createOrder(ctx context.Context, req *orderpb.CreateOrderRequest) (*orderpb.CreateOrderResponse, error) {
+	order, err := s.buildOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reserveStock(ctx, order); err != nil {
+		return nil, err
+	}
+
+	if err := s.saveOrder(ctx, order); err != nil {
+		s.releaseStock(ctx, order, "order could not be saved")
+		return nil, err
+	}
+
+	return &orderpb.CreateOrderResponse{
+		OrderId:     order.ID,
+		UserId:      req.UserId,
+		TotalAmount: order.TotalAmount,
+		Tax:         order.Tax,
+		Status:      statusToProto(order.Status),
+		Message:     "order created successfully",
+	}, nil
+}
+
+func (s *OrderService) GetOrder(ctx context.Context, req *orderpb.GetOrderRequest) (*orderpb.GetOrderResponse, error) {
+	order, err := s.getOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Tenders, err = s.repo.ListTenders(ctx, order.ID); err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.OrderFetchFailedCode, errors.OrderFetchFailedMsg)
+	}
+
+	return toGetOrderResponse(order), nil
+}
+
+func (s *OrderService) ListOrders(ctx context.Context, req *orderpb.ListOrdersRequest) (*orderpb.ListOrdersResponse, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UserId != "" && !isUUID(req.UserId) {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+	var statusFilter string
+	if req.StatusFilter != orderpb.OrderStatus_ORDER_STATUS_UNSPECIFIED {
+		var ok bool
+		if statusFilter, ok = statusFromProto(req.StatusFilter); !ok {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.OrderInvalidStatusCode, errors.OrderInvalidStatusMsg)
+		}
+	}
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = defaultPageSize
+	}
+
+	filter := dto.OrderFilter{
+		UserID:   req.UserId,
+		Status:   statusFilter,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if req.Cursor != "" {
+		cursor, err := pagination.DecodeCursor(req.Cursor)
+		if err != nil {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+		}
+		filter.After = &dto.OrderCursor{CreatedAt: cursor.CreatedAt, ID: cursor.ID}
+	}
+
+	orders, total, err := s.repo.ListByShop(ctx, shopID, filter)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.OrderListFailedCode, errors.OrderListFailedMsg)
+	}
+
+	var nextCursor string
+	if len(orders) > pageSize {
+		last := orders[pageSize-1]
+		nextCursor, _ = pagination.EncodeCursor(pagination.ProductCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+		orders = orders[:pageSize]
+	}
+
+	resp := &orderpb.ListOrdersResponse{
+		Orders:     make([]*orderpb.Order, 0, len(orders)),
+		TotalCount: int32(total),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+		NextCursor: nextCursor,
+	}
+	for _, order := range orders {
+		resp.Orders = append(resp.Orders, toOrder(order))
+	}
+
+	return resp, nil
+}
+
+func (s *OrderService) UpdateOrderStatus(ctx context.Context, req *orderpb.UpdateOrderStatusRequest) (*orderpb.UpdateOrderStatusResponse, error) {
+	newStatus, ok := statusFromProto(req.NewStatus)
+	if !ok {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.OrderInvalidStatusCode, errors.OrderInvalidStatusMsg)
+	}
+
+	order, err := s.getOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canTransition(order.Status, newStatus) {
+		s.logger.WarnContext(ctx, "rejected order status transition",
+			slog.String("order_id", order.ID),
+			slog.String("from_status", order.Status),
+			slog.String("to_status", newStatus),
+		)
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.OrderInvalidTransitionCode, errors.OrderInvalidTransitionMsg)
+	}
+
+	updated, err := s.transition(ctx, order, newStatus, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderpb.UpdateOrderStatusResponse{
+		OrderId:   updated.ID,
+		Status:    statusToProto(updated.Status),
+		Message:   "order status updated",
+		UpdatedAt: timestamppb.New(updated.UpdatedAt),
+	}, nil
+}
+
+func (s *OrderService) CancelOrder(ctx context.Context, req *orderpb.CancelOrderRequest) (*orderpb.CancelOrderResponse, error) {
+	order, err := s.getOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canTransition(order.Status, dto.OrderStatusCancelled) {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.OrderCancelNotAllowedCode, errors.OrderCancelNotAllowedMsg)
+	}
+
+	updated, err := s.transition(ctx, order, dto.OrderStatusCancelled, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	s.refundTenders(ctx, updated, "order cancelled")
+
+	return &orderpb.CancelOrderResponse{
+		OrderId: updated.ID,
+		Status:  statusToProto(updated.Status),
+		Message: "order cancelled",
+	}, nil
+}
+
+func (s *OrderService) TrackOrder(ctx context.Context, req *orderpb.TrackOrderRequest) (*orderpb.TrackOrderResponse, error) {
+	order, err := s.getOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.repo.ListStatusHistory(ctx, order.ID)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.OrderFetchFailedCode, errors.OrderFetchFailedMsg)
+	}
+
+	events := make([]*orderpb.TrackingEvent, 0, len(history))
+	for _, change := range history {
+		events = append(events, toTrackingEvent(change))
+	}
+
+	return &orderpb.TrackOrderResponse{
+		OrderId:       order.ID,
+		CurrentStatus: statusToProto(order.Status),
+		Events:        events,
+	}, nil
+}
+
+func (s *OrderService) DeleteOrder(ctx context.Context, req *orderpb.GetOrderRequest) error {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !isUUID(req.OrderId) {
+		return errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	affected, err := s.repo.DeleteOrder(ctx, shopID, req.OrderId)
+	if err != nil {
+		return errors.GRPC(codes.Internal, errors.OrderDeleteFailedCode, errors.OrderDeleteFailedMsg)
+	}
+	if affected == 0 {
+		return errors.GRPC(codes.NotFound, errors.OrderNotFoundCode, errors.OrderNotFoundMsg)
+	}
+
+	return nil
+}
+
+// buildOrder validates req and prices it into a new pending order for the
+// caller's shop. Nothing is persisted.
+func (s *OrderService) buildOrder(ctx context.Context, req *orderpb.CreateOrderRequest) (*dto.OrderDTO, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Items) == 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.OrderItemsRequiredCode, errors.OrderItemsRequiredMsg)
+	}
+	if req.Discount < 0 || (req.UserId != "" && !isUUID(req.UserId)) {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+	currency, err := currencyCode(req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	order := &dto.OrderDTO{
+		ID:              uuid.New().String(),
+		ShopID:          shopID,
+		UserID:          emptyStrToNil(req.UserId),
+		Status:          dto.OrderStatusPending,
+		Currency:        currency,
+		PaymentStatus:   dto.OrderPaymentUnpaid,
+		PaymentMethod:   emptyStrToNil(req.PaymentMethod),
+		ShippingAddress: emptyStrToNil(req.ShippingAddress),
+		Items:           make([]*dto.OrderItemDTO, 0, len(req.Items)),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	lines, err := s.priceItems(ctx, order, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	subtotal := minorUnits(order.Subtotal, currency)
+	discount := min(minorUnits(req.Discount, currency), subtotal)
+
+	// Lines are taxed on what they sell for once the discount is off.
+	amounts := make([]int64, len(lines))
+	for i, line := range lines {
+		amounts[i] = line.Amount
+	}
+	for i, share := range discountShares(amounts, discount) {
+		lines[i].Amount -= share
+	}
+	taxes, err := s.applyTax(ctx, order, lines, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Inclusive prices already carry their tax in the subtotal.
+	total := subtotal - discount
+	if !order.TaxInclusive {
+		total += taxes.Tax
+	}
+	order.Discount = majorUnits(discount, currency)
+	// Rounded to what can be paid, e.g. a KHR total to the nearest 100 riel.
+	order.TotalAmount = fromMinor(total, currency).Round().Float()
+
+	return order, nil
+}
+
+// saveOrder writes a built order together with its first status history entry.
+func (s *OrderService) saveOrder(ctx context.Context, order *dto.OrderDTO) error {
+	initial := &dto.OrderStatusChangeDTO{
+		ID:        uuid.New().String(),
+		OrderID:   order.ID,
+		ToStatus:  order.Status,
+		ActorID:   actorID(ctx),
+		CreatedAt: order.CreatedAt,
+	}
+
+	if err := s.repo.CreateOrder(ctx, order, initial); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create order in repository",
+			slog.String("shop_id", order.ShopID),
+			slog.String("error", err.Error()),
+		)
+		return errors.GRPC(codes.Internal, errors.OrderCreateFailedCode, errors.OrderCreateFailedMsg)
+	}
+
+	return nil
+}
+
+func (s *OrderService) getOrder(ctx context.Context, orderID string) (*dto.OrderDTO, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isUUID(orderID) {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	order, err := s.repo.GetByID(ctx, shopID, orderID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.OrderNotFoundCode, errors.OrderNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.OrderFetchFailedCode, errors.OrderFetchFailedMsg)
+	}
+
+	return order, nil
+}
+
+// transition moves order to status and records the change in its history.
+// The caller must have checked canTransition; if the order's status changed
+// in the meantime the update is rejected rather than applied on top of it.
+// Confirming an order commits its stock reservation first, so an order whose
+// reservation expired cannot be confirmed; cancelling releases it afterwards.
+func (s *OrderService) transition(ctx context.Context, order *dto.OrderDTO, status string, reason string) (*dto.OrderDTO, error) {
+	if status == dto.OrderStatusConfirmed {
+		if err := s.commitStock(ctx, order); err != nil {
+			return nil, err
+		}
+	}
+
+	from := order.Status
+	updated, err := s.repo.TransitionStatus(ctx, order.ShopID, &dto.OrderStatusChangeDTO{
+		ID:         uuid.New().String(),
+		OrderID:    order.ID,
+		FromStatus: &from,
+		ToStatus:   status,
+		ActorID:    actorID(ctx),
+		Reason:     emptyStrToNil(reason),
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.Aborted, errors.OrderStatusConflictCode, errors.OrderStatusConflictMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.OrderUpdateFailedCode, errors.OrderUpdateFailedMsg)
+	}
+
+	if status == dto.OrderStatusCancelled {
+		s.releaseStock(ctx, updated, reason)
+	}
+
+	return updated, nil
+}
+
+// actorID returns the x-user-id of the caller, or nil when the request was
+// made without a (valid) user, e.g. by another service.
+func actorID(ctx context.Context) *string {
+	userID, err := reqCtx.MustGetUserID(ctx)
+	if err != nil || !isUUID(userID) {
+		return nil
+	}
+	return &userID
+}
+
+func toOrder(o *dto.OrderDTO) *orderpb.Order {
+	return &orderpb.Order{
+		Id:               o.ID,
+		ShopId:           o.ShopID,
+		UserId:           ptrOrEmpty(o.UserID),
+		Items:            toOrderItems(o.Items),
+		Subtotal:         o.Subtotal,
+		TotalAmount:      o.TotalAmount,
+		Currency:         o.Currency,
+		TotalMoney:       moneyProto(o.TotalAmount, o.Currency),
+		BalanceDueMoney:  money.ToProto(balanceDue(o)),
+		PricesIncludeTax: o.TaxInclusive,
+		AmountPaid:       o.AmountPaid,
+		AmountRefunded:   o.AmountRefunded,
+		BalanceDue:       balanceDue(o).Float(),
+		ChangeDue:        o.ChangeDue,
+		PaymentStatus:    paymentStatusToProto(o.PaymentStatus),
+		Tax:              o.Tax,
+		Discount:         o.Discount,
+		Status:           statusToProto(o.Status),
+		PaymentMethod:    ptrOrEmpty(o.PaymentMethod),
+		ShippingAddress:  ptrOrEmpty(o.ShippingAddress),
+		CreatedAt:        timestamppb.New(o.CreatedAt),
+		UpdatedAt:        timestamppb.New(o.UpdatedAt),
+	}
+}
+
+func toGetOrderResponse(o *dto.OrderDTO) *orderpb.GetOrderResponse {
+	return &orderpb.GetOrderResponse{
+		Id:               o.ID,
+		ShopId:           o.ShopID,
+		UserId:           ptrOrEmpty(o.UserID),
+		Items:            toOrderItems(o.Items),
+		Subtotal:         o.Subtotal,
+		TotalAmount:      o.TotalAmount,
+		Currency:         o.Currency,
+		TotalMoney:       moneyProto(o.TotalAmount, o.Currency),
+		BalanceDueMoney:  money.ToProto(balanceDue(o)),
+		PricesIncludeTax: o.TaxInclusive,
+		AmountPaid:       o.AmountPaid,
+		AmountRefunded:   o.AmountRefunded,
+		BalanceDue:       balanceDue(o).Float(),
+		ChangeDue:        o.ChangeDue,
+		PaymentStatus:    paymentStatusToProto(o.PaymentStatus),
+		Tax:              o.Tax,
+		Discount:         o.Discount,
+		Status:           statusToProto(o.Status),
+		PaymentMethod:    ptrOrEmpty(o.PaymentMethod),
+		ShippingAddress:  ptrOrEmpty(o.ShippingAddress),
+		CreatedAt:        timestamppb.New(o.CreatedAt),
+		UpdatedAt:        timestamppb.New(o.UpdatedAt),
+		Tenders:          toTenders(o.Tenders),
+	}
+}
+
+func toTenders(tenders []*dto.TenderDTO) []*orderpb.Tender {
+	resp := make([]*orderpb.Tender, 0, len(tenders))
+	for _, t := range tenders {
+		resp = append(resp, toTender(t))
+	}
+	return resp
+}
+
+func toOrderItems(items []*dto.OrderItemDTO) []*orderpb.OrderItem {
+	resp := make([]*orderpb.OrderItem, 0, len(items))
+	for _, item := range items {
+		resp = append(resp, &orderpb.OrderItem{
+			Id:               item.ID,
+			ProductId:        item.ProductID,
+			ProductName:      item.ProductName,
+			VariantId:        item.VariantID,
+			VariantName:      item.VariantName,
+			Quantity:         item.Quantity,
+			UnitPrice:        item.UnitPrice,
+			Subtotal:         item.Subtotal,
+			TaxRate:          item.TaxRate,
+			TaxAmount:        item.TaxAmount,
+			ReturnedQuantity: item.ReturnedQuantity,
+		})
+	}
+	return resp
+}
+
+func toTrackingEvent(c *dto.OrderStatusChangeDTO) *orderpb.TrackingEvent {
+	event := &orderpb.TrackingEvent{
+		Status:      statusToProto(c.ToStatus),
+		Description: ptrOrEmpty(c.Reason),
+		Timestamp:   timestamppb.New(c.CreatedAt),
+		ActorId:     ptrOrEmpty(c.ActorID),
+	}
+	if c.FromStatus != nil {
+		event.PreviousStatus = statusToProto(*c.FromStatus)
+	}
+	if event.Description == "" {
+		event.Description = "Order " + c.ToStatus
+	}
+	return event
+}
+
+func isUUID(s string) bool {
+	_, err := uuid.Parse(s)
+	return err == nil
+}
+
+func emptyStrToNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func ptrOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"orderservice/internal/domain/dto"
+	"orderservice/proto/orderpb"
+)
+
+// orderTransitions lists, for every status, the statuses an order may move to
+// next. Delivered and cancelled orders are final.
+var orderTransitions = map[string][]string{
+	dto.OrderStatusPending:   {dto.OrderStatusConfirmed, dto.OrderStatusCancelled},
+	dto.OrderStatusConfirmed: {dto.OrderStatusShipped, dto.OrderStatusDelivered, dto.OrderStatusCancelled},
+	dto.OrderStatusShipped:   {dto.OrderStatusDelivered},
+	dto.OrderStatusDelivered: {},
+	dto.OrderStatusCancelled: {},
+}
+
+var statusNames = map[orderpb.OrderStatus]string{
+	orderpb.OrderStatus_ORDER_STATUS_PENDING:   dto.OrderStatusPending,
+	orderpb.OrderStatus_ORDER_STATUS_CONFIRMED: dto.OrderStatusConfirmed,
+	orderpb.OrderStatus_ORDER_STATUS_SHIPPED:   dto.OrderStatusShipped,
+	orderpb.OrderStatus_ORDER_STATUS_DELIVERED: dto.OrderStatusDelivered,
+	orderpb.OrderStatus_ORDER_STATUS_CANCELLED: dto.OrderStatusCancelled,
+}
+
+var statusValues = func() map[string]orderpb.OrderStatus {
+	values := make(map[string]orderpb.OrderStatus, len(statusNames))
+	for v, name := range statusNames {
+		values[name] = v
+	}
+	return values
+}()
+
+func canTransition(from, to string) bool {
+	for _, next := range orderTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// statusFromProto returns the stored name of a status and false for
+// ORDER_STATUS_UNSPECIFIED or unknown values.
+func statusFromProto(s orderpb.OrderStatus) (string, bool) {
+	name, ok := statusNames[s]
+	return name, ok
+}
+
+func statusToProto(name string) orderpb.OrderStatus {
+	return statusValues[name]
+}
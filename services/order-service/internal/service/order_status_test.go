@@ -0,0 +1,74 @@
+package service
+
+import (
+	"testing"
+
+	"orderservice/internal/domain/dto"
+	"orderservice/proto/orderpb"
+)
+
+func TestCanTransition(t *testing.T) {
+	const (
+		pending   = dto.OrderStatusPending
+		confirmed = dto.OrderStatusConfirmed
+		shipped   = dto.OrderStatusShipped
+		delivered = dto.OrderStatusDelivered
+		cancelled = dto.OrderStatusCancelled
+	)
+	statuses := []string{pending, confirmed, shipped, delivered, cancelled}
+
+	// allowed lists every move an order may make; all others are refused.
+	allowed := map[[2]string]bool{
+		{pending, confirmed}:   true,
+		{pending, cancelled}:   true,
+		{confirmed, shipped}:   true,
+		{confirmed, delivered}: true,
+		{confirmed, cancelled}: true,
+		{shipped, delivered}:   true,
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := allowed[[2]string{from, to}]
+			if got := canTransition(from, to); got != want {
+				t.Errorf("canTransition(%s, %s) = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+
+	for _, tt := range []struct{ from, to string }{
+		{"", pending},
+		{pending, ""},
+		{"unknown", confirmed},
+		{pending, "unknown"},
+	} {
+		if canTransition(tt.from, tt.to) {
+			t.Errorf("canTransition(%q, %q) = true, want false", tt.from, tt.to)
+		}
+	}
+}
+
+func TestStatusProto(t *testing.T) {
+	tests := []struct {
+		proto orderpb.OrderStatus
+		name  string
+		ok    bool
+	}{
+		{orderpb.OrderStatus_ORDER_STATUS_PENDING, dto.OrderStatusPending, true},
+		{orderpb.OrderStatus_ORDER_STATUS_CONFIRMED, dto.OrderStatusConfirmed, true},
+		{orderpb.OrderStatus_ORDER_STATUS_SHIPPED, dto.OrderStatusShipped, true},
+		{orderpb.OrderStatus_ORDER_STATUS_DELIVERED, dto.OrderStatusDelivered, true},
+		{orderpb.OrderStatus_ORDER_STATUS_CANCELLED, dto.OrderStatusCancelled, true},
+		{orderpb.OrderStatus_ORDER_STATUS_UNSPECIFIED, "", false},
+		{orderpb.OrderStatus(99), "", false},
+	}
+	for _, tt := range tests {
+		name, ok := statusFromProto(tt.proto)
+		if name != tt.name || ok != tt.ok {
+			t.Errorf("statusFromProto(%v) = %q, %v, want %q, %v", tt.proto, name, ok, tt.name, tt.ok)
+		}
+		if ok && statusToProto(name) != tt.proto {
+			t.Errorf("statusToProto(%q) = %v, want %v", name, statusToProto(name), tt.proto)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+	"hpkg/money"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	"orderservice/internal/domain/dto"
+	"orderservice/internal/tax"
+	"orderservice/proto/orderpb"
+	"productservice/proto/productpb"
+)
+
+// permPriceOverride lets a caller sell a line at a price other than the
+// catalog price, e.g. a manager applying a manual markdown at the till.
+const permPriceOverride = "PermOrderPriceOverride"
+
+// ProductCatalog resolves the authoritative price and tax settings of
+// products, and the prices of the variants sold.
+type ProductCatalog interface {
+	BatchGetProducts(ctx context.Context, productIDs, variantIDs []string) (map[string]*productpb.CatalogItem, map[string]*productpb.CatalogVariant, error)
+}
+
+// priceItems builds the order lines from the catalog and returns them as
+// taxable lines for applyTax. Client supplied subtotals are ignored; a client
+// supplied unit price is only honoured when it matches the catalog or the
+// caller holds permPriceOverride. A zero unit price means "use the catalog
+// price". A line that names a variant sells at the variant's price, or the
+// product's when the variant has none. Catalog prices in another currency
+// than the order's are converted at the shop's exchange rate.
+func (s *OrderService) priceItems(ctx context.Context, order *dto.OrderDTO, items []*orderpb.OrderItem) ([]tax.Line, error) {
+	productIDs := make([]string, 0, len(items))
+	var variantIDs []string
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if !isUUID(item.ProductId) || item.Quantity <= 0 || item.UnitPrice < 0 ||
+			(item.VariantId != "" && !isUUID(item.VariantId)) {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.OrderInvalidItemCode, errors.OrderInvalidItemMsg)
+		}
+		if !seen[item.ProductId] {
+			seen[item.ProductId] = true
+			productIDs = append(productIDs, item.ProductId)
+		}
+		if item.VariantId != "" && !seen[item.VariantId] {
+			seen[item.VariantId] = true
+			variantIDs = append(variantIDs, item.VariantId)
+		}
+	}
+
+	catalog, variants, err := s.products.BatchGetProducts(ctx, productIDs, variantIDs)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to resolve order products",
+			slog.String("shop_id", order.ShopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, errors.GRPC(codes.Unavailable, errors.ErrProductServiceCode, errors.ErrProductServiceMsg)
+	}
+
+	canOverride := reqCtx.HasPermission(ctx, permPriceOverride)
+	taxLines := make([]tax.Line, 0, len(items))
+	var subtotal int64
+
+	for _, item := range items {
+		product, ok := catalog[item.ProductId]
+		if !ok || !product.IsActive {
+			return nil, errors.GRPC(codes.NotFound, errors.ErrProductNotFoundCode, errors.ErrProductNotFoundMsg)
+		}
+
+		priced := product
+		var variant *productpb.CatalogVariant
+		if item.VariantId != "" {
+			variant, ok = variants[item.VariantId]
+			if !ok || !variant.IsActive || variant.ProductId != product.Id {
+				return nil, errors.GRPC(codes.NotFound, errors.ErrVariantNotFoundCode, errors.ErrVariantNotFoundMsg)
+			}
+			if variant.Price != nil {
+				priced = &productpb.CatalogItem{
+					Id:       product.Id,
+					Price:    variant.GetPrice(),
+					Currency: product.Currency,
+				}
+			}
+		}
+		price, err := s.catalogPrice(ctx, order, priced)
+		if err != nil {
+			return nil, err
+		}
+
+		line := &dto.OrderItemDTO{
+			ID:           uuid.New().String(),
+			OrderID:      order.ID,
+			ProductID:    product.Id,
+			ProductName:  product.Name,
+			Quantity:     item.Quantity,
+			CatalogPrice: price.Float(),
+			IsTaxable:    product.IsTaxable,
+		}
+		if variant != nil {
+			line.VariantID = variant.Id
+			line.VariantName = variant.Name
+		}
+
+		unitPrice := price
+		if submitted := toMoney(item.UnitPrice, order.Currency); !submitted.IsZero() && submitted != price {
+			if !canOverride {
+				s.logger.WarnContext(ctx, "rejected order line with non-catalog price",
+					slog.String("shop_id", order.ShopID),
+					slog.String("product_id", product.Id),
+					slog.String("variant_id", line.VariantID),
+					slog.String("catalog_price", price.String()),
+					slog.String("submitted_price", submitted.String()),
+				)
+				return nil, errors.GRPC(codes.FailedPrecondition, errors.OrderPriceMismatchCode, errors.OrderPriceMismatchMsg)
+			}
+			unitPrice = submitted
+			line.PriceOverridden = true
+		}
+
+		lineTotal := unitPrice.Mul(int64(line.Quantity))
+		line.UnitPrice = unitPrice.Float()
+		line.Subtotal = lineTotal.Float()
+
+		order.Items = append(order.Items, line)
+		subtotal += lineTotal.Amount
+		taxLines = append(taxLines, tax.Line{
+			ID:          line.ID,
+			Amount:      lineTotal.Amount,
+			CategoryID:  product.CategoryId,
+			ProductRate: product.TaxRate,
+			Taxable:     product.IsTaxable,
+		})
+	}
+
+	order.Subtotal = majorUnits(subtotal, order.Currency)
+
+	return taxLines, nil
+}
+
+// catalogPrice returns a product's price in the order's currency.
+func (s *OrderService) catalogPrice(ctx context.Context, order *dto.OrderDTO, product *productpb.CatalogItem) (money.Money, error) {
+	if product.Currency == "" || product.Currency == order.Currency {
+		return toMoney(product.Price, order.Currency), nil
+	}
+
+	price, err := money.FromProto(product.PriceMoney)
+	if product.PriceMoney == nil {
+		price, err = money.FromFloat(product.Price, product.Currency)
+	}
+	if err != nil {
+		s.logger.ErrorContext(ctx, "catalog price in unknown currency",
+			slog.String("product_id", product.Id),
+			slog.String("currency", product.Currency),
+		)
+		return money.Money{}, errors.GRPC(codes.FailedPrecondition, errors.CurrencyUnsupportedCode, errors.CurrencyUnsupportedMsg)
+	}
+
+	rate, err := s.exchangeRate(ctx, order.ShopID, price.Currency, order.Currency)
+	if err != nil {
+		return money.Money{}, err
+	}
+	converted, err := price.Convert(order.Currency, rate)
+	if err != nil {
+		return money.Money{}, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+	}
+	return converted, nil
+}
+
+// discountShares splits discount across lines worth amounts, in proportion
+// to them. Shares are rounded down and the minor units left over go one each
+// to the first lines, so they add up to the discount exactly.
+func discountShares(amounts []int64, discount int64) []int64 {
+	shares := make([]int64, len(amounts))
+	var total int64
+	for _, a := range amounts {
+		total += a
+	}
+	if total <= 0 || discount <= 0 {
+		return shares
+	}
+
+	left := discount
+	for i, a := range amounts {
+		shares[i] = discount * a / total
+		left -= shares[i]
+	}
+	for i := 0; left > 0; i = (i + 1) % len(amounts) {
+		if amounts[i] > 0 {
+			shares[i]++
+			left--
+		}
+	}
+	return shares
+}
+
+// applyTax taxes the priced lines of order with the shop's tax rules. Country
+// and state override the shop's own jurisdiction when set. The result is in
+// minor units of the order's currency.
+func (s *OrderService) applyTax(ctx context.Context, order *dto.OrderDTO, lines []tax.Line, country, state string) (*tax.Result, error) {
+	result, err := s.taxes.Calculate(ctx, tax.Request{
+		ShopID:  order.ShopID,
+		Country: country,
+		State:   state,
+		Lines:   lines,
+	})
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to calculate order tax",
+			slog.String("shop_id", order.ShopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, errors.GRPC(codes.Internal, errors.OrderTaxFailedCode, errors.OrderTaxFailedMsg)
+	}
+
+	for i, lr := range result.Lines {
+		order.Items[i].TaxRate = lr.Rate
+		order.Items[i].TaxAmount = majorUnits(lr.Tax, order.Currency)
+	}
+	order.Tax = majorUnits(result.Tax, order.Currency)
+	order.TaxInclusive = result.PricesIncludeTax
+
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiscountShares(t *testing.T) {
+	tests := []struct {
+		name     string
+		amounts  []int64
+		discount int64
+		want     []int64
+	}{
+		{"in proportion", []int64{3000, 1000}, 400, []int64{300, 100}},
+		{"left over cents go to the first lines", []int64{100, 100, 100}, 100, []int64{34, 33, 33}},
+		{"free lines take no share", []int64{0, 500, 500}, 101, []int64{0, 51, 50}},
+		{"whole subtotal", []int64{250, 750}, 1000, []int64{250, 750}},
+		{"no discount", []int64{250, 750}, 0, []int64{0, 0}},
+		{"nothing to discount", []int64{0, 0}, 100, []int64{0, 0}},
+		{"no lines", nil, 100, []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := discountShares(tt.amounts, tt.discount)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("discountShares(%v, %d) = %v, want %v", tt.amounts, tt.discount, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"orderservice/internal/domain/dto"
+	"orderservice/internal/receipt"
+	"orderservice/proto/orderpb"
+	"shopservice/proto/shoppb"
+)
+
+// permReceiptTemplateUpdate lets a caller change the shop's receipt template.
+const permReceiptTemplateUpdate = "PermReceiptTemplateUpdate"
+
+// Shops resolves the profile printed at the top of receipts.
+type Shops interface {
+	GetShop(ctx context.Context, shopID string) (*shoppb.ShopResponse, error)
+}
+
+// GetReceipt renders a paid or confirmed order as text, PDF or ESC/POS. The
+// shop's name and logo are left out, with a warning logged, when the shop
+// service cannot provide them; a till should still be able to print.
+func (s *OrderService) GetReceipt(ctx context.Context, req *orderpb.GetReceiptRequest) (*orderpb.GetReceiptResponse, error) {
+	order, err := s.getOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !receiptAvailable(order) {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.OrderReceiptNotAvailableCode, errors.OrderReceiptNotAvailableMsg)
+	}
+
+	if order.Tenders, err = s.repo.ListTenders(ctx, order.ID); err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.OrderFetchFailedCode, errors.OrderFetchFailedMsg)
+	}
+
+	template, _, err := s.receipts.GetTemplate(ctx, order.ShopID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.GRPC(codes.Internal, errors.ReceiptRenderFailedCode, errors.ReceiptRenderFailedMsg)
+	}
+
+	r := toReceipt(order)
+	if shop, err := s.shops.GetShop(ctx, order.ShopID); err == nil {
+		r.ShopName = shop.Name
+		r.ShopLogo = shop.Logo
+	} else {
+		s.logger.WarnContext(ctx, "printing receipt without shop details",
+			slog.String("shop_id", order.ShopID),
+			slog.String("error", err.Error()),
+		)
+	}
+	if r.ShopLogo != "" && req.Format != orderpb.ReceiptFormat_RECEIPT_FORMAT_TEXT &&
+		req.Format != orderpb.ReceiptFormat_RECEIPT_FORMAT_UNSPECIFIED {
+		if r.Logo, err = receipt.FetchLogo(ctx, r.ShopLogo); err != nil {
+			s.logger.WarnContext(ctx, "printing receipt without shop logo",
+				slog.String("shop_id", order.ShopID),
+				slog.String("logo", r.ShopLogo),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	paper := receipt.Paper80mm
+	if req.PaperWidth == orderpb.PaperWidth_PAPER_WIDTH_58MM {
+		paper = receipt.Paper58mm
+	}
+
+	text, err := receipt.Text(template, r, paper)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to render receipt template",
+			slog.String("order_id", order.ID),
+			slog.String("error", err.Error()),
+		)
+		return nil, errors.GRPC(codes.Internal, errors.ReceiptRenderFailedCode, errors.ReceiptRenderFailedMsg)
+	}
+
+	resp := &orderpb.GetReceiptResponse{
+		OrderId: order.ID,
+		Format:  req.Format,
+	}
+	name := "receipt-" + r.Number
+	switch req.Format {
+	case orderpb.ReceiptFormat_RECEIPT_FORMAT_PDF:
+		if resp.Content, err = receipt.PDF(text, r, paper); err != nil {
+			return nil, errors.GRPC(codes.Internal, errors.ReceiptRenderFailedCode, errors.ReceiptRenderFailedMsg)
+		}
+		resp.ContentType = "application/pdf"
+		resp.Filename = name + ".pdf"
+	case orderpb.ReceiptFormat_RECEIPT_FORMAT_ESCPOS:
+		resp.Content = receipt.ESCPOS(text, r, paper)
+		resp.ContentType = "application/octet-stream"
+		resp.Filename = name + ".bin"
+	default:
+		resp.Format = orderpb.ReceiptFormat_RECEIPT_FORMAT_TEXT
+		resp.Content = []byte(text)
+		resp.ContentType = "text/plain; charset=utf-8"
+		resp.Filename = name + ".txt"
+	}
+
+	return resp, nil
+}
+
+func (s *OrderService) GetReceiptTemplate(ctx context.Context, req *orderpb.GetReceiptTemplateRequest) (*orderpb.ReceiptTemplate, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, updatedAt, err := s.receipts.GetTemplate(ctx, shopID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &orderpb.ReceiptTemplate{Template: receipt.DefaultTemplate, IsDefault: true}, nil
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	return &orderpb.ReceiptTemplate{
+		Template:  template,
+		UpdatedAt: timestamppb.New(updatedAt),
+	}, nil
+}
+
+// SetReceiptTemplate saves the shop's receipt template after checking that it
+// renders. An empty template reverts the shop to the default.
+func (s *OrderService) SetReceiptTemplate(ctx context.Context, req *orderpb.SetReceiptTemplateRequest) (*orderpb.ReceiptTemplate, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reqCtx.HasPermission(ctx, permReceiptTemplateUpdate) {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+
+	if strings.TrimSpace(req.Template) == "" {
+		if err := s.receipts.DeleteTemplate(ctx, shopID); err != nil {
+			return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+		}
+		return &orderpb.ReceiptTemplate{Template: receipt.DefaultTemplate, IsDefault: true}, nil
+	}
+
+	if err := receipt.Validate(req.Template); err != nil {
+		s.logger.WarnContext(ctx, "rejected receipt template",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ReceiptTemplateInvalidCode, errors.ReceiptTemplateInvalidMsg)
+	}
+
+	now := time.Now()
+	if err := s.receipts.SaveTemplate(ctx, shopID, req.Template, now); err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	return &orderpb.ReceiptTemplate{
+		Template:  req.Template,
+		UpdatedAt: timestamppb.New(now),
+	}, nil
+}
+
+func receiptAvailable(o *dto.OrderDTO) bool {
+	switch o.Status {
+	case dto.OrderStatusConfirmed, dto.OrderStatusShipped, dto.OrderStatusDelivered:
+		return true
+	case dto.OrderStatusCancelled:
+		return false
+	}
+	return o.PaymentStatus == dto.OrderPaymentPaid
+}
+
+// toReceipt lays out an order for printing. Taxes are summed per rate since
+// orders keep each line's tax but not the rules that produced it.
+func toReceipt(o *dto.OrderDTO) *receipt.Receipt {
+	r := &receipt.Receipt{
+		OrderID:          o.ID,
+		Number:           strings.ToUpper(strings.SplitN(o.ID, "-", 2)[0]),
+		Status:           o.Status,
+		Date:             o.CreatedAt,
+		Subtotal:         o.Subtotal,
+		Discount:         o.Discount,
+		Tax:              o.Tax,
+		Total:            o.TotalAmount,
+		PricesIncludeTax: o.TaxInclusive,
+		AmountPaid:       o.AmountPaid,
+		BalanceDue:       balanceDue(o).Float(),
+		ChangeDue:        o.ChangeDue,
+	}
+
+	taxes := make(map[float64]int64)
+	for _, item := range o.Items {
+		name := item.ProductName
+		if item.VariantName != "" {
+			name += " (" + item.VariantName + ")"
+		}
+		r.Items = append(r.Items, receipt.Item{
+			Name:      name,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			Amount:    item.Subtotal,
+			TaxRate:   item.TaxRate,
+		})
+		if item.TaxAmount != 0 {
+			taxes[item.TaxRate] += minorUnits(item.TaxAmount, o.Currency)
+		}
+	}
+	for rate, amount := range taxes {
+		r.Taxes = append(r.Taxes, receipt.Tax{Name: "Tax", Rate: rate, Amount: majorUnits(amount, o.Currency)})
+	}
+	sort.Slice(r.Taxes, func(i, j int) bool { return r.Taxes[i].Rate < r.Taxes[j].Rate })
+
+	for _, t := range o.Tenders {
+		r.Tenders = append(r.Tenders, receipt.Tender{
+			Method:   t.PaymentMethod,
+			Amount:   t.Amount,
+			Tendered: t.Tendered,
+			Change:   t.ChangeDue,
+		})
+	}
+
+	return r
+}
@@ -0,0 +1,608 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"orderservice/internal/domain/dto"
+	"orderservice/internal/infrastructure/persistence"
+	"orderservice/proto/orderpb"
+	"productservice/proto/productpb"
+)
+
+// permOrderReturn lets a caller take goods back and refund them.
+const permOrderReturn = "PermOrderReturn"
+
+var dispositionValues = map[string]orderpb.ReturnDisposition{
+	dto.DispositionRestock:  orderpb.ReturnDisposition_RETURN_DISPOSITION_RESTOCK,
+	dto.DispositionWriteOff: orderpb.ReturnDisposition_RETURN_DISPOSITION_WRITE_OFF,
+}
+
+var refundMethodValues = map[string]orderpb.RefundMethod{
+	dto.RefundToOriginalTender: orderpb.RefundMethod_REFUND_METHOD_ORIGINAL_TENDER,
+	dto.RefundToStoreCredit:    orderpb.RefundMethod_REFUND_METHOD_STORE_CREDIT,
+}
+
+// CreateReturn takes back some lines of a confirmed order. Restocked items go
+// back into stock, and the refund goes to the order's tenders, most recent
+// first, or onto a new store credit. An exchange is paid from that credit.
+//
+// The return is recorded before any money moves; a refund that then fails
+// leaves it in refund_failed with the error, for staff to settle by hand.
+func (s *OrderService) CreateReturn(ctx context.Context, req *orderpb.CreateReturnRequest) (*orderpb.CreateReturnResponse, error) {
+	if !reqCtx.HasPermission(ctx, permOrderReturn) {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+	if len(req.Items) == 0 || strings.TrimSpace(req.Reason) == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+	refundMethod, ok := refundMethodFromProto(req.RefundMethod)
+	if !ok {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+	if req.ExchangeOrder != nil {
+		refundMethod = dto.RefundToStoreCredit
+	}
+
+	order, err := s.getOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if !isReturnable(order.Status) {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.OrderReturnNotAllowedCode, errors.OrderReturnNotAllowedMsg)
+	}
+
+	now := time.Now()
+	ret := &dto.ReturnDTO{
+		ID:           uuid.New().String(),
+		ShopID:       order.ShopID,
+		OrderID:      order.ID,
+		Reason:       strings.TrimSpace(req.Reason),
+		RefundMethod: refundMethod,
+		Status:       dto.ReturnStatusPending,
+		ActorID:      actorID(ctx),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if ret.Items, err = returnItems(order, req.Items); err != nil {
+		return nil, err
+	}
+	var refund int64
+	for _, item := range ret.Items {
+		refund += minorUnits(item.RefundAmount, order.Currency)
+	}
+	// Rounding per line must never refund more than was taken.
+	refundable := minorUnits(order.AmountPaid, order.Currency) - minorUnits(order.AmountRefunded, order.Currency)
+	ret.RefundAmount = majorUnits(min(refund, max(refundable, 0)), order.Currency)
+
+	var exchange *dto.OrderDTO
+	if req.ExchangeOrder != nil {
+		if req.ExchangeOrder.UserId == "" {
+			req.ExchangeOrder.UserId = ptrOrEmpty(order.UserID)
+		}
+		if exchange, err = s.placeExchange(ctx, req.ExchangeOrder); err != nil {
+			return nil, err
+		}
+		ret.ExchangeOrderID = &exchange.ID
+	}
+
+	if err := s.returns.CreateReturn(ctx, ret); err != nil {
+		if exchange != nil {
+			if _, cancelErr := s.transition(ctx, exchange, dto.OrderStatusCancelled, "return could not be created"); cancelErr != nil {
+				s.logger.ErrorContext(ctx, "failed to cancel exchange order",
+					slog.String("order_id", exchange.ID),
+					slog.String("error", cancelErr.Error()),
+				)
+			}
+		}
+		switch err {
+		case persistence.ErrReturnQuantityExceeded:
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.OrderReturnQuantityExceededCode, errors.OrderReturnQuantityExceededMsg)
+		case persistence.ErrRefundExceedsOrder:
+			return nil, errors.GRPC(codes.Aborted, errors.OrderStatusConflictCode, errors.OrderStatusConflictMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.OrderReturnFailedCode, errors.OrderReturnFailedMsg)
+	}
+
+	ret.Status = dto.ReturnStatusCompleted
+	s.restockReturn(ctx, ret)
+	if refundMethod == dto.RefundToStoreCredit {
+		s.issueStoreCredit(ctx, order, ret)
+	} else {
+		s.refundReturn(ctx, order, ret)
+	}
+	if exchange != nil && ret.StoreCredit != nil {
+		exchange = s.payExchange(ctx, exchange, ret)
+	}
+
+	ret.UpdatedAt = time.Now()
+	if err := s.returns.FinishReturn(ctx, ret); err != nil {
+		// Everything has happened already; only the final status is lost.
+		s.logger.ErrorContext(ctx, "failed to record return outcome",
+			slog.String("return_id", ret.ID),
+			slog.String("status", ret.Status),
+		)
+	}
+
+	resp := &orderpb.CreateReturnResponse{
+		OrderReturn: toOrderReturn(ret),
+		Message:     "return created",
+	}
+	if exchange != nil {
+		resp.ExchangeOrder = &orderpb.CreateOrderResponse{
+			OrderId:     exchange.ID,
+			UserId:      ptrOrEmpty(exchange.UserID),
+			TotalAmount: exchange.TotalAmount,
+			Tax:         exchange.Tax,
+			Status:      statusToProto(exchange.Status),
+			Message:     "exchange order created",
+		}
+	}
+	if ret.Status == dto.ReturnStatusRefundFailed {
+		resp.Message = "return created but the refund failed"
+	}
+	return resp, nil
+}
+
+func (s *OrderService) GetReturn(ctx context.Context, req *orderpb.GetReturnRequest) (*orderpb.OrderReturn, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isUUID(req.ReturnId) {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	ret, err := s.returns.GetReturn(ctx, shopID, req.ReturnId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.ReturnNotFoundCode, errors.ReturnNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ReturnFetchFailedCode, errors.ReturnFetchFailedMsg)
+	}
+	return toOrderReturn(ret), nil
+}
+
+// ListReturns reports the shop's returns, optionally narrowed to one order,
+// one reason or a period, with the total refunded across all pages.
+func (s *OrderService) ListReturns(ctx context.Context, req *orderpb.ListReturnsRequest) (*orderpb.ListReturnsResponse, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.OrderId != "" && !isUUID(req.OrderId) {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = defaultPageSize
+	}
+
+	filter := dto.ReturnFilter{
+		OrderID:  req.OrderId,
+		Reason:   strings.TrimSpace(req.Reason),
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+
+	returns, total, refunded, err := s.returns.ListReturns(ctx, shopID, filter)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ReturnFetchFailedCode, errors.ReturnFetchFailedMsg)
+	}
+
+	resp := &orderpb.ListReturnsResponse{
+		Returns:       make([]*orderpb.OrderReturn, 0, len(returns)),
+		TotalCount:    int32(total),
+		Page:          int32(page),
+		PageSize:      int32(pageSize),
+		TotalRefunded: refunded,
+	}
+	for _, ret := range returns {
+		resp.Returns = append(resp.Returns, toOrderReturn(ret))
+	}
+	return resp, nil
+}
+
+func (s *OrderService) GetStoreCredit(ctx context.Context, req *orderpb.GetStoreCreditRequest) (*orderpb.StoreCredit, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Code == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	credit, err := s.returns.GetStoreCredit(ctx, shopID, strings.ToUpper(req.Code))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.StoreCreditNotFoundCode, errors.StoreCreditNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.OrderFetchFailedCode, errors.OrderFetchFailedMsg)
+	}
+	return toStoreCredit(credit), nil
+}
+
+// returnItems checks the requested lines against the order and works out what
+// each is worth: its share of the line as paid, i.e. after its share of the
+// order discount and with the tax charged on it when prices excluded tax.
+func returnItems(order *dto.OrderDTO, req []*orderpb.ReturnItemRequest) ([]*dto.ReturnItemDTO, error) {
+	lines := make(map[string]*dto.OrderItemDTO, len(order.Items))
+	amounts := make([]int64, len(order.Items))
+	for i, item := range order.Items {
+		lines[item.ID] = item
+		amounts[i] = minorUnits(item.Subtotal, order.Currency)
+	}
+	// The discount is split as it was when the order was taxed.
+	discounts := make(map[string]int64, len(order.Items))
+	for i, share := range discountShares(amounts, minorUnits(order.Discount, order.Currency)) {
+		discounts[order.Items[i].ID] = share
+	}
+
+	items := make([]*dto.ReturnItemDTO, 0, len(req))
+	seen := make(map[string]bool, len(req))
+	for _, r := range req {
+		line, ok := lines[r.OrderItemId]
+		if !ok || seen[r.OrderItemId] || r.Quantity <= 0 {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+		}
+		seen[r.OrderItemId] = true
+		if r.Quantity > line.Quantity-line.ReturnedQuantity {
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.OrderReturnQuantityExceededCode, errors.OrderReturnQuantityExceededMsg)
+		}
+		disposition, ok := dispositionFromProto(r.Disposition)
+		if !ok {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+		}
+
+		// What the line was paid, in minor units: its share of the
+		// discount off, the tax charged on the rest on.
+		paid := minorUnits(line.Subtotal, order.Currency) - discounts[line.ID]
+		if !order.TaxInclusive {
+			paid += minorUnits(line.TaxAmount, order.Currency)
+		}
+		refund := int64(math.Round(float64(paid) * float64(r.Quantity) / float64(line.Quantity)))
+
+		items = append(items, &dto.ReturnItemDTO{
+			ID:           uuid.New().String(),
+			OrderItemID:  line.ID,
+			ProductID:    line.ProductID,
+			ProductName:  line.ProductName,
+			VariantID:    line.VariantID,
+			Quantity:     r.Quantity,
+			RefundAmount: majorUnits(refund, order.Currency),
+			Disposition:  disposition,
+		})
+	}
+	return items, nil
+}
+
+// placeExchange creates the replacement order of an exchange, holding its stock.
+func (s *OrderService) placeExchange(ctx context.Context, req *orderpb.CreateOrderRequest) (*dto.OrderDTO, error) {
+	exchange, err := s.buildOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.reserveStock(ctx, exchange); err != nil {
+		return nil, err
+	}
+	if err := s.saveOrder(ctx, exchange); err != nil {
+		s.releaseStock(ctx, exchange, "exchange order could not be saved")
+		return nil, err
+	}
+	return exchange, nil
+}
+
+// restockReturn puts restocked items back into stock. A failure is noted on
+// the return rather than undoing it; the goods are back in the shop either way.
+func (s *OrderService) restockReturn(ctx context.Context, ret *dto.ReturnDTO) {
+	items := make([]*productpb.StockItem, 0, len(ret.Items))
+	for _, item := range ret.Items {
+		if item.Disposition == dto.DispositionRestock {
+			items = append(items, &productpb.StockItem{
+				ProductId: item.ProductID,
+				VariantId: item.VariantID,
+				Quantity:  item.Quantity,
+			})
+		}
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	if err := s.inventory.RestockItems(ctx, ret.ID, items, ret.Reason); err != nil {
+		s.logger.ErrorContext(ctx, "failed to restock returned items",
+			slog.String("return_id", ret.ID),
+			slog.String("error", err.Error()),
+		)
+		msg := "restock failed: " + err.Error()
+		ret.LastError = &msg
+	}
+}
+
+// refundReturn pays a return's refund back to the order's tenders, most
+// recent first, as far as each has anything left to refund.
+func (s *OrderService) refundReturn(ctx context.Context, order *dto.OrderDTO, ret *dto.ReturnDTO) {
+	left := minorUnits(ret.RefundAmount, order.Currency)
+	if left <= 0 {
+		return
+	}
+
+	tenders, err := s.repo.ListTenders(ctx, ret.OrderID)
+	if err != nil {
+		s.failRefund(ret, "tenders could not be loaded: "+err.Error())
+		return
+	}
+
+	for i := len(tenders) - 1; i >= 0 && left > 0; i-- {
+		t := tenders[i]
+		share := min(minorUnits(t.Amount, order.Currency)-minorUnits(t.Refunded, order.Currency), left)
+		if share <= 0 {
+			continue
+		}
+		amount := majorUnits(share, order.Currency)
+
+		if err := s.refundTender(ctx, t, amount, refundReasonItemReturned, ret.Reason); err != nil {
+			s.logger.ErrorContext(ctx, "failed to refund returned items",
+				slog.String("return_id", ret.ID),
+				slog.String("payment_id", t.PaymentID),
+				slog.String("error", err.Error()),
+			)
+			s.failRefund(ret, "refund to "+t.PaymentMethod+" failed: "+err.Error())
+			return
+		}
+
+		refund := &dto.ReturnRefundDTO{
+			ID:            uuid.New().String(),
+			ReturnID:      ret.ID,
+			TenderID:      t.ID,
+			PaymentID:     t.PaymentID,
+			PaymentMethod: t.PaymentMethod,
+			Amount:        amount,
+			CreatedAt:     time.Now(),
+		}
+		if err := s.returns.RecordReturnRefund(ctx, t, refund); err != nil {
+			// The money has gone back; keep it on the response at least.
+			s.logger.ErrorContext(ctx, "failed to record return refund",
+				slog.String("return_id", ret.ID),
+				slog.String("payment_id", t.PaymentID),
+				slog.String("error", err.Error()),
+			)
+		}
+		ret.Refunds = append(ret.Refunds, refund)
+		left -= share
+	}
+
+	if left > 0 {
+		s.failRefund(ret, "no tender left to refund to")
+	}
+}
+
+// issueStoreCredit refunds a return as a new store credit for the customer.
+func (s *OrderService) issueStoreCredit(ctx context.Context, order *dto.OrderDTO, ret *dto.ReturnDTO) {
+	if ret.RefundAmount <= 0 {
+		return
+	}
+
+	code, err := newStoreCreditCode()
+	if err != nil {
+		s.failRefund(ret, "store credit code could not be generated: "+err.Error())
+		return
+	}
+	credit := &dto.StoreCreditDTO{
+		ID:         uuid.New().String(),
+		ShopID:     ret.ShopID,
+		Code:       code,
+		CustomerID: order.UserID,
+		Amount:     ret.RefundAmount,
+		CreatedAt:  time.Now(),
+	}
+	if err := s.returns.CreateStoreCredit(ctx, credit); err != nil {
+		s.failRefund(ret, "store credit could not be issued: "+err.Error())
+		return
+	}
+	ret.StoreCreditID = &credit.ID
+	ret.StoreCredit = credit
+	s.recordStoreCredit(ctx, storeCreditIssue, credit.ID, order.ID, credit.Amount, order.Currency)
+}
+
+// payExchange spends the return's store credit on its exchange order. If that
+// fails the credit stays on its code and can still be tendered by hand.
+func (s *OrderService) payExchange(ctx context.Context, exchange *dto.OrderDTO, ret *dto.ReturnDTO) *dto.OrderDTO {
+	share := min(minorUnits(ret.StoreCredit.Balance, exchange.Currency), minorUnits(exchange.TotalAmount, exchange.Currency))
+	if share <= 0 {
+		return exchange
+	}
+	amount := majorUnits(share, exchange.Currency)
+
+	tender, err := s.redeemStoreCredit(ctx, exchange, ret.StoreCredit.Code, amount)
+	if err == nil {
+		var updated *dto.OrderDTO
+		if updated, err = s.recordTender(ctx, exchange, tender); err == nil {
+			ret.StoreCredit.Balance = majorUnits(minorUnits(ret.StoreCredit.Balance, exchange.Currency)-share, exchange.Currency)
+			return updated
+		}
+	}
+
+	s.logger.WarnContext(ctx, "failed to pay exchange order with store credit",
+		slog.String("return_id", ret.ID),
+		slog.String("order_id", exchange.ID),
+		slog.String("error", err.Error()),
+	)
+	msg := "exchange order could not be paid with store credit: " + err.Error()
+	ret.LastError = &msg
+	return exchange
+}
+
+// redeemStoreCredit takes amount off the store credit with code as a tender
+// for order; the tender's payment ID is the redemption's transaction ID.
+func (s *OrderService) redeemStoreCredit(ctx context.Context, order *dto.OrderDTO, code string, amount float64) (*dto.TenderDTO, error) {
+	transactionID, err := s.returns.RedeemStoreCredit(ctx, order.ShopID, strings.ToUpper(code), order.ID, amount)
+	if err != nil {
+		switch {
+		case err == sql.ErrNoRows:
+			return nil, errors.GRPC(codes.NotFound, errors.StoreCreditNotFoundCode, errors.StoreCreditNotFoundMsg)
+		case err == persistence.ErrStoreCreditInsufficient:
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.StoreCreditInsufficientCode, errors.StoreCreditInsufficientMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.OrderUpdateFailedCode, errors.OrderUpdateFailedMsg)
+	}
+	s.recordStoreCredit(ctx, storeCreditRedeem, transactionID, order.ID, amount, order.Currency)
+
+	return &dto.TenderDTO{
+		ID:            uuid.New().String(),
+		OrderID:       order.ID,
+		PaymentID:     transactionID,
+		PaymentMethod: paymentMethodStoreCredit,
+		Amount:        amount,
+		Tendered:      amount,
+		Currency:      order.Currency,
+		PaymentAmount: amount,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// Store credit movements as the payment ledger knows them.
+const (
+	storeCreditIssue   = "issue"
+	storeCreditRedeem  = "redeem"
+	storeCreditRestore = "restore"
+)
+
+// recordStoreCredit posts a store credit movement of amount in currency to
+// the payment ledger. The movement has already happened, so a failure is
+// only logged; the ledger ignores a movement posted twice, so it can be
+// posted again by hand.
+func (s *OrderService) recordStoreCredit(ctx context.Context, kind, referenceID, orderID string, amount float64, currency string) {
+	if err := s.payments.RecordStoreCreditMovement(ctx, kind, referenceID, orderID, amount, currency); err != nil {
+		s.logger.WarnContext(ctx, "failed to record store credit movement in ledger",
+			slog.String("kind", kind),
+			slog.String("reference_id", referenceID),
+			slog.String("order_id", orderID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func (s *OrderService) failRefund(ret *dto.ReturnDTO, msg string) {
+	ret.Status = dto.ReturnStatusRefundFailed
+	ret.LastError = &msg
+}
+
+// isReturnable reports whether goods of an order in status have been handed
+// over and paid for, so can come back.
+func isReturnable(status string) bool {
+	switch status {
+	case dto.OrderStatusConfirmed, dto.OrderStatusShipped, dto.OrderStatusDelivered:
+		return true
+	}
+	return false
+}
+
+// newStoreCreditCode returns a random code such as SC7KQ2M4XJ9PA, short
+// enough to read out at a till.
+func newStoreCreditCode() (string, error) {
+	b := make([]byte, 7)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "SC" + base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)[:11], nil
+}
+
+func dispositionFromProto(d orderpb.ReturnDisposition) (string, bool) {
+	if d == orderpb.ReturnDisposition_RETURN_DISPOSITION_UNSPECIFIED {
+		return dto.DispositionRestock, true
+	}
+	for name, value := range dispositionValues {
+		if value == d {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func refundMethodFromProto(m orderpb.RefundMethod) (string, bool) {
+	if m == orderpb.RefundMethod_REFUND_METHOD_UNSPECIFIED {
+		return dto.RefundToOriginalTender, true
+	}
+	for name, value := range refundMethodValues {
+		if value == m {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func toOrderReturn(r *dto.ReturnDTO) *orderpb.OrderReturn {
+	resp := &orderpb.OrderReturn{
+		Id:              r.ID,
+		OrderId:         r.OrderID,
+		ExchangeOrderId: ptrOrEmpty(r.ExchangeOrderID),
+		Reason:          r.Reason,
+		RefundMethod:    refundMethodValues[r.RefundMethod],
+		RefundAmount:    r.RefundAmount,
+		Status:          r.Status,
+		Items:           make([]*orderpb.ReturnItem, 0, len(r.Items)),
+		Refunds:         make([]*orderpb.ReturnRefund, 0, len(r.Refunds)),
+		ActorId:         ptrOrEmpty(r.ActorID),
+		CreatedAt:       timestamppb.New(r.CreatedAt),
+	}
+	for _, item := range r.Items {
+		resp.Items = append(resp.Items, &orderpb.ReturnItem{
+			OrderItemId:  item.OrderItemID,
+			ProductId:    item.ProductID,
+			ProductName:  item.ProductName,
+			VariantId:    item.VariantID,
+			Quantity:     item.Quantity,
+			RefundAmount: item.RefundAmount,
+			Disposition:  dispositionValues[item.Disposition],
+		})
+	}
+	for _, refund := range r.Refunds {
+		resp.Refunds = append(resp.Refunds, &orderpb.ReturnRefund{
+			PaymentId:     refund.PaymentID,
+			PaymentMethod: refund.PaymentMethod,
+			Amount:        refund.Amount,
+		})
+	}
+	if r.StoreCredit != nil {
+		resp.StoreCredit = toStoreCredit(r.StoreCredit)
+	}
+	return resp
+}
+
+func toStoreCredit(c *dto.StoreCreditDTO) *orderpb.StoreCredit {
+	return &orderpb.StoreCredit{
+		Id:         c.ID,
+		Code:       c.Code,
+		CustomerId: ptrOrEmpty(c.CustomerID),
+		Amount:     c.Amount,
+		Balance:    c.Balance,
+		CreatedAt:  timestamppb.New(c.CreatedAt),
+	}
+}
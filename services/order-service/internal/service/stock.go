@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	errors "hpkg/constants/responses"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"orderservice/internal/domain/dto"
+	"productservice/proto/productpb"
+)
+
+// Inventory holds stock on the product service for the lifetime of an order:
+// reserved when the order is placed, committed once it is confirmed and
+// released when it is cancelled. Returned goods are restocked separately.
+type Inventory interface {
+	ReserveStock(ctx context.Context, orderID string, items []*productpb.StockItem) (*productpb.StockReservation, error)
+	ReleaseStock(ctx context.Context, orderID string, reason string) error
+	CommitStock(ctx context.Context, orderID string) error
+	RestockItems(ctx context.Context, referenceID string, items []*productpb.StockItem, reason string) error
+}
+
+func (s *OrderService) reserveStock(ctx context.Context, order *dto.OrderDTO) error {
+	items := make([]*productpb.StockItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, &productpb.StockItem{
+			ProductId: item.ProductID,
+			VariantId: item.VariantID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	if _, err := s.inventory.ReserveStock(ctx, order.ID, items); err != nil {
+		s.logger.WarnContext(ctx, "failed to reserve stock for order",
+			slog.String("order_id", order.ID),
+			slog.String("error", err.Error()),
+		)
+		return stockError(err)
+	}
+
+	return nil
+}
+
+// commitStock is a no-op for orders placed before stock was reserved.
+func (s *OrderService) commitStock(ctx context.Context, order *dto.OrderDTO) error {
+	if err := s.inventory.CommitStock(ctx, order.ID); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		s.logger.WarnContext(ctx, "failed to commit stock for order",
+			slog.String("order_id", order.ID),
+			slog.String("error", err.Error()),
+		)
+		return stockError(err)
+	}
+
+	return nil
+}
+
+// releaseStock is best effort: the order change that triggered it has already
+// happened, so a failure is logged for follow up rather than returned.
+func (s *OrderService) releaseStock(ctx context.Context, order *dto.OrderDTO, reason string) {
+	if err := s.inventory.ReleaseStock(ctx, order.ID, reason); err != nil && status.Code(err) != codes.NotFound {
+		s.logger.ErrorContext(ctx, "failed to release stock for order",
+			slog.String("order_id", order.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// stockError passes business errors from the product service (out of stock,
+// expired reservation, unknown product) through unchanged and reports
+// anything else as the product service being unavailable.
+func stockError(err error) error {
+	switch status.Code(err) {
+	case codes.FailedPrecondition, codes.NotFound, codes.InvalidArgument:
+		return err
+	default:
+		return errors.GRPC(codes.Unavailable, errors.ErrProductServiceCode, errors.ErrProductServiceMsg)
+	}
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
+	"google.golang.org/grpc/codes"
+
+	"orderservice/internal/domain/dto"
+	"orderservice/internal/tax"
+	"orderservice/proto/orderpb"
+)
+
+// CalculateTax quotes the tax on a basket without creating an order. Items
+// are priced from the catalog exactly as CreateOrder would, in the requested
+// currency; without items the subtotal is taxed as a single uncategorised
+// line.
+func (s *OrderService) CalculateTax(ctx context.Context, req *orderpb.CalculateTaxRequest) (*orderpb.CalculateTaxResponse, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Items) == 0 && req.Subtotal < 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	currency, err := currencyCode(req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &dto.OrderDTO{ShopID: shopID, Currency: currency}
+	lines := []tax.Line{{Amount: minorUnits(req.Subtotal, currency), Taxable: true}}
+	if len(req.Items) > 0 {
+		if lines, err = s.priceItems(ctx, order, req.Items); err != nil {
+			return nil, err
+		}
+	} else {
+		order.Items = []*dto.OrderItemDTO{{Subtotal: majorUnits(lines[0].Amount, currency)}}
+	}
+
+	result, err := s.applyTax(ctx, order, lines, req.Country, req.State)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &orderpb.CalculateTaxResponse{
+		TaxAmount:        majorUnits(result.Tax, currency),
+		TaxRate:          result.EffectiveRate(),
+		TaxType:          result.TaxType(),
+		Components:       make([]*orderpb.TaxComponent, 0, len(result.Components)),
+		PricesIncludeTax: result.PricesIncludeTax,
+		NetAmount:        majorUnits(result.Net, currency),
+		GrossAmount:      majorUnits(result.Gross, currency),
+		Currency:         currency,
+	}
+	for _, c := range result.Components {
+		resp.Components = append(resp.Components, &orderpb.TaxComponent{
+			Name:     c.Name,
+			Rate:     c.Rate,
+			Amount:   majorUnits(c.Amount, currency),
+			Compound: c.Compound,
+		})
+	}
+	if len(req.Items) > 0 {
+		resp.Items = toOrderItems(order.Items)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"math/big"
+	"strconv"
+	"time"
+
+	errors "hpkg/constants/responses"
+	"hpkg/money"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"orderservice/internal/domain/dto"
+	"orderservice/internal/infrastructure/persistence"
+	"orderservice/proto/orderpb"
+	"paymentservice/proto/paymentpb"
+)
+
+const (
+	// paymentMethodCash is the only tender that may exceed the balance due;
+	// the excess is handed back as change.
+	paymentMethodCash = "cash"
+	// paymentMethodStoreCredit tenders are redeemed from a store credit
+	// rather than taken through the payment service.
+	paymentMethodStoreCredit = "store_credit"
+)
+
+var paymentStatusValues = map[string]orderpb.OrderPaymentStatus{
+	dto.OrderPaymentUnpaid:        orderpb.OrderPaymentStatus_ORDER_PAYMENT_STATUS_UNPAID,
+	dto.OrderPaymentPartiallyPaid: orderpb.OrderPaymentStatus_ORDER_PAYMENT_STATUS_PARTIALLY_PAID,
+	dto.OrderPaymentPaid:          orderpb.OrderPaymentStatus_ORDER_PAYMENT_STATUS_PAID,
+}
+
+// AddPayment takes one tender towards an order, e.g. the cash half of a
+// part cash, part card sale. Tenders are accepted until the balance reaches
+// zero; the tender that covers it confirms a pending order. A tender in
+// another currency than the order's is converted at the shop's exchange rate
+// and rounded the way the order's currency is paid.
+func (s *OrderService) AddPayment(ctx context.Context, req *orderpb.AddPaymentRequest) (*orderpb.AddPaymentResponse, error) {
+	if req.PaymentMethod == "" || (req.AmountMoney == nil && req.Amount <= 0) {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	order, err := s.getOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	paid, err := tenderMoney(req, order.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if paid.Currency != order.Currency && req.PaymentMethod == paymentMethodStoreCredit {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.CurrencyUnsupportedCode, errors.CurrencyUnsupportedMsg)
+	}
+	rate, err := s.exchangeRate(ctx, order.ShopID, paid.Currency, order.Currency)
+	if err != nil {
+		return nil, err
+	}
+	converted, err := paid.Convert(order.Currency, rate)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+	}
+
+	if order.Status == dto.OrderStatusCancelled {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.OrderPaymentNotAllowedCode, errors.OrderPaymentNotAllowedMsg)
+	}
+	balance := balanceDue(order)
+	if balance.Amount <= 0 {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.OrderAlreadyPaidCode, errors.OrderAlreadyPaidMsg)
+	}
+
+	amount := converted
+	charge := paid
+	if amount.Amount > balance.Amount {
+		if req.PaymentMethod != paymentMethodCash {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.OrderOverpaymentCode, errors.OrderOverpaymentMsg)
+		}
+		amount = balance
+		// Only what covers the balance is taken; the rest is change.
+		if charge, err = amount.Convert(paid.Currency, new(big.Rat).Inv(rate)); err != nil {
+			return nil, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+		}
+	}
+
+	var tender *dto.TenderDTO
+	if req.PaymentMethod == paymentMethodStoreCredit {
+		if req.StoreCreditCode == "" {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+		}
+		tender, err = s.redeemStoreCredit(ctx, order, req.StoreCreditCode, amount.Float())
+	} else {
+		tender, err = s.chargeTender(ctx, order, req, charge, amount)
+	}
+	if err != nil {
+		return nil, err
+	}
+	tender.Tendered = converted.Float()
+	tender.ChangeDue = fromMinor(converted.Amount-amount.Amount, order.Currency).Float()
+	tender.ExchangeRate = formatRate(rate)
+
+	updated, err := s.recordTender(ctx, order, tender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderpb.AddPaymentResponse{
+		OrderId:       updated.ID,
+		Tender:        toTender(tender),
+		AmountPaid:    updated.AmountPaid,
+		BalanceDue:    balanceDue(updated).Float(),
+		ChangeDue:     tender.ChangeDue,
+		PaymentStatus: paymentStatusToProto(updated.PaymentStatus),
+		Status:        statusToProto(updated.Status),
+		Message:       "payment added",
+	}, nil
+}
+
+// tenderMoney reads the amount tendered in req. Without a currency it is in
+// the order's.
+func tenderMoney(req *orderpb.AddPaymentRequest, orderCurrency string) (money.Money, error) {
+	var (
+		m   money.Money
+		err error
+	)
+	if req.AmountMoney != nil {
+		m, err = money.FromProto(req.AmountMoney)
+	} else {
+		currency := req.Currency
+		if currency == "" {
+			currency = orderCurrency
+		}
+		m, err = money.FromFloat(req.Amount, currency)
+	}
+	if err == money.ErrUnknownCurrency {
+		return money.Money{}, errors.GRPC(codes.InvalidArgument, errors.CurrencyUnsupportedCode, errors.CurrencyUnsupportedMsg)
+	}
+	if err != nil || m.Amount <= 0 {
+		return money.Money{}, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+	return m, nil
+}
+
+// chargeTender takes charge through the payment service towards amount of
+// the order's balance.
+func (s *OrderService) chargeTender(ctx context.Context, order *dto.OrderDTO, req *orderpb.AddPaymentRequest, charge, amount money.Money) (*dto.TenderDTO, error) {
+	payment, err := s.payments.ProcessPayment(ctx, &paymentpb.ProcessPaymentRequest{
+		OrderId:       order.ID,
+		UserId:        ptrOrEmpty(order.UserID),
+		Amount:        charge.Float(),
+		Currency:      charge.Currency,
+		AmountMoney:   money.ToProto(charge),
+		PaymentMethod: req.PaymentMethod,
+		CardToken:     req.CardToken,
+	})
+	if err != nil {
+		s.logger.WarnContext(ctx, "order payment failed",
+			slog.String("order_id", order.ID),
+			slog.String("payment_method", req.PaymentMethod),
+			slog.String("error", err.Error()),
+		)
+		return nil, paymentError(err)
+	}
+	if payment.Status != paymentStatusCompleted {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentDeclinedCode, errors.PaymentDeclinedMsg)
+	}
+
+	return &dto.TenderDTO{
+		ID:            uuid.New().String(),
+		OrderID:       order.ID,
+		PaymentID:     payment.PaymentId,
+		PaymentMethod: req.PaymentMethod,
+		Amount:        amount.Float(),
+		Tendered:      amount.Float(),
+		Currency:      charge.Currency,
+		PaymentAmount: charge.Float(),
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// recordTender puts a tender that was taken against its order, confirming a
+// pending order once it is paid in full.
+func (s *OrderService) recordTender(ctx context.Context, order *dto.OrderDTO, tender *dto.TenderDTO) (*dto.OrderDTO, error) {
+	updated, err := s.repo.RecordTender(ctx, order.ShopID, tender)
+	if err != nil {
+		// The money was taken but cannot be put against the order, so
+		// give it back rather than leave it unaccounted for. That includes
+		// a tender beaten to the balance by a concurrent one.
+		reason := "payment could not be recorded"
+		if err == persistence.ErrTenderExceedsBalance {
+			reason = "order balance was paid by another payment"
+		}
+		if refundErr := s.refundTender(context.WithoutCancel(ctx), tender, tender.Amount, refundReasonOther, reason); refundErr != nil {
+			s.logger.ErrorContext(ctx, "failed to refund unrecorded order payment",
+				slog.String("order_id", order.ID),
+				slog.String("payment_id", tender.PaymentID),
+				slog.String("error", refundErr.Error()),
+			)
+		}
+		if err == persistence.ErrTenderExceedsBalance {
+			return nil, errors.GRPC(codes.Aborted, errors.OrderBalanceChangedCode, errors.OrderBalanceChangedMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.OrderUpdateFailedCode, errors.OrderUpdateFailedMsg)
+	}
+
+	// The order stays pending if confirming fails; its payment is recorded
+	// and it can still be confirmed through UpdateOrderStatus.
+	if updated.PaymentStatus == dto.OrderPaymentPaid && updated.Status == dto.OrderStatusPending {
+		if confirmed, err := s.transition(ctx, updated, dto.OrderStatusConfirmed, "payment received"); err == nil {
+			updated = confirmed
+		} else {
+			s.logger.WarnContext(ctx, "failed to confirm paid order",
+				slog.String("order_id", updated.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	return updated, nil
+}
+
+// refundTender gives amount of a tender back the way it was taken. amount is
+// in the order's currency; a tender paid in another currency gets back the
+// same share of what it paid.
+func (s *OrderService) refundTender(ctx context.Context, t *dto.TenderDTO, amount float64, reasonCode, reason string) error {
+	if t.PaymentMethod == paymentMethodStoreCredit {
+		restoreID, err := s.returns.RestoreStoreCredit(ctx, t.PaymentID, amount)
+		if err != nil {
+			return err
+		}
+		s.recordStoreCredit(ctx, storeCreditRestore, restoreID, t.OrderID, amount, t.Currency)
+		return nil
+	}
+	return s.payments.RefundPayment(ctx, t.PaymentID, paymentShare(t, amount), reasonCode, reason)
+}
+
+// paymentShare returns the part of what a tender paid, in the tender's own
+// currency, that amount of the order's currency stands for.
+func paymentShare(t *dto.TenderDTO, amount float64) float64 {
+	if t.Amount <= 0 || t.PaymentAmount == t.Amount {
+		return amount
+	}
+	paid := toMoney(t.PaymentAmount, t.Currency)
+	if amount >= t.Amount {
+		return paid.Float()
+	}
+	share := new(big.Rat).Quo(decimalRat(amount), decimalRat(t.Amount))
+	refund, err := paid.Convert(paid.Currency, share)
+	if err != nil {
+		return amount
+	}
+	return refund.Float()
+}
+
+// decimalRat returns f at its shortest decimal form as an exact fraction.
+func decimalRat(f float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	return r
+}
+
+// recordCheckoutTender puts a checkout's payment against its order. It is
+// idempotent, so a resumed checkout may record the same payment again.
+func (s *OrderService) recordCheckoutTender(ctx context.Context, saga *dto.CheckoutSagaDTO) error {
+	tender := &dto.TenderDTO{
+		ID:            uuid.New().String(),
+		OrderID:       saga.OrderID,
+		PaymentID:     *saga.PaymentID,
+		PaymentMethod: ptrOrEmpty(saga.PaymentMethod),
+		Amount:        saga.Amount,
+		Tendered:      saga.Amount,
+		Currency:      saga.Currency,
+		PaymentAmount: saga.Amount,
+		ExchangeRate:  "1",
+		CreatedAt:     time.Now(),
+	}
+	if _, err := s.repo.RecordTender(ctx, saga.ShopID, tender); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.GRPC(codes.NotFound, errors.OrderNotFoundCode, errors.OrderNotFoundMsg)
+		}
+		return errors.GRPC(codes.Internal, errors.OrderUpdateFailedCode, errors.OrderUpdateFailedMsg)
+	}
+	return nil
+}
+
+// refundTenders gives back what is left of every payment taken towards a
+// cancelled order.
+// Failures are logged for follow-up rather than undoing the cancellation.
+func (s *OrderService) refundTenders(ctx context.Context, order *dto.OrderDTO, reason string) {
+	if order.AmountPaid <= 0 {
+		return
+	}
+
+	tenders, err := s.repo.ListTenders(ctx, order.ID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to load tenders of cancelled order",
+			slog.String("order_id", order.ID),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	for _, t := range tenders {
+		left := minorUnits(t.Amount, order.Currency) - minorUnits(t.Refunded, order.Currency)
+		if left <= 0 {
+			continue
+		}
+		amount := majorUnits(left, order.Currency)
+		if err := s.refundTender(ctx, t, amount, refundReasonOrderCancelled, reason); err != nil {
+			s.logger.ErrorContext(ctx, "failed to refund tender of cancelled order",
+				slog.String("order_id", order.ID),
+				slog.String("payment_id", t.PaymentID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		if err := s.repo.RecordTenderRefund(ctx, order.ShopID, t, amount); err != nil {
+			s.logger.ErrorContext(ctx, "failed to record refund of cancelled order",
+				slog.String("order_id", order.ID),
+				slog.String("payment_id", t.PaymentID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// balanceDue is what is left to pay of an order, in its currency.
+func balanceDue(o *dto.OrderDTO) money.Money {
+	due := toMoney(o.TotalAmount, o.Currency)
+	due.Amount = max(due.Amount-minorUnits(o.AmountPaid, o.Currency), 0)
+	return due
+}
+
+func paymentStatusToProto(name string) orderpb.OrderPaymentStatus {
+	return paymentStatusValues[name]
+}
+
+func toTender(t *dto.TenderDTO) *orderpb.Tender {
+	return &orderpb.Tender{
+		PaymentId:      t.PaymentID,
+		PaymentMethod:  t.PaymentMethod,
+		Amount:         t.Amount,
+		Tendered:       t.Tendered,
+		ChangeDue:      t.ChangeDue,
+		CreatedAt:      timestamppb.New(t.CreatedAt),
+		RefundedAmount: t.Refunded,
+		Currency:       t.Currency,
+		PaymentAmount:  moneyProto(t.PaymentAmount, t.Currency),
+		ExchangeRate:   normalizeRate(t.ExchangeRate),
+	}
+}
@@ -0,0 +1,121 @@
+package tax
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// FileStore serves tax configuration read from a JSON file, for deployments
+// that keep tax rules under configuration management instead of in the
+// database. Settings without a shop_id apply to every shop not listed.
+//
+//	{
+//	  "settings": [{"country": "KH", "prices_include_tax": false}],
+//	  "rules": [{"country": "KH", "name": "VAT", "rate": 10}]
+//	}
+type FileStore struct {
+	defaults *Settings
+	settings map[string]*Settings
+	rules    []Rule
+}
+
+type fileConfig struct {
+	Settings []Settings `json:"settings"`
+	Rules    []Rule     `json:"rules"`
+}
+
+// NewFileStore loads and validates the rules file at path.
+func NewFileStore(path string) (*FileStore, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg fileConfig
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("parse tax rules %s: %w", path, err)
+	}
+
+	fs := &FileStore{settings: make(map[string]*Settings)}
+	for i := range cfg.Settings {
+		s := &cfg.Settings[i]
+		if err := ValidateSettings(s); err != nil {
+			return nil, fmt.Errorf("tax settings %d: %w", i, err)
+		}
+		if s.ShopID == "" {
+			fs.defaults = s
+		} else {
+			fs.settings[s.ShopID] = s
+		}
+	}
+	for i, r := range cfg.Rules {
+		if err := ValidateRule(r); err != nil {
+			return nil, fmt.Errorf("tax rule %d: %w", i, err)
+		}
+	}
+	fs.rules = cfg.Rules
+
+	return fs, nil
+}
+
+func (fs *FileStore) Settings(ctx context.Context, shopID string) (*Settings, error) {
+	if s, ok := fs.settings[shopID]; ok {
+		return s, nil
+	}
+	if fs.defaults == nil {
+		return nil, nil
+	}
+	s := *fs.defaults
+	s.ShopID = shopID
+	return &s, nil
+}
+
+func (fs *FileStore) Rules(ctx context.Context, shopID, country, state string) ([]Rule, error) {
+	rules := make([]Rule, 0)
+	for _, r := range fs.rules {
+		if r.ShopID != "" && r.ShopID != shopID {
+			continue
+		}
+		if !strings.EqualFold(r.Country, country) {
+			continue
+		}
+		if r.State != "" && !strings.EqualFold(r.State, state) {
+			continue
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// ValidateSettings checks s and fills in default rounding.
+func ValidateSettings(s *Settings) error {
+	if s.Country == "" {
+		return fmt.Errorf("country is required")
+	}
+	if s.RoundingMode == "" {
+		s.RoundingMode = HalfUp
+	}
+	if !s.RoundingMode.Valid() {
+		return fmt.Errorf("unknown rounding mode %q", s.RoundingMode)
+	}
+	if s.RoundingLevel == "" {
+		s.RoundingLevel = RoundPerLine
+	}
+	if s.RoundingLevel != RoundPerLine && s.RoundingLevel != RoundPerOrder {
+		return fmt.Errorf("unknown rounding level %q", s.RoundingLevel)
+	}
+	return nil
+}
+
+func ValidateRule(r Rule) error {
+	if r.Country == "" || r.Name == "" {
+		return fmt.Errorf("country and name are required")
+	}
+	if r.Rate < 0 {
+		return fmt.Errorf("rate must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package tax
+
+import "math"
+
+// RoundingMode decides which way amounts that fall between two minor units,
+// e.g. two cents, go.
+type RoundingMode string
+
+const (
+	// HalfUp rounds halves away from zero: 0.125 -> 0.13.
+	HalfUp RoundingMode = "half_up"
+	// HalfEven rounds halves to the even cent (banker's rounding): 0.125 -> 0.12.
+	HalfEven RoundingMode = "half_even"
+	// Up always rounds away from zero: 0.121 -> 0.13.
+	Up RoundingMode = "up"
+	// Down always rounds toward zero: 0.129 -> 0.12.
+	Down RoundingMode = "down"
+)
+
+// Valid reports whether m is a known rounding mode.
+func (m RoundingMode) Valid() bool {
+	switch m {
+	case HalfUp, HalfEven, Up, Down:
+		return true
+	}
+	return false
+}
+
+// Round rounds v to the given number of decimal places.
+func Round(v float64, mode RoundingMode, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	// Strip float noise first so 0.125 stored as 0.12499999 still counts
+	// as a half and 1.10 does not round up to 1.11 under Up.
+	x := math.Round(v*scale*1e6) / 1e6
+
+	switch mode {
+	case HalfEven:
+		x = math.RoundToEven(x)
+	case Up:
+		if x < 0 {
+			x = math.Floor(x)
+		} else {
+			x = math.Ceil(x)
+		}
+	case Down:
+		x = math.Trunc(x)
+	default:
+		x = math.Round(x)
+	}
+
+	return x / scale
+}
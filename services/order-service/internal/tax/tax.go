@@ -0,0 +1,310 @@
+// Package tax computes sales taxes from shop-configurable rules.
+//
+// A shop's Settings name its tax jurisdiction and say whether its prices
+// already include tax and how amounts are rounded. Rules are the taxes levied
+// in a jurisdiction, e.g. Cambodian VAT or a US state sales tax. A rule may
+// be limited to one product category, may take its rate from the product
+// (products.tax_rate) and may be compound, i.e. charged on the price plus the
+// taxes applied before it.
+//
+// Amounts are whole minor units of the order's currency, e.g. cents or yen,
+// and every tax is rounded to one.
+package tax
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// Settings is how a shop is taxed.
+type Settings struct {
+	ShopID           string       `json:"shop_id"`
+	Country          string       `json:"country"`
+	State            string       `json:"state,omitempty"`
+	PricesIncludeTax bool         `json:"prices_include_tax"`
+	RoundingMode     RoundingMode `json:"rounding_mode,omitempty"`
+	RoundingLevel    string       `json:"rounding_level,omitempty"`
+}
+
+const (
+	// RoundPerLine rounds each tax on each line; the order tax is their sum.
+	RoundPerLine = "line"
+	// RoundPerOrder rounds each tax once over the whole order.
+	RoundPerOrder = "order"
+)
+
+// Rule is one tax levied in a jurisdiction. An empty ShopID applies the rule
+// to every shop there; an empty State to the whole country; an empty
+// CategoryID to every category. Rates are percentages.
+type Rule struct {
+	ID             string  `json:"id,omitempty"`
+	ShopID         string  `json:"shop_id,omitempty"`
+	Country        string  `json:"country"`
+	State          string  `json:"state,omitempty"`
+	CategoryID     string  `json:"category_id,omitempty"`
+	Name           string  `json:"name"`
+	Rate           float64 `json:"rate"`
+	UseProductRate bool    `json:"use_product_rate,omitempty"`
+	Compound       bool    `json:"compound,omitempty"`
+	Sequence       int     `json:"sequence,omitempty"`
+}
+
+// Store loads tax configuration.
+type Store interface {
+	// Settings returns the shop's settings, or nil if it has none.
+	Settings(ctx context.Context, shopID string) (*Settings, error)
+	// Rules returns the active rules for the shop in country and state,
+	// including rules shared by all shops.
+	Rules(ctx context.Context, shopID, country, state string) ([]Rule, error)
+}
+
+// Line is a taxable amount, normally price times quantity of an order line.
+type Line struct {
+	ID          string
+	Amount      int64
+	CategoryID  string
+	ProductRate float64
+	Taxable     bool
+}
+
+// Request asks for the taxes on lines sold by a shop. Country and State
+// default to the shop's own jurisdiction.
+type Request struct {
+	ShopID  string
+	Country string
+	State   string
+	Lines   []Line
+}
+
+// Component is the amount of one tax. Rate is 0 when it differs between
+// lines, e.g. for a tax charged at each product's own rate.
+type Component struct {
+	Name     string
+	Rate     float64
+	Amount   int64
+	Compound bool
+}
+
+// LineResult is the tax of one line. Net excludes tax and Gross includes it,
+// whichever way the price was given. Rate is the effective rate on Net.
+type LineResult struct {
+	ID    string
+	Net   int64
+	Tax   int64
+	Gross int64
+	Rate  float64
+}
+
+type Result struct {
+	Lines            []LineResult
+	Components       []Component
+	Net              int64
+	Tax              int64
+	Gross            int64
+	PricesIncludeTax bool
+}
+
+// TaxType is a readable summary of the applied taxes, e.g. "VAT" or
+// "State sales tax + County tax".
+func (r *Result) TaxType() string {
+	names := make([]string, 0, len(r.Components))
+	for _, c := range r.Components {
+		names = append(names, c.Name)
+	}
+	return strings.Join(names, " + ")
+}
+
+// EffectiveRate is the overall tax rate on the net amount, as a percentage.
+func (r *Result) EffectiveRate() float64 {
+	if r.Net == 0 {
+		return 0
+	}
+	return Round(float64(r.Tax)/float64(r.Net)*100, HalfUp, 4)
+}
+
+// Engine applies a Store's rules to requests.
+type Engine struct {
+	store Store
+}
+
+func NewEngine(store Store) *Engine {
+	return &Engine{store: store}
+}
+
+// Calculate works out the taxes on req. A shop without settings keeps the
+// behaviour that predates tax rules: exclusive prices, each product taxed at
+// its own tax_rate, rounded half up per line.
+func (e *Engine) Calculate(ctx context.Context, req Request) (*Result, error) {
+	settings, err := e.store.Settings(ctx, req.ShopID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []Rule
+	if settings == nil {
+		settings = &Settings{ShopID: req.ShopID}
+		rules = []Rule{{Name: "Tax", UseProductRate: true}}
+	} else {
+		country, state := settings.Country, settings.State
+		if req.Country != "" {
+			country, state = req.Country, req.State
+		}
+		if rules, err = e.store.Rules(ctx, req.ShopID, country, state); err != nil {
+			return nil, err
+		}
+	}
+
+	return apply(settings, rules, req.Lines), nil
+}
+
+// applicable returns, for a line, the rules to apply in sequence order. A
+// rule for the line's category replaces the general rule of the same name,
+// and rules specific to the shop replace shared ones.
+func applicable(rules []Rule, line Line) []Rule {
+	chosen := make(map[string]Rule)
+	for _, r := range rules {
+		if r.CategoryID != "" && r.CategoryID != line.CategoryID {
+			continue
+		}
+		if prev, ok := chosen[r.Name]; ok && specificity(prev) >= specificity(r) {
+			continue
+		}
+		chosen[r.Name] = r
+	}
+
+	out := make([]Rule, 0, len(chosen))
+	for _, r := range chosen {
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Sequence != out[j].Sequence {
+			return out[i].Sequence < out[j].Sequence
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+func specificity(r Rule) int {
+	n := 0
+	if r.CategoryID != "" {
+		n += 4
+	}
+	if r.ShopID != "" {
+		n += 2
+	}
+	if r.State != "" {
+		n++
+	}
+	return n
+}
+
+func rateOf(r Rule, line Line) float64 {
+	if r.UseProductRate {
+		return line.ProductRate
+	}
+	return r.Rate
+}
+
+// taxes returns the raw (unrounded) amount of every rule on net. Simple
+// taxes are charged on net, compound taxes on net plus the taxes before them.
+func taxes(rules []Rule, line Line, net float64) []float64 {
+	amounts := make([]float64, len(rules))
+	base := net
+	for i, r := range rules {
+		if r.Compound {
+			amounts[i] = base * rateOf(r, line) / 100
+		} else {
+			amounts[i] = net * rateOf(r, line) / 100
+		}
+		base += amounts[i]
+	}
+	return amounts
+}
+
+func apply(settings *Settings, rules []Rule, lines []Line) *Result {
+	mode := settings.RoundingMode
+	if mode == "" {
+		mode = HalfUp
+	}
+	perOrder := settings.RoundingLevel == RoundPerOrder
+
+	res := &Result{
+		Lines:            make([]LineResult, 0, len(lines)),
+		PricesIncludeTax: settings.PricesIncludeTax,
+	}
+	components := make(map[string]*Component)
+	// What each tax comes to before it is rounded once for the order.
+	amounts := make(map[string]float64)
+	order := make([]string, 0)
+
+	for _, line := range lines {
+		var lineRules []Rule
+		if line.Taxable {
+			lineRules = applicable(rules, line)
+		}
+
+		// For inclusive prices find the net amount that grosses up to the
+		// price: the taxes are linear in net, so one unit tells the factor.
+		net := float64(line.Amount)
+		if settings.PricesIncludeTax {
+			factor := 1.0
+			for _, t := range taxes(lineRules, line, 1) {
+				factor += t
+			}
+			net /= factor
+		}
+
+		lr := LineResult{ID: line.ID}
+		var lineTax float64
+		for i, t := range taxes(lineRules, line, net) {
+			r := lineRules[i]
+			if !perOrder {
+				t = Round(t, mode, 0)
+			}
+			lineTax += t
+
+			c, ok := components[r.Name]
+			if !ok {
+				c = &Component{Name: r.Name, Rate: rateOf(r, line), Compound: r.Compound}
+				components[r.Name] = c
+				order = append(order, r.Name)
+			} else if c.Rate != rateOf(r, line) {
+				c.Rate = 0
+			}
+			amounts[r.Name] += t
+		}
+
+		lr.Tax = int64(Round(lineTax, mode, 0))
+		if settings.PricesIncludeTax {
+			lr.Gross = line.Amount
+			lr.Net = lr.Gross - lr.Tax
+		} else {
+			lr.Net = line.Amount
+			lr.Gross = lr.Net + lr.Tax
+		}
+		if lr.Net != 0 {
+			lr.Rate = Round(float64(lr.Tax)/float64(lr.Net)*100, HalfUp, 4)
+		}
+
+		res.Lines = append(res.Lines, lr)
+		res.Net += lr.Net
+		res.Gross += lr.Gross
+	}
+
+	for _, name := range order {
+		c := components[name]
+		c.Amount = int64(Round(amounts[name], mode, 0))
+		res.Tax += c.Amount
+		res.Components = append(res.Components, *c)
+	}
+
+	if settings.PricesIncludeTax {
+		res.Net = res.Gross - res.Tax
+	} else {
+		res.Gross = res.Net + res.Tax
+	}
+
+	return res
+}
@@ -0,0 +1,171 @@
+package tax
+
+import (
+	"context"
+	"testing"
+)
+
+// memStore serves one shop's settings and rules.
+type memStore struct {
+	settings *Settings
+	rules    []Rule
+}
+
+func (m memStore) Settings(ctx context.Context, shopID string) (*Settings, error) {
+	return m.settings, nil
+}
+
+func (m memStore) Rules(ctx context.Context, shopID, country, state string) ([]Rule, error) {
+	return m.rules, nil
+}
+
+func TestCalculate(t *testing.T) {
+	vat := []Rule{{Name: "VAT", Rate: 10}}
+	stateAndCounty := []Rule{
+		{Name: "State sales tax", Rate: 5, Sequence: 1},
+		{Name: "County tax", Rate: 2, Compound: true, Sequence: 2},
+	}
+	threeLines := []Line{
+		{ID: "a", Amount: 105, Taxable: true},
+		{ID: "b", Amount: 105, Taxable: true},
+		{ID: "c", Amount: 105, Taxable: true},
+	}
+
+	tests := []struct {
+		name     string
+		settings *Settings
+		rules    []Rule
+		lines    []Line
+		net      int64
+		tax      int64
+		gross    int64
+	}{
+		{
+			name:     "exclusive",
+			settings: &Settings{},
+			rules:    vat,
+			lines:    []Line{{Amount: 1000, Taxable: true}},
+			net:      1000, tax: 100, gross: 1100,
+		},
+		{
+			name:     "inclusive",
+			settings: &Settings{PricesIncludeTax: true},
+			rules:    vat,
+			lines:    []Line{{Amount: 1100, Taxable: true}},
+			net:      1000, tax: 100, gross: 1100,
+		},
+		{
+			name:     "inclusive rounds the tax, not the net",
+			settings: &Settings{PricesIncludeTax: true},
+			rules:    vat,
+			lines:    []Line{{Amount: 999, Taxable: true}},
+			net:      908, tax: 91, gross: 999,
+		},
+		{
+			name:     "compound tax is charged on the tax before it",
+			settings: &Settings{},
+			rules:    stateAndCounty,
+			lines:    []Line{{Amount: 10000, Taxable: true}},
+			net:      10000, tax: 710, gross: 10710,
+		},
+		{
+			name:     "inclusive compound",
+			settings: &Settings{PricesIncludeTax: true},
+			rules:    stateAndCounty,
+			lines:    []Line{{Amount: 10710, Taxable: true}},
+			net:      10000, tax: 710, gross: 10710,
+		},
+		{
+			name:     "untaxable line",
+			settings: &Settings{},
+			rules:    vat,
+			lines:    []Line{{Amount: 1000, Taxable: true}, {Amount: 500}},
+			net:      1500, tax: 100, gross: 1600,
+		},
+		{
+			name:     "half up per line",
+			settings: &Settings{},
+			rules:    vat,
+			lines:    threeLines,
+			net:      315, tax: 33, gross: 348,
+		},
+		{
+			name:     "half even per line",
+			settings: &Settings{RoundingMode: HalfEven},
+			rules:    vat,
+			lines:    threeLines,
+			net:      315, tax: 30, gross: 345,
+		},
+		{
+			name:     "half up per order",
+			settings: &Settings{RoundingLevel: RoundPerOrder},
+			rules:    vat,
+			lines:    threeLines,
+			net:      315, tax: 32, gross: 347,
+		},
+		{
+			name:     "down per order",
+			settings: &Settings{RoundingMode: Down, RoundingLevel: RoundPerOrder},
+			rules:    vat,
+			lines:    threeLines,
+			net:      315, tax: 31, gross: 346,
+		},
+		{
+			name:     "category rule replaces the general one",
+			settings: &Settings{},
+			rules:    []Rule{{Name: "VAT", Rate: 10}, {Name: "VAT", Rate: 5, CategoryID: "food"}},
+			lines:    []Line{{Amount: 1000, Taxable: true, CategoryID: "food"}, {Amount: 1000, Taxable: true}},
+			net:      2000, tax: 150, gross: 2150,
+		},
+		{
+			name:  "no settings taxes at the product's rate",
+			lines: []Line{{Amount: 1000, Taxable: true, ProductRate: 8.25}},
+			net:   1000, tax: 83, gross: 1083,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEngine(memStore{settings: tt.settings, rules: tt.rules})
+			res, err := e.Calculate(context.Background(), Request{ShopID: "shop", Lines: tt.lines})
+			if err != nil {
+				t.Fatalf("Calculate: %v", err)
+			}
+			if res.Net != tt.net || res.Tax != tt.tax || res.Gross != tt.gross {
+				t.Errorf("net, tax, gross = %d, %d, %d, want %d, %d, %d",
+					res.Net, res.Tax, res.Gross, tt.net, tt.tax, tt.gross)
+			}
+			var lineTax int64
+			for _, lr := range res.Lines {
+				lineTax += lr.Tax
+			}
+			if tt.settings == nil || tt.settings.RoundingLevel != RoundPerOrder {
+				if lineTax != res.Tax {
+					t.Errorf("line taxes add up to %d, order tax is %d", lineTax, res.Tax)
+				}
+			}
+		})
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		v    float64
+		mode RoundingMode
+		want float64
+	}{
+		{0.125, HalfUp, 0.13},
+		{0.125, HalfEven, 0.12},
+		{0.135, HalfEven, 0.14},
+		{0.121, Up, 0.13},
+		{1.10, Up, 1.1},
+		{0.129, Down, 0.12},
+		{-0.125, HalfUp, -0.13},
+		{-0.121, Up, -0.13},
+		{1.005, HalfUp, 1.01},
+	}
+	for _, tt := range tests {
+		if got := Round(tt.v, tt.mode, 2); got != tt.want {
+			t.Errorf("Round(%v, %s, 2) = %v, want %v", tt.v, tt.mode, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS orders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    shop_id UUID NOT NULL REFERENCES shops(id) ON DELETE CASCADE,
+    user_id UUID,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    subtotal DECIMAL(12, 2) NOT NULL DEFAULT 0.00,
+    tax DECIMAL(12, 2) NOT NULL DEFAULT 0.00,
+    discount DECIMAL(12, 2) NOT NULL DEFAULT 0.00,
+    total_amount DECIMAL(12, 2) NOT NULL DEFAULT 0.00,
+    payment_method VARCHAR(50),
+    shipping_address TEXT,
+    cancel_reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_orders_shop_id ON orders(shop_id);
+CREATE INDEX IF NOT EXISTS idx_orders_user_id ON orders(shop_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_orders_status ON orders(shop_id, status);
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON orders(shop_id, created_at DESC);
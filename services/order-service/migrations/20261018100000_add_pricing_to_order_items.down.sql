@@ -0,0 +1,6 @@
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS tax_amount,
+    DROP COLUMN IF EXISTS tax_rate,
+    DROP COLUMN IF EXISTS is_taxable,
+    DROP COLUMN IF EXISTS price_overridden,
+    DROP COLUMN IF EXISTS catalog_price;
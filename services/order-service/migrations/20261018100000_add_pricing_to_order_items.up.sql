@@ -0,0 +1,6 @@
+ALTER TABLE order_items
+    ADD COLUMN IF NOT EXISTS catalog_price DECIMAL(12, 2) NOT NULL DEFAULT 0.00,
+    ADD COLUMN IF NOT EXISTS price_overridden BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS is_taxable BOOLEAN NOT NULL DEFAULT true,
+    ADD COLUMN IF NOT EXISTS tax_rate DECIMAL(5, 2) NOT NULL DEFAULT 0.00,
+    ADD COLUMN IF NOT EXISTS tax_amount DECIMAL(12, 2) NOT NULL DEFAULT 0.00;
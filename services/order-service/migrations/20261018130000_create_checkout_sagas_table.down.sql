@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_checkout_sagas_unfinished;
+DROP INDEX IF EXISTS idx_checkout_sagas_order_id;
+DROP TABLE IF EXISTS checkout_sagas CASCADE;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS checkout_sagas (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    shop_id UUID NOT NULL REFERENCES shops(id) ON DELETE CASCADE,
+    -- generated before the order row is written, so not a foreign key
+    order_id UUID NOT NULL,
+    actor_id UUID,
+    step VARCHAR(30) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'running',
+    payment_id UUID,
+    amount DECIMAL(12, 2) NOT NULL DEFAULT 0.00,
+    currency VARCHAR(10) NOT NULL DEFAULT 'USD',
+    payment_method VARCHAR(50),
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_checkout_sagas_order_id ON checkout_sagas(order_id);
+CREATE INDEX IF NOT EXISTS idx_checkout_sagas_unfinished ON checkout_sagas(status)
+    WHERE status IN ('running', 'compensating');
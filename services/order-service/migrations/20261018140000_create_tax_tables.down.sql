@@ -0,0 +1,7 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS prices_include_tax;
+
+DROP INDEX IF EXISTS idx_tax_rules_shop_id;
+DROP INDEX IF EXISTS idx_tax_rules_jurisdiction;
+DROP TABLE IF EXISTS tax_rules CASCADE;
+DROP TABLE IF EXISTS shop_tax_settings CASCADE;
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS shop_tax_settings (
+    shop_id UUID PRIMARY KEY REFERENCES shops(id) ON DELETE CASCADE,
+    country VARCHAR(2) NOT NULL,
+    state VARCHAR(10),
+    prices_include_tax BOOLEAN NOT NULL DEFAULT false,
+    rounding_mode VARCHAR(20) NOT NULL DEFAULT 'half_up',
+    rounding_level VARCHAR(10) NOT NULL DEFAULT 'line',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- shop_id, state and category_id are optional: NULL applies the rule to every
+-- shop, to the whole country and to every category respectively.
+CREATE TABLE IF NOT EXISTS tax_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    shop_id UUID REFERENCES shops(id) ON DELETE CASCADE,
+    country VARCHAR(2) NOT NULL,
+    state VARCHAR(10),
+    category_id UUID,
+    name VARCHAR(100) NOT NULL,
+    rate DECIMAL(7, 4) NOT NULL DEFAULT 0.0000,
+    use_product_rate BOOLEAN NOT NULL DEFAULT false,
+    compound BOOLEAN NOT NULL DEFAULT false,
+    sequence INT NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_tax_rules_jurisdiction ON tax_rules(country, state);
+CREATE INDEX IF NOT EXISTS idx_tax_rules_shop_id ON tax_rules(shop_id);
+
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS prices_include_tax BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_orders_payment_status;
+DROP TABLE IF EXISTS order_tenders;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS payment_status,
+    DROP COLUMN IF EXISTS change_due,
+    DROP COLUMN IF EXISTS amount_paid;
@@ -0,0 +1,21 @@
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS amount_paid DECIMAL(12, 2) NOT NULL DEFAULT 0.00,
+    ADD COLUMN IF NOT EXISTS change_due DECIMAL(12, 2) NOT NULL DEFAULT 0.00,
+    ADD COLUMN IF NOT EXISTS payment_status VARCHAR(20) NOT NULL DEFAULT 'unpaid';
+
+-- One row per payment taken towards an order. amount is what was applied to
+-- the balance, tendered what the customer handed over; the difference is
+-- change_due.
+CREATE TABLE IF NOT EXISTS order_tenders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    payment_id UUID NOT NULL UNIQUE,
+    payment_method VARCHAR(50) NOT NULL,
+    amount DECIMAL(12, 2) NOT NULL,
+    tendered DECIMAL(12, 2) NOT NULL,
+    change_due DECIMAL(12, 2) NOT NULL DEFAULT 0.00,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_tenders_order_id ON order_tenders(order_id);
+CREATE INDEX IF NOT EXISTS idx_orders_payment_status ON orders(shop_id, payment_status);
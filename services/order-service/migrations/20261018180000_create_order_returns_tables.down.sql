@@ -0,0 +1,21 @@
+DROP INDEX IF EXISTS idx_order_return_refunds_return_id;
+DROP INDEX IF EXISTS idx_order_return_items_return_id;
+DROP INDEX IF EXISTS idx_order_returns_order_id;
+DROP INDEX IF EXISTS idx_order_returns_shop_created_at;
+DROP INDEX IF EXISTS idx_store_credit_transactions_credit_id;
+DROP INDEX IF EXISTS idx_store_credits_shop_id;
+
+DROP TABLE IF EXISTS order_return_refunds;
+DROP TABLE IF EXISTS order_return_items;
+DROP TABLE IF EXISTS order_returns;
+DROP TABLE IF EXISTS store_credit_transactions;
+DROP TABLE IF EXISTS store_credits;
+
+ALTER TABLE order_tenders
+    DROP COLUMN IF EXISTS refunded_amount;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS amount_refunded;
+
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS returned_quantity;
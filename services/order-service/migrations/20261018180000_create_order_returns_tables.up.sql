@@ -0,0 +1,73 @@
+ALTER TABLE order_items
+    ADD COLUMN IF NOT EXISTS returned_quantity INT NOT NULL DEFAULT 0;
+
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS amount_refunded DECIMAL(12, 2) NOT NULL DEFAULT 0.00;
+
+ALTER TABLE order_tenders
+    ADD COLUMN IF NOT EXISTS refunded_amount DECIMAL(12, 2) NOT NULL DEFAULT 0.00;
+
+CREATE TABLE IF NOT EXISTS store_credits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    shop_id UUID NOT NULL REFERENCES shops(id) ON DELETE CASCADE,
+    code VARCHAR(20) NOT NULL UNIQUE,
+    customer_id UUID,
+    amount DECIMAL(12, 2) NOT NULL,
+    balance DECIMAL(12, 2) NOT NULL CHECK (balance >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Every change to a store credit's balance: positive when credit is issued or
+-- given back, negative when it is spent on an order.
+CREATE TABLE IF NOT EXISTS store_credit_transactions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    store_credit_id UUID NOT NULL REFERENCES store_credits(id) ON DELETE CASCADE,
+    order_id UUID,
+    amount DECIMAL(12, 2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS order_returns (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    shop_id UUID NOT NULL REFERENCES shops(id) ON DELETE CASCADE,
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    exchange_order_id UUID REFERENCES orders(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL,
+    refund_method VARCHAR(20) NOT NULL,
+    refund_amount DECIMAL(12, 2) NOT NULL DEFAULT 0.00,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    store_credit_id UUID REFERENCES store_credits(id) ON DELETE SET NULL,
+    actor_id UUID,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS order_return_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    return_id UUID NOT NULL REFERENCES order_returns(id) ON DELETE CASCADE,
+    order_item_id UUID NOT NULL REFERENCES order_items(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    product_name VARCHAR(255) NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    refund_amount DECIMAL(12, 2) NOT NULL,
+    disposition VARCHAR(20) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS order_return_refunds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    return_id UUID NOT NULL REFERENCES order_returns(id) ON DELETE CASCADE,
+    tender_id UUID NOT NULL REFERENCES order_tenders(id) ON DELETE CASCADE,
+    payment_id UUID NOT NULL,
+    payment_method VARCHAR(50) NOT NULL,
+    amount DECIMAL(12, 2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_store_credits_shop_id ON store_credits(shop_id);
+CREATE INDEX IF NOT EXISTS idx_store_credit_transactions_credit_id ON store_credit_transactions(store_credit_id);
+CREATE INDEX IF NOT EXISTS idx_order_returns_shop_created_at ON order_returns(shop_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_order_returns_order_id ON order_returns(order_id);
+CREATE INDEX IF NOT EXISTS idx_order_return_items_return_id ON order_return_items(return_id);
+CREATE INDEX IF NOT EXISTS idx_order_return_refunds_return_id ON order_return_refunds(return_id);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS shop_exchange_rates;
+
+ALTER TABLE order_tenders
+    DROP COLUMN IF EXISTS exchange_rate,
+    DROP COLUMN IF EXISTS payment_amount,
+    DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS currency;
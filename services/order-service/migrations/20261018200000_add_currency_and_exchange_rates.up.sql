@@ -0,0 +1,27 @@
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+-- A tender may be paid in another currency than its order. amount stays in
+-- the order's currency; payment_amount is what was taken in the tender's own
+-- currency, at exchange_rate units of the order's currency per unit.
+ALTER TABLE order_tenders
+    ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    ADD COLUMN IF NOT EXISTS payment_amount DECIMAL(14, 2),
+    ADD COLUMN IF NOT EXISTS exchange_rate NUMERIC(20, 10) NOT NULL DEFAULT 1;
+
+UPDATE order_tenders SET payment_amount = amount WHERE payment_amount IS NULL;
+
+ALTER TABLE order_tenders ALTER COLUMN payment_amount SET NOT NULL;
+
+-- rate is how many units of quote_currency one unit of base_currency buys.
+-- A shop only needs one direction of a pair; the inverse is derived.
+CREATE TABLE IF NOT EXISTS shop_exchange_rates (
+    shop_id UUID NOT NULL REFERENCES shops(id) ON DELETE CASCADE,
+    base_currency VARCHAR(3) NOT NULL,
+    quote_currency VARCHAR(3) NOT NULL,
+    rate NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (shop_id, base_currency, quote_currency),
+    CONSTRAINT chk_exchange_rate_pair CHECK (base_currency <> quote_currency)
+);
@@ -0,0 +1,6 @@
+ALTER TABLE order_return_items
+    DROP COLUMN IF EXISTS variant_id;
+
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS variant_name,
+    DROP COLUMN IF EXISTS variant_id;
@@ -0,0 +1,7 @@
+-- Lines may sell a product variant; the name is kept as it was at the sale.
+ALTER TABLE order_items
+    ADD COLUMN IF NOT EXISTS variant_id UUID,
+    ADD COLUMN IF NOT EXISTS variant_name VARCHAR(255);
+
+ALTER TABLE order_return_items
+    ADD COLUMN IF NOT EXISTS variant_id UUID;
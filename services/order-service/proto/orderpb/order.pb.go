@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	moneypb "hpkg/money/moneypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,15 +24,332 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNSPECIFIED OrderStatus = 0
+	OrderStatus_ORDER_STATUS_PENDING     OrderStatus = 1
+	OrderStatus_ORDER_STATUS_CONFIRMED   OrderStatus = 2
+	OrderStatus_ORDER_STATUS_SHIPPED     OrderStatus = 3
+	OrderStatus_ORDER_STATUS_DELIVERED   OrderStatus = 4
+	OrderStatus_ORDER_STATUS_CANCELLED   OrderStatus = 5
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_STATUS_UNSPECIFIED",
+		1: "ORDER_STATUS_PENDING",
+		2: "ORDER_STATUS_CONFIRMED",
+		3: "ORDER_STATUS_SHIPPED",
+		4: "ORDER_STATUS_DELIVERED",
+		5: "ORDER_STATUS_CANCELLED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNSPECIFIED": 0,
+		"ORDER_STATUS_PENDING":     1,
+		"ORDER_STATUS_CONFIRMED":   2,
+		"ORDER_STATUS_SHIPPED":     3,
+		"ORDER_STATUS_DELIVERED":   4,
+		"ORDER_STATUS_CANCELLED":   5,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_order_order_proto_enumTypes[0]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{0}
+}
+
+// How much of an order's total its tenders cover.
+type OrderPaymentStatus int32
+
+const (
+	OrderPaymentStatus_ORDER_PAYMENT_STATUS_UNSPECIFIED    OrderPaymentStatus = 0
+	OrderPaymentStatus_ORDER_PAYMENT_STATUS_UNPAID         OrderPaymentStatus = 1
+	OrderPaymentStatus_ORDER_PAYMENT_STATUS_PARTIALLY_PAID OrderPaymentStatus = 2
+	OrderPaymentStatus_ORDER_PAYMENT_STATUS_PAID           OrderPaymentStatus = 3
+)
+
+// Enum value maps for OrderPaymentStatus.
+var (
+	OrderPaymentStatus_name = map[int32]string{
+		0: "ORDER_PAYMENT_STATUS_UNSPECIFIED",
+		1: "ORDER_PAYMENT_STATUS_UNPAID",
+		2: "ORDER_PAYMENT_STATUS_PARTIALLY_PAID",
+		3: "ORDER_PAYMENT_STATUS_PAID",
+	}
+	OrderPaymentStatus_value = map[string]int32{
+		"ORDER_PAYMENT_STATUS_UNSPECIFIED":    0,
+		"ORDER_PAYMENT_STATUS_UNPAID":         1,
+		"ORDER_PAYMENT_STATUS_PARTIALLY_PAID": 2,
+		"ORDER_PAYMENT_STATUS_PAID":           3,
+	}
+)
+
+func (x OrderPaymentStatus) Enum() *OrderPaymentStatus {
+	p := new(OrderPaymentStatus)
+	*p = x
+	return p
+}
+
+func (x OrderPaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderPaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_proto_enumTypes[1].Descriptor()
+}
+
+func (OrderPaymentStatus) Type() protoreflect.EnumType {
+	return &file_order_order_proto_enumTypes[1]
+}
+
+func (x OrderPaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderPaymentStatus.Descriptor instead.
+func (OrderPaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{1}
+}
+
+type ReceiptFormat int32
+
+const (
+	ReceiptFormat_RECEIPT_FORMAT_UNSPECIFIED ReceiptFormat = 0 // plain text
+	ReceiptFormat_RECEIPT_FORMAT_TEXT        ReceiptFormat = 1
+	ReceiptFormat_RECEIPT_FORMAT_PDF         ReceiptFormat = 2
+	ReceiptFormat_RECEIPT_FORMAT_ESCPOS      ReceiptFormat = 3 // raw bytes for a thermal printer
+)
+
+// Enum value maps for ReceiptFormat.
+var (
+	ReceiptFormat_name = map[int32]string{
+		0: "RECEIPT_FORMAT_UNSPECIFIED",
+		1: "RECEIPT_FORMAT_TEXT",
+		2: "RECEIPT_FORMAT_PDF",
+		3: "RECEIPT_FORMAT_ESCPOS",
+	}
+	ReceiptFormat_value = map[string]int32{
+		"RECEIPT_FORMAT_UNSPECIFIED": 0,
+		"RECEIPT_FORMAT_TEXT":        1,
+		"RECEIPT_FORMAT_PDF":         2,
+		"RECEIPT_FORMAT_ESCPOS":      3,
+	}
+)
+
+func (x ReceiptFormat) Enum() *ReceiptFormat {
+	p := new(ReceiptFormat)
+	*p = x
+	return p
+}
+
+func (x ReceiptFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReceiptFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_proto_enumTypes[2].Descriptor()
+}
+
+func (ReceiptFormat) Type() protoreflect.EnumType {
+	return &file_order_order_proto_enumTypes[2]
+}
+
+func (x ReceiptFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReceiptFormat.Descriptor instead.
+func (ReceiptFormat) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{2}
+}
+
+type PaperWidth int32
+
+const (
+	PaperWidth_PAPER_WIDTH_UNSPECIFIED PaperWidth = 0 // 80mm
+	PaperWidth_PAPER_WIDTH_80MM        PaperWidth = 1
+	PaperWidth_PAPER_WIDTH_58MM        PaperWidth = 2
+)
+
+// Enum value maps for PaperWidth.
+var (
+	PaperWidth_name = map[int32]string{
+		0: "PAPER_WIDTH_UNSPECIFIED",
+		1: "PAPER_WIDTH_80MM",
+		2: "PAPER_WIDTH_58MM",
+	}
+	PaperWidth_value = map[string]int32{
+		"PAPER_WIDTH_UNSPECIFIED": 0,
+		"PAPER_WIDTH_80MM":        1,
+		"PAPER_WIDTH_58MM":        2,
+	}
+)
+
+func (x PaperWidth) Enum() *PaperWidth {
+	p := new(PaperWidth)
+	*p = x
+	return p
+}
+
+func (x PaperWidth) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaperWidth) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_proto_enumTypes[3].Descriptor()
+}
+
+func (PaperWidth) Type() protoreflect.EnumType {
+	return &file_order_order_proto_enumTypes[3]
+}
+
+func (x PaperWidth) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaperWidth.Descriptor instead.
+func (PaperWidth) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{3}
+}
+
+// What happens to a returned item.
+type ReturnDisposition int32
+
+const (
+	ReturnDisposition_RETURN_DISPOSITION_UNSPECIFIED ReturnDisposition = 0 // restock
+	ReturnDisposition_RETURN_DISPOSITION_RESTOCK     ReturnDisposition = 1
+	ReturnDisposition_RETURN_DISPOSITION_WRITE_OFF   ReturnDisposition = 2 // damaged or unsellable
+)
+
+// Enum value maps for ReturnDisposition.
+var (
+	ReturnDisposition_name = map[int32]string{
+		0: "RETURN_DISPOSITION_UNSPECIFIED",
+		1: "RETURN_DISPOSITION_RESTOCK",
+		2: "RETURN_DISPOSITION_WRITE_OFF",
+	}
+	ReturnDisposition_value = map[string]int32{
+		"RETURN_DISPOSITION_UNSPECIFIED": 0,
+		"RETURN_DISPOSITION_RESTOCK":     1,
+		"RETURN_DISPOSITION_WRITE_OFF":   2,
+	}
+)
+
+func (x ReturnDisposition) Enum() *ReturnDisposition {
+	p := new(ReturnDisposition)
+	*p = x
+	return p
+}
+
+func (x ReturnDisposition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReturnDisposition) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_proto_enumTypes[4].Descriptor()
+}
+
+func (ReturnDisposition) Type() protoreflect.EnumType {
+	return &file_order_order_proto_enumTypes[4]
+}
+
+func (x ReturnDisposition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReturnDisposition.Descriptor instead.
+func (ReturnDisposition) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{4}
+}
+
+type RefundMethod int32
+
+const (
+	RefundMethod_REFUND_METHOD_UNSPECIFIED     RefundMethod = 0 // original tender
+	RefundMethod_REFUND_METHOD_ORIGINAL_TENDER RefundMethod = 1
+	RefundMethod_REFUND_METHOD_STORE_CREDIT    RefundMethod = 2
+)
+
+// Enum value maps for RefundMethod.
+var (
+	RefundMethod_name = map[int32]string{
+		0: "REFUND_METHOD_UNSPECIFIED",
+		1: "REFUND_METHOD_ORIGINAL_TENDER",
+		2: "REFUND_METHOD_STORE_CREDIT",
+	}
+	RefundMethod_value = map[string]int32{
+		"REFUND_METHOD_UNSPECIFIED":     0,
+		"REFUND_METHOD_ORIGINAL_TENDER": 1,
+		"REFUND_METHOD_STORE_CREDIT":    2,
+	}
+)
+
+func (x RefundMethod) Enum() *RefundMethod {
+	p := new(RefundMethod)
+	*p = x
+	return p
+}
+
+func (x RefundMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_proto_enumTypes[5].Descriptor()
+}
+
+func (RefundMethod) Type() protoreflect.EnumType {
+	return &file_order_order_proto_enumTypes[5]
+}
+
+func (x RefundMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundMethod.Descriptor instead.
+func (RefundMethod) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{5}
+}
+
 type OrderItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	UnitPrice     float64                `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
-	Subtotal      float64                `protobuf:"fixed64,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName      string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity         int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice        float64                `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // optional on create: defaults to the catalog price
+	Subtotal         float64                `protobuf:"fixed64,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`                    // ignored on create: always computed by the server
+	TaxRate          float64                `protobuf:"fixed64,6,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxAmount        float64                `protobuf:"fixed64,7,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	Id               string                 `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"` // order line ID, set by the server
+	ReturnedQuantity int32                  `protobuf:"varint,9,opt,name=returned_quantity,json=returnedQuantity,proto3" json:"returned_quantity,omitempty"`
+	VariantId        string                 `protobuf:"bytes,10,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`       // optional: the product variant sold
+	VariantName      string                 `protobuf:"bytes,11,opt,name=variant_name,json=variantName,proto3" json:"variant_name,omitempty"` // set by the server
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *OrderItem) Reset() {
@@ -64,11 +382,11 @@ func (*OrderItem) Descriptor() ([]byte, []int) {
 	return file_order_order_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *OrderItem) GetProductId() int32 {
+func (x *OrderItem) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
-	return 0
+	return ""
 }
 
 func (x *OrderItem) GetProductName() string {
@@ -99,21 +417,74 @@ func (x *OrderItem) GetSubtotal() float64 {
 	return 0
 }
 
+func (x *OrderItem) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *OrderItem) GetTaxAmount() float64 {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return 0
+}
+
+func (x *OrderItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderItem) GetReturnedQuantity() int32 {
+	if x != nil {
+		return x.ReturnedQuantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetVariantName() string {
+	if x != nil {
+		return x.VariantName
+	}
+	return ""
+}
+
 type Order struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId          int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Items           []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	TotalAmount     float64                `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Tax             float64                `protobuf:"fixed64,5,opt,name=tax,proto3" json:"tax,omitempty"`
-	Discount        float64                `protobuf:"fixed64,6,opt,name=discount,proto3" json:"discount,omitempty"`
-	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending, confirmed, shipped, delivered, cancelled
-	PaymentMethod   string                 `protobuf:"bytes,8,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	ShippingAddress string                 `protobuf:"bytes,9,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items            []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	TotalAmount      float64                `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Tax              float64                `protobuf:"fixed64,5,opt,name=tax,proto3" json:"tax,omitempty"`
+	Discount         float64                `protobuf:"fixed64,6,opt,name=discount,proto3" json:"discount,omitempty"`
+	Status           OrderStatus            `protobuf:"varint,7,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	PaymentMethod    string                 `protobuf:"bytes,8,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	ShippingAddress  string                 `protobuf:"bytes,9,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ShopId           string                 `protobuf:"bytes,12,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	Subtotal         float64                `protobuf:"fixed64,13,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	PricesIncludeTax bool                   `protobuf:"varint,14,opt,name=prices_include_tax,json=pricesIncludeTax,proto3" json:"prices_include_tax,omitempty"` // subtotal and total already contain tax
+	AmountPaid       float64                `protobuf:"fixed64,15,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	BalanceDue       float64                `protobuf:"fixed64,16,opt,name=balance_due,json=balanceDue,proto3" json:"balance_due,omitempty"`
+	ChangeDue        float64                `protobuf:"fixed64,17,opt,name=change_due,json=changeDue,proto3" json:"change_due,omitempty"` // cash handed back on overpayment
+	PaymentStatus    OrderPaymentStatus     `protobuf:"varint,18,opt,name=payment_status,json=paymentStatus,proto3,enum=order.OrderPaymentStatus" json:"payment_status,omitempty"`
+	AmountRefunded   float64                `protobuf:"fixed64,19,opt,name=amount_refunded,json=amountRefunded,proto3" json:"amount_refunded,omitempty"`
+	Currency         string                 `protobuf:"bytes,20,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalMoney       *moneypb.Money         `protobuf:"bytes,21,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
+	BalanceDueMoney  *moneypb.Money         `protobuf:"bytes,22,opt,name=balance_due_money,json=balanceDueMoney,proto3" json:"balance_due_money,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
@@ -146,18 +517,18 @@ func (*Order) Descriptor() ([]byte, []int) {
 	return file_order_order_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Order) GetId() int32 {
+func (x *Order) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *Order) GetUserId() int32 {
+func (x *Order) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
-	return 0
+	return ""
 }
 
 func (x *Order) GetItems() []*OrderItem {
@@ -188,11 +559,11 @@ func (x *Order) GetDiscount() float64 {
 	return 0
 }
 
-func (x *Order) GetStatus() string {
+func (x *Order) GetStatus() OrderStatus {
 	if x != nil {
 		return x.Status
 	}
-	return ""
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
 }
 
 func (x *Order) GetPaymentMethod() string {
@@ -223,109 +594,116 @@ func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type CreateOrderRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserId          int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Items           []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	Discount        float64                `protobuf:"fixed64,3,opt,name=discount,proto3" json:"discount,omitempty"`
-	PaymentMethod   string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	ShippingAddress string                 `protobuf:"bytes,5,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *Order) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
 }
 
-func (x *CreateOrderRequest) Reset() {
-	*x = CreateOrderRequest{}
-	mi := &file_order_order_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Order) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
 }
 
-func (x *CreateOrderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Order) GetPricesIncludeTax() bool {
+	if x != nil {
+		return x.PricesIncludeTax
+	}
+	return false
 }
 
-func (*CreateOrderRequest) ProtoMessage() {}
-
-func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[2]
+func (x *Order) GetAmountPaid() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AmountPaid
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{2}
+func (x *Order) GetBalanceDue() float64 {
+	if x != nil {
+		return x.BalanceDue
+	}
+	return 0
 }
 
-func (x *CreateOrderRequest) GetUserId() int32 {
+func (x *Order) GetChangeDue() float64 {
 	if x != nil {
-		return x.UserId
+		return x.ChangeDue
 	}
 	return 0
 }
 
-func (x *CreateOrderRequest) GetItems() []*OrderItem {
+func (x *Order) GetPaymentStatus() OrderPaymentStatus {
 	if x != nil {
-		return x.Items
+		return x.PaymentStatus
 	}
-	return nil
+	return OrderPaymentStatus_ORDER_PAYMENT_STATUS_UNSPECIFIED
 }
 
-func (x *CreateOrderRequest) GetDiscount() float64 {
+func (x *Order) GetAmountRefunded() float64 {
 	if x != nil {
-		return x.Discount
+		return x.AmountRefunded
 	}
 	return 0
 }
 
-func (x *CreateOrderRequest) GetPaymentMethod() string {
+func (x *Order) GetCurrency() string {
 	if x != nil {
-		return x.PaymentMethod
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *CreateOrderRequest) GetShippingAddress() string {
+func (x *Order) GetTotalMoney() *moneypb.Money {
 	if x != nil {
-		return x.ShippingAddress
+		return x.TotalMoney
 	}
-	return ""
+	return nil
 }
 
-type CreateOrderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TotalAmount   float64                `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Tax           float64                `protobuf:"fixed64,4,opt,name=tax,proto3" json:"tax,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Order) GetBalanceDueMoney() *moneypb.Money {
+	if x != nil {
+		return x.BalanceDueMoney
+	}
+	return nil
 }
 
-func (x *CreateOrderResponse) Reset() {
-	*x = CreateOrderResponse{}
-	mi := &file_order_order_proto_msgTypes[3]
+// Tender is one payment taken towards an order. amount is what was applied
+// to the balance; tendered is what the customer handed over.
+type Tender struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId      string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	PaymentMethod  string                 `protobuf:"bytes,2,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Amount         float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Tendered       float64                `protobuf:"fixed64,4,opt,name=tendered,proto3" json:"tendered,omitempty"`
+	ChangeDue      float64                `protobuf:"fixed64,5,opt,name=change_due,json=changeDue,proto3" json:"change_due,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RefundedAmount float64                `protobuf:"fixed64,7,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`                                // currency the tender was paid in
+	PaymentAmount  *moneypb.Money         `protobuf:"bytes,9,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount,omitempty"` // taken in currency; amount is in the order's currency
+	ExchangeRate   string                 `protobuf:"bytes,10,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`   // units of the order's currency per unit of currency
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Tender) Reset() {
+	*x = Tender{}
+	mi := &file_order_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderResponse) String() string {
+func (x *Tender) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderResponse) ProtoMessage() {}
+func (*Tender) ProtoMessage() {}
 
-func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[3]
+func (x *Tender) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,25 +714,221 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Tender.ProtoReflect.Descriptor instead.
+func (*Tender) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateOrderResponse) GetOrderId() int32 {
+func (x *Tender) GetPaymentId() string {
 	if x != nil {
-		return x.OrderId
+		return x.PaymentId
 	}
-	return 0
+	return ""
+}
+
+func (x *Tender) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *Tender) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Tender) GetTendered() float64 {
+	if x != nil {
+		return x.Tendered
+	}
+	return 0
+}
+
+func (x *Tender) GetChangeDue() float64 {
+	if x != nil {
+		return x.ChangeDue
+	}
+	return 0
+}
+
+func (x *Tender) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Tender) GetRefundedAmount() float64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *Tender) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Tender) GetPaymentAmount() *moneypb.Money {
+	if x != nil {
+		return x.PaymentAmount
+	}
+	return nil
+}
+
+func (x *Tender) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+type CreateOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items           []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Discount        float64                `protobuf:"fixed64,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	PaymentMethod   string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	ShippingAddress string                 `protobuf:"bytes,5,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"` // defaults to USD; catalog prices in other currencies are converted
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_order_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateOrderResponse) GetUserId() int32 {
+func (x *CreateOrderRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
 	return 0
 }
 
+func (x *CreateOrderRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetShippingAddress() string {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CreateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TotalAmount   float64                `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Tax           float64                `protobuf:"fixed64,4,opt,name=tax,proto3" json:"tax,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
+	mi := &file_order_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResponse) ProtoMessage() {}
+
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateOrderResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateOrderResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 func (x *CreateOrderResponse) GetTotalAmount() float64 {
 	if x != nil {
 		return x.TotalAmount
@@ -369,42 +943,1857 @@ func (x *CreateOrderResponse) GetTax() float64 {
 	return 0
 }
 
-func (x *CreateOrderResponse) GetStatus() string {
+func (x *CreateOrderResponse) GetStatus() OrderStatus {
 	if x != nil {
 		return x.Status
 	}
-	return ""
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
 }
 
 func (x *CreateOrderResponse) GetMessage() string {
 	if x != nil {
-		return x.Message
+		return x.Message
+	}
+	return ""
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_order_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items            []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	TotalAmount      float64                `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Tax              float64                `protobuf:"fixed64,5,opt,name=tax,proto3" json:"tax,omitempty"`
+	Discount         float64                `protobuf:"fixed64,6,opt,name=discount,proto3" json:"discount,omitempty"`
+	Status           OrderStatus            `protobuf:"varint,7,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	PaymentMethod    string                 `protobuf:"bytes,8,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	ShippingAddress  string                 `protobuf:"bytes,9,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ShopId           string                 `protobuf:"bytes,12,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	Subtotal         float64                `protobuf:"fixed64,13,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	PricesIncludeTax bool                   `protobuf:"varint,14,opt,name=prices_include_tax,json=pricesIncludeTax,proto3" json:"prices_include_tax,omitempty"` // subtotal and total already contain tax
+	AmountPaid       float64                `protobuf:"fixed64,15,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	BalanceDue       float64                `protobuf:"fixed64,16,opt,name=balance_due,json=balanceDue,proto3" json:"balance_due,omitempty"`
+	ChangeDue        float64                `protobuf:"fixed64,17,opt,name=change_due,json=changeDue,proto3" json:"change_due,omitempty"` // cash handed back on overpayment
+	PaymentStatus    OrderPaymentStatus     `protobuf:"varint,18,opt,name=payment_status,json=paymentStatus,proto3,enum=order.OrderPaymentStatus" json:"payment_status,omitempty"`
+	Tenders          []*Tender              `protobuf:"bytes,19,rep,name=tenders,proto3" json:"tenders,omitempty"`
+	AmountRefunded   float64                `protobuf:"fixed64,20,opt,name=amount_refunded,json=amountRefunded,proto3" json:"amount_refunded,omitempty"`
+	Currency         string                 `protobuf:"bytes,21,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalMoney       *moneypb.Money         `protobuf:"bytes,22,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
+	BalanceDueMoney  *moneypb.Money         `protobuf:"bytes,23,opt,name=balance_due_money,json=balanceDueMoney,proto3" json:"balance_due_money,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
+	mi := &file_order_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResponse) ProtoMessage() {}
+
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetOrderResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetOrderResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetOrderResponse) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetOrderResponse) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *GetOrderResponse) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *GetOrderResponse) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *GetOrderResponse) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *GetOrderResponse) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *GetOrderResponse) GetShippingAddress() string {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return ""
+}
+
+func (x *GetOrderResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GetOrderResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GetOrderResponse) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+func (x *GetOrderResponse) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *GetOrderResponse) GetPricesIncludeTax() bool {
+	if x != nil {
+		return x.PricesIncludeTax
+	}
+	return false
+}
+
+func (x *GetOrderResponse) GetAmountPaid() float64 {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return 0
+}
+
+func (x *GetOrderResponse) GetBalanceDue() float64 {
+	if x != nil {
+		return x.BalanceDue
+	}
+	return 0
+}
+
+func (x *GetOrderResponse) GetChangeDue() float64 {
+	if x != nil {
+		return x.ChangeDue
+	}
+	return 0
+}
+
+func (x *GetOrderResponse) GetPaymentStatus() OrderPaymentStatus {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return OrderPaymentStatus_ORDER_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *GetOrderResponse) GetTenders() []*Tender {
+	if x != nil {
+		return x.Tenders
+	}
+	return nil
+}
+
+func (x *GetOrderResponse) GetAmountRefunded() float64 {
+	if x != nil {
+		return x.AmountRefunded
+	}
+	return 0
+}
+
+func (x *GetOrderResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetOrderResponse) GetTotalMoney() *moneypb.Money {
+	if x != nil {
+		return x.TotalMoney
+	}
+	return nil
+}
+
+func (x *GetOrderResponse) GetBalanceDueMoney() *moneypb.Money {
+	if x != nil {
+		return x.BalanceDueMoney
+	}
+	return nil
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional: filter by customer
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	StatusFilter  OrderStatus            `protobuf:"varint,4,opt,name=status_filter,json=statusFilter,proto3,enum=order.OrderStatus" json:"status_filter,omitempty"` // optional: filter by status
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                         // optional: next_cursor of the previous page; replaces page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_order_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetStatusFilter() OrderStatus {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *ListOrdersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_order_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	NewStatus     OrderStatus            `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3,enum=order.OrderStatus" json:"new_status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // optional: reason for status change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_order_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateOrderStatusRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetNewStatus() OrderStatus {
+	if x != nil {
+		return x.NewStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateOrderStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusResponse) Reset() {
+	*x = UpdateOrderStatusResponse{}
+	mi := &file_order_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateOrderStatusResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusResponse) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateOrderStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_order_order_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_order_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelOrderResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderResponse) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *CancelOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CalculateTaxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subtotal      float64                `protobuf:"fixed64,1,opt,name=subtotal,proto3" json:"subtotal,omitempty"` // used when items is empty: taxed as one uncategorised line
+	Country       string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`     // optional: defaults to the shop's tax jurisdiction
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Items         []*OrderItem           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`       // optional: priced from the catalog and taxed per line
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"` // defaults to USD; catalog prices in other currencies are converted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateTaxRequest) Reset() {
+	*x = CalculateTaxRequest{}
+	mi := &file_order_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateTaxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateTaxRequest) ProtoMessage() {}
+
+func (x *CalculateTaxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateTaxRequest.ProtoReflect.Descriptor instead.
+func (*CalculateTaxRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CalculateTaxRequest) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *CalculateTaxRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CalculateTaxRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CalculateTaxRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CalculateTaxRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type TaxComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rate          float64                `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"` // 0 when the rate differs between lines
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Compound      bool                   `protobuf:"varint,4,opt,name=compound,proto3" json:"compound,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxComponent) Reset() {
+	*x = TaxComponent{}
+	mi := &file_order_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxComponent) ProtoMessage() {}
+
+func (x *TaxComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxComponent.ProtoReflect.Descriptor instead.
+func (*TaxComponent) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TaxComponent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaxComponent) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *TaxComponent) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TaxComponent) GetCompound() bool {
+	if x != nil {
+		return x.Compound
+	}
+	return false
+}
+
+type CalculateTaxResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TaxAmount        float64                `protobuf:"fixed64,1,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	TaxRate          float64                `protobuf:"fixed64,2,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"` // effective rate on the net amount
+	TaxType          string                 `protobuf:"bytes,3,opt,name=tax_type,json=taxType,proto3" json:"tax_type,omitempty"`
+	Components       []*TaxComponent        `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
+	PricesIncludeTax bool                   `protobuf:"varint,5,opt,name=prices_include_tax,json=pricesIncludeTax,proto3" json:"prices_include_tax,omitempty"`
+	NetAmount        float64                `protobuf:"fixed64,6,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	GrossAmount      float64                `protobuf:"fixed64,7,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	Items            []*OrderItem           `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	Currency         string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CalculateTaxResponse) Reset() {
+	*x = CalculateTaxResponse{}
+	mi := &file_order_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateTaxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateTaxResponse) ProtoMessage() {}
+
+func (x *CalculateTaxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateTaxResponse.ProtoReflect.Descriptor instead.
+func (*CalculateTaxResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CalculateTaxResponse) GetTaxAmount() float64 {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return 0
+}
+
+func (x *CalculateTaxResponse) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *CalculateTaxResponse) GetTaxType() string {
+	if x != nil {
+		return x.TaxType
+	}
+	return ""
+}
+
+func (x *CalculateTaxResponse) GetComponents() []*TaxComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *CalculateTaxResponse) GetPricesIncludeTax() bool {
+	if x != nil {
+		return x.PricesIncludeTax
+	}
+	return false
+}
+
+func (x *CalculateTaxResponse) GetNetAmount() float64 {
+	if x != nil {
+		return x.NetAmount
+	}
+	return 0
+}
+
+func (x *CalculateTaxResponse) GetGrossAmount() float64 {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return 0
+}
+
+func (x *CalculateTaxResponse) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CalculateTaxResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type TrackOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackOrderRequest) Reset() {
+	*x = TrackOrderRequest{}
+	mi := &file_order_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackOrderRequest) ProtoMessage() {}
+
+func (x *TrackOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackOrderRequest.ProtoReflect.Descriptor instead.
+func (*TrackOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TrackOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type TrackOrderResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrderId           string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CurrentStatus     OrderStatus            `protobuf:"varint,2,opt,name=current_status,json=currentStatus,proto3,enum=order.OrderStatus" json:"current_status,omitempty"`
+	Location          string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	EstimatedDelivery string                 `protobuf:"bytes,4,opt,name=estimated_delivery,json=estimatedDelivery,proto3" json:"estimated_delivery,omitempty"`
+	Events            []*TrackingEvent       `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TrackOrderResponse) Reset() {
+	*x = TrackOrderResponse{}
+	mi := &file_order_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackOrderResponse) ProtoMessage() {}
+
+func (x *TrackOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackOrderResponse.ProtoReflect.Descriptor instead.
+func (*TrackOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TrackOrderResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *TrackOrderResponse) GetCurrentStatus() OrderStatus {
+	if x != nil {
+		return x.CurrentStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *TrackOrderResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *TrackOrderResponse) GetEstimatedDelivery() string {
+	if x != nil {
+		return x.EstimatedDelivery
+	}
+	return ""
+}
+
+func (x *TrackOrderResponse) GetEvents() []*TrackingEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type TrackingEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         OrderStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Location       string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	PreviousStatus OrderStatus            `protobuf:"varint,5,opt,name=previous_status,json=previousStatus,proto3,enum=order.OrderStatus" json:"previous_status,omitempty"`
+	ActorId        string                 `protobuf:"bytes,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // x-user-id of the staff member who made the change
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TrackingEvent) Reset() {
+	*x = TrackingEvent{}
+	mi := &file_order_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackingEvent) ProtoMessage() {}
+
+func (x *TrackingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackingEvent.ProtoReflect.Descriptor instead.
+func (*TrackingEvent) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TrackingEvent) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *TrackingEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TrackingEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TrackingEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *TrackingEvent) GetPreviousStatus() OrderStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *TrackingEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+// Checkout places an order, reserves its stock, takes payment and confirms
+// it as one flow. If a step fails the earlier ones are undone.
+type CheckoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *CreateOrderRequest    `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`                    // defaults to the order's currency, then USD
+	CardToken     string                 `protobuf:"bytes,4,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"` // from the payment service's TokenizeCard; required for card payments
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutRequest) Reset() {
+	*x = CheckoutRequest{}
+	mi := &file_order_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutRequest) ProtoMessage() {}
+
+func (x *CheckoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckoutRequest) GetOrder() *CreateOrderRequest {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *CheckoutRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CheckoutRequest) GetCardToken() string {
+	if x != nil {
+		return x.CardToken
+	}
+	return ""
+}
+
+type CheckoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckoutId    string                 `protobuf:"bytes,1,opt,name=checkout_id,json=checkoutId,proto3" json:"checkout_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,3,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	TotalAmount   float64                `protobuf:"fixed64,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutResponse) Reset() {
+	*x = CheckoutResponse{}
+	mi := &file_order_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutResponse) ProtoMessage() {}
+
+func (x *CheckoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutResponse.ProtoReflect.Descriptor instead.
+func (*CheckoutResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckoutResponse) GetCheckoutId() string {
+	if x != nil {
+		return x.CheckoutId
+	}
+	return ""
+}
+
+func (x *CheckoutResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CheckoutResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CheckoutResponse) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *CheckoutResponse) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *CheckoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// AddPayment takes one tender towards an order's balance. Cash may exceed the
+// balance, the excess being change due; other methods may not. The order is
+// confirmed once its tenders cover the total.
+type AddPaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrderId         string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PaymentMethod   string                 `protobuf:"bytes,2,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`         // cash, credit_card, debit_card, bank_transfer, ...
+	Amount          float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`                                          // amount tendered
+	Currency        string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                                        // defaults to the order's currency; others are converted at the shop's rate
+	StoreCreditCode string                 `protobuf:"bytes,6,opt,name=store_credit_code,json=storeCreditCode,proto3" json:"store_credit_code,omitempty"` // required when payment_method is store_credit
+	CardToken       string                 `protobuf:"bytes,7,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`                     // from the payment service's TokenizeCard; required for card payments
+	AmountMoney     *moneypb.Money         `protobuf:"bytes,8,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`               // exact amount tendered; when set, amount and currency are ignored
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddPaymentRequest) Reset() {
+	*x = AddPaymentRequest{}
+	mi := &file_order_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPaymentRequest) ProtoMessage() {}
+
+func (x *AddPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPaymentRequest.ProtoReflect.Descriptor instead.
+func (*AddPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddPaymentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AddPaymentRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *AddPaymentRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddPaymentRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AddPaymentRequest) GetStoreCreditCode() string {
+	if x != nil {
+		return x.StoreCreditCode
+	}
+	return ""
+}
+
+func (x *AddPaymentRequest) GetCardToken() string {
+	if x != nil {
+		return x.CardToken
+	}
+	return ""
+}
+
+func (x *AddPaymentRequest) GetAmountMoney() *moneypb.Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
+type AddPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Tender        *Tender                `protobuf:"bytes,2,opt,name=tender,proto3" json:"tender,omitempty"`
+	AmountPaid    float64                `protobuf:"fixed64,3,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	BalanceDue    float64                `protobuf:"fixed64,4,opt,name=balance_due,json=balanceDue,proto3" json:"balance_due,omitempty"`
+	ChangeDue     float64                `protobuf:"fixed64,5,opt,name=change_due,json=changeDue,proto3" json:"change_due,omitempty"`
+	PaymentStatus OrderPaymentStatus     `protobuf:"varint,6,opt,name=payment_status,json=paymentStatus,proto3,enum=order.OrderPaymentStatus" json:"payment_status,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,7,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPaymentResponse) Reset() {
+	*x = AddPaymentResponse{}
+	mi := &file_order_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPaymentResponse) ProtoMessage() {}
+
+func (x *AddPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPaymentResponse.ProtoReflect.Descriptor instead.
+func (*AddPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddPaymentResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AddPaymentResponse) GetTender() *Tender {
+	if x != nil {
+		return x.Tender
+	}
+	return nil
+}
+
+func (x *AddPaymentResponse) GetAmountPaid() float64 {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return 0
+}
+
+func (x *AddPaymentResponse) GetBalanceDue() float64 {
+	if x != nil {
+		return x.BalanceDue
+	}
+	return 0
+}
+
+func (x *AddPaymentResponse) GetChangeDue() float64 {
+	if x != nil {
+		return x.ChangeDue
+	}
+	return 0
+}
+
+func (x *AddPaymentResponse) GetPaymentStatus() OrderPaymentStatus {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return OrderPaymentStatus_ORDER_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *AddPaymentResponse) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *AddPaymentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetReceipt renders a paid or confirmed order with the shop's receipt
+// template.
+type GetReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Format        ReceiptFormat          `protobuf:"varint,2,opt,name=format,proto3,enum=order.ReceiptFormat" json:"format,omitempty"`
+	PaperWidth    PaperWidth             `protobuf:"varint,3,opt,name=paper_width,json=paperWidth,proto3,enum=order.PaperWidth" json:"paper_width,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_order_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetReceiptRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetReceiptRequest) GetFormat() ReceiptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReceiptFormat_RECEIPT_FORMAT_UNSPECIFIED
+}
+
+func (x *GetReceiptRequest) GetPaperWidth() PaperWidth {
+	if x != nil {
+		return x.PaperWidth
+	}
+	return PaperWidth_PAPER_WIDTH_UNSPECIFIED
+}
+
+type GetReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Format        ReceiptFormat          `protobuf:"varint,2,opt,name=format,proto3,enum=order.ReceiptFormat" json:"format,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // text/plain, application/pdf or application/octet-stream
+	Filename      string                 `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	Content       []byte                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptResponse) Reset() {
+	*x = GetReceiptResponse{}
+	mi := &file_order_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptResponse) ProtoMessage() {}
+
+func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetReceiptResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetReceiptResponse) GetFormat() ReceiptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReceiptFormat_RECEIPT_FORMAT_UNSPECIFIED
+}
+
+func (x *GetReceiptResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetReceiptResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *GetReceiptResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GetReceiptTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptTemplateRequest) Reset() {
+	*x = GetReceiptTemplateRequest{}
+	mi := &file_order_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptTemplateRequest) ProtoMessage() {}
+
+func (x *GetReceiptTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{25}
+}
+
+// SetReceiptTemplate replaces the shop's receipt template, a Go text/template
+// document. An empty template restores the default.
+type SetReceiptTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      string                 `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReceiptTemplateRequest) Reset() {
+	*x = SetReceiptTemplateRequest{}
+	mi := &file_order_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReceiptTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReceiptTemplateRequest) ProtoMessage() {}
+
+func (x *SetReceiptTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReceiptTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SetReceiptTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetReceiptTemplateRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+type ReceiptTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      string                 `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptTemplate) Reset() {
+	*x = ReceiptTemplate{}
+	mi := &file_order_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptTemplate) ProtoMessage() {}
+
+func (x *ReceiptTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptTemplate.ProtoReflect.Descriptor instead.
+func (*ReceiptTemplate) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReceiptTemplate) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *ReceiptTemplate) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *ReceiptTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateReturn takes back some lines of a paid order. The refund is worked
+// out from what was paid for the lines, discount and tax included, and is
+// issued to the original tenders or as store credit. An exchange creates a
+// new order and pays it with the refund as store credit.
+type CreateReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items         []*ReturnItemRequest   `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RefundMethod  RefundMethod           `protobuf:"varint,4,opt,name=refund_method,json=refundMethod,proto3,enum=order.RefundMethod" json:"refund_method,omitempty"`
+	ExchangeOrder *CreateOrderRequest    `protobuf:"bytes,5,opt,name=exchange_order,json=exchangeOrder,proto3" json:"exchange_order,omitempty"` // optional: the replacement goods
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReturnRequest) Reset() {
+	*x = CreateReturnRequest{}
+	mi := &file_order_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReturnRequest) ProtoMessage() {}
+
+func (x *CreateReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnRequest.ProtoReflect.Descriptor instead.
+func (*CreateReturnRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateReturnRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateReturnRequest) GetItems() []*ReturnItemRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateReturnRequest) GetRefundMethod() RefundMethod {
+	if x != nil {
+		return x.RefundMethod
+	}
+	return RefundMethod_REFUND_METHOD_UNSPECIFIED
+}
+
+func (x *CreateReturnRequest) GetExchangeOrder() *CreateOrderRequest {
+	if x != nil {
+		return x.ExchangeOrder
 	}
-	return ""
+	return nil
 }
 
-type GetOrderRequest struct {
+type ReturnItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderItemId   string                 `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Disposition   ReturnDisposition      `protobuf:"varint,3,opt,name=disposition,proto3,enum=order.ReturnDisposition" json:"disposition,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderRequest) Reset() {
-	*x = GetOrderRequest{}
-	mi := &file_order_order_proto_msgTypes[4]
+func (x *ReturnItemRequest) Reset() {
+	*x = ReturnItemRequest{}
+	mi := &file_order_order_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderRequest) String() string {
+func (x *ReturnItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderRequest) ProtoMessage() {}
+func (*ReturnItemRequest) ProtoMessage() {}
 
-func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[4]
+func (x *ReturnItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,50 +2804,60 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ReturnItemRequest.ProtoReflect.Descriptor instead.
+func (*ReturnItemRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetOrderRequest) GetOrderId() int32 {
+func (x *ReturnItemRequest) GetOrderItemId() string {
 	if x != nil {
-		return x.OrderId
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *ReturnItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
 	}
 	return 0
 }
 
-type GetOrderResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId          int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Items           []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	TotalAmount     float64                `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Tax             float64                `protobuf:"fixed64,5,opt,name=tax,proto3" json:"tax,omitempty"`
-	Discount        float64                `protobuf:"fixed64,6,opt,name=discount,proto3" json:"discount,omitempty"`
-	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	PaymentMethod   string                 `protobuf:"bytes,8,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	ShippingAddress string                 `protobuf:"bytes,9,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ReturnItemRequest) GetDisposition() ReturnDisposition {
+	if x != nil {
+		return x.Disposition
+	}
+	return ReturnDisposition_RETURN_DISPOSITION_UNSPECIFIED
 }
 
-func (x *GetOrderResponse) Reset() {
-	*x = GetOrderResponse{}
-	mi := &file_order_order_proto_msgTypes[5]
+type ReturnItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId   string                 `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	RefundAmount  float64                `protobuf:"fixed64,5,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	Disposition   ReturnDisposition      `protobuf:"varint,6,opt,name=disposition,proto3,enum=order.ReturnDisposition" json:"disposition,omitempty"`
+	VariantId     string                 `protobuf:"bytes,7,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnItem) Reset() {
+	*x = ReturnItem{}
+	mi := &file_order_order_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderResponse) String() string {
+func (x *ReturnItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderResponse) ProtoMessage() {}
+func (*ReturnItem) ProtoMessage() {}
 
-func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[5]
+func (x *ReturnItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,113 +2868,84 @@ func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *GetOrderResponse) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use ReturnItem.ProtoReflect.Descriptor instead.
+func (*ReturnItem) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetOrderResponse) GetUserId() int32 {
+func (x *ReturnItem) GetOrderItemId() string {
 	if x != nil {
-		return x.UserId
+		return x.OrderItemId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderResponse) GetItems() []*OrderItem {
+func (x *ReturnItem) GetProductId() string {
 	if x != nil {
-		return x.Items
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetOrderResponse) GetTotalAmount() float64 {
+func (x *ReturnItem) GetProductName() string {
 	if x != nil {
-		return x.TotalAmount
+		return x.ProductName
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderResponse) GetTax() float64 {
+func (x *ReturnItem) GetQuantity() int32 {
 	if x != nil {
-		return x.Tax
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *GetOrderResponse) GetDiscount() float64 {
+func (x *ReturnItem) GetRefundAmount() float64 {
 	if x != nil {
-		return x.Discount
+		return x.RefundAmount
 	}
 	return 0
 }
 
-func (x *GetOrderResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *GetOrderResponse) GetPaymentMethod() string {
+func (x *ReturnItem) GetDisposition() ReturnDisposition {
 	if x != nil {
-		return x.PaymentMethod
+		return x.Disposition
 	}
-	return ""
+	return ReturnDisposition_RETURN_DISPOSITION_UNSPECIFIED
 }
 
-func (x *GetOrderResponse) GetShippingAddress() string {
+func (x *ReturnItem) GetVariantId() string {
 	if x != nil {
-		return x.ShippingAddress
+		return x.VariantId
 	}
 	return ""
 }
 
-func (x *GetOrderResponse) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *GetOrderResponse) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-type ListOrdersRequest struct {
+type ReturnRefund struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	StatusFilter  string                 `protobuf:"bytes,4,opt,name=status_filter,json=statusFilter,proto3" json:"status_filter,omitempty"` // optional: filter by status
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,2,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
-	mi := &file_order_order_proto_msgTypes[6]
+func (x *ReturnRefund) Reset() {
+	*x = ReturnRefund{}
+	mi := &file_order_order_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *ReturnRefund) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*ReturnRefund) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[6]
+func (x *ReturnRefund) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,64 +2956,59 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ReturnRefund.ProtoReflect.Descriptor instead.
+func (*ReturnRefund) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListOrdersRequest) GetUserId() int32 {
+func (x *ReturnRefund) GetPaymentId() string {
 	if x != nil {
-		return x.UserId
+		return x.PaymentId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListOrdersRequest) GetPage() int32 {
+func (x *ReturnRefund) GetPaymentMethod() string {
 	if x != nil {
-		return x.Page
+		return x.PaymentMethod
 	}
-	return 0
+	return ""
 }
 
-func (x *ListOrdersRequest) GetPageSize() int32 {
+func (x *ReturnRefund) GetAmount() float64 {
 	if x != nil {
-		return x.PageSize
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *ListOrdersRequest) GetStatusFilter() string {
-	if x != nil {
-		return x.StatusFilter
-	}
-	return ""
-}
-
-type ListOrdersResponse struct {
+type StoreCredit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Balance       float64                `protobuf:"fixed64,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	mi := &file_order_order_proto_msgTypes[7]
+func (x *StoreCredit) Reset() {
+	*x = StoreCredit{}
+	mi := &file_order_order_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *StoreCredit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*StoreCredit) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[7]
+func (x *StoreCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,63 +3019,86 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use StoreCredit.ProtoReflect.Descriptor instead.
+func (*StoreCredit) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListOrdersResponse) GetOrders() []*Order {
+func (x *StoreCredit) GetId() string {
 	if x != nil {
-		return x.Orders
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListOrdersResponse) GetTotalCount() int32 {
+func (x *StoreCredit) GetCode() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *ListOrdersResponse) GetPage() int32 {
+func (x *StoreCredit) GetCustomerId() string {
 	if x != nil {
-		return x.Page
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *StoreCredit) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *ListOrdersResponse) GetPageSize() int32 {
+func (x *StoreCredit) GetBalance() float64 {
 	if x != nil {
-		return x.PageSize
+		return x.Balance
 	}
 	return 0
 }
 
-type UpdateOrderStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	NewStatus     string                 `protobuf:"bytes,2,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"` // confirmed, shipped, delivered, cancelled
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                        // optional: reason for status change
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StoreCredit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *UpdateOrderStatusRequest) Reset() {
-	*x = UpdateOrderStatusRequest{}
-	mi := &file_order_order_proto_msgTypes[8]
+type OrderReturn struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId         string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ExchangeOrderId string                 `protobuf:"bytes,3,opt,name=exchange_order_id,json=exchangeOrderId,proto3" json:"exchange_order_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RefundMethod    RefundMethod           `protobuf:"varint,5,opt,name=refund_method,json=refundMethod,proto3,enum=order.RefundMethod" json:"refund_method,omitempty"`
+	RefundAmount    float64                `protobuf:"fixed64,6,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // completed, refund_failed
+	Items           []*ReturnItem          `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	Refunds         []*ReturnRefund        `protobuf:"bytes,9,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	StoreCredit     *StoreCredit           `protobuf:"bytes,10,opt,name=store_credit,json=storeCredit,proto3" json:"store_credit,omitempty"`
+	ActorId         string                 `protobuf:"bytes,11,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderReturn) Reset() {
+	*x = OrderReturn{}
+	mi := &file_order_order_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusRequest) String() string {
+func (x *OrderReturn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusRequest) ProtoMessage() {}
+func (*OrderReturn) ProtoMessage() {}
 
-func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[8]
+func (x *OrderReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,123 +3109,119 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use OrderReturn.ProtoReflect.Descriptor instead.
+func (*OrderReturn) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *UpdateOrderStatusRequest) GetOrderId() int32 {
+func (x *OrderReturn) GetId() string {
 	if x != nil {
-		return x.OrderId
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateOrderStatusRequest) GetNewStatus() string {
+func (x *OrderReturn) GetOrderId() string {
 	if x != nil {
-		return x.NewStatus
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *UpdateOrderStatusRequest) GetReason() string {
+func (x *OrderReturn) GetExchangeOrderId() string {
 	if x != nil {
-		return x.Reason
+		return x.ExchangeOrderId
 	}
 	return ""
 }
 
-type UpdateOrderStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrderReturn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *UpdateOrderStatusResponse) Reset() {
-	*x = UpdateOrderStatusResponse{}
-	mi := &file_order_order_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OrderReturn) GetRefundMethod() RefundMethod {
+	if x != nil {
+		return x.RefundMethod
+	}
+	return RefundMethod_REFUND_METHOD_UNSPECIFIED
 }
 
-func (x *UpdateOrderStatusResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OrderReturn) GetRefundAmount() float64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
 }
 
-func (*UpdateOrderStatusResponse) ProtoMessage() {}
-
-func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[9]
+func (x *OrderReturn) GetStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{9}
+func (x *OrderReturn) GetItems() []*ReturnItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
 }
 
-func (x *UpdateOrderStatusResponse) GetOrderId() int32 {
+func (x *OrderReturn) GetRefunds() []*ReturnRefund {
 	if x != nil {
-		return x.OrderId
+		return x.Refunds
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateOrderStatusResponse) GetStatus() string {
+func (x *OrderReturn) GetStoreCredit() *StoreCredit {
 	if x != nil {
-		return x.Status
+		return x.StoreCredit
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateOrderStatusResponse) GetMessage() string {
+func (x *OrderReturn) GetActorId() string {
 	if x != nil {
-		return x.Message
+		return x.ActorId
 	}
 	return ""
 }
 
-func (x *UpdateOrderStatusResponse) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *OrderReturn) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type CancelOrderRequest struct {
+type CreateReturnResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	OrderReturn   *OrderReturn           `protobuf:"bytes,1,opt,name=order_return,json=orderReturn,proto3" json:"order_return,omitempty"`
+	ExchangeOrder *CreateOrderResponse   `protobuf:"bytes,2,opt,name=exchange_order,json=exchangeOrder,proto3" json:"exchange_order,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelOrderRequest) Reset() {
-	*x = CancelOrderRequest{}
-	mi := &file_order_order_proto_msgTypes[10]
+func (x *CreateReturnResponse) Reset() {
+	*x = CreateReturnResponse{}
+	mi := &file_order_order_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderRequest) String() string {
+func (x *CreateReturnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderRequest) ProtoMessage() {}
+func (*CreateReturnResponse) ProtoMessage() {}
 
-func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[10]
+func (x *CreateReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,49 +3232,54 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
-func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CreateReturnResponse.ProtoReflect.Descriptor instead.
+func (*CreateReturnResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CancelOrderRequest) GetOrderId() int32 {
+func (x *CreateReturnResponse) GetOrderReturn() *OrderReturn {
 	if x != nil {
-		return x.OrderId
+		return x.OrderReturn
 	}
-	return 0
+	return nil
+}
+
+func (x *CreateReturnResponse) GetExchangeOrder() *CreateOrderResponse {
+	if x != nil {
+		return x.ExchangeOrder
+	}
+	return nil
 }
 
-func (x *CancelOrderRequest) GetReason() string {
+func (x *CreateReturnResponse) GetMessage() string {
 	if x != nil {
-		return x.Reason
+		return x.Message
 	}
 	return ""
 }
 
-type CancelOrderResponse struct {
+type GetReturnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ReturnId      string                 `protobuf:"bytes,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelOrderResponse) Reset() {
-	*x = CancelOrderResponse{}
-	mi := &file_order_order_proto_msgTypes[11]
+func (x *GetReturnRequest) Reset() {
+	*x = GetReturnRequest{}
+	mi := &file_order_order_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderResponse) String() string {
+func (x *GetReturnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderResponse) ProtoMessage() {}
+func (*GetReturnRequest) ProtoMessage() {}
 
-func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[11]
+func (x *GetReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,56 +3290,45 @@ func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
-func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *CancelOrderResponse) GetOrderId() int32 {
-	if x != nil {
-		return x.OrderId
-	}
-	return 0
-}
-
-func (x *CancelOrderResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use GetReturnRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CancelOrderResponse) GetMessage() string {
+func (x *GetReturnRequest) GetReturnId() string {
 	if x != nil {
-		return x.Message
+		return x.ReturnId
 	}
 	return ""
 }
 
-type CalculateTaxRequest struct {
+type ListReturnsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subtotal      float64                `protobuf:"fixed64,1,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
-	Country       string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
-	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // optional
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                  // optional: exact match
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`                      // optional
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`                          // optional, exclusive
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateTaxRequest) Reset() {
-	*x = CalculateTaxRequest{}
-	mi := &file_order_order_proto_msgTypes[12]
+func (x *ListReturnsRequest) Reset() {
+	*x = ListReturnsRequest{}
+	mi := &file_order_order_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateTaxRequest) String() string {
+func (x *ListReturnsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateTaxRequest) ProtoMessage() {}
+func (*ListReturnsRequest) ProtoMessage() {}
 
-func (x *CalculateTaxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[12]
+func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,56 +3339,79 @@ func (x *CalculateTaxRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateTaxRequest.ProtoReflect.Descriptor instead.
-func (*CalculateTaxRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListReturnsRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CalculateTaxRequest) GetSubtotal() float64 {
+func (x *ListReturnsRequest) GetOrderId() string {
 	if x != nil {
-		return x.Subtotal
+		return x.OrderId
 	}
-	return 0
+	return ""
 }
 
-func (x *CalculateTaxRequest) GetCountry() string {
+func (x *ListReturnsRequest) GetReason() string {
 	if x != nil {
-		return x.Country
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CalculateTaxRequest) GetState() string {
+func (x *ListReturnsRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.State
+		return x.From
 	}
-	return ""
+	return nil
 }
 
-type CalculateTaxResponse struct {
+func (x *ListReturnsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListReturnsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReturnsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListReturnsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaxAmount     float64                `protobuf:"fixed64,1,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
-	TaxRate       float64                `protobuf:"fixed64,2,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
-	TaxType       string                 `protobuf:"bytes,3,opt,name=tax_type,json=taxType,proto3" json:"tax_type,omitempty"`
+	Returns       []*OrderReturn         `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalRefunded float64                `protobuf:"fixed64,5,opt,name=total_refunded,json=totalRefunded,proto3" json:"total_refunded,omitempty"` // across all pages
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateTaxResponse) Reset() {
-	*x = CalculateTaxResponse{}
-	mi := &file_order_order_proto_msgTypes[13]
+func (x *ListReturnsResponse) Reset() {
+	*x = ListReturnsResponse{}
+	mi := &file_order_order_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateTaxResponse) String() string {
+func (x *ListReturnsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateTaxResponse) ProtoMessage() {}
+func (*ListReturnsResponse) ProtoMessage() {}
 
-func (x *CalculateTaxResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[13]
+func (x *ListReturnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1021,54 +3422,68 @@ func (x *CalculateTaxResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateTaxResponse.ProtoReflect.Descriptor instead.
-func (*CalculateTaxResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListReturnsResponse.ProtoReflect.Descriptor instead.
+func (*ListReturnsResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CalculateTaxResponse) GetTaxAmount() float64 {
+func (x *ListReturnsResponse) GetReturns() []*OrderReturn {
 	if x != nil {
-		return x.TaxAmount
+		return x.Returns
+	}
+	return nil
+}
+
+func (x *ListReturnsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *CalculateTaxResponse) GetTaxRate() float64 {
+func (x *ListReturnsResponse) GetPage() int32 {
 	if x != nil {
-		return x.TaxRate
+		return x.Page
 	}
 	return 0
 }
 
-func (x *CalculateTaxResponse) GetTaxType() string {
+func (x *ListReturnsResponse) GetPageSize() int32 {
 	if x != nil {
-		return x.TaxType
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type TrackOrderRequest struct {
+func (x *ListReturnsResponse) GetTotalRefunded() float64 {
+	if x != nil {
+		return x.TotalRefunded
+	}
+	return 0
+}
+
+type GetStoreCreditRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TrackOrderRequest) Reset() {
-	*x = TrackOrderRequest{}
-	mi := &file_order_order_proto_msgTypes[14]
+func (x *GetStoreCreditRequest) Reset() {
+	*x = GetStoreCreditRequest{}
+	mi := &file_order_order_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TrackOrderRequest) String() string {
+func (x *GetStoreCreditRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackOrderRequest) ProtoMessage() {}
+func (*GetStoreCreditRequest) ProtoMessage() {}
 
-func (x *TrackOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[14]
+func (x *GetStoreCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1079,44 +3494,46 @@ func (x *TrackOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackOrderRequest.ProtoReflect.Descriptor instead.
-func (*TrackOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetStoreCreditRequest.ProtoReflect.Descriptor instead.
+func (*GetStoreCreditRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *TrackOrderRequest) GetOrderId() int32 {
+func (x *GetStoreCreditRequest) GetCode() string {
 	if x != nil {
-		return x.OrderId
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-type TrackOrderResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	OrderId           int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CurrentStatus     string                 `protobuf:"bytes,2,opt,name=current_status,json=currentStatus,proto3" json:"current_status,omitempty"`
-	Location          string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	EstimatedDelivery string                 `protobuf:"bytes,4,opt,name=estimated_delivery,json=estimatedDelivery,proto3" json:"estimated_delivery,omitempty"`
-	Events            []*TrackingEvent       `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// ExchangeRate is how many units of quote_currency one unit of
+// base_currency buys in a shop, e.g. USD/KHR 4100. Rates are decimal strings
+// so they are kept exactly.
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TrackOrderResponse) Reset() {
-	*x = TrackOrderResponse{}
-	mi := &file_order_order_proto_msgTypes[15]
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_order_order_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TrackOrderResponse) String() string {
+func (x *ExchangeRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackOrderResponse) ProtoMessage() {}
+func (*ExchangeRate) ProtoMessage() {}
 
-func (x *TrackOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[15]
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,71 +3544,63 @@ func (x *TrackOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackOrderResponse.ProtoReflect.Descriptor instead.
-func (*TrackOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *TrackOrderResponse) GetOrderId() int32 {
-	if x != nil {
-		return x.OrderId
-	}
-	return 0
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *TrackOrderResponse) GetCurrentStatus() string {
+func (x *ExchangeRate) GetBaseCurrency() string {
 	if x != nil {
-		return x.CurrentStatus
+		return x.BaseCurrency
 	}
 	return ""
 }
 
-func (x *TrackOrderResponse) GetLocation() string {
+func (x *ExchangeRate) GetQuoteCurrency() string {
 	if x != nil {
-		return x.Location
+		return x.QuoteCurrency
 	}
 	return ""
 }
 
-func (x *TrackOrderResponse) GetEstimatedDelivery() string {
+func (x *ExchangeRate) GetRate() string {
 	if x != nil {
-		return x.EstimatedDelivery
+		return x.Rate
 	}
 	return ""
 }
 
-func (x *TrackOrderResponse) GetEvents() []*TrackingEvent {
+func (x *ExchangeRate) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Events
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type TrackingEvent struct {
+type SetExchangeRateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"` // must be positive; replaces the pair's current rate
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TrackingEvent) Reset() {
-	*x = TrackingEvent{}
-	mi := &file_order_order_proto_msgTypes[16]
+func (x *SetExchangeRateRequest) Reset() {
+	*x = SetExchangeRateRequest{}
+	mi := &file_order_order_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TrackingEvent) String() string {
+func (x *SetExchangeRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackingEvent) ProtoMessage() {}
+func (*SetExchangeRateRequest) ProtoMessage() {}
 
-func (x *TrackingEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[16]
+func (x *SetExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,148 +3611,469 @@ func (x *TrackingEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackingEvent.ProtoReflect.Descriptor instead.
-func (*TrackingEvent) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SetExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *TrackingEvent) GetStatus() string {
+func (x *SetExchangeRateRequest) GetBaseCurrency() string {
 	if x != nil {
-		return x.Status
+		return x.BaseCurrency
 	}
 	return ""
 }
 
-func (x *TrackingEvent) GetDescription() string {
+func (x *SetExchangeRateRequest) GetQuoteCurrency() string {
 	if x != nil {
-		return x.Description
+		return x.QuoteCurrency
 	}
 	return ""
 }
 
-func (x *TrackingEvent) GetTimestamp() *timestamppb.Timestamp {
+func (x *SetExchangeRateRequest) GetRate() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Rate
 	}
-	return nil
+	return ""
 }
 
-func (x *TrackingEvent) GetLocation() string {
+type ListExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_order_order_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[41]
 	if x != nil {
-		return x.Location
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{41}
+}
+
+type ListExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	mi := &file_order_order_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListExchangeRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
 }
 
 var File_order_order_proto protoreflect.FileDescriptor
 
 const file_order_order_proto_rawDesc = "" +
 	"\n" +
-	"\x11order/order.proto\x12\x05order\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa4\x01\n" +
+	"\x11order/order.proto\x12\x05order\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x11money/money.proto\"\xdd\x02\n" +
 	"\tOrderItem\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\x05R\tproductId\x12!\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
 	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
 	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
 	"\n" +
 	"unit_price\x18\x04 \x01(\x01R\tunitPrice\x12\x1a\n" +
-	"\bsubtotal\x18\x05 \x01(\x01R\bsubtotal\"\x89\x03\n" +
+	"\bsubtotal\x18\x05 \x01(\x01R\bsubtotal\x12\x19\n" +
+	"\btax_rate\x18\x06 \x01(\x01R\ataxRate\x12\x1d\n" +
+	"\n" +
+	"tax_amount\x18\a \x01(\x01R\ttaxAmount\x12\x0e\n" +
+	"\x02id\x18\b \x01(\tR\x02id\x12+\n" +
+	"\x11returned_quantity\x18\t \x01(\x05R\x10returnedQuantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\n" +
+	" \x01(\tR\tvariantId\x12!\n" +
+	"\fvariant_name\x18\v \x01(\tR\vvariantName\"\xd1\x06\n" +
 	"\x05Order\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12&\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12&\n" +
 	"\x05items\x18\x03 \x03(\v2\x10.order.OrderItemR\x05items\x12!\n" +
 	"\ftotal_amount\x18\x04 \x01(\x01R\vtotalAmount\x12\x10\n" +
 	"\x03tax\x18\x05 \x01(\x01R\x03tax\x12\x1a\n" +
-	"\bdiscount\x18\x06 \x01(\x01R\bdiscount\x12\x16\n" +
-	"\x06status\x18\a \x01(\tR\x06status\x12%\n" +
+	"\bdiscount\x18\x06 \x01(\x01R\bdiscount\x12*\n" +
+	"\x06status\x18\a \x01(\x0e2\x12.order.OrderStatusR\x06status\x12%\n" +
 	"\x0epayment_method\x18\b \x01(\tR\rpaymentMethod\x12)\n" +
 	"\x10shipping_address\x18\t \x01(\tR\x0fshippingAddress\x129\n" +
 	"\n" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc3\x01\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
+	"\ashop_id\x18\f \x01(\tR\x06shopId\x12\x1a\n" +
+	"\bsubtotal\x18\r \x01(\x01R\bsubtotal\x12,\n" +
+	"\x12prices_include_tax\x18\x0e \x01(\bR\x10pricesIncludeTax\x12\x1f\n" +
+	"\vamount_paid\x18\x0f \x01(\x01R\n" +
+	"amountPaid\x12\x1f\n" +
+	"\vbalance_due\x18\x10 \x01(\x01R\n" +
+	"balanceDue\x12\x1d\n" +
+	"\n" +
+	"change_due\x18\x11 \x01(\x01R\tchangeDue\x12@\n" +
+	"\x0epayment_status\x18\x12 \x01(\x0e2\x19.order.OrderPaymentStatusR\rpaymentStatus\x12'\n" +
+	"\x0famount_refunded\x18\x13 \x01(\x01R\x0eamountRefunded\x12\x1a\n" +
+	"\bcurrency\x18\x14 \x01(\tR\bcurrency\x12-\n" +
+	"\vtotal_money\x18\x15 \x01(\v2\f.money.MoneyR\n" +
+	"totalMoney\x128\n" +
+	"\x11balance_due_money\x18\x16 \x01(\v2\f.money.MoneyR\x0fbalanceDueMoney\"\xfb\x02\n" +
+	"\x06Tender\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12%\n" +
+	"\x0epayment_method\x18\x02 \x01(\tR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\btendered\x18\x04 \x01(\x01R\btendered\x12\x1d\n" +
+	"\n" +
+	"change_due\x18\x05 \x01(\x01R\tchangeDue\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
+	"\x0frefunded_amount\x18\a \x01(\x01R\x0erefundedAmount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x123\n" +
+	"\x0epayment_amount\x18\t \x01(\v2\f.money.MoneyR\rpaymentAmount\x12#\n" +
+	"\rexchange_rate\x18\n" +
+	" \x01(\tR\fexchangeRate\"\xdf\x01\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12&\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
 	"\x05items\x18\x02 \x03(\v2\x10.order.OrderItemR\x05items\x12\x1a\n" +
 	"\bdiscount\x18\x03 \x01(\x01R\bdiscount\x12%\n" +
 	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12)\n" +
-	"\x10shipping_address\x18\x05 \x01(\tR\x0fshippingAddress\"\xb0\x01\n" +
+	"\x10shipping_address\x18\x05 \x01(\tR\x0fshippingAddress\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\"\xc4\x01\n" +
 	"\x13CreateOrderResponse\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12!\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
 	"\ftotal_amount\x18\x03 \x01(\x01R\vtotalAmount\x12\x10\n" +
-	"\x03tax\x18\x04 \x01(\x01R\x03tax\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\x12\x18\n" +
+	"\x03tax\x18\x04 \x01(\x01R\x03tax\x12*\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x12.order.OrderStatusR\x06status\x12\x18\n" +
 	"\amessage\x18\x06 \x01(\tR\amessage\",\n" +
 	"\x0fGetOrderRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x05R\aorderId\"\x94\x03\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\x85\a\n" +
 	"\x10GetOrderResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12&\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12&\n" +
 	"\x05items\x18\x03 \x03(\v2\x10.order.OrderItemR\x05items\x12!\n" +
 	"\ftotal_amount\x18\x04 \x01(\x01R\vtotalAmount\x12\x10\n" +
 	"\x03tax\x18\x05 \x01(\x01R\x03tax\x12\x1a\n" +
-	"\bdiscount\x18\x06 \x01(\x01R\bdiscount\x12\x16\n" +
-	"\x06status\x18\a \x01(\tR\x06status\x12%\n" +
+	"\bdiscount\x18\x06 \x01(\x01R\bdiscount\x12*\n" +
+	"\x06status\x18\a \x01(\x0e2\x12.order.OrderStatusR\x06status\x12%\n" +
 	"\x0epayment_method\x18\b \x01(\tR\rpaymentMethod\x12)\n" +
 	"\x10shipping_address\x18\t \x01(\tR\x0fshippingAddress\x129\n" +
 	"\n" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x82\x01\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
+	"\ashop_id\x18\f \x01(\tR\x06shopId\x12\x1a\n" +
+	"\bsubtotal\x18\r \x01(\x01R\bsubtotal\x12,\n" +
+	"\x12prices_include_tax\x18\x0e \x01(\bR\x10pricesIncludeTax\x12\x1f\n" +
+	"\vamount_paid\x18\x0f \x01(\x01R\n" +
+	"amountPaid\x12\x1f\n" +
+	"\vbalance_due\x18\x10 \x01(\x01R\n" +
+	"balanceDue\x12\x1d\n" +
+	"\n" +
+	"change_due\x18\x11 \x01(\x01R\tchangeDue\x12@\n" +
+	"\x0epayment_status\x18\x12 \x01(\x0e2\x19.order.OrderPaymentStatusR\rpaymentStatus\x12'\n" +
+	"\atenders\x18\x13 \x03(\v2\r.order.TenderR\atenders\x12'\n" +
+	"\x0famount_refunded\x18\x14 \x01(\x01R\x0eamountRefunded\x12\x1a\n" +
+	"\bcurrency\x18\x15 \x01(\tR\bcurrency\x12-\n" +
+	"\vtotal_money\x18\x16 \x01(\v2\f.money.MoneyR\n" +
+	"totalMoney\x128\n" +
+	"\x11balance_due_money\x18\x17 \x01(\v2\f.money.MoneyR\x0fbalanceDueMoney\"\xae\x01\n" +
 	"\x11ListOrdersRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12#\n" +
-	"\rstatus_filter\x18\x04 \x01(\tR\fstatusFilter\"\x8c\x01\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x127\n" +
+	"\rstatus_filter\x18\x04 \x01(\x0e2\x12.order.OrderStatusR\fstatusFilter\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\"\xad\x01\n" +
 	"\x12ListOrdersResponse\x12$\n" +
 	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"l\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\"\x80\x01\n" +
 	"\x18UpdateOrderStatusRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x1d\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x121\n" +
 	"\n" +
-	"new_status\x18\x02 \x01(\tR\tnewStatus\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xa3\x01\n" +
+	"new_status\x18\x02 \x01(\x0e2\x12.order.OrderStatusR\tnewStatus\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xb7\x01\n" +
 	"\x19UpdateOrderStatusResponse\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12*\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x12.order.OrderStatusR\x06status\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x129\n" +
 	"\n" +
 	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"G\n" +
 	"\x12CancelOrderRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"b\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"v\n" +
 	"\x13CancelOrderResponse\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"a\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12*\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x12.order.OrderStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa5\x01\n" +
 	"\x13CalculateTaxRequest\x12\x1a\n" +
 	"\bsubtotal\x18\x01 \x01(\x01R\bsubtotal\x12\x18\n" +
 	"\acountry\x18\x02 \x01(\tR\acountry\x12\x14\n" +
-	"\x05state\x18\x03 \x01(\tR\x05state\"k\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12&\n" +
+	"\x05items\x18\x04 \x03(\v2\x10.order.OrderItemR\x05items\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"j\n" +
+	"\fTaxComponent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04rate\x18\x02 \x01(\x01R\x04rate\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcompound\x18\x04 \x01(\bR\bcompound\"\xd4\x02\n" +
 	"\x14CalculateTaxResponse\x12\x1d\n" +
 	"\n" +
 	"tax_amount\x18\x01 \x01(\x01R\ttaxAmount\x12\x19\n" +
 	"\btax_rate\x18\x02 \x01(\x01R\ataxRate\x12\x19\n" +
-	"\btax_type\x18\x03 \x01(\tR\ataxType\".\n" +
+	"\btax_type\x18\x03 \x01(\tR\ataxType\x123\n" +
+	"\n" +
+	"components\x18\x04 \x03(\v2\x13.order.TaxComponentR\n" +
+	"components\x12,\n" +
+	"\x12prices_include_tax\x18\x05 \x01(\bR\x10pricesIncludeTax\x12\x1d\n" +
+	"\n" +
+	"net_amount\x18\x06 \x01(\x01R\tnetAmount\x12!\n" +
+	"\fgross_amount\x18\a \x01(\x01R\vgrossAmount\x12&\n" +
+	"\x05items\x18\b \x03(\v2\x10.order.OrderItemR\x05items\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\".\n" +
 	"\x11TrackOrderRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x05R\aorderId\"\xcf\x01\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\xe3\x01\n" +
 	"\x12TrackOrderResponse\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x05R\aorderId\x12%\n" +
-	"\x0ecurrent_status\x18\x02 \x01(\tR\rcurrentStatus\x12\x1a\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x129\n" +
+	"\x0ecurrent_status\x18\x02 \x01(\x0e2\x12.order.OrderStatusR\rcurrentStatus\x12\x1a\n" +
 	"\blocation\x18\x03 \x01(\tR\blocation\x12-\n" +
 	"\x12estimated_delivery\x18\x04 \x01(\tR\x11estimatedDelivery\x12,\n" +
-	"\x06events\x18\x05 \x03(\v2\x14.order.TrackingEventR\x06events\"\x9f\x01\n" +
-	"\rTrackingEvent\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\x12 \n" +
+	"\x06events\x18\x05 \x03(\v2\x14.order.TrackingEventR\x06events\"\x8b\x02\n" +
+	"\rTrackingEvent\x12*\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x12.order.OrderStatusR\x06status\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x128\n" +
 	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1a\n" +
-	"\blocation\x18\x04 \x01(\tR\blocation2\xbd\x04\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12;\n" +
+	"\x0fprevious_status\x18\x05 \x01(\x0e2\x12.order.OrderStatusR\x0epreviousStatus\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\tR\aactorId\"\x89\x01\n" +
+	"\x0fCheckoutRequest\x12/\n" +
+	"\x05order\x18\x01 \x01(\v2\x19.order.CreateOrderRequestR\x05order\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"card_token\x18\x04 \x01(\tR\tcardTokenJ\x04\b\x03\x10\x04R\x04card\"\xd6\x01\n" +
+	"\x10CheckoutResponse\x12\x1f\n" +
+	"\vcheckout_id\x18\x01 \x01(\tR\n" +
+	"checkoutId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x03 \x01(\tR\tpaymentId\x12*\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x12.order.OrderStatusR\x06status\x12!\n" +
+	"\ftotal_amount\x18\x05 \x01(\x01R\vtotalAmount\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"\x91\x02\n" +
+	"\x11AddPaymentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12%\n" +
+	"\x0epayment_method\x18\x02 \x01(\tR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12*\n" +
+	"\x11store_credit_code\x18\x06 \x01(\tR\x0fstoreCreditCode\x12\x1d\n" +
+	"\n" +
+	"card_token\x18\a \x01(\tR\tcardToken\x12/\n" +
+	"\famount_money\x18\b \x01(\v2\f.money.MoneyR\vamountMoneyJ\x04\b\x05\x10\x06R\x04card\"\xbf\x02\n" +
+	"\x12AddPaymentResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12%\n" +
+	"\x06tender\x18\x02 \x01(\v2\r.order.TenderR\x06tender\x12\x1f\n" +
+	"\vamount_paid\x18\x03 \x01(\x01R\n" +
+	"amountPaid\x12\x1f\n" +
+	"\vbalance_due\x18\x04 \x01(\x01R\n" +
+	"balanceDue\x12\x1d\n" +
+	"\n" +
+	"change_due\x18\x05 \x01(\x01R\tchangeDue\x12@\n" +
+	"\x0epayment_status\x18\x06 \x01(\x0e2\x19.order.OrderPaymentStatusR\rpaymentStatus\x12*\n" +
+	"\x06status\x18\a \x01(\x0e2\x12.order.OrderStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\"\x90\x01\n" +
+	"\x11GetReceiptRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12,\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.order.ReceiptFormatR\x06format\x122\n" +
+	"\vpaper_width\x18\x03 \x01(\x0e2\x11.order.PaperWidthR\n" +
+	"paperWidth\"\xb6\x01\n" +
+	"\x12GetReceiptResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12,\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.order.ReceiptFormatR\x06format\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x04 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\fR\acontent\"\x1b\n" +
+	"\x19GetReceiptTemplateRequest\"7\n" +
+	"\x19SetReceiptTemplateRequest\x12\x1a\n" +
+	"\btemplate\x18\x01 \x01(\tR\btemplate\"\x87\x01\n" +
+	"\x0fReceiptTemplate\x12\x1a\n" +
+	"\btemplate\x18\x01 \x01(\tR\btemplate\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x02 \x01(\bR\tisDefault\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf4\x01\n" +
+	"\x13CreateReturnRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12.\n" +
+	"\x05items\x18\x02 \x03(\v2\x18.order.ReturnItemRequestR\x05items\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x128\n" +
+	"\rrefund_method\x18\x04 \x01(\x0e2\x13.order.RefundMethodR\frefundMethod\x12@\n" +
+	"\x0eexchange_order\x18\x05 \x01(\v2\x19.order.CreateOrderRequestR\rexchangeOrder\"\x8f\x01\n" +
+	"\x11ReturnItemRequest\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\tR\vorderItemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12:\n" +
+	"\vdisposition\x18\x03 \x01(\x0e2\x18.order.ReturnDispositionR\vdisposition\"\x8e\x02\n" +
+	"\n" +
+	"ReturnItem\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\tR\vorderItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12#\n" +
+	"\rrefund_amount\x18\x05 \x01(\x01R\frefundAmount\x12:\n" +
+	"\vdisposition\x18\x06 \x01(\x0e2\x18.order.ReturnDispositionR\vdisposition\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\a \x01(\tR\tvariantId\"l\n" +
+	"\fReturnRefund\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12%\n" +
+	"\x0epayment_method\x18\x02 \x01(\tR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\"\xbf\x01\n" +
+	"\vStoreCredit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x18\n" +
+	"\abalance\x18\x05 \x01(\x01R\abalance\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd8\x03\n" +
+	"\vOrderReturn\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12*\n" +
+	"\x11exchange_order_id\x18\x03 \x01(\tR\x0fexchangeOrderId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x128\n" +
+	"\rrefund_method\x18\x05 \x01(\x0e2\x13.order.RefundMethodR\frefundMethod\x12#\n" +
+	"\rrefund_amount\x18\x06 \x01(\x01R\frefundAmount\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12'\n" +
+	"\x05items\x18\b \x03(\v2\x11.order.ReturnItemR\x05items\x12-\n" +
+	"\arefunds\x18\t \x03(\v2\x13.order.ReturnRefundR\arefunds\x125\n" +
+	"\fstore_credit\x18\n" +
+	" \x01(\v2\x12.order.StoreCreditR\vstoreCredit\x12\x19\n" +
+	"\bactor_id\x18\v \x01(\tR\aactorId\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xaa\x01\n" +
+	"\x14CreateReturnResponse\x125\n" +
+	"\forder_return\x18\x01 \x01(\v2\x12.order.OrderReturnR\vorderReturn\x12A\n" +
+	"\x0eexchange_order\x18\x02 \x01(\v2\x1a.order.CreateOrderResponseR\rexchangeOrder\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"/\n" +
+	"\x10GetReturnRequest\x12\x1b\n" +
+	"\treturn_id\x18\x01 \x01(\tR\breturnId\"\xd4\x01\n" +
+	"\x12ListReturnsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"\xbc\x01\n" +
+	"\x13ListReturnsResponse\x12,\n" +
+	"\areturns\x18\x01 \x03(\v2\x12.order.OrderReturnR\areturns\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12%\n" +
+	"\x0etotal_refunded\x18\x05 \x01(\x01R\rtotalRefunded\"+\n" +
+	"\x15GetStoreCreditRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\xa9\x01\n" +
+	"\fExchangeRate\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"x\n" +
+	"\x16SetExchangeRateRequest\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\"\x1a\n" +
+	"\x18ListExchangeRatesRequest\"F\n" +
+	"\x19ListExchangeRatesResponse\x12)\n" +
+	"\x05rates\x18\x01 \x03(\v2\x13.order.ExchangeRateR\x05rates*\xb3\x01\n" +
+	"\vOrderStatus\x12\x1c\n" +
+	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ORDER_STATUS_PENDING\x10\x01\x12\x1a\n" +
+	"\x16ORDER_STATUS_CONFIRMED\x10\x02\x12\x18\n" +
+	"\x14ORDER_STATUS_SHIPPED\x10\x03\x12\x1a\n" +
+	"\x16ORDER_STATUS_DELIVERED\x10\x04\x12\x1a\n" +
+	"\x16ORDER_STATUS_CANCELLED\x10\x05*\xa3\x01\n" +
+	"\x12OrderPaymentStatus\x12$\n" +
+	" ORDER_PAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bORDER_PAYMENT_STATUS_UNPAID\x10\x01\x12'\n" +
+	"#ORDER_PAYMENT_STATUS_PARTIALLY_PAID\x10\x02\x12\x1d\n" +
+	"\x19ORDER_PAYMENT_STATUS_PAID\x10\x03*{\n" +
+	"\rReceiptFormat\x12\x1e\n" +
+	"\x1aRECEIPT_FORMAT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13RECEIPT_FORMAT_TEXT\x10\x01\x12\x16\n" +
+	"\x12RECEIPT_FORMAT_PDF\x10\x02\x12\x19\n" +
+	"\x15RECEIPT_FORMAT_ESCPOS\x10\x03*U\n" +
+	"\n" +
+	"PaperWidth\x12\x1b\n" +
+	"\x17PAPER_WIDTH_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10PAPER_WIDTH_80MM\x10\x01\x12\x14\n" +
+	"\x10PAPER_WIDTH_58MM\x10\x02*y\n" +
+	"\x11ReturnDisposition\x12\"\n" +
+	"\x1eRETURN_DISPOSITION_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aRETURN_DISPOSITION_RESTOCK\x10\x01\x12 \n" +
+	"\x1cRETURN_DISPOSITION_WRITE_OFF\x10\x02*p\n" +
+	"\fRefundMethod\x12\x1d\n" +
+	"\x19REFUND_METHOD_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dREFUND_METHOD_ORIGINAL_TENDER\x10\x01\x12\x1e\n" +
+	"\x1aREFUND_METHOD_STORE_CREDIT\x10\x022\xcc\n" +
+	"\n" +
 	"\fOrderService\x12D\n" +
 	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12;\n" +
 	"\bGetOrder\x12\x16.order.GetOrderRequest\x1a\x17.order.GetOrderResponse\x12A\n" +
@@ -1354,7 +4084,20 @@ const file_order_order_proto_rawDesc = "" +
 	"\fCalculateTax\x12\x1a.order.CalculateTaxRequest\x1a\x1b.order.CalculateTaxResponse\x12A\n" +
 	"\n" +
 	"TrackOrder\x12\x18.order.TrackOrderRequest\x1a\x19.order.TrackOrderResponse\x12=\n" +
-	"\vDeleteOrder\x12\x16.order.GetOrderRequest\x1a\x16.google.protobuf.EmptyB\x17Z\x15proto/orderpb;orderpbb\x06proto3"
+	"\vDeleteOrder\x12\x16.order.GetOrderRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\bCheckout\x12\x16.order.CheckoutRequest\x1a\x17.order.CheckoutResponse\x12A\n" +
+	"\n" +
+	"AddPayment\x12\x18.order.AddPaymentRequest\x1a\x19.order.AddPaymentResponse\x12A\n" +
+	"\n" +
+	"GetReceipt\x12\x18.order.GetReceiptRequest\x1a\x19.order.GetReceiptResponse\x12N\n" +
+	"\x12GetReceiptTemplate\x12 .order.GetReceiptTemplateRequest\x1a\x16.order.ReceiptTemplate\x12N\n" +
+	"\x12SetReceiptTemplate\x12 .order.SetReceiptTemplateRequest\x1a\x16.order.ReceiptTemplate\x12G\n" +
+	"\fCreateReturn\x12\x1a.order.CreateReturnRequest\x1a\x1b.order.CreateReturnResponse\x128\n" +
+	"\tGetReturn\x12\x17.order.GetReturnRequest\x1a\x12.order.OrderReturn\x12D\n" +
+	"\vListReturns\x12\x19.order.ListReturnsRequest\x1a\x1a.order.ListReturnsResponse\x12B\n" +
+	"\x0eGetStoreCredit\x12\x1c.order.GetStoreCreditRequest\x1a\x12.order.StoreCredit\x12E\n" +
+	"\x0fSetExchangeRate\x12\x1d.order.SetExchangeRateRequest\x1a\x13.order.ExchangeRate\x12V\n" +
+	"\x11ListExchangeRates\x12\x1f.order.ListExchangeRatesRequest\x1a .order.ListExchangeRatesResponseB\x17Z\x15proto/orderpb;orderpbb\x06proto3"
 
 var (
 	file_order_order_proto_rawDescOnce sync.Once
@@ -1368,61 +4111,167 @@ func file_order_order_proto_rawDescGZIP() []byte {
 	return file_order_order_proto_rawDescData
 }
 
-var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_order_order_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_order_order_proto_goTypes = []any{
-	(*OrderItem)(nil),                 // 0: order.OrderItem
-	(*Order)(nil),                     // 1: order.Order
-	(*CreateOrderRequest)(nil),        // 2: order.CreateOrderRequest
-	(*CreateOrderResponse)(nil),       // 3: order.CreateOrderResponse
-	(*GetOrderRequest)(nil),           // 4: order.GetOrderRequest
-	(*GetOrderResponse)(nil),          // 5: order.GetOrderResponse
-	(*ListOrdersRequest)(nil),         // 6: order.ListOrdersRequest
-	(*ListOrdersResponse)(nil),        // 7: order.ListOrdersResponse
-	(*UpdateOrderStatusRequest)(nil),  // 8: order.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil), // 9: order.UpdateOrderStatusResponse
-	(*CancelOrderRequest)(nil),        // 10: order.CancelOrderRequest
-	(*CancelOrderResponse)(nil),       // 11: order.CancelOrderResponse
-	(*CalculateTaxRequest)(nil),       // 12: order.CalculateTaxRequest
-	(*CalculateTaxResponse)(nil),      // 13: order.CalculateTaxResponse
-	(*TrackOrderRequest)(nil),         // 14: order.TrackOrderRequest
-	(*TrackOrderResponse)(nil),        // 15: order.TrackOrderResponse
-	(*TrackingEvent)(nil),             // 16: order.TrackingEvent
-	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),             // 18: google.protobuf.Empty
+	(OrderStatus)(0),                  // 0: order.OrderStatus
+	(OrderPaymentStatus)(0),           // 1: order.OrderPaymentStatus
+	(ReceiptFormat)(0),                // 2: order.ReceiptFormat
+	(PaperWidth)(0),                   // 3: order.PaperWidth
+	(ReturnDisposition)(0),            // 4: order.ReturnDisposition
+	(RefundMethod)(0),                 // 5: order.RefundMethod
+	(*OrderItem)(nil),                 // 6: order.OrderItem
+	(*Order)(nil),                     // 7: order.Order
+	(*Tender)(nil),                    // 8: order.Tender
+	(*CreateOrderRequest)(nil),        // 9: order.CreateOrderRequest
+	(*CreateOrderResponse)(nil),       // 10: order.CreateOrderResponse
+	(*GetOrderRequest)(nil),           // 11: order.GetOrderRequest
+	(*GetOrderResponse)(nil),          // 12: order.GetOrderResponse
+	(*ListOrdersRequest)(nil),         // 13: order.ListOrdersRequest
+	(*ListOrdersResponse)(nil),        // 14: order.ListOrdersResponse
+	(*UpdateOrderStatusRequest)(nil),  // 15: order.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil), // 16: order.UpdateOrderStatusResponse
+	(*CancelOrderRequest)(nil),        // 17: order.CancelOrderRequest
+	(*CancelOrderResponse)(nil),       // 18: order.CancelOrderResponse
+	(*CalculateTaxRequest)(nil),       // 19: order.CalculateTaxRequest
+	(*TaxComponent)(nil),              // 20: order.TaxComponent
+	(*CalculateTaxResponse)(nil),      // 21: order.CalculateTaxResponse
+	(*TrackOrderRequest)(nil),         // 22: order.TrackOrderRequest
+	(*TrackOrderResponse)(nil),        // 23: order.TrackOrderResponse
+	(*TrackingEvent)(nil),             // 24: order.TrackingEvent
+	(*CheckoutRequest)(nil),           // 25: order.CheckoutRequest
+	(*CheckoutResponse)(nil),          // 26: order.CheckoutResponse
+	(*AddPaymentRequest)(nil),         // 27: order.AddPaymentRequest
+	(*AddPaymentResponse)(nil),        // 28: order.AddPaymentResponse
+	(*GetReceiptRequest)(nil),         // 29: order.GetReceiptRequest
+	(*GetReceiptResponse)(nil),        // 30: order.GetReceiptResponse
+	(*GetReceiptTemplateRequest)(nil), // 31: order.GetReceiptTemplateRequest
+	(*SetReceiptTemplateRequest)(nil), // 32: order.SetReceiptTemplateRequest
+	(*ReceiptTemplate)(nil),           // 33: order.ReceiptTemplate
+	(*CreateReturnRequest)(nil),       // 34: order.CreateReturnRequest
+	(*ReturnItemRequest)(nil),         // 35: order.ReturnItemRequest
+	(*ReturnItem)(nil),                // 36: order.ReturnItem
+	(*ReturnRefund)(nil),              // 37: order.ReturnRefund
+	(*StoreCredit)(nil),               // 38: order.StoreCredit
+	(*OrderReturn)(nil),               // 39: order.OrderReturn
+	(*CreateReturnResponse)(nil),      // 40: order.CreateReturnResponse
+	(*GetReturnRequest)(nil),          // 41: order.GetReturnRequest
+	(*ListReturnsRequest)(nil),        // 42: order.ListReturnsRequest
+	(*ListReturnsResponse)(nil),       // 43: order.ListReturnsResponse
+	(*GetStoreCreditRequest)(nil),     // 44: order.GetStoreCreditRequest
+	(*ExchangeRate)(nil),              // 45: order.ExchangeRate
+	(*SetExchangeRateRequest)(nil),    // 46: order.SetExchangeRateRequest
+	(*ListExchangeRatesRequest)(nil),  // 47: order.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil), // 48: order.ListExchangeRatesResponse
+	(*timestamppb.Timestamp)(nil),     // 49: google.protobuf.Timestamp
+	(*moneypb.Money)(nil),             // 50: money.Money
+	(*emptypb.Empty)(nil),             // 51: google.protobuf.Empty
 }
 var file_order_order_proto_depIdxs = []int32{
-	0,  // 0: order.Order.items:type_name -> order.OrderItem
-	17, // 1: order.Order.created_at:type_name -> google.protobuf.Timestamp
-	17, // 2: order.Order.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: order.CreateOrderRequest.items:type_name -> order.OrderItem
-	0,  // 4: order.GetOrderResponse.items:type_name -> order.OrderItem
-	17, // 5: order.GetOrderResponse.created_at:type_name -> google.protobuf.Timestamp
-	17, // 6: order.GetOrderResponse.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 7: order.ListOrdersResponse.orders:type_name -> order.Order
-	17, // 8: order.UpdateOrderStatusResponse.updated_at:type_name -> google.protobuf.Timestamp
-	16, // 9: order.TrackOrderResponse.events:type_name -> order.TrackingEvent
-	17, // 10: order.TrackingEvent.timestamp:type_name -> google.protobuf.Timestamp
-	2,  // 11: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	4,  // 12: order.OrderService.GetOrder:input_type -> order.GetOrderRequest
-	6,  // 13: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
-	8,  // 14: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	10, // 15: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
-	12, // 16: order.OrderService.CalculateTax:input_type -> order.CalculateTaxRequest
-	14, // 17: order.OrderService.TrackOrder:input_type -> order.TrackOrderRequest
-	4,  // 18: order.OrderService.DeleteOrder:input_type -> order.GetOrderRequest
-	3,  // 19: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	5,  // 20: order.OrderService.GetOrder:output_type -> order.GetOrderResponse
-	7,  // 21: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
-	9,  // 22: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
-	11, // 23: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
-	13, // 24: order.OrderService.CalculateTax:output_type -> order.CalculateTaxResponse
-	15, // 25: order.OrderService.TrackOrder:output_type -> order.TrackOrderResponse
-	18, // 26: order.OrderService.DeleteOrder:output_type -> google.protobuf.Empty
-	19, // [19:27] is the sub-list for method output_type
-	11, // [11:19] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	6,  // 0: order.Order.items:type_name -> order.OrderItem
+	0,  // 1: order.Order.status:type_name -> order.OrderStatus
+	49, // 2: order.Order.created_at:type_name -> google.protobuf.Timestamp
+	49, // 3: order.Order.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: order.Order.payment_status:type_name -> order.OrderPaymentStatus
+	50, // 5: order.Order.total_money:type_name -> money.Money
+	50, // 6: order.Order.balance_due_money:type_name -> money.Money
+	49, // 7: order.Tender.created_at:type_name -> google.protobuf.Timestamp
+	50, // 8: order.Tender.payment_amount:type_name -> money.Money
+	6,  // 9: order.CreateOrderRequest.items:type_name -> order.OrderItem
+	0,  // 10: order.CreateOrderResponse.status:type_name -> order.OrderStatus
+	6,  // 11: order.GetOrderResponse.items:type_name -> order.OrderItem
+	0,  // 12: order.GetOrderResponse.status:type_name -> order.OrderStatus
+	49, // 13: order.GetOrderResponse.created_at:type_name -> google.protobuf.Timestamp
+	49, // 14: order.GetOrderResponse.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 15: order.GetOrderResponse.payment_status:type_name -> order.OrderPaymentStatus
+	8,  // 16: order.GetOrderResponse.tenders:type_name -> order.Tender
+	50, // 17: order.GetOrderResponse.total_money:type_name -> money.Money
+	50, // 18: order.GetOrderResponse.balance_due_money:type_name -> money.Money
+	0,  // 19: order.ListOrdersRequest.status_filter:type_name -> order.OrderStatus
+	7,  // 20: order.ListOrdersResponse.orders:type_name -> order.Order
+	0,  // 21: order.UpdateOrderStatusRequest.new_status:type_name -> order.OrderStatus
+	0,  // 22: order.UpdateOrderStatusResponse.status:type_name -> order.OrderStatus
+	49, // 23: order.UpdateOrderStatusResponse.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 24: order.CancelOrderResponse.status:type_name -> order.OrderStatus
+	6,  // 25: order.CalculateTaxRequest.items:type_name -> order.OrderItem
+	20, // 26: order.CalculateTaxResponse.components:type_name -> order.TaxComponent
+	6,  // 27: order.CalculateTaxResponse.items:type_name -> order.OrderItem
+	0,  // 28: order.TrackOrderResponse.current_status:type_name -> order.OrderStatus
+	24, // 29: order.TrackOrderResponse.events:type_name -> order.TrackingEvent
+	0,  // 30: order.TrackingEvent.status:type_name -> order.OrderStatus
+	49, // 31: order.TrackingEvent.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 32: order.TrackingEvent.previous_status:type_name -> order.OrderStatus
+	9,  // 33: order.CheckoutRequest.order:type_name -> order.CreateOrderRequest
+	0,  // 34: order.CheckoutResponse.status:type_name -> order.OrderStatus
+	50, // 35: order.AddPaymentRequest.amount_money:type_name -> money.Money
+	8,  // 36: order.AddPaymentResponse.tender:type_name -> order.Tender
+	1,  // 37: order.AddPaymentResponse.payment_status:type_name -> order.OrderPaymentStatus
+	0,  // 38: order.AddPaymentResponse.status:type_name -> order.OrderStatus
+	2,  // 39: order.GetReceiptRequest.format:type_name -> order.ReceiptFormat
+	3,  // 40: order.GetReceiptRequest.paper_width:type_name -> order.PaperWidth
+	2,  // 41: order.GetReceiptResponse.format:type_name -> order.ReceiptFormat
+	49, // 42: order.ReceiptTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	35, // 43: order.CreateReturnRequest.items:type_name -> order.ReturnItemRequest
+	5,  // 44: order.CreateReturnRequest.refund_method:type_name -> order.RefundMethod
+	9,  // 45: order.CreateReturnRequest.exchange_order:type_name -> order.CreateOrderRequest
+	4,  // 46: order.ReturnItemRequest.disposition:type_name -> order.ReturnDisposition
+	4,  // 47: order.ReturnItem.disposition:type_name -> order.ReturnDisposition
+	49, // 48: order.StoreCredit.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 49: order.OrderReturn.refund_method:type_name -> order.RefundMethod
+	36, // 50: order.OrderReturn.items:type_name -> order.ReturnItem
+	37, // 51: order.OrderReturn.refunds:type_name -> order.ReturnRefund
+	38, // 52: order.OrderReturn.store_credit:type_name -> order.StoreCredit
+	49, // 53: order.OrderReturn.created_at:type_name -> google.protobuf.Timestamp
+	39, // 54: order.CreateReturnResponse.order_return:type_name -> order.OrderReturn
+	10, // 55: order.CreateReturnResponse.exchange_order:type_name -> order.CreateOrderResponse
+	49, // 56: order.ListReturnsRequest.from:type_name -> google.protobuf.Timestamp
+	49, // 57: order.ListReturnsRequest.to:type_name -> google.protobuf.Timestamp
+	39, // 58: order.ListReturnsResponse.returns:type_name -> order.OrderReturn
+	49, // 59: order.ExchangeRate.updated_at:type_name -> google.protobuf.Timestamp
+	45, // 60: order.ListExchangeRatesResponse.rates:type_name -> order.ExchangeRate
+	9,  // 61: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	11, // 62: order.OrderService.GetOrder:input_type -> order.GetOrderRequest
+	13, // 63: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
+	15, // 64: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	17, // 65: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
+	19, // 66: order.OrderService.CalculateTax:input_type -> order.CalculateTaxRequest
+	22, // 67: order.OrderService.TrackOrder:input_type -> order.TrackOrderRequest
+	11, // 68: order.OrderService.DeleteOrder:input_type -> order.GetOrderRequest
+	25, // 69: order.OrderService.Checkout:input_type -> order.CheckoutRequest
+	27, // 70: order.OrderService.AddPayment:input_type -> order.AddPaymentRequest
+	29, // 71: order.OrderService.GetReceipt:input_type -> order.GetReceiptRequest
+	31, // 72: order.OrderService.GetReceiptTemplate:input_type -> order.GetReceiptTemplateRequest
+	32, // 73: order.OrderService.SetReceiptTemplate:input_type -> order.SetReceiptTemplateRequest
+	34, // 74: order.OrderService.CreateReturn:input_type -> order.CreateReturnRequest
+	41, // 75: order.OrderService.GetReturn:input_type -> order.GetReturnRequest
+	42, // 76: order.OrderService.ListReturns:input_type -> order.ListReturnsRequest
+	44, // 77: order.OrderService.GetStoreCredit:input_type -> order.GetStoreCreditRequest
+	46, // 78: order.OrderService.SetExchangeRate:input_type -> order.SetExchangeRateRequest
+	47, // 79: order.OrderService.ListExchangeRates:input_type -> order.ListExchangeRatesRequest
+	10, // 80: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	12, // 81: order.OrderService.GetOrder:output_type -> order.GetOrderResponse
+	14, // 82: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
+	16, // 83: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
+	18, // 84: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
+	21, // 85: order.OrderService.CalculateTax:output_type -> order.CalculateTaxResponse
+	23, // 86: order.OrderService.TrackOrder:output_type -> order.TrackOrderResponse
+	51, // 87: order.OrderService.DeleteOrder:output_type -> google.protobuf.Empty
+	26, // 88: order.OrderService.Checkout:output_type -> order.CheckoutResponse
+	28, // 89: order.OrderService.AddPayment:output_type -> order.AddPaymentResponse
+	30, // 90: order.OrderService.GetReceipt:output_type -> order.GetReceiptResponse
+	33, // 91: order.OrderService.GetReceiptTemplate:output_type -> order.ReceiptTemplate
+	33, // 92: order.OrderService.SetReceiptTemplate:output_type -> order.ReceiptTemplate
+	40, // 93: order.OrderService.CreateReturn:output_type -> order.CreateReturnResponse
+	39, // 94: order.OrderService.GetReturn:output_type -> order.OrderReturn
+	43, // 95: order.OrderService.ListReturns:output_type -> order.ListReturnsResponse
+	38, // 96: order.OrderService.GetStoreCredit:output_type -> order.StoreCredit
+	45, // 97: order.OrderService.SetExchangeRate:output_type -> order.ExchangeRate
+	48, // 98: order.OrderService.ListExchangeRates:output_type -> order.ListExchangeRatesResponse
+	80, // [80:99] is the sub-list for method output_type
+	61, // [61:80] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_order_order_proto_init() }
@@ -1435,13 +4284,14 @@ func file_order_order_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_order_proto_rawDesc), len(file_order_order_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      6,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_order_order_proto_goTypes,
 		DependencyIndexes: file_order_order_proto_depIdxs,
+		EnumInfos:         file_order_order_proto_enumTypes,
 		MessageInfos:      file_order_order_proto_msgTypes,
 	}.Build()
 	File_order_order_proto = out.File
@@ -1,13 +1,18 @@
 package main
 
 import (
+	"context"
 	"hpkg/grpc/interceptor"
 	"log"
 	"net"
+	"os"
 	"paymentservice/internal/db"
 	"paymentservice/internal/handler"
+	"paymentservice/internal/provider"
 	"paymentservice/internal/service"
+	"paymentservice/internal/vault"
 	"paymentservice/proto/paymentpb"
+	"time"
 
 	"google.golang.org/grpc"
 )
@@ -25,13 +30,35 @@ func main() {
 		log.Fatal(err)
 	}
 
+	providers, err := newProviders()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	authorizationTTL := 7 * 24 * time.Hour
+	if v := os.Getenv("PAYMENT_AUTHORIZATION_TTL"); v != "" {
+		authorizationTTL, err = time.ParseDuration(v)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	keys, err := newVaultKeys()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	svc := service.NewPaymentService(dbConn)
-	h := handler.NewPaymentHandler(svc)
+	h := handler.NewPaymentHandler(svc, providers, vault.New(keys), authorizationTTL)
+
+	go h.ExpireAuthorizations(context.Background(), time.Minute)
+	go h.PurgeIdempotencyKeys(context.Background(), time.Hour)
 
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			interceptor.RecoveryUnaryInterceptor(),
 			interceptor.LoggingUnaryInterceptor(),
+			interceptor.ContextUnaryServerInterceptor(),
 		),
 	)
 	paymentpb.RegisterPaymentServiceServer(grpcServer, h)
@@ -41,3 +68,34 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// newProviders sets up the provider for each payment method. Cards go to the
+// offline simulator until a real gateway is registered; PAYMENT_SIMULATOR_OUTCOME
+// (approve, decline or timeout) overrides its default outcome.
+func newProviders() (*provider.Registry, error) {
+	cfg := provider.DefaultSimulatorConfig()
+	if v := os.Getenv("PAYMENT_SIMULATOR_OUTCOME"); v != "" {
+		outcome, err := provider.ParseOutcome(v)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Default = outcome
+	}
+
+	providers := provider.NewRegistry()
+	providers.Register(provider.NewCash(), "cash")
+	providers.Register(provider.NewBankTransfer(), "bank_transfer")
+	providers.Register(provider.NewSimulator(cfg), "credit_card", "debit_card")
+	return providers, nil
+}
+
+// newVaultKeys loads the card vault keys from PAYMENT_VAULT_KEY_FILE. Without
+// one a random key is used, and cards tokenized before a restart can no
+// longer be charged.
+func newVaultKeys() (vault.KeyProvider, error) {
+	if path := os.Getenv("PAYMENT_VAULT_KEY_FILE"); path != "" {
+		return vault.NewFileKeyProvider(path)
+	}
+	log.Println("payment: PAYMENT_VAULT_KEY_FILE not set; card tokens will not survive a restart")
+	return vault.NewEphemeralKeyProvider()
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"time"
+)
+
+// CardToken is a card saved in the vault. Payments refer to the card by
+// Token only; the PAN is kept sealed under the vault key KeyID.
+type CardToken struct {
+	Token         string
+	ShopID        string
+	KeyID         string
+	PANCiphertext []byte
+	Brand         string
+	Last4         string
+	Holder        string
+	ExpiryMonth   string
+	ExpiryYear    string
+	CreatedAt     time.Time
+}
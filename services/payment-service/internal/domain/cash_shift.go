@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"time"
+)
+
+// PaymentMethodCash is the payment method taken into a cash drawer.
+const PaymentMethodCash = "cash"
+
+// A cash shift is open from when its float goes into the drawer until the
+// drawer is counted and the shift closed.
+const (
+	CashShiftStatusOpen   = "open"
+	CashShiftStatusClosed = "closed"
+)
+
+// Cash movements, other than sales and refunds, in and out of a drawer.
+const (
+	CashMovementIn  = "cash_in"
+	CashMovementOut = "cash_out"
+)
+
+// Cash shift reports: an X-report reads a shift mid-way and leaves it open, a
+// Z-report is the one it was closed with.
+const (
+	CashReportX = "X"
+	CashReportZ = "Z"
+)
+
+// CashShift is one staff member's session on a cash drawer in one currency.
+type CashShift struct {
+	ID           string
+	ShopID       string
+	OpenedBy     string
+	ClosedBy     string
+	Currency     string
+	Status       string
+	OpeningFloat float64
+	// ExpectedAmount, CountedAmount and Variance are set when the shift is
+	// closed.
+	ExpectedAmount float64
+	CountedAmount  float64
+	Variance       float64
+	Note           string
+	OpenedAt       time.Time
+	ClosedAt       *time.Time
+}
+
+type CashMovement struct {
+	ID        string
+	ShiftID   string
+	Kind      string
+	Amount    float64
+	Reason    string
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// CashShiftTotals adds up what went in and out of a shift's drawer.
+type CashShiftTotals struct {
+	SalesCount   int
+	Sales        float64
+	RefundsCount int
+	Refunds      float64
+	CashIn       float64
+	CashOut      float64
+}
+
+// Expected returns the cash that should be in the drawer of a shift opened
+// with openingFloat in currency.
+func (t *CashShiftTotals) Expected(openingFloat float64, currency string) float64 {
+	return RoundAmount(openingFloat+t.Sales-t.Refunds+t.CashIn-t.CashOut, currency)
+}
@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"time"
+)
+
+// IdempotencyKey remembers the first request made with a client's key, so a
+// retry gets the original response instead of being processed again.
+type IdempotencyKey struct {
+	Operation   string
+	Key         string
+	RequestHash string
+	// Response is the marshalled response, or nil while the first request
+	// is still in flight.
+	Response  []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
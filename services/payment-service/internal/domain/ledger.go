@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"time"
+)
+
+// Ledger accounts. Debits increase provider_clearing, refunds and
+// processing_fees; credits increase sales and store_credit.
+const (
+	// AccountProviderClearing is money taken through a provider or the till
+	// and not yet paid out.
+	AccountProviderClearing = "provider_clearing"
+	AccountSales            = "sales"
+	AccountRefunds          = "refunds"
+	AccountProcessingFees   = "processing_fees"
+	// AccountStoreCredit is store credit owed to customers.
+	AccountStoreCredit = "store_credit"
+)
+
+// Ledger transaction kinds, each posted at most once per reference.
+const (
+	LedgerKindCapture           = "capture"
+	LedgerKindRefund            = "refund"
+	LedgerKindStoreCreditIssue  = "store_credit_issue"
+	LedgerKindStoreCreditRedeem = "store_credit_redeem"
+	// LedgerKindStoreCreditRestore puts a redemption back on its store
+	// credit, i.e. refunds a store credit tender.
+	LedgerKindStoreCreditRestore = "store_credit_restore"
+)
+
+type LedgerTransaction struct {
+	ID          string
+	ShopID      string
+	PaymentID   string
+	Kind        string
+	Reference   string
+	Currency    string
+	Description string
+	Lines       []LedgerLine
+	CreatedAt   time.Time
+}
+
+type LedgerLine struct {
+	Account string
+	Debit   float64
+	Credit  float64
+}
+
+// Balanced reports whether the transaction has lines and its debits equal its
+// credits to the minor unit of its currency.
+func (t *LedgerTransaction) Balanced() bool {
+	if len(t.Lines) == 0 {
+		return false
+	}
+	var debit, credit int64
+	for _, l := range t.Lines {
+		if l.Debit < 0 || l.Credit < 0 || (l.Debit == 0) == (l.Credit == 0) {
+			return false
+		}
+		debit += MinorUnits(l.Debit, t.Currency)
+		credit += MinorUnits(l.Credit, t.Currency)
+	}
+	return debit == credit
+}
+
+// Transfer returns the two lines moving amount from the credited account to
+// the debited one.
+func Transfer(debitAccount, creditAccount string, amount float64) []LedgerLine {
+	return []LedgerLine{
+		{Account: debitAccount, Debit: amount},
+		{Account: creditAccount, Credit: amount},
+	}
+}
+
+type AccountBalance struct {
+	Account  string
+	Currency string
+	Debit    float64
+	Credit   float64
+}
+
+// PaymentDiscrepancy is a payment whose ledger entries of Kind add up to
+// Recorded where the payments row says Expected.
+type PaymentDiscrepancy struct {
+	PaymentID string
+	Kind      string
+	Expected  float64
+	Recorded  float64
+}
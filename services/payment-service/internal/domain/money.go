@@ -0,0 +1,27 @@
+package domain
+
+import "hpkg/money"
+
+// DefaultCurrency is the currency of amounts recorded without one.
+const DefaultCurrency = "USD"
+
+// MinorUnits returns amount, in major units of currency, as a whole number of
+// the currency's minor units, e.g. 10.5 USD is 1050. Amounts recorded in a
+// currency the money package does not know are read as DefaultCurrency.
+func MinorUnits(amount float64, currency string) int64 {
+	return toMoney(amount, currency).Amount
+}
+
+// RoundAmount rounds amount to the minor unit of currency, e.g. to the cent
+// for USD and to the whole dong for VND.
+func RoundAmount(amount float64, currency string) float64 {
+	return toMoney(amount, currency).Float()
+}
+
+func toMoney(amount float64, currency string) money.Money {
+	m, err := money.FromFloat(amount, currency)
+	if err != nil {
+		m, _ = money.FromFloat(amount, DefaultCurrency)
+	}
+	return m
+}
@@ -1,21 +1,87 @@
 package domain
 
 import (
+	"errors"
 	"time"
 )
 
+// A payment is either taken in one go (pending or straight to completed) or
+// authorized first and then captured (completed), voided or left to expire.
+// A completed payment can be refunded in parts (partially_refunded) until all
+// of it has been given back (refunded).
+const (
+	PaymentStatusPending           = "pending"
+	PaymentStatusAuthorized        = "authorized"
+	PaymentStatusCompleted         = "completed"
+	PaymentStatusFailed            = "failed"
+	PaymentStatusVoided            = "voided"
+	PaymentStatusExpired           = "expired"
+	PaymentStatusPartiallyRefunded = "partially_refunded"
+	PaymentStatusRefunded          = "refunded"
+)
+
+// ErrInvalidTransition is returned for a status change CanTransition does not
+// allow.
+var ErrInvalidTransition = errors.New("invalid payment status transition")
+
+// paymentTransitions lists the statuses each status may move on to.
+var paymentTransitions = map[string][]string{
+	PaymentStatusPending: {
+		PaymentStatusAuthorized, PaymentStatusCompleted, PaymentStatusFailed,
+		PaymentStatusVoided, PaymentStatusExpired,
+	},
+	PaymentStatusAuthorized: {
+		PaymentStatusCompleted, PaymentStatusFailed, PaymentStatusVoided, PaymentStatusExpired,
+	},
+	PaymentStatusCompleted:         {PaymentStatusPartiallyRefunded, PaymentStatusRefunded},
+	PaymentStatusPartiallyRefunded: {PaymentStatusRefunded},
+}
+
+// CanTransition reports whether a payment may move from status from to
+// status to. Failed, voided, expired and refunded payments are final.
+func CanTransition(from, to string) bool {
+	for _, next := range paymentTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCaptured reports whether a payment in status has had its money taken.
+func IsCaptured(status string) bool {
+	return status == PaymentStatusCompleted || IsRefundable(status) || status == PaymentStatusRefunded
+}
+
+// IsRefundable reports whether a payment in status has money left to refund.
+func IsRefundable(status string) bool {
+	return status == PaymentStatusCompleted || status == PaymentStatusPartiallyRefunded
+}
+
 type Payment struct {
 	ID            string
+	ShopID        string
 	OrderID       string
 	UserID        string
 	Amount        float64
 	Currency      string
 	PaymentMethod string
+	Provider      string
 	Status        string
 	TransactionID string
 	ReferenceID   string
 	ProcessingFee float64
+	Refunded      float64
 	ErrorMessage  string
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	// AuthorizedAmount is what was held for a two-phase payment; Amount
+	// becomes the captured amount once it is captured.
+	AuthorizedAmount float64
+	AuthorizedAt     *time.Time
+	ExpiresAt        *time.Time
+	CapturedAt       *time.Time
+	VoidedAt         *time.Time
+	StatusReason     string
+	ShiftID          string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
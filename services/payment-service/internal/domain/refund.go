@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	RefundStatusPending   = "pending"
+	RefundStatusCompleted = "completed"
+	RefundStatusFailed    = "failed"
+)
+
+// Refund reason codes, for reporting on why money was given back.
+const (
+	RefundReasonCustomerRequest = "customer_request"
+	RefundReasonDuplicate       = "duplicate"
+	RefundReasonFraudulent      = "fraudulent"
+	RefundReasonOrderCancelled  = "order_cancelled"
+	RefundReasonItemReturned    = "item_returned"
+	RefundReasonCheckoutFailed  = "checkout_failed"
+	RefundReasonOther           = "other"
+)
+
+// IsRefundReason reports whether code is a known refund reason code.
+func IsRefundReason(code string) bool {
+	switch code {
+	case RefundReasonCustomerRequest, RefundReasonDuplicate, RefundReasonFraudulent,
+		RefundReasonOrderCancelled, RefundReasonItemReturned, RefundReasonCheckoutFailed,
+		RefundReasonOther:
+		return true
+	}
+	return false
+}
+
+// Refund is one amount given back of a payment. A pending refund already
+// counts towards the payment's Refunded, so concurrent refunds cannot give
+// back more than was captured.
+type Refund struct {
+	ID            string
+	PaymentID     string
+	Amount        float64
+	ReasonCode    string
+	Reason        string
+	Status        string
+	TransactionID string
+	ErrorMessage  string
+	CreatedBy     string
+	ShiftID       string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
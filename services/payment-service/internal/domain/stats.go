@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+)
+
+// Reporting periods for payment statistics.
+const (
+	StatsPeriodDaily   = "daily"
+	StatsPeriodWeekly  = "weekly"
+	StatsPeriodMonthly = "monthly"
+)
+
+// PaymentStatsRow totals the payments of one period that share a currency,
+// method and status.
+type PaymentStatsRow struct {
+	PeriodStart  time.Time
+	Currency     string
+	Method       string
+	Status       string
+	Transactions int
+	Amount       float64
+	Refunded     float64
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// Outcomes of a webhook event.
+const (
+	// WebhookOutcomeApplied: the event moved its payment to a new status.
+	WebhookOutcomeApplied = "applied"
+	// WebhookOutcomeIgnored: the payment was already in the event's status,
+	// or the event type is not one that changes a payment.
+	WebhookOutcomeIgnored = "ignored"
+	// WebhookOutcomeRejected: the event asked for a transition the payment's
+	// status does not allow, e.g. failing a completed payment.
+	WebhookOutcomeRejected = "rejected"
+)
+
+// webhookStatuses maps the event types providers send to the payment status
+// each one reports.
+var webhookStatuses = map[string]string{
+	"payment.authorized": PaymentStatusAuthorized,
+	"payment.captured":   PaymentStatusCompleted,
+	"payment.failed":     PaymentStatusFailed,
+	"payment.voided":     PaymentStatusVoided,
+	"payment.expired":    PaymentStatusExpired,
+}
+
+// WebhookStatus returns the payment status an event type reports.
+func WebhookStatus(eventType string) (string, bool) {
+	status, ok := webhookStatuses[eventType]
+	return status, ok
+}
+
+// WebhookEvent is a provider callback as it was received.
+type WebhookEvent struct {
+	Provider   string
+	EventID    string
+	EventType  string
+	PaymentID  string
+	Outcome    string
+	OccurredAt *time.Time
+	ReceivedAt time.Time
+}
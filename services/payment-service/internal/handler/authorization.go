@@ -0,0 +1,300 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"log"
+	"paymentservice/internal/domain"
+	"paymentservice/internal/provider"
+	paymentpb "paymentservice/proto/paymentpb"
+	"time"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxOvercaptureRate is how far a capture may exceed its authorization, to
+// cover a tip added at fulfilment.
+const maxOvercaptureRate = 0.20
+
+// AuthorizePayment holds funds for an order without taking them. The hold is
+// settled by CapturePayment or released by VoidPayment, and expires after the
+// handler's authorization TTL.
+func (h *PaymentHandler) AuthorizePayment(
+	ctx context.Context,
+	req *paymentpb.AuthorizePaymentRequest,
+) (*paymentpb.AuthorizePaymentResponse, error) {
+
+	if req.OrderId == "" || req.PaymentMethod == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.PaymentInvalidCode, errors.PaymentInvalidMsg)
+	}
+	charge, err := chargeAmount(req.Amount, req.Currency, req.AmountMoney)
+	if err != nil {
+		return nil, err
+	}
+	amount, currency := charge.Float(), charge.Currency
+	card, err := h.paymentCard(ctx, req.PaymentMethod, req.CardToken)
+	if err != nil {
+		return nil, err
+	}
+	prov, err := h.providers.ForMethod(req.PaymentMethod)
+	if err != nil {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.PaymentMethodUnsupportedCode, errors.PaymentMethodUnsupportedMsg)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(h.authorizationTTL)
+	shopID, _ := reqCtx.MustGetShopID(ctx)
+	p := &domain.Payment{
+		ShopID:           shopID,
+		OrderID:          req.OrderId,
+		UserID:           req.UserId,
+		Amount:           amount,
+		AuthorizedAmount: amount,
+		Currency:         currency,
+		PaymentMethod:    req.PaymentMethod,
+		Provider:         prov.Name(),
+	}
+	if p.PaymentMethod == domain.PaymentMethodCash {
+		p.ShiftID = h.openCashShiftID(ctx, shopID, currency)
+	}
+	message := "payment authorized"
+
+	auth, authErr := prov.Authorize(ctx, &provider.AuthorizeRequest{
+		OrderID:       req.OrderId,
+		Amount:        amount,
+		Currency:      currency,
+		PaymentMethod: req.PaymentMethod,
+		Card:          card,
+	})
+	switch {
+	case authErr != nil:
+		p.Status = domain.PaymentStatusFailed
+		p.TransactionID = newReference("txn_")
+		p.ErrorMessage = authErr.Error()
+	case auth.Status == provider.StatusDeclined:
+		p.Status = domain.PaymentStatusFailed
+		p.TransactionID = auth.TransactionID
+		p.ReferenceID = auth.Reference
+		p.ErrorMessage = auth.Message
+		message = "payment declined"
+	default:
+		p.Status = domain.PaymentStatusAuthorized
+		if auth.Status == provider.StatusPending {
+			p.Status = domain.PaymentStatusPending
+			message = auth.Message
+		}
+		p.TransactionID = auth.TransactionID
+		p.ReferenceID = auth.Reference
+		p.AuthorizedAt = &now
+		p.ExpiresAt = &expiresAt
+	}
+
+	payment, err := h.svc.Create(ctx, p)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.PaymentCreateFailedCode, errors.PaymentCreateFailedMsg)
+	}
+	if authErr != nil {
+		return nil, providerError(authErr)
+	}
+
+	resp := &paymentpb.AuthorizePaymentResponse{
+		PaymentId:     payment.ID,
+		OrderId:       payment.OrderID,
+		TransactionId: payment.TransactionID,
+		Status:        payment.Status,
+		Amount:        payment.Amount,
+		AmountMoney:   amountMoney(payment.Amount, payment.Currency),
+		Message:       message,
+	}
+	if payment.ExpiresAt != nil {
+		resp.ExpiresAt = timestamppb.New(*payment.ExpiresAt)
+	}
+	return resp, nil
+}
+
+// CapturePayment takes an authorized payment, for the authorized amount or a
+// different one: less for a partial fulfilment, or somewhat more for a tip.
+// A pending bank transfer is captured once the money has arrived.
+func (h *PaymentHandler) CapturePayment(
+	ctx context.Context,
+	req *paymentpb.CapturePaymentRequest,
+) (*paymentpb.CapturePaymentResponse, error) {
+
+	if req.Amount < 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.PaymentInvalidCode, errors.PaymentInvalidMsg)
+	}
+
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := h.svc.GetShopPayment(ctx, shopID, req.PaymentId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.PaymentNotFoundCode, errors.PaymentNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	if p.Status != domain.PaymentStatusAuthorized && p.Status != domain.PaymentStatusPending {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentInvalidTransitionCode, errors.PaymentInvalidTransitionMsg)
+	}
+	if p.ExpiresAt != nil && !p.ExpiresAt.After(time.Now()) {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentAuthorizationExpiredCode, errors.PaymentAuthorizationExpiredMsg)
+	}
+
+	authorized := p.AuthorizedAmount
+	if authorized == 0 {
+		authorized = p.Amount
+	}
+	amount := req.Amount
+	if amount == 0 {
+		amount = authorized
+	}
+	amount = domain.RoundAmount(amount, p.Currency)
+	if amount > domain.RoundAmount(authorized*(1+maxOvercaptureRate), p.Currency) {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.PaymentCaptureExceedsAuthorizationCode, errors.PaymentCaptureExceedsAuthorizationMsg)
+	}
+
+	transactionID := p.TransactionID
+	var fee float64
+	prov, err := h.providerFor(p)
+	if err != nil {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentMethodUnsupportedCode, errors.PaymentMethodUnsupportedMsg)
+	}
+	if prov != nil {
+		res, err := prov.Capture(ctx, p.ReferenceID, amount)
+		if err != nil {
+			return nil, transitionError(err)
+		}
+		if res.Status == provider.StatusDeclined {
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentDeclinedCode, errors.PaymentDeclinedMsg)
+		}
+		transactionID = res.TransactionID
+		fee = res.ProcessingFee
+	}
+
+	captured, err := h.svc.Capture(ctx, p.ID, amount, fee, transactionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// Voided or expired while the provider was capturing.
+			log.Printf("payment: captured %s at provider but payment is no longer open", p.ID)
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentInvalidTransitionCode, errors.PaymentInvalidTransitionMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+	h.postCapture(ctx, captured)
+
+	return &paymentpb.CapturePaymentResponse{
+		PaymentId:        captured.ID,
+		TransactionId:    captured.TransactionID,
+		Status:           captured.Status,
+		AuthorizedAmount: authorized,
+		Amount:           captured.Amount,
+		ProcessingFee:    captured.ProcessingFee,
+		Message:          "payment captured",
+	}, nil
+}
+
+// VoidPayment releases an authorization that will not be captured.
+func (h *PaymentHandler) VoidPayment(
+	ctx context.Context,
+	req *paymentpb.VoidPaymentRequest,
+) (*paymentpb.VoidPaymentResponse, error) {
+
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := h.svc.GetShopPayment(ctx, shopID, req.PaymentId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.PaymentNotFoundCode, errors.PaymentNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	if p.Status != domain.PaymentStatusAuthorized && p.Status != domain.PaymentStatusPending {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentInvalidTransitionCode, errors.PaymentInvalidTransitionMsg)
+	}
+
+	prov, err := h.providerFor(p)
+	if err != nil {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentMethodUnsupportedCode, errors.PaymentMethodUnsupportedMsg)
+	}
+	if prov != nil {
+		if _, err := prov.Void(ctx, p.ReferenceID); err != nil {
+			return nil, transitionError(err)
+		}
+	}
+
+	voided, err := h.svc.Void(ctx, p.ID, req.Reason)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentInvalidTransitionCode, errors.PaymentInvalidTransitionMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	return &paymentpb.VoidPaymentResponse{
+		PaymentId: voided.ID,
+		Status:    voided.Status,
+		Message:   "payment voided",
+	}, nil
+}
+
+// ExpireAuthorizations periodically expires authorizations that were neither
+// captured nor voided in time, releasing them at their provider. It blocks
+// until ctx is cancelled.
+func (h *PaymentHandler) ExpireAuthorizations(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Drain in batches so a backlog clears within one tick.
+			for {
+				expired, err := h.svc.ExpireAuthorizations(ctx, 100)
+				if err != nil {
+					log.Printf("payment: failed to expire authorizations: %v", err)
+					break
+				}
+				for _, p := range expired {
+					h.releaseExpired(ctx, p)
+				}
+				if len(expired) < 100 {
+					break
+				}
+			}
+		}
+	}
+}
+
+// releaseExpired voids an expired authorization at its provider. The payment
+// is already expired either way; a provider failure is only logged.
+func (h *PaymentHandler) releaseExpired(ctx context.Context, p *domain.Payment) {
+	prov, err := h.providerFor(p)
+	if err != nil || prov == nil {
+		return
+	}
+	if _, err := prov.Void(ctx, p.ReferenceID); err != nil {
+		log.Printf("payment: failed to void expired authorization %s: %v", p.ID, err)
+	}
+}
+
+// transitionError maps a provider failure on capture or void, where a state
+// mismatch means the provider has already moved the payment on.
+func transitionError(err error) error {
+	if stderrors.Is(err, provider.ErrInvalidState) || stderrors.Is(err, provider.ErrUnknownReference) {
+		return errors.GRPC(codes.FailedPrecondition, errors.PaymentInvalidTransitionCode, errors.PaymentInvalidTransitionMsg)
+	}
+	return providerError(err)
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strconv"
+	"time"
+
+	"paymentservice/internal/domain"
+	"paymentservice/internal/provider"
+	"paymentservice/internal/vault"
+	paymentpb "paymentservice/proto/paymentpb"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TokenizeCard saves a card in the vault and returns a token to pay with.
+// The card number is stored encrypted; the CVV is checked and discarded.
+func (h *PaymentHandler) TokenizeCard(
+	ctx context.Context,
+	req *paymentpb.TokenizeCardRequest,
+) (*paymentpb.TokenizeCardResponse, error) {
+
+	t, err := h.tokenize(ctx, req.Card)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paymentpb.TokenizeCardResponse{
+		CardToken:   t.Token,
+		Brand:       t.Brand,
+		Last4:       t.Last4,
+		ExpiryMonth: t.ExpiryMonth,
+		ExpiryYear:  t.ExpiryYear,
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+	}, nil
+}
+
+func (h *PaymentHandler) tokenize(ctx context.Context, card *paymentpb.PaymentCard) (*domain.CardToken, error) {
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if card == nil {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.CardInvalidCode, errors.CardInvalidMsg)
+	}
+	pan, month, year, err := h.vault.Validate(card.CardNumber, card.ExpiryMonth, card.ExpiryYear, card.Cvv)
+	if err != nil {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.CardInvalidCode, errors.CardInvalidMsg)
+	}
+
+	token, err := vault.NewToken()
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+	}
+	keyID, sealed, err := h.vault.Seal(ctx, token, pan)
+	if err != nil {
+		log.Printf("payment: failed to seal card: %v", err)
+		return nil, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+	}
+
+	t, err := h.svc.CreateCardToken(ctx, &domain.CardToken{
+		Token:         token,
+		ShopID:        shopID,
+		KeyID:         keyID,
+		PANCiphertext: sealed,
+		Brand:         vault.Brand(pan),
+		Last4:         pan[len(pan)-4:],
+		Holder:        card.CardHolder,
+		ExpiryMonth:   month,
+		ExpiryYear:    year,
+	})
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+	return t, nil
+}
+
+// paymentCard returns the card to charge for a payment made with method.
+// Cards are only charged by token; methods other than cards need none and
+// get nil.
+func (h *PaymentHandler) paymentCard(
+	ctx context.Context,
+	method string,
+	token string,
+) (*provider.Card, error) {
+
+	if method != "credit_card" && method != "debit_card" {
+		return nil, nil
+	}
+	if token == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.CardRequiredCode, errors.CardRequiredMsg)
+	}
+	return h.detokenize(ctx, token)
+}
+
+// detokenize opens the card saved under token. Tokens saved for a shop can
+// only be used by that shop.
+func (h *PaymentHandler) detokenize(ctx context.Context, token string) (*provider.Card, error) {
+	t, err := h.svc.GetCardToken(ctx, token)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.CardTokenNotFoundCode, errors.CardTokenNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if t.ShopID != shopID {
+		return nil, errors.GRPC(codes.NotFound, errors.CardTokenNotFoundCode, errors.CardTokenNotFoundMsg)
+	}
+
+	month, _ := strconv.Atoi(t.ExpiryMonth)
+	year, _ := strconv.Atoi(t.ExpiryYear)
+	if vault.Expired(month, year, time.Now()) {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.CardInvalidCode, errors.CardInvalidMsg)
+	}
+
+	pan, err := h.vault.Open(ctx, t.KeyID, t.Token, t.PANCiphertext)
+	if err != nil {
+		log.Printf("payment: failed to open card token %s: %v", t.Token, err)
+		return nil, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+	}
+
+	return &provider.Card{
+		Token:       t.Token,
+		Number:      pan,
+		Holder:      t.Holder,
+		ExpiryMonth: t.ExpiryMonth,
+		ExpiryYear:  t.ExpiryYear,
+	}, nil
+}
@@ -0,0 +1,376 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"paymentservice/internal/domain"
+	"paymentservice/internal/service"
+	paymentpb "paymentservice/proto/paymentpb"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+	"hpkg/money"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	permCashShiftManage = "PermCashShiftManage"
+	permCashShiftRead   = "PermCashShiftRead"
+
+	defaultCashShiftLimit = 50
+	maxCashShiftLimit     = 200
+)
+
+// OpenCashShift opens a shift on a cash drawer for the caller, starting with
+// the float put in the drawer. A staff member has at most one shift open per
+// currency.
+func (h *PaymentHandler) OpenCashShift(
+	ctx context.Context,
+	req *paymentpb.OpenCashShiftRequest,
+) (*paymentpb.CashShift, error) {
+
+	if !reqCtx.HasPermission(ctx, permCashShiftManage) {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := reqCtx.MustGetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opening, err := cashAmount(req.OpeningFloat, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := h.svc.OpenCashShift(ctx, &domain.CashShift{
+		ShopID:       shopID,
+		OpenedBy:     userID,
+		Currency:     opening.Currency,
+		OpeningFloat: opening.Float(),
+		Note:         req.Note,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.AlreadyExists, errors.CashShiftAlreadyOpenCode, errors.CashShiftAlreadyOpenMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	log.Printf("payment: cash shift %s opened by %s with %.2f %s", s.ID, userID, s.OpeningFloat, s.Currency)
+	return cashShiftProto(s), nil
+}
+
+// RecordCashMovement records cash put into or taken out of an open shift's
+// drawer other than by a sale or refund, e.g. a change top-up or a bank drop.
+func (h *PaymentHandler) RecordCashMovement(
+	ctx context.Context,
+	req *paymentpb.RecordCashMovementRequest,
+) (*paymentpb.CashMovement, error) {
+
+	if !reqCtx.HasPermission(ctx, permCashShiftManage) {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Kind != domain.CashMovementIn && req.Kind != domain.CashMovementOut {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.CashMovementInvalidCode, errors.CashMovementInvalidMsg)
+	}
+
+	s, err := h.cashShift(ctx, shopID, req.ShiftId)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := money.FromFloat(req.Amount, s.Currency)
+	if err != nil || amount.Amount <= 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.CashMovementInvalidCode, errors.CashMovementInvalidMsg)
+	}
+
+	userID, _ := reqCtx.MustGetUserID(ctx)
+	m := &domain.CashMovement{
+		ShiftID:   s.ID,
+		Kind:      req.Kind,
+		Amount:    amount.Float(),
+		Reason:    req.Reason,
+		CreatedBy: userID,
+	}
+	if err := h.svc.AddCashMovement(ctx, m); err != nil {
+		if err == service.ErrCashShiftClosed {
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.CashShiftClosedCode, errors.CashShiftClosedMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	return cashMovementProto(m), nil
+}
+
+// CloseCashShift closes an open shift with the cash counted in its drawer and
+// returns its Z-report. What the drawer should hold and the variance are
+// fixed at this point.
+func (h *PaymentHandler) CloseCashShift(
+	ctx context.Context,
+	req *paymentpb.CloseCashShiftRequest,
+) (*paymentpb.CashShiftReport, error) {
+
+	if !reqCtx.HasPermission(ctx, permCashShiftManage) {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := h.cashShift(ctx, shopID, req.ShiftId)
+	if err != nil {
+		return nil, err
+	}
+	counted, err := cashAmount(req.CountedAmount, s.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, _ := reqCtx.MustGetUserID(ctx)
+	closed, totals, err := h.svc.CloseCashShift(ctx, shopID, s.ID, userID, counted.Float(), req.Note)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, errors.GRPC(codes.NotFound, errors.CashShiftNotFoundCode, errors.CashShiftNotFoundMsg)
+		case service.ErrCashShiftClosed:
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.CashShiftClosedCode, errors.CashShiftClosedMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	log.Printf("payment: cash shift %s closed by %s, expected %.2f counted %.2f %s",
+		closed.ID, userID, closed.ExpectedAmount, closed.CountedAmount, closed.Currency)
+	return h.cashShiftReport(ctx, closed, totals)
+}
+
+// GetCashShiftReport returns an X-report of an open shift, leaving it open,
+// or the Z-report of a closed one. Staff may read their own shifts; other
+// shifts need PermCashShiftRead.
+func (h *PaymentHandler) GetCashShiftReport(
+	ctx context.Context,
+	req *paymentpb.GetCashShiftReportRequest,
+) (*paymentpb.CashShiftReport, error) {
+
+	canRead := reqCtx.HasPermission(ctx, permCashShiftRead)
+	if !canRead && !reqCtx.HasPermission(ctx, permCashShiftManage) {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := reqCtx.MustGetUserID(ctx)
+
+	var s *domain.CashShift
+	if req.ShiftId != "" {
+		s, err = h.cashShift(ctx, shopID, req.ShiftId)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		currency, err := cashCurrency(req.Currency)
+		if err != nil {
+			return nil, err
+		}
+		s, err = h.svc.GetOpenCashShift(ctx, shopID, userID, currency)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.GRPC(codes.NotFound, errors.CashShiftNotFoundCode, errors.CashShiftNotFoundMsg)
+			}
+			return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+		}
+	}
+	if !canRead && s.OpenedBy != userID {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+
+	totals, err := h.svc.CashShiftTotals(ctx, s.ID)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+	return h.cashShiftReport(ctx, s, totals)
+}
+
+// ListCashShifts returns the shop's most recently opened shifts.
+func (h *PaymentHandler) ListCashShifts(
+	ctx context.Context,
+	req *paymentpb.ListCashShiftsRequest,
+) (*paymentpb.ListCashShiftsResponse, error) {
+
+	if !reqCtx.HasPermission(ctx, permCashShiftRead) {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Status {
+	case "", domain.CashShiftStatusOpen, domain.CashShiftStatusClosed:
+	default:
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultCashShiftLimit
+	}
+	if limit > maxCashShiftLimit {
+		limit = maxCashShiftLimit
+	}
+
+	shifts, err := h.svc.ListCashShifts(ctx, shopID, req.Status, limit)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	resp := &paymentpb.ListCashShiftsResponse{
+		Shifts: make([]*paymentpb.CashShift, 0, len(shifts)),
+	}
+	for _, s := range shifts {
+		resp.Shifts = append(resp.Shifts, cashShiftProto(s))
+	}
+	return resp, nil
+}
+
+// openCashShiftID returns the shift the caller has open in currency, which a
+// cash payment or refund they take counts towards, or "" when they have none.
+func (h *PaymentHandler) openCashShiftID(ctx context.Context, shopID, currency string) string {
+	userID, err := reqCtx.MustGetUserID(ctx)
+	if err != nil || shopID == "" {
+		return ""
+	}
+	s, err := h.svc.GetOpenCashShift(ctx, shopID, userID, currency)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.Printf("payment: failed to look up open cash shift of %s: %v", userID, err)
+		}
+		return ""
+	}
+	return s.ID
+}
+
+// cashShift returns one of the shop's shifts as a gRPC error when it cannot.
+func (h *PaymentHandler) cashShift(ctx context.Context, shopID, id string) (*domain.CashShift, error) {
+	if id == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+	s, err := h.svc.GetCashShift(ctx, shopID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.CashShiftNotFoundCode, errors.CashShiftNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+	return s, nil
+}
+
+func (h *PaymentHandler) cashShiftReport(
+	ctx context.Context,
+	s *domain.CashShift,
+	totals *domain.CashShiftTotals,
+) (*paymentpb.CashShiftReport, error) {
+
+	movements, err := h.svc.ListCashMovements(ctx, s.ID)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	report := &paymentpb.CashShiftReport{
+		ReportType:       domain.CashReportX,
+		Shift:            cashShiftProto(s),
+		CashSalesCount:   int32(totals.SalesCount),
+		CashSales:        roundCents(totals.Sales),
+		CashRefundsCount: int32(totals.RefundsCount),
+		CashRefunds:      roundCents(totals.Refunds),
+		CashIn:           roundCents(totals.CashIn),
+		CashOut:          roundCents(totals.CashOut),
+		ExpectedAmount:   totals.Expected(s.OpeningFloat, s.Currency),
+		Movements:        make([]*paymentpb.CashMovement, 0, len(movements)),
+		GeneratedAt:      timestamppb.New(time.Now()),
+	}
+	// A Z-report stands as the shift was counted, whatever is recorded
+	// against the shift afterwards.
+	if s.Status == domain.CashShiftStatusClosed {
+		report.ReportType = domain.CashReportZ
+		report.ExpectedAmount = s.ExpectedAmount
+		report.CountedAmount = s.CountedAmount
+		report.Variance = s.Variance
+	}
+	for _, m := range movements {
+		report.Movements = append(report.Movements, cashMovementProto(m))
+	}
+	return report, nil
+}
+
+// cashCurrency returns a drawer's currency in its canonical form, USD when
+// none is given.
+func cashCurrency(code string) (string, error) {
+	if code == "" {
+		return defaultCurrency, nil
+	}
+	c, err := money.Lookup(code)
+	if err != nil {
+		return "", errors.GRPC(codes.InvalidArgument, errors.CurrencyUnsupportedCode, errors.CurrencyUnsupportedMsg)
+	}
+	return c.Code, nil
+}
+
+// cashAmount returns an amount of cash counted or put in a drawer, which may
+// be zero but not negative.
+func cashAmount(amount float64, currency string) (money.Money, error) {
+	code, err := cashCurrency(currency)
+	if err != nil {
+		return money.Money{}, err
+	}
+	m, err := money.FromFloat(amount, code)
+	if err != nil || m.Amount < 0 {
+		return money.Money{}, errors.GRPC(codes.InvalidArgument, errors.CashShiftInvalidCode, errors.CashShiftInvalidMsg)
+	}
+	return m, nil
+}
+
+func cashShiftProto(s *domain.CashShift) *paymentpb.CashShift {
+	out := &paymentpb.CashShift{
+		Id:             s.ID,
+		OpenedBy:       s.OpenedBy,
+		ClosedBy:       s.ClosedBy,
+		Currency:       s.Currency,
+		Status:         s.Status,
+		OpeningFloat:   s.OpeningFloat,
+		ExpectedAmount: s.ExpectedAmount,
+		CountedAmount:  s.CountedAmount,
+		Variance:       s.Variance,
+		Note:           s.Note,
+		OpenedAt:       timestamppb.New(s.OpenedAt),
+	}
+	if s.ClosedAt != nil {
+		out.ClosedAt = timestamppb.New(*s.ClosedAt)
+	}
+	return out
+}
+
+func cashMovementProto(m *domain.CashMovement) *paymentpb.CashMovement {
+	return &paymentpb.CashMovement{
+		Id:        m.ID,
+		ShiftId:   m.ShiftID,
+		Kind:      m.Kind,
+		Amount:    m.Amount,
+		Reason:    m.Reason,
+		CreatedBy: m.CreatedBy,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotencyKeyTTL is how long a response is kept for replay.
+const idempotencyKeyTTL = 24 * time.Hour
+
+// beginIdempotent claims the call's idempotency key for operation. It returns
+// the key, "" when the caller sent none, and whether resp was filled in from
+// an earlier request made with the same key and payload.
+func (h *PaymentHandler) beginIdempotent(
+	ctx context.Context,
+	operation string,
+	req proto.Message,
+	resp proto.Message,
+) (string, bool, error) {
+
+	key := reqCtx.GetIdempotencyKey(ctx)
+	if key == "" {
+		return "", false, nil
+	}
+	if len(key) > reqCtx.MaxIdempotencyKeyLength {
+		return "", false, errors.GRPC(codes.InvalidArgument, errors.IdempotencyKeyInvalidCode, errors.IdempotencyKeyInvalidMsg)
+	}
+
+	hash, err := reqCtx.RequestHash(req)
+	if err != nil {
+		return "", false, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+	}
+
+	existing, claimed, err := h.svc.ClaimIdempotencyKey(ctx, operation, key, hash, idempotencyKeyTTL)
+	switch {
+	case err == sql.ErrNoRows:
+		// The first request failed and released the key while we looked.
+		return "", false, errors.GRPC(codes.Aborted, errors.IdempotencyKeyInProgressCode, errors.IdempotencyKeyInProgressMsg)
+	case err != nil:
+		return "", false, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	case claimed:
+		return key, false, nil
+	case existing.RequestHash != hash:
+		return "", false, errors.GRPC(codes.FailedPrecondition, errors.IdempotencyKeyMismatchCode, errors.IdempotencyKeyMismatchMsg)
+	case existing.Response == nil:
+		return "", false, errors.GRPC(codes.Aborted, errors.IdempotencyKeyInProgressCode, errors.IdempotencyKeyInProgressMsg)
+	}
+
+	if err := proto.Unmarshal(existing.Response, resp); err != nil {
+		return "", false, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+	}
+	return key, true, nil
+}
+
+// finishIdempotent stores resp for replay under key. When the request failed
+// the key is released instead, so the client can retry with it.
+func (h *PaymentHandler) finishIdempotent(
+	ctx context.Context,
+	operation string,
+	key string,
+	resp proto.Message,
+	callErr error,
+) {
+	if key == "" {
+		return
+	}
+	// The client may have given up waiting; the outcome must be kept anyway.
+	ctx = context.WithoutCancel(ctx)
+
+	if callErr != nil {
+		if err := h.svc.ReleaseIdempotencyKey(ctx, operation, key); err != nil {
+			log.Printf("payment: failed to release idempotency key %s: %v", key, err)
+		}
+		return
+	}
+
+	b, err := proto.Marshal(resp)
+	if err == nil {
+		err = h.svc.SaveIdempotentResponse(ctx, operation, key, b)
+	}
+	if err != nil {
+		log.Printf("payment: failed to save response for idempotency key %s: %v", key, err)
+	}
+}
+
+// PurgeIdempotencyKeys periodically deletes expired idempotency keys. It
+// blocks until ctx is cancelled.
+func (h *PaymentHandler) PurgeIdempotencyKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := h.svc.PurgeIdempotencyKeys(ctx); err != nil {
+				log.Printf("payment: failed to purge idempotency keys: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"paymentservice/internal/domain"
+	paymentpb "paymentservice/proto/paymentpb"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+	"hpkg/money"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// reconcileWindow is the period ReconcilePayments checks by default.
+const reconcileWindow = 24 * time.Hour
+
+// storeCreditLines maps a store credit movement kind to its ledger kind and
+// the accounts it debits and credits.
+var storeCreditLines = map[string]struct {
+	kind          string
+	debit, credit string
+}{
+	"issue":   {domain.LedgerKindStoreCreditIssue, domain.AccountRefunds, domain.AccountStoreCredit},
+	"redeem":  {domain.LedgerKindStoreCreditRedeem, domain.AccountStoreCredit, domain.AccountSales},
+	"restore": {domain.LedgerKindStoreCreditRestore, domain.AccountRefunds, domain.AccountStoreCredit},
+}
+
+// RecordStoreCreditMovement posts a store credit issued, redeemed or restored
+// by the order service. Store credit never passes through a provider, so this
+// is the only way it reaches the ledger.
+func (h *PaymentHandler) RecordStoreCreditMovement(
+	ctx context.Context,
+	req *paymentpb.RecordStoreCreditMovementRequest,
+) (*paymentpb.RecordStoreCreditMovementResponse, error) {
+
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := req.Currency
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	movement, ok := storeCreditLines[req.Kind]
+	amount, err := money.FromFloat(req.Amount, currency)
+	if !ok || req.ReferenceId == "" || err != nil || amount.Amount <= 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.LedgerMovementInvalidCode, errors.LedgerMovementInvalidMsg)
+	}
+
+	description := "store credit " + req.Kind
+	if req.OrderId != "" {
+		description += " for order " + req.OrderId
+	}
+
+	t, err := h.svc.PostLedgerTransaction(ctx, &domain.LedgerTransaction{
+		ShopID:      shopID,
+		Kind:        movement.kind,
+		Reference:   req.ReferenceId,
+		Currency:    amount.Currency,
+		Description: description,
+		Lines:       domain.Transfer(movement.debit, movement.credit, amount.Float()),
+	})
+	if err != nil {
+		log.Printf("payment: failed to post store credit %s %s: %v", req.Kind, req.ReferenceId, err)
+		return nil, errors.GRPC(codes.Internal, errors.LedgerPostFailedCode, errors.LedgerPostFailedMsg)
+	}
+
+	resp := &paymentpb.RecordStoreCreditMovementResponse{TransactionId: t.ID}
+	for _, l := range t.Lines {
+		resp.Lines = append(resp.Lines, &paymentpb.LedgerLine{
+			Account: l.Account,
+			Debit:   l.Debit,
+			Credit:  l.Credit,
+		})
+	}
+	return resp, nil
+}
+
+// GetShopBalance totals the caller's shop ledger by account and currency.
+func (h *PaymentHandler) GetShopBalance(
+	ctx context.Context,
+	req *paymentpb.GetShopBalanceRequest,
+) (*paymentpb.GetShopBalanceResponse, error) {
+
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := time.Now()
+	if req.AsOf != nil {
+		asOf = req.AsOf.AsTime()
+	}
+
+	balances, err := h.svc.ShopBalance(ctx, shopID, asOf)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	resp := &paymentpb.GetShopBalanceResponse{AsOf: timestamppb.New(asOf)}
+	for _, b := range balances {
+		resp.Balances = append(resp.Balances, &paymentpb.AccountBalance{
+			Account:  b.Account,
+			Currency: b.Currency,
+			Debit:    b.Debit,
+			Credit:   b.Credit,
+			Balance:  domain.RoundAmount(b.Debit-b.Credit, b.Currency),
+		})
+	}
+	return resp, nil
+}
+
+// ReconcilePayments flags the caller's shop payments whose captured amount,
+// fee or refunds do not match their ledger entries, e.g. because posting
+// failed after the payment was updated.
+func (h *PaymentHandler) ReconcilePayments(
+	ctx context.Context,
+	req *paymentpb.ReconcilePaymentsRequest,
+) (*paymentpb.ReconcilePaymentsResponse, error) {
+
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	from := to.Add(-reconcileWindow)
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if !from.Before(to) {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.LedgerPeriodInvalidCode, errors.LedgerPeriodInvalidMsg)
+	}
+
+	checked, discrepancies, err := h.svc.Reconcile(ctx, shopID, from, to)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	resp := &paymentpb.ReconcilePaymentsResponse{Checked: int32(checked)}
+	for _, d := range discrepancies {
+		resp.Discrepancies = append(resp.Discrepancies, &paymentpb.PaymentDiscrepancy{
+			PaymentId: d.PaymentID,
+			Kind:      d.Kind,
+			Expected:  d.Expected,
+			Recorded:  d.Recorded,
+		})
+	}
+	return resp, nil
+}
+
+// postCapture records the money taken for a payment and the provider's fee.
+func (h *PaymentHandler) postCapture(ctx context.Context, p *domain.Payment) {
+	lines := domain.Transfer(domain.AccountProviderClearing, domain.AccountSales, p.Amount)
+	if p.ProcessingFee > 0 {
+		lines = append(lines, domain.Transfer(domain.AccountProcessingFees, domain.AccountProviderClearing, p.ProcessingFee)...)
+	}
+
+	h.post(ctx, &domain.LedgerTransaction{
+		ShopID:      p.ShopID,
+		PaymentID:   p.ID,
+		Kind:        domain.LedgerKindCapture,
+		Reference:   p.ID,
+		Currency:    p.Currency,
+		Description: "payment " + p.TransactionID + " captured",
+		Lines:       lines,
+	})
+}
+
+// postRefund records amount of p given back under refundID.
+func (h *PaymentHandler) postRefund(ctx context.Context, p *domain.Payment, refundID string, amount float64) {
+	h.post(ctx, &domain.LedgerTransaction{
+		ShopID:      p.ShopID,
+		PaymentID:   p.ID,
+		Kind:        domain.LedgerKindRefund,
+		Reference:   refundID,
+		Currency:    p.Currency,
+		Description: "payment " + p.TransactionID + " refunded",
+		Lines:       domain.Transfer(domain.AccountRefunds, domain.AccountProviderClearing, amount),
+	})
+}
+
+// post writes t to the ledger after the payment it records has changed. The
+// payment stands either way; a failure is logged and left for
+// ReconcilePayments to flag.
+func (h *PaymentHandler) post(ctx context.Context, t *domain.LedgerTransaction) {
+	if _, err := h.svc.PostLedgerTransaction(context.WithoutCancel(ctx), t); err != nil {
+		log.Printf("payment: failed to post %s %s to ledger: %v", t.Kind, t.Reference, err)
+	}
+}
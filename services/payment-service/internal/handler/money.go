@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"hpkg/money"
+	"hpkg/money/moneypb"
+	"paymentservice/internal/domain"
+
+	errors "hpkg/constants/responses"
+
+	"google.golang.org/grpc/codes"
+)
+
+const defaultCurrency = domain.DefaultCurrency
+
+// chargeAmount returns the amount a request asks to take: exact when given,
+// otherwise amount in currency, USD by default.
+func chargeAmount(amount float64, currency string, exact *moneypb.Money) (money.Money, error) {
+	var m money.Money
+	var err error
+	switch {
+	case exact != nil:
+		m, err = money.FromProto(exact)
+	case currency == "":
+		m, err = money.FromFloat(amount, defaultCurrency)
+	default:
+		m, err = money.FromFloat(amount, currency)
+	}
+	if err == money.ErrUnknownCurrency {
+		return money.Money{}, errors.GRPC(codes.InvalidArgument, errors.CurrencyUnsupportedCode, errors.CurrencyUnsupportedMsg)
+	}
+	if err != nil || m.Amount <= 0 {
+		return money.Money{}, errors.GRPC(codes.InvalidArgument, errors.PaymentInvalidCode, errors.PaymentInvalidMsg)
+	}
+	return m, nil
+}
+
+// amountMoney returns a stored amount as a money.Money message, or nil for a
+// payment recorded in a currency the money package does not know.
+func amountMoney(amount float64, currency string) *moneypb.Money {
+	m, err := money.FromFloat(amount, currency)
+	if err != nil {
+		return nil
+	}
+	return money.ToProto(m)
+}
@@ -2,12 +2,22 @@ package handler
 
 import (
 	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	stderrors "errors"
+	"log"
 	"paymentservice/internal/domain"
+	"paymentservice/internal/provider"
 	"paymentservice/internal/repository"
 	"paymentservice/internal/service"
+	"paymentservice/internal/vault"
 	paymentpb "paymentservice/proto/paymentpb"
 	"time"
 
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -15,23 +25,54 @@ import (
 
 type PaymentHandler struct {
 	paymentpb.UnimplementedPaymentServiceServer
-	repo repository.PaymentRepository
-	svc  *service.PaymentService
+	repo      repository.PaymentRepository
+	svc       *service.PaymentService
+	providers *provider.Registry
+	vault     *vault.Vault
+	// authorizationTTL is how long an authorization may wait for capture.
+	authorizationTTL time.Duration
 }
 
 func NewPaymentHandler(
 	svc *service.PaymentService,
+	providers *provider.Registry,
+	vault *vault.Vault,
+	authorizationTTL time.Duration,
 ) *PaymentHandler {
 	return &PaymentHandler{
-		svc: svc,
+		svc:              svc,
+		providers:        providers,
+		vault:            vault,
+		authorizationTTL: authorizationTTL,
 	}
 }
 
+// ProcessPayment charges an order in one go. A retry sent with the same
+// idempotency key gets the original response instead of a second charge.
 func (h *PaymentHandler) ProcessPayment(
 	ctx context.Context,
 	req *paymentpb.ProcessPaymentRequest,
 ) (*paymentpb.ProcessPaymentResponse, error) {
 
+	resp := &paymentpb.ProcessPaymentResponse{}
+	key, replayed, err := h.beginIdempotent(ctx, "ProcessPayment", req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if replayed {
+		return resp, nil
+	}
+
+	resp, err = h.processPayment(ctx, req)
+	h.finishIdempotent(ctx, "ProcessPayment", key, resp, err)
+	return resp, err
+}
+
+func (h *PaymentHandler) processPayment(
+	ctx context.Context,
+	req *paymentpb.ProcessPaymentRequest,
+) (*paymentpb.ProcessPaymentResponse, error) {
+
 	if h.svc == nil {
 		return nil, status.Error(codes.Internal, "payment service not initialized")
 	}
@@ -40,38 +81,110 @@ func (h *PaymentHandler) ProcessPayment(
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
 	}
 
-	if req.PaymentMethod == "credit_card" && req.Card == nil {
-		return nil, status.Error(
-			codes.InvalidArgument,
-			"card details are required",
-		)
+	if req.OrderId == "" || req.PaymentMethod == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.PaymentInvalidCode, errors.PaymentInvalidMsg)
+	}
+	charge, err := chargeAmount(req.Amount, req.Currency, req.AmountMoney)
+	if err != nil {
+		return nil, err
+	}
+	amount, currency := charge.Float(), charge.Currency
+
+	card, err := h.paymentCard(ctx, req.PaymentMethod, req.CardToken)
+	if err != nil {
+		return nil, err
 	}
+	prov, err := h.providers.ForMethod(req.PaymentMethod)
+	if err != nil {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.PaymentMethodUnsupportedCode, errors.PaymentMethodUnsupportedMsg)
+	}
+
+	shopID, _ := reqCtx.MustGetShopID(ctx)
 	var p = &domain.Payment{
+		ShopID:        shopID,
 		OrderID:       req.OrderId,
 		UserID:        req.UserId,
-		Amount:        req.Amount,
-		Currency:      req.Currency,
+		Amount:        amount,
+		Currency:      currency,
 		PaymentMethod: req.PaymentMethod,
+		Provider:      prov.Name(),
+	}
+	if p.PaymentMethod == domain.PaymentMethodCash {
+		p.ShiftID = h.openCashShiftID(ctx, shopID, currency)
+	}
+	message := "payment processed successfully"
+
+	// Payments are taken as a sale: authorized and captured straight away.
+	auth, authErr := prov.Authorize(ctx, &provider.AuthorizeRequest{
+		OrderID:       req.OrderId,
+		Amount:        amount,
+		Currency:      currency,
+		PaymentMethod: req.PaymentMethod,
+		Card:          card,
+	})
+	switch {
+	case authErr != nil:
+		// The provider never told us the outcome; record the attempt as
+		// failed so it shows up for follow-up.
+		p.Status = domain.PaymentStatusFailed
+		p.TransactionID = newReference("txn_")
+		p.ErrorMessage = authErr.Error()
+	case auth.Status == provider.StatusDeclined:
+		p.Status = domain.PaymentStatusFailed
+		p.TransactionID = auth.TransactionID
+		p.ReferenceID = auth.Reference
+		p.ErrorMessage = auth.Message
+		message = "payment declined"
+	case auth.Status == provider.StatusPending:
+		p.Status = domain.PaymentStatusPending
+		p.TransactionID = auth.TransactionID
+		p.ReferenceID = auth.Reference
+		message = auth.Message
+	default:
+		p.ReferenceID = auth.Reference
+		capture, err := prov.Capture(ctx, auth.Reference, amount)
+		if err != nil {
+			if _, voidErr := prov.Void(ctx, auth.Reference); voidErr != nil {
+				log.Printf("payment: failed to void uncaptured authorization %s: %v", auth.Reference, voidErr)
+			}
+			p.Status = domain.PaymentStatusFailed
+			p.TransactionID = auth.TransactionID
+			p.ErrorMessage = err.Error()
+			message = "payment could not be captured"
+			break
+		}
+		now := time.Now()
+		p.Status = domain.PaymentStatusCompleted
+		p.TransactionID = capture.TransactionID
+		p.ProcessingFee = capture.ProcessingFee
+		p.CapturedAt = &now
 	}
 
 	payment, err := h.svc.Create(ctx, p)
 	if err != nil {
-		return nil, err
+		return nil, errors.GRPC(codes.Internal, errors.PaymentCreateFailedCode, errors.PaymentCreateFailedMsg)
 	}
 
 	if payment == nil {
 		return nil, status.Error(codes.Internal, "payment result is nil")
 	}
+	if payment.Status == domain.PaymentStatusCompleted {
+		h.postCapture(ctx, payment)
+	}
+
+	if authErr != nil {
+		return nil, providerError(authErr)
+	}
 
-	transactionID := "txn_" + time.Now().Format("20160102150405")
 	return &paymentpb.ProcessPaymentResponse{
 		PaymentId:     payment.ID,
 		OrderId:       payment.OrderID,
-		TransactionId: transactionID,
+		TransactionId: payment.TransactionID,
 		Status:        payment.Status,
 		Amount:        payment.Amount,
+		AmountMoney:   amountMoney(payment.Amount, payment.Currency),
 		ProcessingFee: payment.ProcessingFee,
-		Message:       "payment processed successfully",
+		Message:       message,
 	}, nil
 }
 
@@ -80,16 +193,25 @@ func (s *PaymentHandler) GetPayment(
 	req *paymentpb.GetPaymentRequest,
 ) (*paymentpb.GetPaymentResponse, error) {
 
-	p, err := s.repo.GetByID(ctx, req.PaymentId)
+	shopID, err := reqCtx.MustGetShopID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	p, err := s.svc.GetShopPayment(ctx, shopID, req.PaymentId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.PaymentNotFoundCode, errors.PaymentNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
 	return &paymentpb.GetPaymentResponse{
 		Id:            p.ID,
 		OrderId:       p.OrderID,
 		UserId:        p.UserID,
 		Amount:        p.Amount,
+		AmountMoney:   amountMoney(p.Amount, p.Currency),
 		Currency:      p.Currency,
 		PaymentMethod: p.PaymentMethod,
 		Status:        p.Status,
@@ -101,17 +223,59 @@ func (s *PaymentHandler) GetPayment(
 	}, nil
 }
 
+// VerifyPayment checks a payment against its provider. Providers that keep
+// no record of payments, such as cash, are verified from the payment record.
 func (s *PaymentHandler) VerifyPayment(
 	ctx context.Context,
 	req *paymentpb.VerifyPaymentRequest,
 ) (*paymentpb.VerifyPaymentResponse, error) {
 
-	return &paymentpb.VerifyPaymentResponse{
-		PaymentId:  req.PaymentId,
-		IsVerified: true,
-		Status:     "completed",
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.svc.GetShopPayment(ctx, shopID, req.PaymentId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.PaymentNotFoundCode, errors.PaymentNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	resp := &paymentpb.VerifyPaymentResponse{
+		PaymentId:  p.ID,
+		IsVerified: domain.IsCaptured(p.Status),
+		Status:     p.Status,
 		Message:    "payment verified",
-	}, nil
+	}
+	if req.TransactionId != "" && req.TransactionId != p.TransactionID {
+		resp.IsVerified = false
+		resp.Message = "transaction does not match payment"
+		return resp, nil
+	}
+
+	prov, err := s.providerFor(p)
+	if err != nil {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentMethodUnsupportedCode, errors.PaymentMethodUnsupportedMsg)
+	}
+	if prov != nil {
+		st, err := prov.Status(ctx, p.ReferenceID)
+		switch {
+		case err == nil:
+			resp.IsVerified = st.State == provider.StateCaptured || st.State == provider.StateRefunded
+			if !resp.IsVerified {
+				resp.Message = "provider reports payment " + st.State
+			}
+		case !stderrors.Is(err, provider.ErrNoStatus):
+			return nil, providerError(err)
+		}
+	}
+	if !resp.IsVerified && resp.Message == "payment verified" {
+		resp.Message = "payment is " + p.Status
+	}
+
+	return resp, nil
 }
 
 func (s *PaymentHandler) ValidatePayment(
@@ -132,3 +296,74 @@ func (s *PaymentHandler) ValidatePayment(
 		Message: "payment is valid",
 	}, nil
 }
+
+func (s *PaymentHandler) ListOrderPayments(
+	ctx context.Context,
+	req *paymentpb.ListOrderPaymentsRequest,
+) (*paymentpb.ListOrderPaymentsResponse, error) {
+
+	if req.OrderId == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := s.svc.ListByOrder(ctx, shopID, req.OrderId)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	resp := &paymentpb.ListOrderPaymentsResponse{
+		Payments:   make([]*paymentpb.Payment, 0, len(payments)),
+		TotalCount: int32(len(payments)),
+	}
+	for _, p := range payments {
+		resp.Payments = append(resp.Payments, &paymentpb.Payment{
+			Id:            p.ID,
+			OrderId:       p.OrderID,
+			UserId:        p.UserID,
+			Amount:        p.Amount,
+			AmountMoney:   amountMoney(p.Amount, p.Currency),
+			Currency:      p.Currency,
+			PaymentMethod: p.PaymentMethod,
+			Status:        p.Status,
+			TransactionId: p.TransactionID,
+			ReferenceId:   p.ReferenceID,
+			ProcessingFee: p.ProcessingFee,
+			CreatedAt:     timestamppb.New(p.CreatedAt),
+			UpdatedAt:     timestamppb.New(p.UpdatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// providerFor returns the provider that took p, or nil for payments recorded
+// before providers existed, which have nothing to settle with a provider.
+func (s *PaymentHandler) providerFor(p *domain.Payment) (provider.Provider, error) {
+	if p.Provider == "" {
+		return nil, nil
+	}
+	return s.providers.ByName(p.Provider)
+}
+
+// providerError maps a provider failure to a gRPC status.
+func providerError(err error) error {
+	switch {
+	case stderrors.Is(err, provider.ErrInvalidState), stderrors.Is(err, provider.ErrUnknownReference):
+		return errors.GRPC(codes.FailedPrecondition, errors.PaymentRefundNotAllowedCode, errors.PaymentRefundNotAllowedMsg)
+	case stderrors.Is(err, provider.ErrUnsupportedMethod):
+		return errors.GRPC(codes.InvalidArgument, errors.PaymentMethodUnsupportedCode, errors.PaymentMethodUnsupportedMsg)
+	}
+	return errors.GRPC(codes.Unavailable, errors.PaymentProviderUnavailableCode, errors.PaymentProviderUnavailableMsg)
+}
+
+// newReference returns a random, prefixed identifier such as "txn_9f86d081884c7d65".
+func newReference(prefix string) string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return prefix + hex.EncodeToString(b)
+}
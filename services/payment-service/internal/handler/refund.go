@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"paymentservice/internal/domain"
+	"paymentservice/internal/provider"
+	paymentpb "paymentservice/proto/paymentpb"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const permPaymentRefund = "PermPaymentRefund"
+
+// RefundPayment gives back refund_amount of a captured payment, or whatever is
+// left of it when no amount is given. A payment can be refunded several times
+// until its captured amount has been given back.
+//
+// The refund is recorded as pending, and counted against the payment, before
+// the provider is asked for the money; if the provider fails the refund is
+// marked failed and the amount is freed again.
+func (h *PaymentHandler) RefundPayment(
+	ctx context.Context,
+	req *paymentpb.RefundPaymentRequest,
+) (*paymentpb.RefundPaymentResponse, error) {
+
+	if !reqCtx.HasPermission(ctx, permPaymentRefund) {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+	if req.PaymentId == "" || req.RefundAmount < 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.PaymentInvalidCode, errors.PaymentInvalidMsg)
+	}
+	reasonCode := req.ReasonCode
+	if reasonCode == "" {
+		reasonCode = domain.RefundReasonOther
+	}
+	if !domain.IsRefundReason(reasonCode) {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.PaymentRefundReasonInvalidCode, errors.PaymentRefundReasonInvalidMsg)
+	}
+
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := h.svc.GetShopPayment(ctx, shopID, req.PaymentId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.PaymentNotFoundCode, errors.PaymentNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	remaining := domain.MinorUnits(p.Amount, p.Currency) - domain.MinorUnits(p.Refunded, p.Currency)
+	amount := domain.RoundAmount(req.RefundAmount, p.Currency)
+	if amount == 0 {
+		amount = domain.RoundAmount(p.Amount-p.Refunded, p.Currency)
+	}
+	if !domain.IsRefundable(p.Status) || amount <= 0 || domain.MinorUnits(amount, p.Currency) > remaining {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentRefundNotAllowedCode, errors.PaymentRefundNotAllowedMsg)
+	}
+
+	prov, err := h.providerFor(p)
+	if err != nil {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentMethodUnsupportedCode, errors.PaymentMethodUnsupportedMsg)
+	}
+
+	userID, _ := reqCtx.MustGetUserID(ctx)
+	refund := &domain.Refund{
+		PaymentID:  p.ID,
+		Amount:     amount,
+		ReasonCode: reasonCode,
+		Reason:     req.Reason,
+		CreatedBy:  userID,
+	}
+	if p.PaymentMethod == domain.PaymentMethodCash {
+		refund.ShiftID = h.openCashShiftID(ctx, p.ShopID, p.Currency)
+	}
+	updated, err := h.svc.CreateRefund(ctx, refund)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentRefundNotAllowedCode, errors.PaymentRefundNotAllowedMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	// Payments recorded before providers existed have nothing to settle
+	// with a provider.
+	transactionID := newReference("rfd_")
+	if prov != nil {
+		res, err := prov.Refund(ctx, p.ReferenceID, amount)
+		switch {
+		case err != nil:
+			h.failRefund(ctx, refund, err.Error())
+			return nil, providerError(err)
+		case res.Status == provider.StatusDeclined:
+			h.failRefund(ctx, refund, res.Message)
+			return nil, errors.GRPC(codes.FailedPrecondition, errors.PaymentDeclinedCode, errors.PaymentDeclinedMsg)
+		}
+		transactionID = res.TransactionID
+	}
+
+	// The provider has given the money back; the refund stands even if the
+	// client has gone. If it cannot be marked completed it is reported as
+	// still pending, as it is stored.
+	ctx = context.WithoutCancel(ctx)
+	if completed, err := h.svc.CompleteRefund(ctx, refund.ID, transactionID); err != nil {
+		log.Printf("payment: failed to complete refund %s of payment %s: %v", refund.ID, p.ID, err)
+		refund.TransactionID = transactionID
+	} else {
+		refund = completed
+	}
+	h.postRefund(ctx, updated, refund.ID, amount)
+
+	return &paymentpb.RefundPaymentResponse{
+		RefundId:          refund.ID,
+		OriginalPaymentId: p.ID,
+		RefundAmount:      amount,
+		Status:            refund.Status,
+		Reason:            req.Reason,
+		TransactionId:     refund.TransactionID,
+		CreatedAt:         timestamppb.New(refund.CreatedAt),
+		ReasonCode:        reasonCode,
+		PaymentStatus:     updated.Status,
+		RefundedTotal:     updated.Refunded,
+	}, nil
+}
+
+// ListRefunds returns a payment's refunds, oldest first.
+func (h *PaymentHandler) ListRefunds(
+	ctx context.Context,
+	req *paymentpb.ListRefundsRequest,
+) (*paymentpb.ListRefundsResponse, error) {
+
+	if req.PaymentId == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := h.svc.GetShopPayment(ctx, shopID, req.PaymentId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.PaymentNotFoundCode, errors.PaymentNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	refunds, err := h.svc.ListRefunds(ctx, p.ID)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	resp := &paymentpb.ListRefundsResponse{
+		Refunds: make([]*paymentpb.Refund, 0, len(refunds)),
+	}
+	for _, rf := range refunds {
+		resp.Refunds = append(resp.Refunds, &paymentpb.Refund{
+			Id:            rf.ID,
+			PaymentId:     rf.PaymentID,
+			Amount:        rf.Amount,
+			ReasonCode:    rf.ReasonCode,
+			Reason:        rf.Reason,
+			Status:        rf.Status,
+			TransactionId: rf.TransactionID,
+			ErrorMessage:  rf.ErrorMessage,
+			CreatedBy:     rf.CreatedBy,
+			CreatedAt:     timestamppb.New(rf.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// failRefund marks refund failed so its amount can be refunded again. If
+// that fails too the refund stays pending and keeps its amount reserved,
+// which errs on the side of not refunding twice.
+func (h *PaymentHandler) failRefund(ctx context.Context, refund *domain.Refund, reason string) {
+	if err := h.svc.FailRefund(context.WithoutCancel(ctx), refund.ID, reason); err != nil {
+		log.Printf("payment: failed to release refund %s of payment %s: %v", refund.ID, refund.PaymentID, err)
+	}
+}
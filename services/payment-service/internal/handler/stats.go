@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"paymentservice/internal/domain"
+	paymentpb "paymentservice/proto/paymentpb"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	permPaymentStatsRead = "PermPaymentStatsRead"
+	// maxStatsPeriods bounds how many periods one report may cover.
+	maxStatsPeriods = 366
+)
+
+// statsPeriods maps a reporting period to its date_trunc field, its longest
+// length and the range reported when the caller gives no start.
+var statsPeriods = map[string]struct {
+	unit                 string
+	length               time.Duration
+	backMonths, backDays int
+}{
+	domain.StatsPeriodDaily:   {"day", 24 * time.Hour, 0, 30},
+	domain.StatsPeriodWeekly:  {"week", 7 * 24 * time.Hour, 0, 7 * 12},
+	domain.StatsPeriodMonthly: {"month", 31 * 24 * time.Hour, 12, 0},
+}
+
+// GetPaymentStats reports on the caller's shop payments by period and
+// currency, with a breakdown by payment method and status. Amounts in
+// different currencies are never added together.
+func (h *PaymentHandler) GetPaymentStats(
+	ctx context.Context,
+	req *paymentpb.GetPaymentStatsRequest,
+) (*paymentpb.GetPaymentStatsResponse, error) {
+
+	if !reqCtx.HasPermission(ctx, permPaymentStatsRead) {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+	shopID, err := reqCtx.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	period := req.Period
+	if period == "" {
+		period = domain.StatsPeriodDaily
+	}
+	p, ok := statsPeriods[period]
+	timeZone := req.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if !ok || err != nil {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.PaymentStatsQueryInvalidCode, errors.PaymentStatsQueryInvalidMsg)
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	from := to.In(loc).AddDate(0, -p.backMonths, -p.backDays)
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if !from.Before(to) || to.Sub(from) > maxStatsPeriods*p.length {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.PaymentStatsQueryInvalidCode, errors.PaymentStatsQueryInvalidMsg)
+	}
+
+	rows, err := h.svc.PaymentStats(ctx, shopID, p.unit, loc.String(), from, to)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+
+	// Rows come ordered by period and currency, so each bucket's rows are
+	// consecutive.
+	resp := &paymentpb.GetPaymentStatsResponse{}
+	totals := make(map[string]*paymentStats)
+	var bucket *paymentStats
+	for _, row := range rows {
+		if bucket == nil || !bucket.periodStart.Equal(row.PeriodStart) || bucket.currency != row.Currency {
+			if bucket != nil {
+				resp.Buckets = append(resp.Buckets, bucket.bucket(period))
+			}
+			bucket = newPaymentStats(row.PeriodStart, row.Currency)
+		}
+		bucket.add(row)
+
+		total, ok := totals[row.Currency]
+		if !ok {
+			total = newPaymentStats(from, row.Currency)
+			totals[row.Currency] = total
+		}
+		total.add(row)
+	}
+	if bucket != nil {
+		resp.Buckets = append(resp.Buckets, bucket.bucket(period))
+	}
+
+	for _, t := range totals {
+		resp.Totals = append(resp.Totals, t.statistics(period))
+	}
+	sort.Slice(resp.Totals, func(i, j int) bool {
+		a, b := resp.Totals[i], resp.Totals[j]
+		if a.TotalTransactions != b.TotalTransactions {
+			return a.TotalTransactions > b.TotalTransactions
+		}
+		return a.Currency < b.Currency
+	})
+	if len(resp.Totals) > 0 {
+		resp.Statistics = resp.Totals[0]
+	} else {
+		resp.Statistics = &paymentpb.PaymentStatistics{Period: period}
+	}
+
+	return resp, nil
+}
+
+// paymentStats adds up the payments of one currency, over a period or the
+// whole report.
+type paymentStats struct {
+	periodStart  time.Time
+	currency     string
+	transactions int
+	captured     int
+	amount       float64
+	refunded     float64
+	methods      map[string]*paymentpb.PaymentStatsBreakdown
+	statuses     map[string]*paymentpb.PaymentStatsBreakdown
+}
+
+func newPaymentStats(periodStart time.Time, currency string) *paymentStats {
+	return &paymentStats{
+		periodStart: periodStart,
+		currency:    currency,
+		methods:     make(map[string]*paymentpb.PaymentStatsBreakdown),
+		statuses:    make(map[string]*paymentpb.PaymentStatsBreakdown),
+	}
+}
+
+// add counts row. Only captured payments count towards the amount and the
+// method breakdown; every payment counts towards its status.
+func (s *paymentStats) add(row *domain.PaymentStatsRow) {
+	s.transactions += row.Transactions
+	addBreakdown(s.statuses, row.Status, row.Transactions, row.Amount)
+
+	if !domain.IsCaptured(row.Status) {
+		return
+	}
+	s.captured += row.Transactions
+	s.amount += row.Amount
+	s.refunded += row.Refunded
+	addBreakdown(s.methods, row.Method, row.Transactions, row.Amount)
+}
+
+func addBreakdown(m map[string]*paymentpb.PaymentStatsBreakdown, key string, transactions int, amount float64) {
+	b, ok := m[key]
+	if !ok {
+		b = &paymentpb.PaymentStatsBreakdown{Key: key}
+		m[key] = b
+	}
+	b.Transactions += int32(transactions)
+	b.Amount = roundCents(b.Amount + amount)
+}
+
+func (s *paymentStats) statistics(period string) *paymentpb.PaymentStatistics {
+	st := &paymentpb.PaymentStatistics{
+		Period:               period,
+		Currency:             s.currency,
+		TotalTransactions:    int32(s.transactions),
+		CapturedTransactions: int32(s.captured),
+		TotalAmount:          roundCents(s.amount),
+		RefundedAmount:       roundCents(s.refunded),
+	}
+	if s.captured > 0 {
+		st.AverageAmount = roundCents(s.amount / float64(s.captured))
+	}
+	if s.transactions > 0 {
+		st.SuccessRate = ratio(float64(s.captured), float64(s.transactions))
+	}
+	if s.amount > 0 {
+		st.RefundRate = ratio(s.refunded, s.amount)
+	}
+
+	methods := sortedBreakdown(s.methods)
+	if len(methods) > 0 {
+		st.MostUsedMethod = methods[0].Key
+	}
+	return st
+}
+
+func (s *paymentStats) bucket(period string) *paymentpb.PaymentStatsBucket {
+	return &paymentpb.PaymentStatsBucket{
+		PeriodStart: timestamppb.New(s.periodStart),
+		Statistics:  s.statistics(period),
+		ByMethod:    sortedBreakdown(s.methods),
+		ByStatus:    sortedBreakdown(s.statuses),
+	}
+}
+
+// sortedBreakdown returns m's entries, most transactions first.
+func sortedBreakdown(m map[string]*paymentpb.PaymentStatsBreakdown) []*paymentpb.PaymentStatsBreakdown {
+	out := make([]*paymentpb.PaymentStatsBreakdown, 0, len(m))
+	for _, b := range m {
+		out = append(out, b)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Transactions != out[j].Transactions {
+			return out[i].Transactions > out[j].Transactions
+		}
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// ratio returns a / b to four decimal places.
+func ratio(a, b float64) float64 {
+	return math.Round(a/b*10000) / 10000
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"log"
+
+	"paymentservice/internal/domain"
+	paymentpb "paymentservice/proto/paymentpb"
+
+	errors "hpkg/constants/responses"
+	reqCtx "hpkg/grpc"
+
+	"google.golang.org/grpc/codes"
+)
+
+// permPaymentWebhook is held by the gateway's webhook route alone, once it
+// has checked the provider's signature.
+const permPaymentWebhook = "PermPaymentWebhook"
+
+// HandleWebhookEvent moves a payment to the status its provider reports. An
+// event the payment's status cannot follow, e.g. a failure for a payment
+// already completed, is recorded and otherwise ignored: the provider gets a
+// success either way, as delivering it again would not change the answer.
+func (h *PaymentHandler) HandleWebhookEvent(
+	ctx context.Context,
+	req *paymentpb.HandleWebhookEventRequest,
+) (*paymentpb.HandleWebhookEventResponse, error) {
+
+	if !reqCtx.HasPermission(ctx, permPaymentWebhook) {
+		return nil, errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+	if req.EventId == "" || req.EventType == "" || req.Reference == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.WebhookEventInvalidCode, errors.WebhookEventInvalidMsg)
+	}
+	if _, err := h.providers.ByName(req.Provider); err != nil {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.WebhookEventInvalidCode, errors.WebhookEventInvalidMsg)
+	}
+
+	event := &domain.WebhookEvent{
+		Provider:  req.Provider,
+		EventID:   req.EventId,
+		EventType: req.EventType,
+	}
+	if req.OccurredAt != nil {
+		t := req.OccurredAt.AsTime()
+		event.OccurredAt = &t
+	}
+
+	claimed, err := h.svc.ClaimWebhookEvent(ctx, event)
+	if err != nil {
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+	if !claimed {
+		return h.duplicateWebhookEvent(ctx, event)
+	}
+
+	resp, err := h.applyWebhookEvent(ctx, event, req)
+	if err != nil {
+		// Let the provider's next delivery try again.
+		if releaseErr := h.svc.ReleaseWebhookEvent(ctx, event); releaseErr != nil {
+			log.Printf("payment: failed to release webhook event %s/%s: %v", event.Provider, event.EventID, releaseErr)
+		}
+		return nil, err
+	}
+
+	// Left without an outcome, the event is claimed again by a delivery
+	// once its claim times out.
+	if err := h.svc.FinishWebhookEvent(ctx, event); err != nil {
+		log.Printf("payment: failed to record outcome of webhook event %s/%s: %v", event.Provider, event.EventID, err)
+	}
+	return resp, nil
+}
+
+// applyWebhookEvent applies a claimed event and sets its outcome.
+func (h *PaymentHandler) applyWebhookEvent(
+	ctx context.Context,
+	event *domain.WebhookEvent,
+	req *paymentpb.HandleWebhookEventRequest,
+) (*paymentpb.HandleWebhookEventResponse, error) {
+
+	p, err := h.svc.GetByReference(ctx, req.Provider, req.Reference)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.PaymentNotFoundCode, errors.PaymentNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+	event.PaymentID = p.ID
+
+	resp := &paymentpb.HandleWebhookEventResponse{
+		PaymentId: p.ID,
+		Status:    p.Status,
+	}
+
+	status, ok := domain.WebhookStatus(req.EventType)
+	if !ok || status == p.Status {
+		event.Outcome = domain.WebhookOutcomeIgnored
+		return resp, nil
+	}
+
+	updated, err := h.svc.UpdateStatus(ctx, p.ID, p.Status, status, req.Message)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, domain.ErrInvalidTransition):
+			log.Printf("payment: webhook event %s/%s cannot move payment %s from %s to %s",
+				event.Provider, event.EventID, p.ID, p.Status, status)
+			event.Outcome = domain.WebhookOutcomeRejected
+			return resp, nil
+		case err == sql.ErrNoRows:
+			// Changed by another request since it was read.
+			return nil, errors.GRPC(codes.Aborted, errors.PaymentInvalidTransitionCode, errors.PaymentInvalidTransitionMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+	if updated.Status == domain.PaymentStatusCompleted {
+		h.postCapture(ctx, updated)
+	}
+
+	event.Outcome = domain.WebhookOutcomeApplied
+	resp.Status = updated.Status
+	resp.Applied = true
+	return resp, nil
+}
+
+// duplicateWebhookEvent answers an event that was seen before. One still
+// being applied by another delivery, or abandoned too recently to be claimed
+// again, is reported as a conflict, so the provider delivers it again rather
+// than taking it as done.
+func (h *PaymentHandler) duplicateWebhookEvent(
+	ctx context.Context,
+	event *domain.WebhookEvent,
+) (*paymentpb.HandleWebhookEventResponse, error) {
+
+	seen, err := h.svc.GetWebhookEvent(ctx, event.Provider, event.EventID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// Released by a failed delivery while this one was claiming it.
+			return nil, errors.GRPC(codes.Aborted, errors.WebhookEventInProgressCode, errors.WebhookEventInProgressMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+	if seen.Outcome == "" {
+		return nil, errors.GRPC(codes.Aborted, errors.WebhookEventInProgressCode, errors.WebhookEventInProgressMsg)
+	}
+
+	resp := &paymentpb.HandleWebhookEventResponse{
+		PaymentId: seen.PaymentID,
+		Duplicate: true,
+	}
+	if seen.PaymentID != "" {
+		if p, err := h.svc.GetByID(ctx, seen.PaymentID); err == nil {
+			resp.Status = p.Status
+		}
+	}
+	return resp, nil
+}
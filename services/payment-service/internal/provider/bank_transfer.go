@@ -0,0 +1,45 @@
+package provider
+
+import "context"
+
+// BankTransfer is settled by hand: the customer transfers the amount quoting
+// the payment's reference, and staff capture the payment once it has arrived.
+// Refunds are paid out the same way.
+type BankTransfer struct{}
+
+func NewBankTransfer() *BankTransfer {
+	return &BankTransfer{}
+}
+
+func (b *BankTransfer) Name() string { return "bank_transfer" }
+
+func (b *BankTransfer) Authorize(ctx context.Context, req *AuthorizeRequest) (*Result, error) {
+	ref := newReference("bt_")
+	return &Result{
+		Status:        StatusPending,
+		Reference:     ref,
+		TransactionID: ref,
+		Message:       "awaiting bank transfer quoting reference " + ref,
+	}, nil
+}
+
+func (b *BankTransfer) Capture(ctx context.Context, reference string, amount float64) (*Result, error) {
+	return &Result{Status: StatusApproved, Reference: reference, TransactionID: reference}, nil
+}
+
+func (b *BankTransfer) Void(ctx context.Context, reference string) (*Result, error) {
+	return &Result{Status: StatusApproved, Reference: reference, TransactionID: reference}, nil
+}
+
+func (b *BankTransfer) Refund(ctx context.Context, reference string, amount float64) (*Result, error) {
+	return &Result{
+		Status:        StatusApproved,
+		Reference:     reference,
+		TransactionID: newReference("bt_"),
+		Message:       "pay the refund out by bank transfer",
+	}, nil
+}
+
+func (b *BankTransfer) Status(ctx context.Context, reference string) (*StatusResult, error) {
+	return nil, ErrNoStatus
+}
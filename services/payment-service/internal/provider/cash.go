@@ -0,0 +1,39 @@
+package provider
+
+import "context"
+
+// Cash is taken at the till, so every operation succeeds at once: the money
+// changes hands in person and nothing is held.
+type Cash struct{}
+
+func NewCash() *Cash {
+	return &Cash{}
+}
+
+func (c *Cash) Name() string { return "cash" }
+
+func (c *Cash) Authorize(ctx context.Context, req *AuthorizeRequest) (*Result, error) {
+	ref := newReference("csh_")
+	return &Result{Status: StatusApproved, Reference: ref, TransactionID: ref}, nil
+}
+
+func (c *Cash) Capture(ctx context.Context, reference string, amount float64) (*Result, error) {
+	return &Result{Status: StatusApproved, Reference: reference, TransactionID: reference}, nil
+}
+
+func (c *Cash) Void(ctx context.Context, reference string) (*Result, error) {
+	return &Result{Status: StatusApproved, Reference: reference, TransactionID: reference}, nil
+}
+
+func (c *Cash) Refund(ctx context.Context, reference string, amount float64) (*Result, error) {
+	return &Result{
+		Status:        StatusApproved,
+		Reference:     reference,
+		TransactionID: newReference("csh_"),
+		Message:       "hand the refund back in cash",
+	}, nil
+}
+
+func (c *Cash) Status(ctx context.Context, reference string) (*StatusResult, error) {
+	return nil, ErrNoStatus
+}
@@ -0,0 +1,139 @@
+// Package provider moves money for payments. Each payment method is served by
+// a Provider; the handler only records what the provider reports, so a real
+// gateway can be added by registering another Provider.
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// Outcomes of a provider operation.
+const (
+	StatusApproved = "approved"
+	StatusPending  = "pending"
+	StatusDeclined = "declined"
+)
+
+// States of a payment as the provider sees it.
+const (
+	StateAuthorized = "authorized"
+	StateCaptured   = "captured"
+	StateVoided     = "voided"
+	StateRefunded   = "refunded"
+	StatePending    = "pending"
+	StateDeclined   = "declined"
+)
+
+var (
+	// ErrUnsupportedMethod is returned when no provider serves a payment method.
+	ErrUnsupportedMethod = errors.New("payment method is not supported")
+	// ErrTimeout is returned when the provider did not answer in time. The
+	// outcome of the operation is unknown.
+	ErrTimeout = errors.New("payment provider timed out")
+	// ErrUnknownReference is returned for a reference the provider never issued.
+	ErrUnknownReference = errors.New("unknown payment reference")
+	// ErrInvalidState is returned when an operation does not apply to the
+	// payment's current state, e.g. capturing a voided authorization.
+	ErrInvalidState = errors.New("operation not allowed in current payment state")
+	// ErrNoStatus is returned by providers that keep no record of payments,
+	// such as cash; the payment record is the only source of truth.
+	ErrNoStatus = errors.New("provider does not track payment status")
+)
+
+// Card is a vaulted card opened for one operation. It carries no security
+// code: that is checked when the card is tokenized and never kept.
+type Card struct {
+	Token       string
+	Number      string
+	Holder      string
+	ExpiryMonth string
+	ExpiryYear  string
+}
+
+type AuthorizeRequest struct {
+	OrderID       string
+	Amount        float64
+	Currency      string
+	PaymentMethod string
+	Card          *Card
+}
+
+// Result is what a provider reports for an operation. Reference identifies the
+// payment at the provider for later operations on it.
+type Result struct {
+	Status        string
+	Reference     string
+	TransactionID string
+	ProcessingFee float64
+	Message       string
+}
+
+type StatusResult struct {
+	State    string
+	Amount   float64
+	Captured float64
+	Refunded float64
+}
+
+// Provider moves money for one or more payment methods. An authorization holds
+// funds, capture takes them and void releases an authorization that was not
+// captured. Refund gives back part or all of a captured amount.
+type Provider interface {
+	Name() string
+	Authorize(ctx context.Context, req *AuthorizeRequest) (*Result, error)
+	Capture(ctx context.Context, reference string, amount float64) (*Result, error)
+	Void(ctx context.Context, reference string) (*Result, error)
+	Refund(ctx context.Context, reference string, amount float64) (*Result, error)
+	Status(ctx context.Context, reference string) (*StatusResult, error)
+}
+
+// Registry selects the provider for a payment method.
+type Registry struct {
+	byMethod map[string]Provider
+	byName   map[string]Provider
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		byMethod: make(map[string]Provider),
+		byName:   make(map[string]Provider),
+	}
+}
+
+// Register makes p serve the given payment methods.
+func (r *Registry) Register(p Provider, methods ...string) {
+	r.byName[p.Name()] = p
+	for _, m := range methods {
+		r.byMethod[m] = p
+	}
+}
+
+// ForMethod returns the provider serving method.
+func (r *Registry) ForMethod(method string) (Provider, error) {
+	p, ok := r.byMethod[method]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMethod, method)
+	}
+	return p, nil
+}
+
+// ByName returns the provider that took a payment, so later operations go to
+// the same provider even if the method has since moved to another.
+func (r *Registry) ByName(name string) (Provider, error) {
+	p, ok := r.byName[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: provider %s", ErrUnsupportedMethod, name)
+	}
+	return p, nil
+}
+
+// newReference returns a random, prefixed identifier such as "csh_9f86d081884c7d65".
+func newReference(prefix string) string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return prefix + hex.EncodeToString(b)
+}
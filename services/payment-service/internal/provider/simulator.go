@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Outcome is what the simulator does with an authorization.
+type Outcome string
+
+const (
+	OutcomeApprove Outcome = "approve"
+	OutcomeDecline Outcome = "decline"
+	OutcomeTimeout Outcome = "timeout"
+)
+
+// SimulatorConfig decides outcomes from the request alone, so the same request
+// always has the same outcome. A card rule wins over a cents rule, which wins
+// over Default.
+type SimulatorConfig struct {
+	Default Outcome
+	// Cards maps a card number to its outcome.
+	Cards map[string]Outcome
+	// Cents maps the cents of an amount to its outcome, e.g. 5 for 10.05,
+	// for methods without a card.
+	Cents map[int]Outcome
+	// Timeout is how long a timeout outcome waits before failing, unless the
+	// context ends first.
+	Timeout time.Duration
+	// FeeRate is the processing fee charged on captures, e.g. 0.029.
+	FeeRate float64
+	// MaxOvercapture is how far a capture may exceed the authorized amount,
+	// e.g. 0.2 to allow a 20% tip.
+	MaxOvercapture float64
+}
+
+// DefaultSimulatorConfig approves everything except a few well-known test
+// cards and amounts ending in .05 (decline) or .08 (timeout).
+func DefaultSimulatorConfig() SimulatorConfig {
+	return SimulatorConfig{
+		Default: OutcomeApprove,
+		Cards: map[string]Outcome{
+			"4000000000000002": OutcomeDecline,
+			"4000000000009995": OutcomeDecline,
+			"4000000000000119": OutcomeTimeout,
+		},
+		Cents: map[int]Outcome{
+			5: OutcomeDecline,
+			8: OutcomeTimeout,
+		},
+		Timeout:        2 * time.Second,
+		MaxOvercapture: 0.2,
+	}
+}
+
+// Simulator is an offline card gateway. It keeps its payments in memory, so
+// they are forgotten on restart.
+type Simulator struct {
+	cfg SimulatorConfig
+
+	mu       sync.Mutex
+	payments map[string]*simulatedPayment
+}
+
+type simulatedPayment struct {
+	state    string
+	amount   float64
+	captured float64
+	refunded float64
+}
+
+func NewSimulator(cfg SimulatorConfig) *Simulator {
+	if cfg.Default == "" {
+		cfg.Default = OutcomeApprove
+	}
+	return &Simulator{
+		cfg:      cfg,
+		payments: make(map[string]*simulatedPayment),
+	}
+}
+
+func (s *Simulator) Name() string { return "simulator" }
+
+func (s *Simulator) Authorize(ctx context.Context, req *AuthorizeRequest) (*Result, error) {
+	ref := newReference("sim_")
+
+	switch s.outcome(req) {
+	case OutcomeTimeout:
+		select {
+		case <-time.After(s.cfg.Timeout):
+		case <-ctx.Done():
+		}
+		return nil, ErrTimeout
+	case OutcomeDecline:
+		s.put(ref, &simulatedPayment{state: StateDeclined, amount: req.Amount})
+		return &Result{
+			Status:        StatusDeclined,
+			Reference:     ref,
+			TransactionID: ref,
+			Message:       "card declined",
+		}, nil
+	}
+
+	s.put(ref, &simulatedPayment{state: StateAuthorized, amount: req.Amount})
+	return &Result{Status: StatusApproved, Reference: ref, TransactionID: ref}, nil
+}
+
+func (s *Simulator) Capture(ctx context.Context, reference string, amount float64) (*Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.payments[reference]
+	if !ok {
+		return nil, ErrUnknownReference
+	}
+	limit := math.Round(p.amount*(1+s.cfg.MaxOvercapture)*100) / 100
+	if p.state != StateAuthorized || amount <= 0 || amount > limit {
+		return nil, ErrInvalidState
+	}
+
+	p.state = StateCaptured
+	p.captured = amount
+	return &Result{
+		Status:        StatusApproved,
+		Reference:     reference,
+		TransactionID: newReference("sim_"),
+		ProcessingFee: math.Round(amount*s.cfg.FeeRate*100) / 100,
+	}, nil
+}
+
+func (s *Simulator) Void(ctx context.Context, reference string) (*Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.payments[reference]
+	if !ok {
+		return nil, ErrUnknownReference
+	}
+	if p.state != StateAuthorized {
+		return nil, ErrInvalidState
+	}
+
+	p.state = StateVoided
+	return &Result{Status: StatusApproved, Reference: reference, TransactionID: reference}, nil
+}
+
+func (s *Simulator) Refund(ctx context.Context, reference string, amount float64) (*Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.payments[reference]
+	if !ok {
+		return nil, ErrUnknownReference
+	}
+	// Allow a cent of float slack so refunding "the rest" always fits.
+	if p.state != StateCaptured || amount <= 0 || p.refunded+amount > p.captured+0.005 {
+		return nil, ErrInvalidState
+	}
+
+	p.refunded += amount
+	if p.refunded >= p.captured-0.005 {
+		p.state = StateRefunded
+	}
+	return &Result{Status: StatusApproved, Reference: reference, TransactionID: newReference("sim_")}, nil
+}
+
+func (s *Simulator) Status(ctx context.Context, reference string) (*StatusResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.payments[reference]
+	if !ok {
+		return nil, ErrUnknownReference
+	}
+	return &StatusResult{
+		State:    p.state,
+		Amount:   p.amount,
+		Captured: p.captured,
+		Refunded: p.refunded,
+	}, nil
+}
+
+func (s *Simulator) outcome(req *AuthorizeRequest) Outcome {
+	if req.Card != nil {
+		if o, ok := s.cfg.Cards[req.Card.Number]; ok {
+			return o
+		}
+	}
+	cents := int(math.Round(req.Amount*100)) % 100
+	if o, ok := s.cfg.Cents[cents]; ok {
+		return o
+	}
+	return s.cfg.Default
+}
+
+func (s *Simulator) put(ref string, p *simulatedPayment) {
+	s.mu.Lock()
+	s.payments[ref] = p
+	s.mu.Unlock()
+}
+
+// ParseOutcome reads an outcome from configuration.
+func ParseOutcome(v string) (Outcome, error) {
+	switch o := Outcome(v); o {
+	case OutcomeApprove, OutcomeDecline, OutcomeTimeout:
+		return o, nil
+	}
+	return "", fmt.Errorf("unknown simulator outcome %q", v)
+}
@@ -10,5 +10,5 @@ type PaymentRepository interface {
 	GetByID(ctx context.Context, id string) (*domain.Payment, error)
 	ListByUser(ctx context.Context, userID string, page, size string, status string) ([]*domain.Payment, int32, error)
 	ListByOrder(ctx context.Context, orderID string, page, size string) ([]*domain.Payment, int32, error)
-	UpdateStatus(ctx context.Context, id string, status string) error
+	UpdateStatus(ctx context.Context, id string, from string, to string, reason string) (*domain.Payment, error)
 }
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	"paymentservice/internal/domain"
+)
+
+func (r *PaymentService) CreateCardToken(
+	ctx context.Context,
+	t *domain.CardToken,
+) (*domain.CardToken, error) {
+
+	query := `
+		INSERT INTO card_tokens (
+			token, shop_id, key_id, pan_ciphertext, brand, last4,
+			card_holder, expiry_month, expiry_year
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		t.Token, nullStr(t.ShopID), t.KeyID, t.PANCiphertext, t.Brand, t.Last4,
+		nullStr(t.Holder), t.ExpiryMonth, t.ExpiryYear,
+	).Scan(&t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *PaymentService) GetCardToken(
+	ctx context.Context,
+	token string,
+) (*domain.CardToken, error) {
+
+	query := `
+		SELECT token, COALESCE(shop_id::text, ''), key_id, pan_ciphertext, brand, last4,
+			COALESCE(card_holder, ''), expiry_month, expiry_year, created_at
+		FROM card_tokens
+		WHERE token = $1
+	`
+
+	var t domain.CardToken
+	err := r.db.QueryRowContext(ctx, query, token).Scan(
+		&t.Token,
+		&t.ShopID,
+		&t.KeyID,
+		&t.PANCiphertext,
+		&t.Brand,
+		&t.Last4,
+		&t.Holder,
+		&t.ExpiryMonth,
+		&t.ExpiryYear,
+		&t.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
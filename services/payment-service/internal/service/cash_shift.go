@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"paymentservice/internal/domain"
+)
+
+// ErrCashShiftClosed is returned for a change to a shift that has been
+// closed.
+var ErrCashShiftClosed = errors.New("cash shift is closed")
+
+const cashShiftColumns = `
+	id, shop_id, opened_by, COALESCE(closed_by::text, ''), currency, status,
+	opening_float, COALESCE(expected_amount, 0), COALESCE(counted_amount, 0), COALESCE(variance, 0),
+	COALESCE(note, ''), opened_at, closed_at
+`
+
+// OpenCashShift records s as open. It returns sql.ErrNoRows when the staff
+// member already has a shift open in s.Currency.
+func (r *PaymentService) OpenCashShift(
+	ctx context.Context,
+	s *domain.CashShift,
+) (*domain.CashShift, error) {
+
+	query := `
+		INSERT INTO cash_shifts (shop_id, opened_by, currency, status, opening_float, note)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (shop_id, opened_by, currency) WHERE status = 'open' DO NOTHING
+		RETURNING ` + cashShiftColumns
+
+	return scanCashShift(r.db.QueryRowContext(ctx, query,
+		s.ShopID, s.OpenedBy, s.Currency, domain.CashShiftStatusOpen, s.OpeningFloat, nullStr(s.Note),
+	))
+}
+
+// GetCashShift returns one of the shop's shifts.
+func (r *PaymentService) GetCashShift(
+	ctx context.Context,
+	shopID string,
+	id string,
+) (*domain.CashShift, error) {
+
+	query := `SELECT ` + cashShiftColumns + ` FROM cash_shifts WHERE shop_id = $1 AND id = $2`
+
+	return scanCashShift(r.db.QueryRowContext(ctx, query, shopID, id))
+}
+
+// GetOpenCashShift returns the shift userID has open in currency, or
+// sql.ErrNoRows when there is none.
+func (r *PaymentService) GetOpenCashShift(
+	ctx context.Context,
+	shopID string,
+	userID string,
+	currency string,
+) (*domain.CashShift, error) {
+
+	query := `
+		SELECT ` + cashShiftColumns + `
+		FROM cash_shifts
+		WHERE shop_id = $1 AND opened_by = $2 AND currency = $3 AND status = $4
+	`
+
+	return scanCashShift(r.db.QueryRowContext(ctx, query, shopID, userID, currency, domain.CashShiftStatusOpen))
+}
+
+// ListCashShifts returns the shop's most recently opened shifts, in status
+// when it is given.
+func (r *PaymentService) ListCashShifts(
+	ctx context.Context,
+	shopID string,
+	status string,
+	limit int,
+) ([]*domain.CashShift, error) {
+
+	query := `
+		SELECT ` + cashShiftColumns + `
+		FROM cash_shifts
+		WHERE shop_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY opened_at DESC
+		LIMIT $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, shopID, status, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	shifts := make([]*domain.CashShift, 0)
+	for rows.Next() {
+		s, err := scanCashShift(rows)
+		if err != nil {
+			return nil, err
+		}
+		shifts = append(shifts, s)
+	}
+	return shifts, rows.Err()
+}
+
+// AddCashMovement records m against its shift. It returns
+// ErrCashShiftClosed when the shift is no longer open.
+func (r *PaymentService) AddCashMovement(
+	ctx context.Context,
+	m *domain.CashMovement,
+) error {
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Held until commit, so the shift cannot be closed with this movement
+	// left out of its count.
+	var status string
+	err = tx.QueryRowContext(ctx, `SELECT status FROM cash_shifts WHERE id = $1 FOR SHARE`, m.ShiftID).Scan(&status)
+	if err != nil {
+		return err
+	}
+	if status != domain.CashShiftStatusOpen {
+		return ErrCashShiftClosed
+	}
+
+	insert := `
+		INSERT INTO cash_movements (shift_id, kind, amount, reason, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	err = tx.QueryRowContext(ctx, insert,
+		m.ShiftID, m.Kind, m.Amount, nullStr(m.Reason), nullStr(m.CreatedBy),
+	).Scan(&m.ID, &m.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListCashMovements returns a shift's cash movements, oldest first.
+func (r *PaymentService) ListCashMovements(
+	ctx context.Context,
+	shiftID string,
+) ([]*domain.CashMovement, error) {
+
+	query := `
+		SELECT id, shift_id, kind, amount, COALESCE(reason, ''), COALESCE(created_by::text, ''), created_at
+		FROM cash_movements
+		WHERE shift_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, shiftID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	movements := make([]*domain.CashMovement, 0)
+	for rows.Next() {
+		var m domain.CashMovement
+		err := rows.Scan(&m.ID, &m.ShiftID, &m.Kind, &m.Amount, &m.Reason, &m.CreatedBy, &m.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		movements = append(movements, &m)
+	}
+	return movements, rows.Err()
+}
+
+// CashShiftTotals adds up the cash taken, given back and moved in and out of
+// a shift's drawer so far.
+func (r *PaymentService) CashShiftTotals(
+	ctx context.Context,
+	shiftID string,
+) (*domain.CashShiftTotals, error) {
+
+	return cashShiftTotals(ctx, r.db, shiftID)
+}
+
+// CloseCashShift closes an open shift with the amount counted in its drawer,
+// fixing what was expected and the variance. It returns ErrCashShiftClosed
+// when the shift was already closed.
+func (r *PaymentService) CloseCashShift(
+	ctx context.Context,
+	shopID string,
+	id string,
+	closedBy string,
+	counted float64,
+	note string,
+) (*domain.CashShift, *domain.CashShiftTotals, error) {
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	lock := `SELECT ` + cashShiftColumns + ` FROM cash_shifts WHERE shop_id = $1 AND id = $2 FOR UPDATE`
+	s, err := scanCashShift(tx.QueryRowContext(ctx, lock, shopID, id))
+	if err != nil {
+		return nil, nil, err
+	}
+	if s.Status != domain.CashShiftStatusOpen {
+		return nil, nil, ErrCashShiftClosed
+	}
+
+	totals, err := cashShiftTotals(ctx, tx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	expected := totals.Expected(s.OpeningFloat, s.Currency)
+
+	update := `
+		UPDATE cash_shifts
+		SET status = $2, closed_by = $3, expected_amount = $4, counted_amount = $5,
+			variance = $6, note = COALESCE($7, note), closed_at = now()
+		WHERE id = $1
+		RETURNING ` + cashShiftColumns
+
+	s, err = scanCashShift(tx.QueryRowContext(ctx, update,
+		id, domain.CashShiftStatusClosed, nullStr(closedBy), expected, counted,
+		domain.RoundAmount(counted-expected, s.Currency), nullStr(note),
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+	return s, totals, nil
+}
+
+func cashShiftTotals(
+	ctx context.Context,
+	q interface {
+		QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	},
+	shiftID string,
+) (*domain.CashShiftTotals, error) {
+
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM payments WHERE shift_id = $1 AND status IN ($2, $3, $4)),
+			(SELECT COALESCE(SUM(amount), 0) FROM payments WHERE shift_id = $1 AND status IN ($2, $3, $4)),
+			(SELECT COUNT(*) FROM refunds WHERE shift_id = $1 AND status = $5),
+			(SELECT COALESCE(SUM(amount), 0) FROM refunds WHERE shift_id = $1 AND status = $5),
+			(SELECT COALESCE(SUM(amount), 0) FROM cash_movements WHERE shift_id = $1 AND kind = $6),
+			(SELECT COALESCE(SUM(amount), 0) FROM cash_movements WHERE shift_id = $1 AND kind = $7)
+	`
+
+	var t domain.CashShiftTotals
+	err := q.QueryRowContext(ctx, query,
+		shiftID,
+		domain.PaymentStatusCompleted, domain.PaymentStatusPartiallyRefunded, domain.PaymentStatusRefunded,
+		domain.RefundStatusCompleted,
+		domain.CashMovementIn, domain.CashMovementOut,
+	).Scan(&t.SalesCount, &t.Sales, &t.RefundsCount, &t.Refunds, &t.CashIn, &t.CashOut)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func scanCashShift(row interface{ Scan(...any) error }) (*domain.CashShift, error) {
+	var s domain.CashShift
+	err := row.Scan(
+		&s.ID,
+		&s.ShopID,
+		&s.OpenedBy,
+		&s.ClosedBy,
+		&s.Currency,
+		&s.Status,
+		&s.OpeningFloat,
+		&s.ExpectedAmount,
+		&s.CountedAmount,
+		&s.Variance,
+		&s.Note,
+		&s.OpenedAt,
+		&s.ClosedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"paymentservice/internal/domain"
+)
+
+// ClaimIdempotencyKey records key as in flight for operation. It reports true
+// when the caller claimed the key and should process the request; otherwise it
+// returns the record left by the earlier request. A key past its expiry is
+// claimed afresh. It returns sql.ErrNoRows if the earlier record disappeared
+// while being read.
+func (r *PaymentService) ClaimIdempotencyKey(
+	ctx context.Context,
+	operation string,
+	key string,
+	requestHash string,
+	ttl time.Duration,
+) (*domain.IdempotencyKey, bool, error) {
+
+	claim := `
+		INSERT INTO idempotency_keys (operation, idempotency_key, request_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (operation, idempotency_key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+			response = NULL,
+			created_at = now(),
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= now()
+		RETURNING operation
+	`
+
+	var claimed string
+	err := r.db.QueryRowContext(ctx, claim, operation, key, requestHash, time.Now().Add(ttl)).Scan(&claimed)
+	if err == nil {
+		return nil, true, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, false, err
+	}
+
+	existing := `
+		SELECT operation, idempotency_key, request_hash, response, created_at, expires_at
+		FROM idempotency_keys
+		WHERE operation = $1 AND idempotency_key = $2
+	`
+
+	var k domain.IdempotencyKey
+	err = r.db.QueryRowContext(ctx, existing, operation, key).Scan(
+		&k.Operation,
+		&k.Key,
+		&k.RequestHash,
+		&k.Response,
+		&k.CreatedAt,
+		&k.ExpiresAt,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &k, false, nil
+}
+
+// SaveIdempotentResponse stores the response to replay for retries of key.
+func (r *PaymentService) SaveIdempotentResponse(
+	ctx context.Context,
+	operation string,
+	key string,
+	response []byte,
+) error {
+
+	_, err := r.db.ExecContext(
+		ctx,
+		`UPDATE idempotency_keys SET response = $3 WHERE operation = $1 AND idempotency_key = $2`,
+		operation,
+		key,
+		response,
+	)
+	return err
+}
+
+// ReleaseIdempotencyKey forgets key, so the request can be retried with it.
+func (r *PaymentService) ReleaseIdempotencyKey(
+	ctx context.Context,
+	operation string,
+	key string,
+) error {
+
+	_, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM idempotency_keys WHERE operation = $1 AND idempotency_key = $2`,
+		operation,
+		key,
+	)
+	return err
+}
+
+// PurgeIdempotencyKeys deletes expired idempotency keys and returns how many
+// were deleted.
+func (r *PaymentService) PurgeIdempotencyKeys(ctx context.Context) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= now()`)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
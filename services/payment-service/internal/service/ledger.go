@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"paymentservice/internal/domain"
+)
+
+// ErrLedgerUnbalanced is returned for a transaction whose debits and credits
+// differ.
+var ErrLedgerUnbalanced = errors.New("ledger transaction is unbalanced")
+
+// PostLedgerTransaction writes t and its lines in one database transaction. A
+// transaction of the same kind and reference posted earlier is kept as it is
+// and returned in place of t, so posting can safely be retried.
+func (r *PaymentService) PostLedgerTransaction(
+	ctx context.Context,
+	t *domain.LedgerTransaction,
+) (*domain.LedgerTransaction, error) {
+
+	if !t.Balanced() {
+		return nil, ErrLedgerUnbalanced
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	insert := `
+		INSERT INTO ledger_transactions (shop_id, payment_id, kind, reference, currency, description)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (kind, reference) DO NOTHING
+		RETURNING id, created_at
+	`
+
+	err = tx.QueryRowContext(ctx, insert,
+		nullStr(t.ShopID), nullStr(t.PaymentID), t.Kind, t.Reference, t.Currency, nullStr(t.Description),
+	).Scan(&t.ID, &t.CreatedAt)
+	if err == sql.ErrNoRows {
+		return r.getLedgerTransaction(ctx, t.Kind, t.Reference)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range t.Lines {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO ledger_entries (transaction_id, account, debit, credit) VALUES ($1, $2, $3, $4)`,
+			t.ID, l.Account, l.Debit, l.Credit,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *PaymentService) getLedgerTransaction(
+	ctx context.Context,
+	kind string,
+	reference string,
+) (*domain.LedgerTransaction, error) {
+
+	query := `
+		SELECT id, COALESCE(shop_id::text, ''), COALESCE(payment_id::text, ''),
+			kind, reference, currency, COALESCE(description, ''), created_at
+		FROM ledger_transactions
+		WHERE kind = $1 AND reference = $2
+	`
+
+	var t domain.LedgerTransaction
+	err := r.db.QueryRowContext(ctx, query, kind, reference).Scan(
+		&t.ID,
+		&t.ShopID,
+		&t.PaymentID,
+		&t.Kind,
+		&t.Reference,
+		&t.Currency,
+		&t.Description,
+		&t.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT account, debit, credit FROM ledger_entries WHERE transaction_id = $1 ORDER BY id`,
+		t.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var l domain.LedgerLine
+		if err := rows.Scan(&l.Account, &l.Debit, &l.Credit); err != nil {
+			return nil, err
+		}
+		t.Lines = append(t.Lines, l)
+	}
+
+	return &t, rows.Err()
+}
+
+// ShopBalance totals the shop's ledger by account and currency, counting
+// transactions up to asOf.
+func (r *PaymentService) ShopBalance(
+	ctx context.Context,
+	shopID string,
+	asOf time.Time,
+) ([]*domain.AccountBalance, error) {
+
+	query := `
+		SELECT e.account, t.currency, SUM(e.debit), SUM(e.credit)
+		FROM ledger_entries e
+		JOIN ledger_transactions t ON t.id = e.transaction_id
+		WHERE t.shop_id = $1 AND t.created_at <= $2
+		GROUP BY e.account, t.currency
+		ORDER BY t.currency, e.account
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, shopID, asOf)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	balances := make([]*domain.AccountBalance, 0)
+	for rows.Next() {
+		var b domain.AccountBalance
+		if err := rows.Scan(&b.Account, &b.Currency, &b.Debit, &b.Credit); err != nil {
+			return nil, err
+		}
+		balances = append(balances, &b)
+	}
+
+	return balances, rows.Err()
+}
+
+// Reconcile compares the shop's payments created in [from, to) with their
+// ledger entries. It returns how many payments were checked and those whose
+// captured amount, fee or refunded amount differ from what the ledger holds.
+func (r *PaymentService) Reconcile(
+	ctx context.Context,
+	shopID string,
+	from time.Time,
+	to time.Time,
+) (int, []*domain.PaymentDiscrepancy, error) {
+
+	query := `
+		SELECT p.id, p.status, p.currency, p.amount, COALESCE(p.processing_fee, 0), p.refunded_amount,
+			COALESCE(l.captured, 0), COALESCE(l.fees, 0), COALESCE(l.refunded, 0)
+		FROM payments p
+		LEFT JOIN (
+			SELECT t.payment_id,
+				SUM(CASE WHEN t.kind = $4 AND e.account = $6 THEN e.credit ELSE 0 END) AS captured,
+				SUM(CASE WHEN t.kind = $4 AND e.account = $7 THEN e.debit ELSE 0 END) AS fees,
+				SUM(CASE WHEN t.kind = $5 AND e.account = $8 THEN e.debit ELSE 0 END) AS refunded
+			FROM ledger_transactions t
+			JOIN ledger_entries e ON e.transaction_id = t.id
+			WHERE t.shop_id = $1 AND t.payment_id IS NOT NULL
+			GROUP BY t.payment_id
+		) l ON l.payment_id = p.id
+		WHERE p.shop_id = $1 AND p.created_at >= $2 AND p.created_at < $3
+		ORDER BY p.created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query,
+		shopID, from, to,
+		domain.LedgerKindCapture, domain.LedgerKindRefund,
+		domain.AccountSales, domain.AccountProcessingFees, domain.AccountRefunds,
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer rows.Close()
+
+	checked := 0
+	discrepancies := make([]*domain.PaymentDiscrepancy, 0)
+	for rows.Next() {
+		var (
+			id, status, currency      string
+			amount, fee, refunded     float64
+			ledgerCaptured, ledgerFee float64
+			ledgerRefunded            float64
+		)
+		if err := rows.Scan(&id, &status, &currency, &amount, &fee, &refunded, &ledgerCaptured, &ledgerFee, &ledgerRefunded); err != nil {
+			return 0, nil, err
+		}
+		checked++
+
+		var captured float64
+		if domain.IsCaptured(status) {
+			captured = amount
+		} else {
+			fee = 0
+		}
+
+		for _, c := range []struct {
+			kind               string
+			expected, recorded float64
+		}{
+			{"capture", captured, ledgerCaptured},
+			{"fee", fee, ledgerFee},
+			{"refund", refunded, ledgerRefunded},
+		} {
+			if domain.MinorUnits(c.expected, currency) != domain.MinorUnits(c.recorded, currency) {
+				discrepancies = append(discrepancies, &domain.PaymentDiscrepancy{
+					PaymentID: id,
+					Kind:      c.kind,
+					Expected:  c.expected,
+					Recorded:  c.recorded,
+				})
+			}
+		}
+	}
+
+	return checked, discrepancies, rows.Err()
+}
@@ -23,11 +23,13 @@ func (r *PaymentService) Create(
 	query := `
 		INSERT INTO payments (
 			order_id, user_id, amount, currency,
-			payment_method, status,
+			payment_method, provider, status,
 			transaction_id, reference_id,
-			processing_fee
+			processing_fee, error_message,
+			authorized_amount, authorized_at, authorization_expires_at, captured_at,
+			shop_id, shift_id
 		)
-		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17)
 		RETURNING id, created_at, updated_at
 	`
 
@@ -35,14 +37,22 @@ func (r *PaymentService) Create(
 		ctx,
 		query,
 		p.OrderID,
-		p.UserID,
+		nullStr(p.UserID),
 		p.Amount,
 		p.Currency,
 		p.PaymentMethod,
+		nullStr(p.Provider),
 		p.Status,
 		p.TransactionID,
-		p.ReferenceID,
+		nullStr(p.ReferenceID),
 		p.ProcessingFee,
+		nullStr(p.ErrorMessage),
+		nullFloat(p.AuthorizedAmount),
+		p.AuthorizedAt,
+		p.ExpiresAt,
+		p.CapturedAt,
+		nullStr(p.ShopID),
+		nullStr(p.ShiftID),
 	).Scan(
 		&p.ID,
 		&p.CreatedAt,
@@ -52,53 +62,252 @@ func (r *PaymentService) Create(
 	return p, err
 }
 
+const paymentColumns = `
+	id, COALESCE(shop_id::text, ''), COALESCE(order_id::text, ''), COALESCE(user_id::text, ''),
+	amount, currency,
+	payment_method, COALESCE(provider, ''), status,
+	transaction_id, COALESCE(reference_id, ''),
+	COALESCE(processing_fee, 0), refunded_amount, COALESCE(error_message, ''),
+	COALESCE(authorized_amount, 0), authorized_at, authorization_expires_at,
+	captured_at, voided_at, COALESCE(status_reason, ''),
+	created_at, updated_at
+`
+
 func (r *PaymentService) GetByID(
 	ctx context.Context,
-	id int32,
+	id string,
 ) (*domain.Payment, error) {
 
 	query := `
-		SELECT
-			id, order_id, user_id,
-			amount, currency,
-			payment_method, status,
-			transaction_id, reference_id,
-			processing_fee,
-			created_at, updated_at
+		SELECT ` + paymentColumns + `
 		FROM payments
 		WHERE id = $1
 	`
 
+	return scanPayment(r.db.QueryRowContext(ctx, query, id))
+}
+
+// GetShopPayment returns one of the shop's payments, or sql.ErrNoRows when
+// the payment belongs to another shop.
+func (r *PaymentService) GetShopPayment(
+	ctx context.Context,
+	shopID string,
+	id string,
+) (*domain.Payment, error) {
+
+	query := `
+		SELECT ` + paymentColumns + `
+		FROM payments
+		WHERE shop_id = $1 AND id = $2
+	`
+
+	return scanPayment(r.db.QueryRowContext(ctx, query, shopID, id))
+}
+
+// ListByOrder returns every payment the shop recorded against an order,
+// oldest first.
+func (r *PaymentService) ListByOrder(
+	ctx context.Context,
+	shopID string,
+	orderID string,
+) ([]*domain.Payment, error) {
+
+	query := `
+		SELECT ` + paymentColumns + `
+		FROM payments
+		WHERE shop_id = $1 AND order_id = $2
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, shopID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	payments := make([]*domain.Payment, 0)
+	for rows.Next() {
+		p, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, p)
+	}
+
+	return payments, rows.Err()
+}
+
+// UpdateStatus moves a payment from status from to status to, recording
+// reason when given. It returns domain.ErrInvalidTransition when the move is
+// not allowed and sql.ErrNoRows when the payment is no longer in status from.
+func (r *PaymentService) UpdateStatus(
+	ctx context.Context,
+	id string,
+	from string,
+	to string,
+	reason string,
+) (*domain.Payment, error) {
+
+	if !domain.CanTransition(from, to) {
+		return nil, domain.ErrInvalidTransition
+	}
+
+	query := `
+		UPDATE payments
+		SET status = $3,
+			status_reason = COALESCE($4, status_reason),
+			captured_at = CASE WHEN $3 = $5 THEN COALESCE(captured_at, now()) ELSE captured_at END,
+			voided_at = CASE WHEN $3 = $6 THEN now() ELSE voided_at END,
+			updated_at = now()
+		WHERE id = $1 AND status = $2
+		RETURNING ` + paymentColumns
+
+	return scanPayment(r.db.QueryRowContext(ctx, query,
+		id, from, to, nullStr(reason),
+		domain.PaymentStatusCompleted, domain.PaymentStatusVoided,
+	))
+}
+
+// GetByReference returns the payment a provider knows by reference.
+func (r *PaymentService) GetByReference(
+	ctx context.Context,
+	providerName string,
+	reference string,
+) (*domain.Payment, error) {
+
+	query := `
+		SELECT ` + paymentColumns + `
+		FROM payments
+		WHERE provider = $1 AND reference_id = $2
+	`
+
+	return scanPayment(r.db.QueryRowContext(ctx, query, providerName, reference))
+}
+
+func scanPayment(row interface{ Scan(...any) error }) (*domain.Payment, error) {
 	var p domain.Payment
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := row.Scan(
 		&p.ID,
+		&p.ShopID,
 		&p.OrderID,
 		&p.UserID,
 		&p.Amount,
 		&p.Currency,
 		&p.PaymentMethod,
+		&p.Provider,
 		&p.Status,
 		&p.TransactionID,
 		&p.ReferenceID,
 		&p.ProcessingFee,
+		&p.Refunded,
+		&p.ErrorMessage,
+		&p.AuthorizedAmount,
+		&p.AuthorizedAt,
+		&p.ExpiresAt,
+		&p.CapturedAt,
+		&p.VoidedAt,
+		&p.StatusReason,
 		&p.CreatedAt,
 		&p.UpdatedAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Capture completes an authorized or pending payment for amount. It returns
+// sql.ErrNoRows when the payment is no longer open for capture, including
+// when its authorization has expired.
+func (r *PaymentService) Capture(
+	ctx context.Context,
+	id string,
+	amount float64,
+	processingFee float64,
+	transactionID string,
+) (*domain.Payment, error) {
 
-	return &p, err
+	query := `
+		UPDATE payments
+		SET status = $5,
+			amount = $2,
+			processing_fee = $3,
+			transaction_id = $4,
+			captured_at = now(),
+			updated_at = now()
+		WHERE id = $1 AND status IN ($6, $7)
+			AND (authorization_expires_at IS NULL OR authorization_expires_at > now())
+		RETURNING ` + paymentColumns
+
+	return scanPayment(r.db.QueryRowContext(ctx, query,
+		id, amount, processingFee, transactionID,
+		domain.PaymentStatusCompleted, domain.PaymentStatusAuthorized, domain.PaymentStatusPending,
+	))
 }
 
-func (r *PaymentService) UpdateStatus(
+// Void releases an authorized or pending payment. It returns sql.ErrNoRows
+// when the payment is no longer open.
+func (r *PaymentService) Void(
+	ctx context.Context,
+	id string,
+	reason string,
+) (*domain.Payment, error) {
+
+	query := `
+		UPDATE payments
+		SET status = $2, status_reason = $3, voided_at = now(), updated_at = now()
+		WHERE id = $1 AND status IN ($4, $5)
+		RETURNING ` + paymentColumns
+
+	return scanPayment(r.db.QueryRowContext(ctx, query,
+		id, domain.PaymentStatusVoided, nullStr(reason),
+		domain.PaymentStatusAuthorized, domain.PaymentStatusPending,
+	))
+}
+
+// ExpireAuthorizations marks up to limit open payments whose authorization
+// has run out as expired and returns them.
+func (r *PaymentService) ExpireAuthorizations(
 	ctx context.Context,
-	id int32,
-	status string,
-) error {
+	limit int,
+) ([]*domain.Payment, error) {
 
-	_, err := r.db.ExecContext(
-		ctx,
-		`UPDATE payments SET status=$1, updated_at=now() WHERE id=$2`,
-		status,
-		id,
+	query := `
+		UPDATE payments
+		SET status = $1, status_reason = 'authorization expired', updated_at = now()
+		WHERE id IN (
+			SELECT id FROM payments
+			WHERE status IN ($2, $3) AND authorization_expires_at <= now()
+			ORDER BY authorization_expires_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + paymentColumns
+
+	rows, err := r.db.QueryContext(ctx, query,
+		domain.PaymentStatusExpired, domain.PaymentStatusAuthorized, domain.PaymentStatusPending, limit,
 	)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	payments := make([]*domain.Payment, 0)
+	for rows.Next() {
+		p, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, p)
+	}
+
+	return payments, rows.Err()
+}
+
+func nullFloat(f float64) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: f, Valid: f != 0}
+}
+
+func nullStr(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
 }
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+
+	"paymentservice/internal/domain"
+)
+
+const refundColumns = `
+	id, payment_id, amount, reason_code, COALESCE(reason, ''), status,
+	COALESCE(transaction_id, ''), COALESCE(error_message, ''), COALESCE(created_by::text, ''),
+	created_at, updated_at
+`
+
+// CreateRefund records rf as pending and adds its amount to the payment's
+// refunded amount, moving the payment to partially_refunded or refunded. It
+// returns the updated payment, or sql.ErrNoRows when the payment cannot be
+// refunded or rf.Amount is more than is left to refund.
+func (r *PaymentService) CreateRefund(
+	ctx context.Context,
+	rf *domain.Refund,
+) (*domain.Payment, error) {
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// The update re-checks status and what is left, so concurrent refunds
+	// cannot give back more than was captured.
+	reserve := `
+		UPDATE payments
+		SET refunded_amount = refunded_amount + $2,
+			status = CASE WHEN refunded_amount + $2 >= amount THEN $3 ELSE $4 END,
+			updated_at = now()
+		WHERE id = $1 AND status IN ($5, $4) AND refunded_amount + $2 <= amount
+		RETURNING ` + paymentColumns
+
+	p, err := scanPayment(tx.QueryRowContext(ctx, reserve,
+		rf.PaymentID, rf.Amount,
+		domain.PaymentStatusRefunded, domain.PaymentStatusPartiallyRefunded, domain.PaymentStatusCompleted,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	insert := `
+		INSERT INTO refunds (payment_id, amount, reason_code, reason, status, created_by, shift_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at
+	`
+
+	rf.Status = domain.RefundStatusPending
+	err = tx.QueryRowContext(ctx, insert,
+		rf.PaymentID, rf.Amount, rf.ReasonCode, nullStr(rf.Reason), rf.Status, nullStr(rf.CreatedBy), nullStr(rf.ShiftID),
+	).Scan(&rf.ID, &rf.CreatedAt, &rf.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, tx.Commit()
+}
+
+// CompleteRefund marks a pending refund completed with the provider's
+// transaction ID.
+func (r *PaymentService) CompleteRefund(
+	ctx context.Context,
+	id string,
+	transactionID string,
+) (*domain.Refund, error) {
+
+	query := `
+		UPDATE refunds
+		SET status = $2, transaction_id = $3, updated_at = now()
+		WHERE id = $1 AND status = $4
+		RETURNING ` + refundColumns
+
+	return scanRefund(r.db.QueryRowContext(ctx, query,
+		id, domain.RefundStatusCompleted, nullStr(transactionID), domain.RefundStatusPending,
+	))
+}
+
+// FailRefund marks a pending refund failed and takes its amount off the
+// payment's refunded amount again.
+func (r *PaymentService) FailRefund(
+	ctx context.Context,
+	id string,
+	errorMessage string,
+) error {
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var paymentID string
+	var amount float64
+	err = tx.QueryRowContext(ctx, `
+		UPDATE refunds
+		SET status = $2, error_message = $3, updated_at = now()
+		WHERE id = $1 AND status = $4
+		RETURNING payment_id, amount
+	`, id, domain.RefundStatusFailed, nullStr(errorMessage), domain.RefundStatusPending).Scan(&paymentID, &amount)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE payments
+		SET refunded_amount = refunded_amount - $2,
+			status = CASE WHEN refunded_amount - $2 <= 0 THEN $3 ELSE $4 END,
+			updated_at = now()
+		WHERE id = $1
+	`, paymentID, amount, domain.PaymentStatusCompleted, domain.PaymentStatusPartiallyRefunded)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PaymentService) ListRefunds(
+	ctx context.Context,
+	paymentID string,
+) ([]*domain.Refund, error) {
+
+	query := `
+		SELECT ` + refundColumns + `
+		FROM refunds
+		WHERE payment_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	refunds := make([]*domain.Refund, 0)
+	for rows.Next() {
+		rf, err := scanRefund(rows)
+		if err != nil {
+			return nil, err
+		}
+		refunds = append(refunds, rf)
+	}
+
+	return refunds, rows.Err()
+}
+
+func scanRefund(row interface{ Scan(...any) error }) (*domain.Refund, error) {
+	var rf domain.Refund
+	err := row.Scan(
+		&rf.ID,
+		&rf.PaymentID,
+		&rf.Amount,
+		&rf.ReasonCode,
+		&rf.Reason,
+		&rf.Status,
+		&rf.TransactionID,
+		&rf.ErrorMessage,
+		&rf.CreatedBy,
+		&rf.CreatedAt,
+		&rf.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &rf, nil
+}
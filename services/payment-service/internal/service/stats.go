@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"paymentservice/internal/domain"
+)
+
+// PaymentStats totals the shop's payments created in [from, to) by period,
+// currency, method and status. unit is a date_trunc field such as "day";
+// periods start at midnight in timeZone.
+func (r *PaymentService) PaymentStats(
+	ctx context.Context,
+	shopID string,
+	unit string,
+	timeZone string,
+	from time.Time,
+	to time.Time,
+) ([]*domain.PaymentStatsRow, error) {
+
+	query := `
+		SELECT date_trunc($4, created_at AT TIME ZONE $5) AT TIME ZONE $5 AS period_start,
+			currency, payment_method, status,
+			COUNT(*), SUM(amount), SUM(refunded_amount)
+		FROM payments
+		WHERE shop_id = $1 AND created_at >= $2 AND created_at < $3
+		GROUP BY 1, currency, payment_method, status
+		ORDER BY 1, currency, payment_method, status
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, shopID, from, to, unit, timeZone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make([]*domain.PaymentStatsRow, 0)
+	for rows.Next() {
+		var s domain.PaymentStatsRow
+		err := rows.Scan(
+			&s.PeriodStart,
+			&s.Currency,
+			&s.Method,
+			&s.Status,
+			&s.Transactions,
+			&s.Amount,
+			&s.Refunded,
+		)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, &s)
+	}
+
+	return stats, rows.Err()
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"paymentservice/internal/domain"
+)
+
+// webhookClaimTimeout is how long a claimed event may go without an outcome
+// before it is taken to have been abandoned, e.g. because its outcome could
+// not be recorded, and is claimed again by the next delivery.
+const webhookClaimTimeout = 5 * time.Minute
+
+// ClaimWebhookEvent records e as being applied. It reports false when the
+// provider already sent an event with the same ID, which must not be applied
+// again, unless that event was claimed over webhookClaimTimeout ago and
+// never finished.
+func (r *PaymentService) ClaimWebhookEvent(
+	ctx context.Context,
+	e *domain.WebhookEvent,
+) (bool, error) {
+
+	query := `
+		INSERT INTO webhook_events (provider, event_id, event_type, occurred_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (provider, event_id) DO UPDATE
+		SET received_at = now()
+		WHERE webhook_events.outcome IS NULL
+			AND webhook_events.received_at < now() - $5 * interval '1 second'
+	`
+
+	res, err := r.db.ExecContext(ctx, query,
+		e.Provider, e.EventID, e.EventType, e.OccurredAt, webhookClaimTimeout.Seconds(),
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// FinishWebhookEvent records what applying a claimed event did.
+func (r *PaymentService) FinishWebhookEvent(
+	ctx context.Context,
+	e *domain.WebhookEvent,
+) error {
+
+	_, err := r.db.ExecContext(
+		ctx,
+		`UPDATE webhook_events SET payment_id = $3, outcome = $4 WHERE provider = $1 AND event_id = $2`,
+		e.Provider,
+		e.EventID,
+		sql.NullString{String: e.PaymentID, Valid: e.PaymentID != ""},
+		e.Outcome,
+	)
+	return err
+}
+
+// ReleaseWebhookEvent forgets a claimed event that could not be applied, so
+// the provider's next delivery of it is applied instead of being dropped as a
+// duplicate.
+func (r *PaymentService) ReleaseWebhookEvent(
+	ctx context.Context,
+	e *domain.WebhookEvent,
+) error {
+
+	_, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM webhook_events WHERE provider = $1 AND event_id = $2 AND outcome IS NULL`,
+		e.Provider,
+		e.EventID,
+	)
+	return err
+}
+
+// GetWebhookEvent returns an event recorded earlier.
+func (r *PaymentService) GetWebhookEvent(
+	ctx context.Context,
+	providerName string,
+	eventID string,
+) (*domain.WebhookEvent, error) {
+
+	query := `
+		SELECT provider, event_id, event_type, COALESCE(payment_id::text, ''), COALESCE(outcome, ''),
+			occurred_at, received_at
+		FROM webhook_events
+		WHERE provider = $1 AND event_id = $2
+	`
+
+	var e domain.WebhookEvent
+	err := r.db.QueryRowContext(ctx, query, providerName, eventID).Scan(
+		&e.Provider,
+		&e.EventID,
+		&e.EventType,
+		&e.PaymentID,
+		&e.Outcome,
+		&e.OccurredAt,
+		&e.ReceivedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
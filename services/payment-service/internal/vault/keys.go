@@ -0,0 +1,106 @@
+package vault
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeySize is the length of a vault key: AES-256.
+const KeySize = 32
+
+// ErrUnknownKey is returned for a key ID the key provider does not hold, e.g.
+// one retired before the cards sealed under it were re-tokenized.
+var ErrUnknownKey = errors.New("unknown vault key")
+
+// KeyProvider supplies the keys card numbers are sealed under. New cards are
+// sealed under the current key; older keys stay available for opening cards
+// sealed before a rotation. A KMS or HSM backed provider can replace the file
+// provider without touching the vault.
+type KeyProvider interface {
+	// CurrentKey returns the key to seal new cards under and its ID.
+	CurrentKey(ctx context.Context) (string, []byte, error)
+	// Key returns the key with the given ID.
+	Key(ctx context.Context, id string) ([]byte, error)
+}
+
+// FileKeyProvider holds keys read from a file, one "<id> <base64 key>" per
+// line. The last key is the current one, so a key is rotated by appending a
+// line. Blank lines and lines starting with # are ignored. Meant for
+// development; keep the file out of the repository and readable by the
+// service only.
+type FileKeyProvider struct {
+	keys    map[string][]byte
+	current string
+}
+
+func NewFileKeyProvider(path string) (*FileKeyProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	p := &FileKeyProvider{keys: make(map[string][]byte)}
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("vault key file %s line %d: want \"<id> <base64 key>\"", path, n)
+		}
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil || len(key) != KeySize {
+			return nil, fmt.Errorf("vault key file %s line %d: key must be %d bytes, base64 encoded", path, n, KeySize)
+		}
+		p.keys[fields[0]] = key
+		p.current = fields[0]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if p.current == "" {
+		return nil, fmt.Errorf("vault key file %s holds no keys", path)
+	}
+	return p, nil
+}
+
+// NewEphemeralKeyProvider returns a provider holding one random key that
+// lives as long as the process. Cards tokenized under it cannot be used after
+// a restart; it is only for running the service without a key file.
+func NewEphemeralKeyProvider() (*FileKeyProvider, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	id := make([]byte, 4)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	current := "ephemeral-" + hex.EncodeToString(id)
+	return &FileKeyProvider{
+		keys:    map[string][]byte{current: key},
+		current: current,
+	}, nil
+}
+
+func (p *FileKeyProvider) CurrentKey(ctx context.Context) (string, []byte, error) {
+	return p.current, p.keys[p.current], nil
+}
+
+func (p *FileKeyProvider) Key(ctx context.Context, id string) ([]byte, error) {
+	key, ok := p.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, id)
+	}
+	return key, nil
+}
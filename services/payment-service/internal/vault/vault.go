@@ -0,0 +1,193 @@
+// Package vault tokenizes card data. A card number is sealed with AES-GCM
+// under a key from a KeyProvider and referred to by an opaque token from then
+// on, so only the vault ever sees it in the clear. Security codes are checked
+// and dropped; the vault never seals them.
+package vault
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TokenPrefix starts every card token.
+const TokenPrefix = "card_"
+
+var (
+	// ErrInvalidCard is returned for a card number that fails the Luhn
+	// check, an expiry in the past or a malformed security code.
+	ErrInvalidCard = errors.New("invalid card")
+	// ErrCorrupt is returned when a sealed card cannot be opened with its key.
+	ErrCorrupt = errors.New("sealed card cannot be opened")
+)
+
+type Vault struct {
+	keys KeyProvider
+	now  func() time.Time
+}
+
+func New(keys KeyProvider) *Vault {
+	return &Vault{keys: keys, now: time.Now}
+}
+
+// NewToken returns a random card token such as "card_3f2a...".
+func NewToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return TokenPrefix + hex.EncodeToString(b), nil
+}
+
+// Seal encrypts pan for token under the current key and returns the key's ID
+// and the nonce followed by the ciphertext. The token is bound in as
+// additional data, so a sealed number cannot be moved to another token.
+func (v *Vault) Seal(ctx context.Context, token, pan string) (string, []byte, error) {
+	keyID, key, err := v.keys.CurrentKey(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+	return keyID, aead.Seal(nonce, nonce, []byte(pan), []byte(token)), nil
+}
+
+// Open decrypts a number sealed by Seal.
+func (v *Vault) Open(ctx context.Context, keyID, token string, sealed []byte) (string, error) {
+	key, err := v.keys.Key(ctx, keyID)
+	if err != nil {
+		return "", err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return "", ErrCorrupt
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	pan, err := aead.Open(nil, nonce, ciphertext, []byte(token))
+	if err != nil {
+		return "", ErrCorrupt
+	}
+	return string(pan), nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Validate checks a card number, expiry and, when given, security code. It
+// returns the number with spaces and dashes removed and the expiry as a
+// two-digit month and four-digit year.
+func (v *Vault) Validate(number, month, year, cvv string) (string, string, string, error) {
+	pan := strings.NewReplacer(" ", "", "-", "").Replace(number)
+	if len(pan) < 12 || len(pan) > 19 || !digits(pan) || !luhn(pan) {
+		return "", "", "", ErrInvalidCard
+	}
+	if cvv != "" && (len(cvv) < 3 || len(cvv) > 4 || !digits(cvv)) {
+		return "", "", "", ErrInvalidCard
+	}
+
+	m, err := strconv.Atoi(month)
+	if err != nil || m < 1 || m > 12 {
+		return "", "", "", ErrInvalidCard
+	}
+	y, err := strconv.Atoi(year)
+	if err != nil || y < 0 {
+		return "", "", "", ErrInvalidCard
+	}
+	if y < 100 {
+		y += 2000
+	}
+	if Expired(m, y, v.now()) {
+		return "", "", "", ErrInvalidCard
+	}
+
+	return pan, pad(m, 2), pad(y, 4), nil
+}
+
+// Expired reports whether a card expiring in month/year has expired at now.
+// A card is good through the last day of its expiry month.
+func Expired(month, year int, now time.Time) bool {
+	return !now.Before(time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, time.UTC))
+}
+
+// Brand names the card network from the number's prefix.
+func Brand(pan string) string {
+	prefix := func(n int) int {
+		if len(pan) < n {
+			return -1
+		}
+		v, _ := strconv.Atoi(pan[:n])
+		return v
+	}
+
+	switch {
+	case pan[0] == '4':
+		return "visa"
+	case prefix(2) >= 51 && prefix(2) <= 55, prefix(4) >= 2221 && prefix(4) <= 2720:
+		return "mastercard"
+	case prefix(2) == 34, prefix(2) == 37:
+		return "amex"
+	case prefix(4) == 6011, prefix(2) == 65, prefix(3) >= 644 && prefix(3) <= 649:
+		return "discover"
+	case prefix(4) >= 3528 && prefix(4) <= 3589:
+		return "jcb"
+	case prefix(2) == 62:
+		return "unionpay"
+	}
+	return "unknown"
+}
+
+func luhn(pan string) bool {
+	sum := 0
+	double := false
+	for i := len(pan) - 1; i >= 0; i-- {
+		d := int(pan[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func digits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func pad(n, width int) string {
+	s := strconv.Itoa(n)
+	for len(s) < width {
+		s = "0" + s
+	}
+	return s
+}
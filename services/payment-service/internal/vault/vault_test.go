@@ -0,0 +1,155 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLuhn(t *testing.T) {
+	tests := []struct {
+		pan  string
+		want bool
+	}{
+		{"4111111111111111", true},
+		{"4111111111111112", false},
+		{"5555555555554444", true},
+		{"378282246310005", true},
+		{"79927398713", true},
+		{"79927398710", false},
+		{"0000000000000000", true},
+	}
+	for _, tt := range tests {
+		if got := luhn(tt.pan); got != tt.want {
+			t.Errorf("luhn(%q) = %v, want %v", tt.pan, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	v := New(nil)
+	v.now = func() time.Time { return time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name                     string
+		number, month, year, cvv string
+		pan, wantMonth, wantYear string
+		err                      error
+	}{
+		{"plain", "4111111111111111", "12", "2030", "123", "4111111111111111", "12", "2030", nil},
+		{"spaces and dashes", "4111 1111-1111 1111", "3", "30", "", "4111111111111111", "03", "2030", nil},
+		{"good through its month", "4111111111111111", "6", "2026", "1234", "4111111111111111", "06", "2026", nil},
+		{"expired", "4111111111111111", "5", "2026", "123", "", "", "", ErrInvalidCard},
+		{"fails Luhn", "4111111111111112", "12", "2030", "123", "", "", "", ErrInvalidCard},
+		{"too short", "41111111111", "12", "2030", "123", "", "", "", ErrInvalidCard},
+		{"letters", "4111a11111111111", "12", "2030", "123", "", "", "", ErrInvalidCard},
+		{"month 13", "4111111111111111", "13", "2030", "123", "", "", "", ErrInvalidCard},
+		{"short cvv", "4111111111111111", "12", "2030", "12", "", "", "", ErrInvalidCard},
+		{"cvv letters", "4111111111111111", "12", "2030", "12a", "", "", "", ErrInvalidCard},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pan, month, year, err := v.Validate(tt.number, tt.month, tt.year, tt.cvv)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Validate error = %v, want %v", err, tt.err)
+			}
+			if pan != tt.pan || month != tt.wantMonth || year != tt.wantYear {
+				t.Errorf("Validate = %q, %q, %q, want %q, %q, %q", pan, month, year, tt.pan, tt.wantMonth, tt.wantYear)
+			}
+		})
+	}
+}
+
+func TestBrand(t *testing.T) {
+	tests := []struct {
+		pan  string
+		want string
+	}{
+		{"4111111111111111", "visa"},
+		{"5555555555554444", "mastercard"},
+		{"2221000000000009", "mastercard"},
+		{"378282246310005", "amex"},
+		{"6011111111111117", "discover"},
+		{"3530111333300000", "jcb"},
+		{"6200000000000005", "unionpay"},
+		{"9999999999999995", "unknown"},
+	}
+	for _, tt := range tests {
+		if got := Brand(tt.pan); got != tt.want {
+			t.Errorf("Brand(%q) = %q, want %q", tt.pan, got, tt.want)
+		}
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	ctx := context.Background()
+	keys := writeKeyFile(t, "old", "new")
+	v := New(keys)
+
+	keyID, sealed, err := v.Seal(ctx, "card_a", "4111111111111111")
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	if keyID != "new" {
+		t.Errorf("sealed under key %q, want the current key %q", keyID, "new")
+	}
+	if bytes.Contains(sealed, []byte("4111111111111111")) {
+		t.Fatal("sealed card holds the number in the clear")
+	}
+
+	tampered := bytes.Clone(sealed)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name   string
+		keyID  string
+		token  string
+		sealed []byte
+		want   string
+		err    error
+	}{
+		{"opens", keyID, "card_a", sealed, "4111111111111111", nil},
+		{"other token", keyID, "card_b", sealed, "", ErrCorrupt},
+		{"tampered", keyID, "card_a", tampered, "", ErrCorrupt},
+		{"truncated", keyID, "card_a", sealed[:4], "", ErrCorrupt},
+		{"other key", "old", "card_a", sealed, "", ErrCorrupt},
+		{"unknown key", "gone", "card_a", sealed, "", ErrUnknownKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pan, err := v.Open(ctx, tt.keyID, tt.token, tt.sealed)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Open error = %v, want %v", err, tt.err)
+			}
+			if pan != tt.want {
+				t.Errorf("Open = %q, want %q", pan, tt.want)
+			}
+		})
+	}
+}
+
+// writeKeyFile writes a key file holding a random key for each ID, the last
+// one current, and loads it.
+func writeKeyFile(t *testing.T, ids ...string) *FileKeyProvider {
+	t.Helper()
+	var buf bytes.Buffer
+	buf.WriteString("# test keys\n\n")
+	for i, id := range ids {
+		key := bytes.Repeat([]byte{byte(i + 1)}, KeySize)
+		buf.WriteString(id + " " + base64.StdEncoding.EncodeToString(key) + "\n")
+	}
+	path := filepath.Join(t.TempDir(), "keys")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewFileKeyProvider(path)
+	if err != nil {
+		t.Fatalf("NewFileKeyProvider: %v", err)
+	}
+	return p
+}
@@ -0,0 +1,3 @@
+ALTER TABLE payments
+    ALTER COLUMN order_id TYPE INT USING NULL,
+    ALTER COLUMN user_id TYPE INT USING NULL;
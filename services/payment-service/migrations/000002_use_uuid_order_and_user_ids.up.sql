@@ -0,0 +1,9 @@
+-- Integer order and user ids predate the order service and never referred to
+-- a real order, so they cannot be converted and are cleared.
+ALTER TABLE payments
+    ALTER COLUMN order_id DROP NOT NULL,
+    ALTER COLUMN user_id DROP NOT NULL;
+
+ALTER TABLE payments
+    ALTER COLUMN order_id TYPE UUID USING NULL,
+    ALTER COLUMN user_id TYPE UUID USING NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS refunded_amount NUMERIC(12,2) NOT NULL DEFAULT 0;
+
+UPDATE payments SET refunded_amount = amount WHERE status = 'refunded';
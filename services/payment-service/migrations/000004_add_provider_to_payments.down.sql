@@ -0,0 +1,3 @@
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS error_message,
+    DROP COLUMN IF EXISTS provider;
@@ -0,0 +1,3 @@
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS provider VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS error_message TEXT;
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_payments_authorization_expires_at;
+
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS status_reason,
+    DROP COLUMN IF EXISTS voided_at,
+    DROP COLUMN IF EXISTS captured_at,
+    DROP COLUMN IF EXISTS authorization_expires_at,
+    DROP COLUMN IF EXISTS authorized_at,
+    DROP COLUMN IF EXISTS authorized_amount;
@@ -0,0 +1,13 @@
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS authorized_amount NUMERIC(12,2),
+    ADD COLUMN IF NOT EXISTS authorized_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS authorization_expires_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS captured_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS voided_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS status_reason TEXT;
+
+UPDATE payments SET captured_at = created_at WHERE status IN ('completed', 'refunded');
+
+CREATE INDEX IF NOT EXISTS idx_payments_authorization_expires_at
+    ON payments(authorization_expires_at)
+    WHERE status IN ('authorized', 'pending');
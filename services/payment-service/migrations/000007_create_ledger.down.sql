@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS ledger_transactions;
+DROP FUNCTION IF EXISTS ledger_transaction_balanced();
+DROP FUNCTION IF EXISTS ledger_append_only();
+
+DROP INDEX IF EXISTS idx_payments_shop_id_created_at;
+ALTER TABLE payments DROP COLUMN IF EXISTS shop_id;
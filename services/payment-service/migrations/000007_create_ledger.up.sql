@@ -0,0 +1,70 @@
+-- Payments made through a shop are attributed to it, so the ledger can be
+-- kept per shop. Older payments stay unattributed.
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS shop_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_payments_shop_id_created_at ON payments(shop_id, created_at);
+
+CREATE TABLE IF NOT EXISTS ledger_transactions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    shop_id UUID,
+    payment_id UUID REFERENCES payments(id),
+    kind VARCHAR(30) NOT NULL,
+    -- what the transaction records, e.g. the payment or refund ID; a movement
+    -- is posted at most once
+    reference VARCHAR(100) NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (kind, reference)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_transactions_shop_id ON ledger_transactions(shop_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_ledger_transactions_payment_id ON ledger_transactions(payment_id);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id BIGSERIAL PRIMARY KEY,
+    transaction_id UUID NOT NULL REFERENCES ledger_transactions(id),
+    account VARCHAR(50) NOT NULL,
+    debit NUMERIC(12,2) NOT NULL DEFAULT 0,
+    credit NUMERIC(12,2) NOT NULL DEFAULT 0,
+    CHECK (debit >= 0 AND credit >= 0 AND (debit = 0) <> (credit = 0))
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_transaction_id ON ledger_entries(transaction_id);
+
+-- The ledger is append-only; mistakes are corrected by posting a reversal.
+CREATE OR REPLACE FUNCTION ledger_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'ledger is append-only: % on % is not allowed', TG_OP, TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER ledger_transactions_append_only
+    BEFORE UPDATE OR DELETE ON ledger_transactions
+    FOR EACH ROW EXECUTE FUNCTION ledger_append_only();
+
+CREATE TRIGGER ledger_entries_append_only
+    BEFORE UPDATE OR DELETE ON ledger_entries
+    FOR EACH ROW EXECUTE FUNCTION ledger_append_only();
+
+-- Checked at commit, once all lines of a transaction are in.
+CREATE OR REPLACE FUNCTION ledger_transaction_balanced() RETURNS trigger AS $$
+DECLARE
+    diff NUMERIC;
+BEGIN
+    SELECT COALESCE(SUM(debit), 0) - COALESCE(SUM(credit), 0) INTO diff
+    FROM ledger_entries
+    WHERE transaction_id = NEW.transaction_id;
+
+    IF diff <> 0 THEN
+        RAISE EXCEPTION 'ledger transaction % is unbalanced by %', NEW.transaction_id, diff;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER ledger_entries_balanced
+    AFTER INSERT ON ledger_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION ledger_transaction_balanced();
@@ -0,0 +1,3 @@
+UPDATE payments SET status = 'completed' WHERE status = 'partially_refunded';
+
+DROP TABLE IF EXISTS refunds;
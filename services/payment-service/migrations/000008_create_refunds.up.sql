@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS refunds (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    payment_id UUID NOT NULL REFERENCES payments(id),
+    amount NUMERIC(12,2) NOT NULL CHECK (amount > 0),
+    reason_code VARCHAR(30) NOT NULL,
+    reason TEXT,
+    -- pending while the provider is refunding; a failed refund no longer
+    -- counts towards the payment's refunded_amount
+    status VARCHAR(20) NOT NULL,
+    transaction_id VARCHAR(100),
+    error_message TEXT,
+    created_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_refunds_payment_id ON refunds(payment_id, created_at);
+
+-- Refunds made before they were itemised are kept as one refund per payment.
+INSERT INTO refunds (payment_id, amount, reason_code, reason, status, created_at, updated_at)
+SELECT id, refunded_amount, 'other', 'refunded before refunds were itemised', 'completed', updated_at, updated_at
+FROM payments
+WHERE refunded_amount > 0;
+
+UPDATE payments SET status = 'partially_refunded'
+WHERE status = 'completed' AND refunded_amount > 0;
@@ -0,0 +1,17 @@
+-- Cards saved by TokenizeCard. The PAN is only ever stored encrypted, under
+-- the key named by key_id; the CVV is never stored.
+CREATE TABLE IF NOT EXISTS card_tokens (
+    token VARCHAR(40) PRIMARY KEY,
+    shop_id UUID,
+    key_id VARCHAR(64) NOT NULL,
+    -- AES-GCM nonce followed by the sealed PAN
+    pan_ciphertext BYTEA NOT NULL,
+    brand VARCHAR(20) NOT NULL,
+    last4 CHAR(4) NOT NULL,
+    card_holder VARCHAR(255),
+    expiry_month CHAR(2) NOT NULL,
+    expiry_year CHAR(4) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_card_tokens_key_id ON card_tokens(key_id);
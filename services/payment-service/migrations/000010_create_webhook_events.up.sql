@@ -0,0 +1,15 @@
+-- Provider webhook events seen so far, so a redelivered event is applied
+-- once. outcome is NULL while the event is being applied.
+CREATE TABLE IF NOT EXISTS webhook_events (
+    provider VARCHAR(50) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payment_id UUID REFERENCES payments(id) ON DELETE SET NULL,
+    outcome VARCHAR(20),
+    occurred_at TIMESTAMPTZ,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (provider, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_events_payment_id ON webhook_events(payment_id);
+CREATE INDEX IF NOT EXISTS idx_payments_provider_reference ON payments(provider, reference_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_refunds_shift_id;
+DROP INDEX IF EXISTS idx_payments_shift_id;
+ALTER TABLE refunds DROP COLUMN IF EXISTS shift_id;
+ALTER TABLE payments DROP COLUMN IF EXISTS shift_id;
+DROP TABLE IF EXISTS cash_movements;
+DROP TABLE IF EXISTS cash_shifts;
@@ -0,0 +1,44 @@
+-- A staff member's session on a cash drawer in one currency. expected_amount,
+-- counted_amount and variance are fixed when the shift is closed.
+CREATE TABLE IF NOT EXISTS cash_shifts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    shop_id UUID NOT NULL,
+    opened_by UUID NOT NULL,
+    closed_by UUID,
+    currency VARCHAR(10) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    opening_float NUMERIC(14,2) NOT NULL CHECK (opening_float >= 0),
+    expected_amount NUMERIC(14,2),
+    counted_amount NUMERIC(14,2),
+    variance NUMERIC(14,2),
+    note TEXT,
+    opened_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    closed_at TIMESTAMPTZ
+);
+
+-- One open drawer per staff member and currency.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_cash_shifts_open
+    ON cash_shifts(shop_id, opened_by, currency) WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS idx_cash_shifts_shop_id ON cash_shifts(shop_id, opened_at);
+
+-- Cash put into (cash_in) or taken out of (cash_out) a drawer other than by
+-- a sale or refund, e.g. change top-ups and bank drops.
+CREATE TABLE IF NOT EXISTS cash_movements (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    shift_id UUID NOT NULL REFERENCES cash_shifts(id),
+    kind VARCHAR(20) NOT NULL,
+    amount NUMERIC(14,2) NOT NULL CHECK (amount > 0),
+    reason TEXT,
+    created_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_cash_movements_shift_id ON cash_movements(shift_id, created_at);
+
+-- Cash payments and refunds are counted towards the shift of the staff
+-- member who took or gave them.
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS shift_id UUID REFERENCES cash_shifts(id);
+ALTER TABLE refunds ADD COLUMN IF NOT EXISTS shift_id UUID REFERENCES cash_shifts(id);
+
+CREATE INDEX IF NOT EXISTS idx_payments_shift_id ON payments(shift_id) WHERE shift_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_refunds_shift_id ON refunds(shift_id) WHERE shift_id IS NOT NULL;
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	moneypb "hpkg/money/moneypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -106,13 +107,14 @@ type Payment struct {
 	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"` // USD, EUR, etc
 	PaymentMethod string                 `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending, processing, completed, failed, refunded
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending, authorized, completed, failed, voided, expired, partially_refunded, refunded
 	TransactionId string                 `protobuf:"bytes,8,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 	ReferenceId   string                 `protobuf:"bytes,9,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
 	ProcessingFee float64                `protobuf:"fixed64,10,opt,name=processing_fee,json=processingFee,proto3" json:"processing_fee,omitempty"`
 	ErrorMessage  string                 `protobuf:"bytes,11,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AmountMoney   *moneypb.Money         `protobuf:"bytes,14,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"` // amount and currency, exactly
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -238,6 +240,13 @@ func (x *Payment) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Payment) GetAmountMoney() *moneypb.Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
 type ProcessPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -245,7 +254,8 @@ type ProcessPaymentRequest struct {
 	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
 	PaymentMethod string                 `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"` // credit_card, debit_card, paypal, bank_transfer
-	Card          *PaymentCard           `protobuf:"bytes,6,opt,name=card,proto3" json:"card,omitempty"`                                        // required for card payments
+	CardToken     string                 `protobuf:"bytes,7,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`             // from TokenizeCard; required for card payments
+	AmountMoney   *moneypb.Money         `protobuf:"bytes,8,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`       // exact amount; when set, amount and currency are ignored
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -315,13 +325,22 @@ func (x *ProcessPaymentRequest) GetPaymentMethod() string {
 	return ""
 }
 
-func (x *ProcessPaymentRequest) GetCard() *PaymentCard {
+func (x *ProcessPaymentRequest) GetCardToken() string {
 	if x != nil {
-		return x.Card
+		return x.CardToken
+	}
+	return ""
+}
+
+func (x *ProcessPaymentRequest) GetAmountMoney() *moneypb.Money {
+	if x != nil {
+		return x.AmountMoney
 	}
 	return nil
 }
 
+// PaymentCard is raw card data. It is only accepted by TokenizeCard; the PAN
+// is stored encrypted and the CVV is checked and dropped, never stored.
 type PaymentCard struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CardNumber    string                 `protobuf:"bytes,1,opt,name=card_number,json=cardNumber,proto3" json:"card_number,omitempty"`
@@ -398,6 +417,135 @@ func (x *PaymentCard) GetCvv() string {
 	return ""
 }
 
+type TokenizeCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *PaymentCard           `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenizeCardRequest) Reset() {
+	*x = TokenizeCardRequest{}
+	mi := &file_payment_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenizeCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeCardRequest) ProtoMessage() {}
+
+func (x *TokenizeCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeCardRequest.ProtoReflect.Descriptor instead.
+func (*TokenizeCardRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TokenizeCardRequest) GetCard() *PaymentCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+// TokenizeCardResponse describes the stored card without exposing it.
+type TokenizeCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardToken     string                 `protobuf:"bytes,1,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
+	Brand         string                 `protobuf:"bytes,2,opt,name=brand,proto3" json:"brand,omitempty"` // visa, mastercard, amex, discover, jcb, unionpay, unknown
+	Last4         string                 `protobuf:"bytes,3,opt,name=last4,proto3" json:"last4,omitempty"`
+	ExpiryMonth   string                 `protobuf:"bytes,4,opt,name=expiry_month,json=expiryMonth,proto3" json:"expiry_month,omitempty"`
+	ExpiryYear    string                 `protobuf:"bytes,5,opt,name=expiry_year,json=expiryYear,proto3" json:"expiry_year,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenizeCardResponse) Reset() {
+	*x = TokenizeCardResponse{}
+	mi := &file_payment_payment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenizeCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeCardResponse) ProtoMessage() {}
+
+func (x *TokenizeCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeCardResponse.ProtoReflect.Descriptor instead.
+func (*TokenizeCardResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TokenizeCardResponse) GetCardToken() string {
+	if x != nil {
+		return x.CardToken
+	}
+	return ""
+}
+
+func (x *TokenizeCardResponse) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *TokenizeCardResponse) GetLast4() string {
+	if x != nil {
+		return x.Last4
+	}
+	return ""
+}
+
+func (x *TokenizeCardResponse) GetExpiryMonth() string {
+	if x != nil {
+		return x.ExpiryMonth
+	}
+	return ""
+}
+
+func (x *TokenizeCardResponse) GetExpiryYear() string {
+	if x != nil {
+		return x.ExpiryYear
+	}
+	return ""
+}
+
+func (x *TokenizeCardResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 type ProcessPaymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
@@ -407,13 +555,14 @@ type ProcessPaymentResponse struct {
 	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
 	ProcessingFee float64                `protobuf:"fixed64,6,opt,name=processing_fee,json=processingFee,proto3" json:"processing_fee,omitempty"`
 	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	AmountMoney   *moneypb.Money         `protobuf:"bytes,8,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProcessPaymentResponse) Reset() {
 	*x = ProcessPaymentResponse{}
-	mi := &file_payment_payment_proto_msgTypes[4]
+	mi := &file_payment_payment_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -425,7 +574,7 @@ func (x *ProcessPaymentResponse) String() string {
 func (*ProcessPaymentResponse) ProtoMessage() {}
 
 func (x *ProcessPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[4]
+	mi := &file_payment_payment_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +587,7 @@ func (x *ProcessPaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessPaymentResponse.ProtoReflect.Descriptor instead.
 func (*ProcessPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{4}
+	return file_payment_payment_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ProcessPaymentResponse) GetPaymentId() string {
@@ -490,6 +639,13 @@ func (x *ProcessPaymentResponse) GetMessage() string {
 	return ""
 }
 
+func (x *ProcessPaymentResponse) GetAmountMoney() *moneypb.Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
 type GetPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
@@ -499,7 +655,7 @@ type GetPaymentRequest struct {
 
 func (x *GetPaymentRequest) Reset() {
 	*x = GetPaymentRequest{}
-	mi := &file_payment_payment_proto_msgTypes[5]
+	mi := &file_payment_payment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -511,7 +667,7 @@ func (x *GetPaymentRequest) String() string {
 func (*GetPaymentRequest) ProtoMessage() {}
 
 func (x *GetPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[5]
+	mi := &file_payment_payment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +680,7 @@ func (x *GetPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentRequest.ProtoReflect.Descriptor instead.
 func (*GetPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{5}
+	return file_payment_payment_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetPaymentRequest) GetPaymentId() string {
@@ -548,13 +704,14 @@ type GetPaymentResponse struct {
 	ProcessingFee float64                `protobuf:"fixed64,10,opt,name=processing_fee,json=processingFee,proto3" json:"processing_fee,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AmountMoney   *moneypb.Money         `protobuf:"bytes,13,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPaymentResponse) Reset() {
 	*x = GetPaymentResponse{}
-	mi := &file_payment_payment_proto_msgTypes[6]
+	mi := &file_payment_payment_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -566,7 +723,7 @@ func (x *GetPaymentResponse) String() string {
 func (*GetPaymentResponse) ProtoMessage() {}
 
 func (x *GetPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[6]
+	mi := &file_payment_payment_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +736,7 @@ func (x *GetPaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentResponse.ProtoReflect.Descriptor instead.
 func (*GetPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{6}
+	return file_payment_payment_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetPaymentResponse) GetId() string {
@@ -666,6 +823,13 @@ func (x *GetPaymentResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetPaymentResponse) GetAmountMoney() *moneypb.Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
 type ListPaymentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -678,7 +842,7 @@ type ListPaymentsRequest struct {
 
 func (x *ListPaymentsRequest) Reset() {
 	*x = ListPaymentsRequest{}
-	mi := &file_payment_payment_proto_msgTypes[7]
+	mi := &file_payment_payment_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -690,7 +854,7 @@ func (x *ListPaymentsRequest) String() string {
 func (*ListPaymentsRequest) ProtoMessage() {}
 
 func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[7]
+	mi := &file_payment_payment_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +867,7 @@ func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
 func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{7}
+	return file_payment_payment_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListPaymentsRequest) GetUserId() string {
@@ -746,7 +910,7 @@ type ListPaymentsResponse struct {
 
 func (x *ListPaymentsResponse) Reset() {
 	*x = ListPaymentsResponse{}
-	mi := &file_payment_payment_proto_msgTypes[8]
+	mi := &file_payment_payment_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -758,7 +922,7 @@ func (x *ListPaymentsResponse) String() string {
 func (*ListPaymentsResponse) ProtoMessage() {}
 
 func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[8]
+	mi := &file_payment_payment_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,7 +935,7 @@ func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
 func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{8}
+	return file_payment_payment_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListPaymentsResponse) GetPayments() []*Payment {
@@ -813,7 +977,7 @@ type ListOrderPaymentsRequest struct {
 
 func (x *ListOrderPaymentsRequest) Reset() {
 	*x = ListOrderPaymentsRequest{}
-	mi := &file_payment_payment_proto_msgTypes[9]
+	mi := &file_payment_payment_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -825,7 +989,7 @@ func (x *ListOrderPaymentsRequest) String() string {
 func (*ListOrderPaymentsRequest) ProtoMessage() {}
 
 func (x *ListOrderPaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[9]
+	mi := &file_payment_payment_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,7 +1002,7 @@ func (x *ListOrderPaymentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrderPaymentsRequest.ProtoReflect.Descriptor instead.
 func (*ListOrderPaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{9}
+	return file_payment_payment_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListOrderPaymentsRequest) GetOrderId() string {
@@ -872,7 +1036,7 @@ type ListOrderPaymentsResponse struct {
 
 func (x *ListOrderPaymentsResponse) Reset() {
 	*x = ListOrderPaymentsResponse{}
-	mi := &file_payment_payment_proto_msgTypes[10]
+	mi := &file_payment_payment_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -884,7 +1048,7 @@ func (x *ListOrderPaymentsResponse) String() string {
 func (*ListOrderPaymentsResponse) ProtoMessage() {}
 
 func (x *ListOrderPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[10]
+	mi := &file_payment_payment_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1061,7 @@ func (x *ListOrderPaymentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrderPaymentsResponse.ProtoReflect.Descriptor instead.
 func (*ListOrderPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{10}
+	return file_payment_payment_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListOrderPaymentsResponse) GetPayments() []*Payment {
@@ -914,18 +1078,22 @@ func (x *ListOrderPaymentsResponse) GetTotalCount() int32 {
 	return 0
 }
 
+// RefundPayment gives back part or all of a captured payment. A payment can be
+// refunded several times until its captured amount has been given back.
+// Requires PermPaymentRefund.
 type RefundPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
-	RefundAmount  float64                `protobuf:"fixed64,2,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"` // optional: partial refund amount
+	RefundAmount  float64                `protobuf:"fixed64,2,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"` // optional: partial refund amount; defaults to what is left
 	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReasonCode    string                 `protobuf:"bytes,4,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"` // customer_request, duplicate, fraudulent, order_cancelled, item_returned, checkout_failed, other (default)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RefundPaymentRequest) Reset() {
 	*x = RefundPaymentRequest{}
-	mi := &file_payment_payment_proto_msgTypes[11]
+	mi := &file_payment_payment_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -937,7 +1105,7 @@ func (x *RefundPaymentRequest) String() string {
 func (*RefundPaymentRequest) ProtoMessage() {}
 
 func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[11]
+	mi := &file_payment_payment_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,7 +1118,7 @@ func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
 func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{11}
+	return file_payment_payment_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RefundPaymentRequest) GetPaymentId() string {
@@ -974,6 +1142,13 @@ func (x *RefundPaymentRequest) GetReason() string {
 	return ""
 }
 
+func (x *RefundPaymentRequest) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
 type RefundPaymentResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	RefundId          string                 `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
@@ -983,13 +1158,16 @@ type RefundPaymentResponse struct {
 	Reason            string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 	TransactionId     string                 `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReasonCode        string                 `protobuf:"bytes,8,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	PaymentStatus     string                 `protobuf:"bytes,9,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"`    // partially_refunded or refunded
+	RefundedTotal     float64                `protobuf:"fixed64,10,opt,name=refunded_total,json=refundedTotal,proto3" json:"refunded_total,omitempty"` // refunded of the payment so far, this refund included
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *RefundPaymentResponse) Reset() {
 	*x = RefundPaymentResponse{}
-	mi := &file_payment_payment_proto_msgTypes[12]
+	mi := &file_payment_payment_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1001,7 +1179,7 @@ func (x *RefundPaymentResponse) String() string {
 func (*RefundPaymentResponse) ProtoMessage() {}
 
 func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[12]
+	mi := &file_payment_payment_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,7 +1192,7 @@ func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
 func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{12}
+	return file_payment_payment_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RefundPaymentResponse) GetRefundId() string {
@@ -1066,83 +1244,58 @@ func (x *RefundPaymentResponse) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type VerifyPaymentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
-	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *VerifyPaymentRequest) Reset() {
-	*x = VerifyPaymentRequest{}
-	mi := &file_payment_payment_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *VerifyPaymentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*VerifyPaymentRequest) ProtoMessage() {}
-
-func (x *VerifyPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[13]
+func (x *RefundPaymentResponse) GetReasonCode() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ReasonCode
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use VerifyPaymentRequest.ProtoReflect.Descriptor instead.
-func (*VerifyPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{13}
+	return ""
 }
 
-func (x *VerifyPaymentRequest) GetPaymentId() string {
+func (x *RefundPaymentResponse) GetPaymentStatus() string {
 	if x != nil {
-		return x.PaymentId
+		return x.PaymentStatus
 	}
 	return ""
 }
 
-func (x *VerifyPaymentRequest) GetTransactionId() string {
+func (x *RefundPaymentResponse) GetRefundedTotal() float64 {
 	if x != nil {
-		return x.TransactionId
+		return x.RefundedTotal
 	}
-	return ""
+	return 0
 }
 
-type VerifyPaymentResponse struct {
+type Refund struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
-	IsVerified    bool                   `protobuf:"varint,2,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReasonCode    string                 `protobuf:"bytes,4,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // pending, completed, failed
+	TransactionId string                 `protobuf:"bytes,7,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyPaymentResponse) Reset() {
-	*x = VerifyPaymentResponse{}
-	mi := &file_payment_payment_proto_msgTypes[14]
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_payment_payment_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyPaymentResponse) String() string {
+func (x *Refund) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyPaymentResponse) ProtoMessage() {}
+func (*Refund) ProtoMessage() {}
 
-func (x *VerifyPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[14]
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,66 +1306,2318 @@ func (x *VerifyPaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyPaymentResponse.ProtoReflect.Descriptor instead.
-func (*VerifyPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *VerifyPaymentResponse) GetPaymentId() string {
+func (x *Refund) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Refund) GetPaymentId() string {
 	if x != nil {
 		return x.PaymentId
 	}
 	return ""
 }
 
-func (x *VerifyPaymentResponse) GetIsVerified() bool {
+func (x *Refund) GetAmount() float64 {
 	if x != nil {
-		return x.IsVerified
+		return x.Amount
 	}
-	return false
+	return 0
 }
 
-func (x *VerifyPaymentResponse) GetStatus() string {
+func (x *Refund) GetReasonCode() string {
 	if x != nil {
-		return x.Status
+		return x.ReasonCode
 	}
 	return ""
 }
 
-func (x *VerifyPaymentResponse) GetMessage() string {
+func (x *Refund) GetReason() string {
 	if x != nil {
-		return x.Message
+		return x.Reason
 	}
 	return ""
 }
 
-type PaymentStatistics struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Period            string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // daily, weekly, monthly
-	TotalTransactions int32                  `protobuf:"varint,2,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
-	TotalAmount       float64                `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	AverageAmount     float64                `protobuf:"fixed64,4,opt,name=average_amount,json=averageAmount,proto3" json:"average_amount,omitempty"`
-	MostUsedMethod    string                 `protobuf:"bytes,5,opt,name=most_used_method,json=mostUsedMethod,proto3" json:"most_used_method,omitempty"`
-	SuccessRate       float64                `protobuf:"fixed64,6,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *Refund) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Refund) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Refund) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *Refund) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Refund) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListRefundsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsRequest) Reset() {
+	*x = ListRefundsRequest{}
+	mi := &file_payment_payment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsRequest) ProtoMessage() {}
+
+func (x *ListRefundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsRequest.ProtoReflect.Descriptor instead.
+func (*ListRefundsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListRefundsRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+type ListRefundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refunds       []*Refund              `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsResponse) Reset() {
+	*x = ListRefundsResponse{}
+	mi := &file_payment_payment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsResponse) ProtoMessage() {}
+
+func (x *ListRefundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsResponse.ProtoReflect.Descriptor instead.
+func (*ListRefundsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListRefundsResponse) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+type VerifyPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPaymentRequest) Reset() {
+	*x = VerifyPaymentRequest{}
+	mi := &file_payment_payment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPaymentRequest) ProtoMessage() {}
+
+func (x *VerifyPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPaymentRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VerifyPaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *VerifyPaymentRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type VerifyPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	IsVerified    bool                   `protobuf:"varint,2,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPaymentResponse) Reset() {
+	*x = VerifyPaymentResponse{}
+	mi := &file_payment_payment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPaymentResponse) ProtoMessage() {}
+
+func (x *VerifyPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPaymentResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VerifyPaymentResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *VerifyPaymentResponse) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *VerifyPaymentResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VerifyPaymentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PaymentStatistics struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Period               string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // daily, weekly, monthly
+	TotalTransactions    int32                  `protobuf:"varint,2,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	TotalAmount          float64                `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`       // captured
+	AverageAmount        float64                `protobuf:"fixed64,4,opt,name=average_amount,json=averageAmount,proto3" json:"average_amount,omitempty"` // average ticket: total_amount per captured payment
+	MostUsedMethod       string                 `protobuf:"bytes,5,opt,name=most_used_method,json=mostUsedMethod,proto3" json:"most_used_method,omitempty"`
+	SuccessRate          float64                `protobuf:"fixed64,6,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"` // captured payments per payment attempted
+	Currency             string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	CapturedTransactions int32                  `protobuf:"varint,8,opt,name=captured_transactions,json=capturedTransactions,proto3" json:"captured_transactions,omitempty"`
+	RefundedAmount       float64                `protobuf:"fixed64,9,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	RefundRate           float64                `protobuf:"fixed64,10,opt,name=refund_rate,json=refundRate,proto3" json:"refund_rate,omitempty"` // refunded_amount per total_amount
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PaymentStatistics) Reset() {
+	*x = PaymentStatistics{}
+	mi := &file_payment_payment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentStatistics) ProtoMessage() {}
+
+func (x *PaymentStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentStatistics.ProtoReflect.Descriptor instead.
+func (*PaymentStatistics) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PaymentStatistics) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *PaymentStatistics) GetTotalTransactions() int32 {
+	if x != nil {
+		return x.TotalTransactions
+	}
+	return 0
+}
+
+func (x *PaymentStatistics) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *PaymentStatistics) GetAverageAmount() float64 {
+	if x != nil {
+		return x.AverageAmount
+	}
+	return 0
+}
+
+func (x *PaymentStatistics) GetMostUsedMethod() string {
+	if x != nil {
+		return x.MostUsedMethod
+	}
+	return ""
+}
+
+func (x *PaymentStatistics) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *PaymentStatistics) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PaymentStatistics) GetCapturedTransactions() int32 {
+	if x != nil {
+		return x.CapturedTransactions
+	}
+	return 0
+}
+
+func (x *PaymentStatistics) GetRefundedAmount() float64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *PaymentStatistics) GetRefundRate() float64 {
+	if x != nil {
+		return x.RefundRate
+	}
+	return 0
+}
+
+// PaymentStatsBreakdown is the part of a bucket taken with one payment method
+// or in one status.
+type PaymentStatsBreakdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Transactions  int32                  `protobuf:"varint,2,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentStatsBreakdown) Reset() {
+	*x = PaymentStatsBreakdown{}
+	mi := &file_payment_payment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentStatsBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentStatsBreakdown) ProtoMessage() {}
+
+func (x *PaymentStatsBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentStatsBreakdown.ProtoReflect.Descriptor instead.
+func (*PaymentStatsBreakdown) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PaymentStatsBreakdown) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PaymentStatsBreakdown) GetTransactions() int32 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+func (x *PaymentStatsBreakdown) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// PaymentStatsBucket holds one period's payments in one currency.
+type PaymentStatsBucket struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	PeriodStart   *timestamppb.Timestamp   `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Statistics    *PaymentStatistics       `protobuf:"bytes,2,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	ByMethod      []*PaymentStatsBreakdown `protobuf:"bytes,3,rep,name=by_method,json=byMethod,proto3" json:"by_method,omitempty"`
+	ByStatus      []*PaymentStatsBreakdown `protobuf:"bytes,4,rep,name=by_status,json=byStatus,proto3" json:"by_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentStatsBucket) Reset() {
+	*x = PaymentStatsBucket{}
+	mi := &file_payment_payment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentStatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentStatsBucket) ProtoMessage() {}
+
+func (x *PaymentStatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentStatsBucket.ProtoReflect.Descriptor instead.
+func (*PaymentStatsBucket) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PaymentStatsBucket) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *PaymentStatsBucket) GetStatistics() *PaymentStatistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+func (x *PaymentStatsBucket) GetByMethod() []*PaymentStatsBreakdown {
+	if x != nil {
+		return x.ByMethod
+	}
+	return nil
+}
+
+func (x *PaymentStatsBucket) GetByStatus() []*PaymentStatsBreakdown {
+	if x != nil {
+		return x.ByStatus
+	}
+	return nil
+}
+
+// GetPaymentStatsRequest reports on the caller's shop. Payments are counted in
+// the period they were created; refunds against the payment they return.
+type GetPaymentStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`      // unused
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`                     // daily (default), weekly, monthly
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`                         // optional: defaults to 30 days, 12 weeks or 12 months before to
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`                             // optional: defaults to now
+	TimeZone      string                 `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // optional: IANA name periods start in, e.g. Asia/Phnom_Penh; defaults to UTC
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentStatsRequest) Reset() {
+	*x = GetPaymentStatsRequest{}
+	mi := &file_payment_payment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentStatsRequest) ProtoMessage() {}
+
+func (x *GetPaymentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPaymentStatsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPaymentStatsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetPaymentStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetPaymentStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetPaymentStatsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type GetPaymentStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statistics    *PaymentStatistics     `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"` // totals for the currency with the most payments
+	Totals        []*PaymentStatistics   `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals,omitempty"`         // totals per currency
+	Buckets       []*PaymentStatsBucket  `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`       // ordered by period_start, then currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentStatsResponse) Reset() {
+	*x = GetPaymentStatsResponse{}
+	mi := &file_payment_payment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentStatsResponse) ProtoMessage() {}
+
+func (x *GetPaymentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPaymentStatsResponse) GetStatistics() *PaymentStatistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+func (x *GetPaymentStatsResponse) GetTotals() []*PaymentStatistics {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *GetPaymentStatsResponse) GetBuckets() []*PaymentStatsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type ValidatePaymentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ExpectedAmount float64                `protobuf:"fixed64,2,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ValidatePaymentRequest) Reset() {
+	*x = ValidatePaymentRequest{}
+	mi := &file_payment_payment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePaymentRequest) ProtoMessage() {}
+
+func (x *ValidatePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePaymentRequest.ProtoReflect.Descriptor instead.
+func (*ValidatePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ValidatePaymentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ValidatePaymentRequest) GetExpectedAmount() float64 {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return 0
+}
+
+type ValidatePaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsValid       bool                   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatePaymentResponse) Reset() {
+	*x = ValidatePaymentResponse{}
+	mi := &file_payment_payment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePaymentResponse) ProtoMessage() {}
+
+func (x *ValidatePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePaymentResponse.ProtoReflect.Descriptor instead.
+func (*ValidatePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ValidatePaymentResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *ValidatePaymentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// AuthorizePayment holds funds without taking them, e.g. when an order is
+// placed. The hold is captured at fulfilment or voided; one that is neither
+// expires at expires_at.
+type AuthorizePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	CardToken     string                 `protobuf:"bytes,7,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
+	AmountMoney   *moneypb.Money         `protobuf:"bytes,8,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"` // exact amount; when set, amount and currency are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizePaymentRequest) Reset() {
+	*x = AuthorizePaymentRequest{}
+	mi := &file_payment_payment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizePaymentRequest) ProtoMessage() {}
+
+func (x *AuthorizePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizePaymentRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AuthorizePaymentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AuthorizePaymentRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetCardToken() string {
+	if x != nil {
+		return x.CardToken
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetAmountMoney() *moneypb.Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
+type AuthorizePaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // authorized, pending, failed
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	AmountMoney   *moneypb.Money         `protobuf:"bytes,8,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizePaymentResponse) Reset() {
+	*x = AuthorizePaymentResponse{}
+	mi := &file_payment_payment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizePaymentResponse) ProtoMessage() {}
+
+func (x *AuthorizePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizePaymentResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AuthorizePaymentResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AuthorizePaymentResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AuthorizePaymentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentResponse) GetAmountMoney() *moneypb.Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
+type CapturePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"` // optional: defaults to the authorized amount; may exceed it by up to 20% for tips
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapturePaymentRequest) Reset() {
+	*x = CapturePaymentRequest{}
+	mi := &file_payment_payment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentRequest) ProtoMessage() {}
+
+func (x *CapturePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentRequest.ProtoReflect.Descriptor instead.
+func (*CapturePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CapturePaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CapturePaymentRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CapturePaymentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId        string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	TransactionId    string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Status           string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	AuthorizedAmount float64                `protobuf:"fixed64,4,opt,name=authorized_amount,json=authorizedAmount,proto3" json:"authorized_amount,omitempty"`
+	Amount           float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"` // captured
+	ProcessingFee    float64                `protobuf:"fixed64,6,opt,name=processing_fee,json=processingFee,proto3" json:"processing_fee,omitempty"`
+	Message          string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CapturePaymentResponse) Reset() {
+	*x = CapturePaymentResponse{}
+	mi := &file_payment_payment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentResponse) ProtoMessage() {}
+
+func (x *CapturePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentResponse.ProtoReflect.Descriptor instead.
+func (*CapturePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CapturePaymentResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CapturePaymentResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CapturePaymentResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CapturePaymentResponse) GetAuthorizedAmount() float64 {
+	if x != nil {
+		return x.AuthorizedAmount
+	}
+	return 0
+}
+
+func (x *CapturePaymentResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CapturePaymentResponse) GetProcessingFee() float64 {
+	if x != nil {
+		return x.ProcessingFee
+	}
+	return 0
+}
+
+func (x *CapturePaymentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type VoidPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidPaymentRequest) Reset() {
+	*x = VoidPaymentRequest{}
+	mi := &file_payment_payment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidPaymentRequest) ProtoMessage() {}
+
+func (x *VoidPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidPaymentRequest.ProtoReflect.Descriptor instead.
+func (*VoidPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VoidPaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *VoidPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type VoidPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidPaymentResponse) Reset() {
+	*x = VoidPaymentResponse{}
+	mi := &file_payment_payment_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidPaymentResponse) ProtoMessage() {}
+
+func (x *VoidPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidPaymentResponse.ProtoReflect.Descriptor instead.
+func (*VoidPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VoidPaymentResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *VoidPaymentResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VoidPaymentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// The ledger is double-entry and append-only: every transaction has debit
+// and credit lines that sum to the same amount.
+type LedgerLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"` // provider_clearing, sales, refunds, processing_fees, store_credit
+	Debit         float64                `protobuf:"fixed64,2,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit        float64                `protobuf:"fixed64,3,opt,name=credit,proto3" json:"credit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerLine) Reset() {
+	*x = LedgerLine{}
+	mi := &file_payment_payment_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerLine) ProtoMessage() {}
+
+func (x *LedgerLine) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerLine.ProtoReflect.Descriptor instead.
+func (*LedgerLine) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LedgerLine) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *LedgerLine) GetDebit() float64 {
+	if x != nil {
+		return x.Debit
+	}
+	return 0
+}
+
+func (x *LedgerLine) GetCredit() float64 {
+	if x != nil {
+		return x.Credit
+	}
+	return 0
+}
+
+// RecordStoreCreditMovement posts a store credit movement made by the order
+// service. Recording the same kind and reference_id again is a no-op.
+type RecordStoreCreditMovementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                                  // issue, redeem, restore
+	ReferenceId   string                 `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"` // store credit ID for issue, store credit transaction ID otherwise
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordStoreCreditMovementRequest) Reset() {
+	*x = RecordStoreCreditMovementRequest{}
+	mi := &file_payment_payment_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordStoreCreditMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordStoreCreditMovementRequest) ProtoMessage() {}
+
+func (x *RecordStoreCreditMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordStoreCreditMovementRequest.ProtoReflect.Descriptor instead.
+func (*RecordStoreCreditMovementRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RecordStoreCreditMovementRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RecordStoreCreditMovementRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *RecordStoreCreditMovementRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RecordStoreCreditMovementRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecordStoreCreditMovementRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type RecordStoreCreditMovementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Lines         []*LedgerLine          `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordStoreCreditMovementResponse) Reset() {
+	*x = RecordStoreCreditMovementResponse{}
+	mi := &file_payment_payment_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordStoreCreditMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordStoreCreditMovementResponse) ProtoMessage() {}
+
+func (x *RecordStoreCreditMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordStoreCreditMovementResponse.ProtoReflect.Descriptor instead.
+func (*RecordStoreCreditMovementResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RecordStoreCreditMovementResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RecordStoreCreditMovementResponse) GetLines() []*LedgerLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type AccountBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Debit         float64                `protobuf:"fixed64,3,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit        float64                `protobuf:"fixed64,4,opt,name=credit,proto3" json:"credit,omitempty"`
+	Balance       float64                `protobuf:"fixed64,5,opt,name=balance,proto3" json:"balance,omitempty"` // debit - credit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountBalance) Reset() {
+	*x = AccountBalance{}
+	mi := &file_payment_payment_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalance) ProtoMessage() {}
+
+func (x *AccountBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalance.ProtoReflect.Descriptor instead.
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AccountBalance) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetDebit() float64 {
+	if x != nil {
+		return x.Debit
+	}
+	return 0
+}
+
+func (x *AccountBalance) GetCredit() float64 {
+	if x != nil {
+		return x.Credit
+	}
+	return 0
+}
+
+func (x *AccountBalance) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type GetShopBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // optional: defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShopBalanceRequest) Reset() {
+	*x = GetShopBalanceRequest{}
+	mi := &file_payment_payment_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShopBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShopBalanceRequest) ProtoMessage() {}
+
+func (x *GetShopBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShopBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetShopBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetShopBalanceRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type GetShopBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*AccountBalance      `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShopBalanceResponse) Reset() {
+	*x = GetShopBalanceResponse{}
+	mi := &file_payment_payment_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShopBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShopBalanceResponse) ProtoMessage() {}
+
+func (x *GetShopBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShopBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetShopBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetShopBalanceResponse) GetBalances() []*AccountBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *GetShopBalanceResponse) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type PaymentDiscrepancy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`           // capture, fee, refund
+	Expected      float64                `protobuf:"fixed64,3,opt,name=expected,proto3" json:"expected,omitempty"` // from the payments row
+	Recorded      float64                `protobuf:"fixed64,4,opt,name=recorded,proto3" json:"recorded,omitempty"` // from the ledger
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentDiscrepancy) Reset() {
+	*x = PaymentDiscrepancy{}
+	mi := &file_payment_payment_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDiscrepancy) ProtoMessage() {}
+
+func (x *PaymentDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentDiscrepancy.ProtoReflect.Descriptor instead.
+func (*PaymentDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PaymentDiscrepancy) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *PaymentDiscrepancy) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PaymentDiscrepancy) GetExpected() float64 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *PaymentDiscrepancy) GetRecorded() float64 {
+	if x != nil {
+		return x.Recorded
+	}
+	return 0
+}
+
+// ReconcilePayments checks the shop's payments created in [from, to) against
+// the ledger.
+type ReconcilePaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // optional: defaults to 24 hours before to
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`     // optional: defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcilePaymentsRequest) Reset() {
+	*x = ReconcilePaymentsRequest{}
+	mi := &file_payment_payment_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcilePaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcilePaymentsRequest) ProtoMessage() {}
+
+func (x *ReconcilePaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcilePaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ReconcilePaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReconcilePaymentsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ReconcilePaymentsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ReconcilePaymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checked       int32                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Discrepancies []*PaymentDiscrepancy  `protobuf:"bytes,2,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcilePaymentsResponse) Reset() {
+	*x = ReconcilePaymentsResponse{}
+	mi := &file_payment_payment_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcilePaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcilePaymentsResponse) ProtoMessage() {}
+
+func (x *ReconcilePaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcilePaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ReconcilePaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReconcilePaymentsResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *ReconcilePaymentsResponse) GetDiscrepancies() []*PaymentDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+// HandleWebhookEvent applies a status update a provider sent asynchronously.
+// The gateway checks the provider's signature before forwarding it. Each
+// event is applied at most once; a redelivered event is reported as a
+// duplicate.
+type HandleWebhookEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                    // provider name, e.g. simulator or bank_transfer
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`       // provider's event ID
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // payment.authorized, payment.captured, payment.failed, payment.voided or payment.expired
+	Reference     string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`                  // provider's reference for the payment
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`                      // optional: provider's reason, kept as the status reason
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleWebhookEventRequest) Reset() {
+	*x = HandleWebhookEventRequest{}
+	mi := &file_payment_payment_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleWebhookEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleWebhookEventRequest) ProtoMessage() {}
+
+func (x *HandleWebhookEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleWebhookEventRequest.ProtoReflect.Descriptor instead.
+func (*HandleWebhookEventRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *HandleWebhookEventRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *HandleWebhookEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *HandleWebhookEventRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *HandleWebhookEventRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *HandleWebhookEventRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HandleWebhookEventRequest) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type HandleWebhookEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`        // payment status after the event
+	Duplicate     bool                   `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"` // the event was seen before and not applied again
+	Applied       bool                   `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`     // the event moved the payment to a new status
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleWebhookEventResponse) Reset() {
+	*x = HandleWebhookEventResponse{}
+	mi := &file_payment_payment_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleWebhookEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleWebhookEventResponse) ProtoMessage() {}
+
+func (x *HandleWebhookEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleWebhookEventResponse.ProtoReflect.Descriptor instead.
+func (*HandleWebhookEventResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *HandleWebhookEventResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *HandleWebhookEventResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HandleWebhookEventResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *HandleWebhookEventResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+// A cash shift is one staff member's session on a cash drawer in one
+// currency: opened with a float, topped up or drawn from with cash movements
+// and closed with the amount counted in the drawer. Cash payments and refunds
+// the staff member takes while it is open count towards it.
+type CashShift struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OpenedBy       string                 `protobuf:"bytes,2,opt,name=opened_by,json=openedBy,proto3" json:"opened_by,omitempty"` // staff user ID
+	ClosedBy       string                 `protobuf:"bytes,3,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	Currency       string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // open, closed
+	OpeningFloat   float64                `protobuf:"fixed64,6,opt,name=opening_float,json=openingFloat,proto3" json:"opening_float,omitempty"`
+	ExpectedAmount float64                `protobuf:"fixed64,7,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"` // set when closed
+	CountedAmount  float64                `protobuf:"fixed64,8,opt,name=counted_amount,json=countedAmount,proto3" json:"counted_amount,omitempty"`    // set when closed
+	Variance       float64                `protobuf:"fixed64,9,opt,name=variance,proto3" json:"variance,omitempty"`                                   // counted_amount - expected_amount, set when closed
+	Note           string                 `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
+	OpenedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	ClosedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CashShift) Reset() {
+	*x = CashShift{}
+	mi := &file_payment_payment_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashShift) ProtoMessage() {}
+
+func (x *CashShift) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashShift.ProtoReflect.Descriptor instead.
+func (*CashShift) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CashShift) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CashShift) GetOpenedBy() string {
+	if x != nil {
+		return x.OpenedBy
+	}
+	return ""
+}
+
+func (x *CashShift) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *CashShift) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CashShift) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CashShift) GetOpeningFloat() float64 {
+	if x != nil {
+		return x.OpeningFloat
+	}
+	return 0
+}
+
+func (x *CashShift) GetExpectedAmount() float64 {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return 0
+}
+
+func (x *CashShift) GetCountedAmount() float64 {
+	if x != nil {
+		return x.CountedAmount
+	}
+	return 0
+}
+
+func (x *CashShift) GetVariance() float64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *CashShift) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CashShift) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *CashShift) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+type CashMovement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShiftId       string                 `protobuf:"bytes,2,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // cash_in, cash_out
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CashMovement) Reset() {
+	*x = CashMovement{}
+	mi := &file_payment_payment_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashMovement) ProtoMessage() {}
+
+func (x *CashMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashMovement.ProtoReflect.Descriptor instead.
+func (*CashMovement) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CashMovement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CashMovement) GetShiftId() string {
+	if x != nil {
+		return x.ShiftId
+	}
+	return ""
+}
+
+func (x *CashMovement) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CashMovement) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CashMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CashMovement) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CashMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CashShiftReport totals a shift: an X-report while it is open, which leaves
+// it open, or the Z-report it was closed with.
+type CashShiftReport struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReportType       string                 `protobuf:"bytes,1,opt,name=report_type,json=reportType,proto3" json:"report_type,omitempty"` // X, Z
+	Shift            *CashShift             `protobuf:"bytes,2,opt,name=shift,proto3" json:"shift,omitempty"`
+	CashSalesCount   int32                  `protobuf:"varint,3,opt,name=cash_sales_count,json=cashSalesCount,proto3" json:"cash_sales_count,omitempty"`
+	CashSales        float64                `protobuf:"fixed64,4,opt,name=cash_sales,json=cashSales,proto3" json:"cash_sales,omitempty"`
+	CashRefundsCount int32                  `protobuf:"varint,5,opt,name=cash_refunds_count,json=cashRefundsCount,proto3" json:"cash_refunds_count,omitempty"`
+	CashRefunds      float64                `protobuf:"fixed64,6,opt,name=cash_refunds,json=cashRefunds,proto3" json:"cash_refunds,omitempty"`
+	CashIn           float64                `protobuf:"fixed64,7,opt,name=cash_in,json=cashIn,proto3" json:"cash_in,omitempty"`
+	CashOut          float64                `protobuf:"fixed64,8,opt,name=cash_out,json=cashOut,proto3" json:"cash_out,omitempty"`
+	ExpectedAmount   float64                `protobuf:"fixed64,9,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"` // opening_float + cash_sales - cash_refunds + cash_in - cash_out
+	CountedAmount    float64                `protobuf:"fixed64,10,opt,name=counted_amount,json=countedAmount,proto3" json:"counted_amount,omitempty"`   // Z-report only
+	Variance         float64                `protobuf:"fixed64,11,opt,name=variance,proto3" json:"variance,omitempty"`                                  // Z-report only: counted_amount - expected_amount
+	Movements        []*CashMovement        `protobuf:"bytes,12,rep,name=movements,proto3" json:"movements,omitempty"`
+	GeneratedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CashShiftReport) Reset() {
+	*x = CashShiftReport{}
+	mi := &file_payment_payment_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashShiftReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashShiftReport) ProtoMessage() {}
+
+func (x *CashShiftReport) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashShiftReport.ProtoReflect.Descriptor instead.
+func (*CashShiftReport) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CashShiftReport) GetReportType() string {
+	if x != nil {
+		return x.ReportType
+	}
+	return ""
 }
 
-func (x *PaymentStatistics) Reset() {
-	*x = PaymentStatistics{}
-	mi := &file_payment_payment_proto_msgTypes[15]
+func (x *CashShiftReport) GetShift() *CashShift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+func (x *CashShiftReport) GetCashSalesCount() int32 {
+	if x != nil {
+		return x.CashSalesCount
+	}
+	return 0
+}
+
+func (x *CashShiftReport) GetCashSales() float64 {
+	if x != nil {
+		return x.CashSales
+	}
+	return 0
+}
+
+func (x *CashShiftReport) GetCashRefundsCount() int32 {
+	if x != nil {
+		return x.CashRefundsCount
+	}
+	return 0
+}
+
+func (x *CashShiftReport) GetCashRefunds() float64 {
+	if x != nil {
+		return x.CashRefunds
+	}
+	return 0
+}
+
+func (x *CashShiftReport) GetCashIn() float64 {
+	if x != nil {
+		return x.CashIn
+	}
+	return 0
+}
+
+func (x *CashShiftReport) GetCashOut() float64 {
+	if x != nil {
+		return x.CashOut
+	}
+	return 0
+}
+
+func (x *CashShiftReport) GetExpectedAmount() float64 {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return 0
+}
+
+func (x *CashShiftReport) GetCountedAmount() float64 {
+	if x != nil {
+		return x.CountedAmount
+	}
+	return 0
+}
+
+func (x *CashShiftReport) GetVariance() float64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *CashShiftReport) GetMovements() []*CashMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *CashShiftReport) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+// OpenCashShift opens a shift for the caller. Requires PermCashShiftManage.
+type OpenCashShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpeningFloat  float64                `protobuf:"fixed64,1,opt,name=opening_float,json=openingFloat,proto3" json:"opening_float,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // optional: defaults to USD
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenCashShiftRequest) Reset() {
+	*x = OpenCashShiftRequest{}
+	mi := &file_payment_payment_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentStatistics) String() string {
+func (x *OpenCashShiftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentStatistics) ProtoMessage() {}
+func (*OpenCashShiftRequest) ProtoMessage() {}
 
-func (x *PaymentStatistics) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[15]
+func (x *OpenCashShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,76 +3628,128 @@ func (x *PaymentStatistics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentStatistics.ProtoReflect.Descriptor instead.
-func (*PaymentStatistics) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use OpenCashShiftRequest.ProtoReflect.Descriptor instead.
+func (*OpenCashShiftRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *PaymentStatistics) GetPeriod() string {
+func (x *OpenCashShiftRequest) GetOpeningFloat() float64 {
 	if x != nil {
-		return x.Period
+		return x.OpeningFloat
+	}
+	return 0
+}
+
+func (x *OpenCashShiftRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *PaymentStatistics) GetTotalTransactions() int32 {
+func (x *OpenCashShiftRequest) GetNote() string {
 	if x != nil {
-		return x.TotalTransactions
+		return x.Note
 	}
-	return 0
+	return ""
 }
 
-func (x *PaymentStatistics) GetTotalAmount() float64 {
+// RecordCashMovement puts cash into or takes it out of an open shift's
+// drawer. Requires PermCashShiftManage.
+type RecordCashMovementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShiftId       string                 `protobuf:"bytes,1,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // cash_in, cash_out
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordCashMovementRequest) Reset() {
+	*x = RecordCashMovementRequest{}
+	mi := &file_payment_payment_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordCashMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordCashMovementRequest) ProtoMessage() {}
+
+func (x *RecordCashMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[48]
 	if x != nil {
-		return x.TotalAmount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PaymentStatistics) GetAverageAmount() float64 {
+// Deprecated: Use RecordCashMovementRequest.ProtoReflect.Descriptor instead.
+func (*RecordCashMovementRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RecordCashMovementRequest) GetShiftId() string {
 	if x != nil {
-		return x.AverageAmount
+		return x.ShiftId
 	}
-	return 0
+	return ""
 }
 
-func (x *PaymentStatistics) GetMostUsedMethod() string {
+func (x *RecordCashMovementRequest) GetKind() string {
 	if x != nil {
-		return x.MostUsedMethod
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *PaymentStatistics) GetSuccessRate() float64 {
+func (x *RecordCashMovementRequest) GetAmount() float64 {
 	if x != nil {
-		return x.SuccessRate
+		return x.Amount
 	}
 	return 0
 }
 
-type GetPaymentStatsRequest struct {
+func (x *RecordCashMovementRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// CloseCashShift closes an open shift with the cash counted in its drawer and
+// returns its Z-report. Requires PermCashShiftManage.
+type CloseCashShiftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"` // daily, weekly, monthly
+	ShiftId       string                 `protobuf:"bytes,1,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	CountedAmount float64                `protobuf:"fixed64,2,opt,name=counted_amount,json=countedAmount,proto3" json:"counted_amount,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPaymentStatsRequest) Reset() {
-	*x = GetPaymentStatsRequest{}
-	mi := &file_payment_payment_proto_msgTypes[16]
+func (x *CloseCashShiftRequest) Reset() {
+	*x = CloseCashShiftRequest{}
+	mi := &file_payment_payment_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPaymentStatsRequest) String() string {
+func (x *CloseCashShiftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPaymentStatsRequest) ProtoMessage() {}
+func (*CloseCashShiftRequest) ProtoMessage() {}
 
-func (x *GetPaymentStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[16]
+func (x *CloseCashShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,47 +3760,57 @@ func (x *GetPaymentStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPaymentStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetPaymentStatsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CloseCashShiftRequest.ProtoReflect.Descriptor instead.
+func (*CloseCashShiftRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetPaymentStatsRequest) GetUserId() int32 {
+func (x *CloseCashShiftRequest) GetShiftId() string {
 	if x != nil {
-		return x.UserId
+		return x.ShiftId
+	}
+	return ""
+}
+
+func (x *CloseCashShiftRequest) GetCountedAmount() float64 {
+	if x != nil {
+		return x.CountedAmount
 	}
 	return 0
 }
 
-func (x *GetPaymentStatsRequest) GetPeriod() string {
+func (x *CloseCashShiftRequest) GetNote() string {
 	if x != nil {
-		return x.Period
+		return x.Note
 	}
 	return ""
 }
 
-type GetPaymentStatsResponse struct {
+// GetCashShiftReport returns an X-report for an open shift or the Z-report
+// of a closed one. Requires PermCashShiftRead.
+type GetCashShiftReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Statistics    *PaymentStatistics     `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	ShiftId       string                 `protobuf:"bytes,1,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"` // optional: defaults to the caller's open shift in currency
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`              // used without shift_id; defaults to USD
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPaymentStatsResponse) Reset() {
-	*x = GetPaymentStatsResponse{}
-	mi := &file_payment_payment_proto_msgTypes[17]
+func (x *GetCashShiftReportRequest) Reset() {
+	*x = GetCashShiftReportRequest{}
+	mi := &file_payment_payment_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPaymentStatsResponse) String() string {
+func (x *GetCashShiftReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPaymentStatsResponse) ProtoMessage() {}
+func (*GetCashShiftReportRequest) ProtoMessage() {}
 
-func (x *GetPaymentStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[17]
+func (x *GetCashShiftReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,41 +3821,49 @@ func (x *GetPaymentStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPaymentStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetPaymentStatsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetCashShiftReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCashShiftReportRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetPaymentStatsResponse) GetStatistics() *PaymentStatistics {
+func (x *GetCashShiftReportRequest) GetShiftId() string {
 	if x != nil {
-		return x.Statistics
+		return x.ShiftId
 	}
-	return nil
+	return ""
 }
 
-type ValidatePaymentRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	ExpectedAmount float64                `protobuf:"fixed64,2,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetCashShiftReportRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (x *ValidatePaymentRequest) Reset() {
-	*x = ValidatePaymentRequest{}
-	mi := &file_payment_payment_proto_msgTypes[18]
+// ListCashShifts requires PermCashShiftRead.
+type ListCashShiftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // optional: open or closed
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // optional: defaults to 50, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCashShiftsRequest) Reset() {
+	*x = ListCashShiftsRequest{}
+	mi := &file_payment_payment_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidatePaymentRequest) String() string {
+func (x *ListCashShiftsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatePaymentRequest) ProtoMessage() {}
+func (*ListCashShiftsRequest) ProtoMessage() {}
 
-func (x *ValidatePaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[18]
+func (x *ListCashShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1399,48 +3874,47 @@ func (x *ValidatePaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatePaymentRequest.ProtoReflect.Descriptor instead.
-func (*ValidatePaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListCashShiftsRequest.ProtoReflect.Descriptor instead.
+func (*ListCashShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ValidatePaymentRequest) GetOrderId() string {
+func (x *ListCashShiftsRequest) GetStatus() string {
 	if x != nil {
-		return x.OrderId
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ValidatePaymentRequest) GetExpectedAmount() float64 {
+func (x *ListCashShiftsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.ExpectedAmount
+		return x.Limit
 	}
 	return 0
 }
 
-type ValidatePaymentResponse struct {
+type ListCashShiftsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsValid       bool                   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Shifts        []*CashShift           `protobuf:"bytes,1,rep,name=shifts,proto3" json:"shifts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidatePaymentResponse) Reset() {
-	*x = ValidatePaymentResponse{}
-	mi := &file_payment_payment_proto_msgTypes[19]
+func (x *ListCashShiftsResponse) Reset() {
+	*x = ListCashShiftsResponse{}
+	mi := &file_payment_payment_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidatePaymentResponse) String() string {
+func (x *ListCashShiftsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatePaymentResponse) ProtoMessage() {}
+func (*ListCashShiftsResponse) ProtoMessage() {}
 
-func (x *ValidatePaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_proto_msgTypes[19]
+func (x *ListCashShiftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1451,36 +3925,29 @@ func (x *ValidatePaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatePaymentResponse.ProtoReflect.Descriptor instead.
-func (*ValidatePaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_payment_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ValidatePaymentResponse) GetIsValid() bool {
-	if x != nil {
-		return x.IsValid
-	}
-	return false
+// Deprecated: Use ListCashShiftsResponse.ProtoReflect.Descriptor instead.
+func (*ListCashShiftsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ValidatePaymentResponse) GetMessage() string {
+func (x *ListCashShiftsResponse) GetShifts() []*CashShift {
 	if x != nil {
-		return x.Message
+		return x.Shifts
 	}
-	return ""
+	return nil
 }
 
 var File_payment_payment_proto protoreflect.FileDescriptor
 
 const file_payment_payment_proto_rawDesc = "" +
 	"\n" +
-	"\x15payment/payment.proto\x12\apayment\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd8\x01\n" +
+	"\x15payment/payment.proto\x12\apayment\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x11money/money.proto\"\xd8\x01\n" +
 	"\x0ePaymentDetails\x12%\n" +
 	"\x0epayment_method\x18\x01 \x01(\tR\rpaymentMethod\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12%\n" +
 	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x12!\n" +
 	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x12=\n" +
-	"\fprocessed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\"\xcc\x03\n" +
+	"\fprocessed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\"\xfd\x03\n" +
 	"\aPayment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
@@ -1497,14 +3964,17 @@ const file_payment_payment_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd0\x01\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\famount_money\x18\x0e \x01(\v2\f.money.MoneyR\vamountMoney\"\x82\x02\n" +
 	"\x15ProcessPaymentRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12%\n" +
-	"\x0epayment_method\x18\x05 \x01(\tR\rpaymentMethod\x12(\n" +
-	"\x04card\x18\x06 \x01(\v2\x14.payment.PaymentCardR\x04card\"\xa5\x01\n" +
+	"\x0epayment_method\x18\x05 \x01(\tR\rpaymentMethod\x12\x1d\n" +
+	"\n" +
+	"card_token\x18\a \x01(\tR\tcardToken\x12/\n" +
+	"\famount_money\x18\b \x01(\v2\f.money.MoneyR\vamountMoneyJ\x04\b\x06\x10\aR\x04card\"\xa5\x01\n" +
 	"\vPaymentCard\x12\x1f\n" +
 	"\vcard_number\x18\x01 \x01(\tR\n" +
 	"cardNumber\x12\x1f\n" +
@@ -1513,7 +3983,19 @@ const file_payment_payment_proto_rawDesc = "" +
 	"\fexpiry_month\x18\x03 \x01(\tR\vexpiryMonth\x12\x1f\n" +
 	"\vexpiry_year\x18\x04 \x01(\tR\n" +
 	"expiryYear\x12\x10\n" +
-	"\x03cvv\x18\x05 \x01(\tR\x03cvv\"\xea\x01\n" +
+	"\x03cvv\x18\x05 \x01(\tR\x03cvv\"?\n" +
+	"\x13TokenizeCardRequest\x12(\n" +
+	"\x04card\x18\x01 \x01(\v2\x14.payment.PaymentCardR\x04card\"\xe0\x01\n" +
+	"\x14TokenizeCardResponse\x12\x1d\n" +
+	"\n" +
+	"card_token\x18\x01 \x01(\tR\tcardToken\x12\x14\n" +
+	"\x05brand\x18\x02 \x01(\tR\x05brand\x12\x14\n" +
+	"\x05last4\x18\x03 \x01(\tR\x05last4\x12!\n" +
+	"\fexpiry_month\x18\x04 \x01(\tR\vexpiryMonth\x12\x1f\n" +
+	"\vexpiry_year\x18\x05 \x01(\tR\n" +
+	"expiryYear\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9b\x02\n" +
 	"\x16ProcessPaymentResponse\x12\x1d\n" +
 	"\n" +
 	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x19\n" +
@@ -1522,10 +4004,11 @@ const file_payment_payment_proto_rawDesc = "" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
 	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12%\n" +
 	"\x0eprocessing_fee\x18\x06 \x01(\x01R\rprocessingFee\x12\x18\n" +
-	"\amessage\x18\a \x01(\tR\amessage\"2\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12/\n" +
+	"\famount_money\x18\b \x01(\v2\f.money.MoneyR\vamountMoney\"2\n" +
 	"\x11GetPaymentRequest\x12\x1d\n" +
 	"\n" +
-	"payment_id\x18\x01 \x01(\tR\tpaymentId\"\xb2\x03\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\"\xe3\x03\n" +
 	"\x12GetPaymentResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
@@ -1541,7 +4024,8 @@ const file_payment_payment_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x84\x01\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\famount_money\x18\r \x01(\v2\f.money.MoneyR\vamountMoney\"\x84\x01\n" +
 	"\x13ListPaymentsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
@@ -1560,12 +4044,14 @@ const file_payment_payment_proto_rawDesc = "" +
 	"\x19ListOrderPaymentsResponse\x12,\n" +
 	"\bpayments\x18\x01 \x03(\v2\x10.payment.PaymentR\bpayments\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"r\n" +
+	"totalCount\"\x93\x01\n" +
 	"\x14RefundPaymentRequest\x12\x1d\n" +
 	"\n" +
 	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12#\n" +
 	"\rrefund_amount\x18\x02 \x01(\x01R\frefundAmount\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9b\x02\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vreason_code\x18\x04 \x01(\tR\n" +
+	"reasonCode\"\x8a\x03\n" +
 	"\x15RefundPaymentResponse\x12\x1b\n" +
 	"\trefund_id\x18\x01 \x01(\tR\brefundId\x12.\n" +
 	"\x13original_payment_id\x18\x02 \x01(\tR\x11originalPaymentId\x12#\n" +
@@ -1574,7 +4060,33 @@ const file_payment_payment_proto_rawDesc = "" +
 	"\x06reason\x18\x05 \x01(\tR\x06reason\x12%\n" +
 	"\x0etransaction_id\x18\x06 \x01(\tR\rtransactionId\x129\n" +
 	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\\\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
+	"\vreason_code\x18\b \x01(\tR\n" +
+	"reasonCode\x12%\n" +
+	"\x0epayment_status\x18\t \x01(\tR\rpaymentStatus\x12%\n" +
+	"\x0erefunded_total\x18\n" +
+	" \x01(\x01R\rrefundedTotal\"\xc6\x02\n" +
+	"\x06Refund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x02 \x01(\tR\tpaymentId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1f\n" +
+	"\vreason_code\x18\x04 \x01(\tR\n" +
+	"reasonCode\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12%\n" +
+	"\x0etransaction_id\x18\a \x01(\tR\rtransactionId\x12#\n" +
+	"\rerror_message\x18\b \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"\x12ListRefundsRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\"@\n" +
+	"\x13ListRefundsResponse\x12)\n" +
+	"\arefunds\x18\x01 \x03(\v2\x0f.payment.RefundR\arefunds\"\\\n" +
 	"\x14VerifyPaymentRequest\x12\x1d\n" +
 	"\n" +
 	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12%\n" +
@@ -1585,37 +4097,229 @@ const file_payment_payment_proto_rawDesc = "" +
 	"\vis_verified\x18\x02 \x01(\bR\n" +
 	"isVerified\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"\xf1\x01\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x8c\x03\n" +
 	"\x11PaymentStatistics\x12\x16\n" +
 	"\x06period\x18\x01 \x01(\tR\x06period\x12-\n" +
 	"\x12total_transactions\x18\x02 \x01(\x05R\x11totalTransactions\x12!\n" +
 	"\ftotal_amount\x18\x03 \x01(\x01R\vtotalAmount\x12%\n" +
 	"\x0eaverage_amount\x18\x04 \x01(\x01R\raverageAmount\x12(\n" +
 	"\x10most_used_method\x18\x05 \x01(\tR\x0emostUsedMethod\x12!\n" +
-	"\fsuccess_rate\x18\x06 \x01(\x01R\vsuccessRate\"I\n" +
+	"\fsuccess_rate\x18\x06 \x01(\x01R\vsuccessRate\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x123\n" +
+	"\x15captured_transactions\x18\b \x01(\x05R\x14capturedTransactions\x12'\n" +
+	"\x0frefunded_amount\x18\t \x01(\x01R\x0erefundedAmount\x12\x1f\n" +
+	"\vrefund_rate\x18\n" +
+	" \x01(\x01R\n" +
+	"refundRate\"e\n" +
+	"\x15PaymentStatsBreakdown\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\"\n" +
+	"\ftransactions\x18\x02 \x01(\x05R\ftransactions\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\"\x89\x02\n" +
+	"\x12PaymentStatsBucket\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12:\n" +
+	"\n" +
+	"statistics\x18\x02 \x01(\v2\x1a.payment.PaymentStatisticsR\n" +
+	"statistics\x12;\n" +
+	"\tby_method\x18\x03 \x03(\v2\x1e.payment.PaymentStatsBreakdownR\bbyMethod\x12;\n" +
+	"\tby_status\x18\x04 \x03(\v2\x1e.payment.PaymentStatsBreakdownR\bbyStatus\"\xc2\x01\n" +
 	"\x16GetPaymentStatsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x16\n" +
-	"\x06period\x18\x02 \x01(\tR\x06period\"U\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\"\xc0\x01\n" +
 	"\x17GetPaymentStatsResponse\x12:\n" +
 	"\n" +
 	"statistics\x18\x01 \x01(\v2\x1a.payment.PaymentStatisticsR\n" +
-	"statistics\"\\\n" +
+	"statistics\x122\n" +
+	"\x06totals\x18\x02 \x03(\v2\x1a.payment.PaymentStatisticsR\x06totals\x125\n" +
+	"\abuckets\x18\x03 \x03(\v2\x1b.payment.PaymentStatsBucketR\abuckets\"\\\n" +
 	"\x16ValidatePaymentRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12'\n" +
 	"\x0fexpected_amount\x18\x02 \x01(\x01R\x0eexpectedAmount\"N\n" +
 	"\x17ValidatePaymentResponse\x12\x19\n" +
 	"\bis_valid\x18\x01 \x01(\bR\aisValid\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x9f\x05\n" +
-	"\x0ePaymentService\x12Q\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x84\x02\n" +
+	"\x17AuthorizePaymentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12%\n" +
+	"\x0epayment_method\x18\x05 \x01(\tR\rpaymentMethod\x12\x1d\n" +
+	"\n" +
+	"card_token\x18\a \x01(\tR\tcardToken\x12/\n" +
+	"\famount_money\x18\b \x01(\v2\f.money.MoneyR\vamountMoneyJ\x04\b\x06\x10\aR\x04card\"\xb1\x02\n" +
+	"\x18AuthorizePaymentResponse\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12/\n" +
+	"\famount_money\x18\b \x01(\v2\f.money.MoneyR\vamountMoney\"N\n" +
+	"\x15CapturePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"\xfc\x01\n" +
+	"\x16CapturePaymentResponse\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12+\n" +
+	"\x11authorized_amount\x18\x04 \x01(\x01R\x10authorizedAmount\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12%\n" +
+	"\x0eprocessing_fee\x18\x06 \x01(\x01R\rprocessingFee\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"K\n" +
+	"\x12VoidPaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"f\n" +
+	"\x13VoidPaymentResponse\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"T\n" +
+	"\n" +
+	"LedgerLine\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x14\n" +
+	"\x05debit\x18\x02 \x01(\x01R\x05debit\x12\x16\n" +
+	"\x06credit\x18\x03 \x01(\x01R\x06credit\"\xa8\x01\n" +
+	" RecordStoreCreditMovementRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12!\n" +
+	"\freference_id\x18\x02 \x01(\tR\vreferenceId\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"u\n" +
+	"!RecordStoreCreditMovementResponse\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12)\n" +
+	"\x05lines\x18\x02 \x03(\v2\x13.payment.LedgerLineR\x05lines\"\x8e\x01\n" +
+	"\x0eAccountBalance\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05debit\x18\x03 \x01(\x01R\x05debit\x12\x16\n" +
+	"\x06credit\x18\x04 \x01(\x01R\x06credit\x12\x18\n" +
+	"\abalance\x18\x05 \x01(\x01R\abalance\"H\n" +
+	"\x15GetShopBalanceRequest\x12/\n" +
+	"\x05as_of\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"~\n" +
+	"\x16GetShopBalanceResponse\x123\n" +
+	"\bbalances\x18\x01 \x03(\v2\x17.payment.AccountBalanceR\bbalances\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\x7f\n" +
+	"\x12PaymentDiscrepancy\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bexpected\x18\x03 \x01(\x01R\bexpected\x12\x1a\n" +
+	"\brecorded\x18\x04 \x01(\x01R\brecorded\"v\n" +
+	"\x18ReconcilePaymentsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"x\n" +
+	"\x19ReconcilePaymentsResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x05R\achecked\x12A\n" +
+	"\rdiscrepancies\x18\x02 \x03(\v2\x1b.payment.PaymentDiscrepancyR\rdiscrepancies\"\xe6\x01\n" +
+	"\x19HandleWebhookEventRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x8b\x01\n" +
+	"\x1aHandleWebhookEventResponse\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\tduplicate\x18\x03 \x01(\bR\tduplicate\x12\x18\n" +
+	"\aapplied\x18\x04 \x01(\bR\aapplied\"\xa0\x03\n" +
+	"\tCashShift\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\topened_by\x18\x02 \x01(\tR\bopenedBy\x12\x1b\n" +
+	"\tclosed_by\x18\x03 \x01(\tR\bclosedBy\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
+	"\ropening_float\x18\x06 \x01(\x01R\fopeningFloat\x12'\n" +
+	"\x0fexpected_amount\x18\a \x01(\x01R\x0eexpectedAmount\x12%\n" +
+	"\x0ecounted_amount\x18\b \x01(\x01R\rcountedAmount\x12\x1a\n" +
+	"\bvariance\x18\t \x01(\x01R\bvariance\x12\x12\n" +
+	"\x04note\x18\n" +
+	" \x01(\tR\x04note\x127\n" +
+	"\topened_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x127\n" +
+	"\tclosed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\"\xd7\x01\n" +
+	"\fCashMovement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bshift_id\x18\x02 \x01(\tR\ashiftId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8a\x04\n" +
+	"\x0fCashShiftReport\x12\x1f\n" +
+	"\vreport_type\x18\x01 \x01(\tR\n" +
+	"reportType\x12(\n" +
+	"\x05shift\x18\x02 \x01(\v2\x12.payment.CashShiftR\x05shift\x12(\n" +
+	"\x10cash_sales_count\x18\x03 \x01(\x05R\x0ecashSalesCount\x12\x1d\n" +
+	"\n" +
+	"cash_sales\x18\x04 \x01(\x01R\tcashSales\x12,\n" +
+	"\x12cash_refunds_count\x18\x05 \x01(\x05R\x10cashRefundsCount\x12!\n" +
+	"\fcash_refunds\x18\x06 \x01(\x01R\vcashRefunds\x12\x17\n" +
+	"\acash_in\x18\a \x01(\x01R\x06cashIn\x12\x19\n" +
+	"\bcash_out\x18\b \x01(\x01R\acashOut\x12'\n" +
+	"\x0fexpected_amount\x18\t \x01(\x01R\x0eexpectedAmount\x12%\n" +
+	"\x0ecounted_amount\x18\n" +
+	" \x01(\x01R\rcountedAmount\x12\x1a\n" +
+	"\bvariance\x18\v \x01(\x01R\bvariance\x123\n" +
+	"\tmovements\x18\f \x03(\v2\x15.payment.CashMovementR\tmovements\x12=\n" +
+	"\fgenerated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"k\n" +
+	"\x14OpenCashShiftRequest\x12#\n" +
+	"\ropening_float\x18\x01 \x01(\x01R\fopeningFloat\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"z\n" +
+	"\x19RecordCashMovementRequest\x12\x19\n" +
+	"\bshift_id\x18\x01 \x01(\tR\ashiftId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"m\n" +
+	"\x15CloseCashShiftRequest\x12\x19\n" +
+	"\bshift_id\x18\x01 \x01(\tR\ashiftId\x12%\n" +
+	"\x0ecounted_amount\x18\x02 \x01(\x01R\rcountedAmount\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"R\n" +
+	"\x19GetCashShiftReportRequest\x12\x19\n" +
+	"\bshift_id\x18\x01 \x01(\tR\ashiftId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"E\n" +
+	"\x15ListCashShiftsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"D\n" +
+	"\x16ListCashShiftsResponse\x12*\n" +
+	"\x06shifts\x18\x01 \x03(\v2\x12.payment.CashShiftR\x06shifts2\xb6\x0e\n" +
+	"\x0ePaymentService\x12K\n" +
+	"\fTokenizeCard\x12\x1c.payment.TokenizeCardRequest\x1a\x1d.payment.TokenizeCardResponse\x12Q\n" +
 	"\x0eProcessPayment\x12\x1e.payment.ProcessPaymentRequest\x1a\x1f.payment.ProcessPaymentResponse\x12E\n" +
 	"\n" +
 	"GetPayment\x12\x1a.payment.GetPaymentRequest\x1a\x1b.payment.GetPaymentResponse\x12K\n" +
 	"\fListPayments\x12\x1c.payment.ListPaymentsRequest\x1a\x1d.payment.ListPaymentsResponse\x12Z\n" +
 	"\x11ListOrderPayments\x12!.payment.ListOrderPaymentsRequest\x1a\".payment.ListOrderPaymentsResponse\x12N\n" +
-	"\rRefundPayment\x12\x1d.payment.RefundPaymentRequest\x1a\x1e.payment.RefundPaymentResponse\x12N\n" +
+	"\rRefundPayment\x12\x1d.payment.RefundPaymentRequest\x1a\x1e.payment.RefundPaymentResponse\x12H\n" +
+	"\vListRefunds\x12\x1b.payment.ListRefundsRequest\x1a\x1c.payment.ListRefundsResponse\x12N\n" +
 	"\rVerifyPayment\x12\x1d.payment.VerifyPaymentRequest\x1a\x1e.payment.VerifyPaymentResponse\x12T\n" +
 	"\x0fValidatePayment\x12\x1f.payment.ValidatePaymentRequest\x1a .payment.ValidatePaymentResponse\x12T\n" +
-	"\x0fGetPaymentStats\x12\x1f.payment.GetPaymentStatsRequest\x1a .payment.GetPaymentStatsResponseB\x1bZ\x19proto/paymentpb;paymentpbb\x06proto3"
+	"\x0fGetPaymentStats\x12\x1f.payment.GetPaymentStatsRequest\x1a .payment.GetPaymentStatsResponse\x12W\n" +
+	"\x10AuthorizePayment\x12 .payment.AuthorizePaymentRequest\x1a!.payment.AuthorizePaymentResponse\x12Q\n" +
+	"\x0eCapturePayment\x12\x1e.payment.CapturePaymentRequest\x1a\x1f.payment.CapturePaymentResponse\x12H\n" +
+	"\vVoidPayment\x12\x1b.payment.VoidPaymentRequest\x1a\x1c.payment.VoidPaymentResponse\x12r\n" +
+	"\x19RecordStoreCreditMovement\x12).payment.RecordStoreCreditMovementRequest\x1a*.payment.RecordStoreCreditMovementResponse\x12Q\n" +
+	"\x0eGetShopBalance\x12\x1e.payment.GetShopBalanceRequest\x1a\x1f.payment.GetShopBalanceResponse\x12Z\n" +
+	"\x11ReconcilePayments\x12!.payment.ReconcilePaymentsRequest\x1a\".payment.ReconcilePaymentsResponse\x12]\n" +
+	"\x12HandleWebhookEvent\x12\".payment.HandleWebhookEventRequest\x1a#.payment.HandleWebhookEventResponse\x12B\n" +
+	"\rOpenCashShift\x12\x1d.payment.OpenCashShiftRequest\x1a\x12.payment.CashShift\x12O\n" +
+	"\x12RecordCashMovement\x12\".payment.RecordCashMovementRequest\x1a\x15.payment.CashMovement\x12J\n" +
+	"\x0eCloseCashShift\x12\x1e.payment.CloseCashShiftRequest\x1a\x18.payment.CashShiftReport\x12R\n" +
+	"\x12GetCashShiftReport\x12\".payment.GetCashShiftReportRequest\x1a\x18.payment.CashShiftReport\x12Q\n" +
+	"\x0eListCashShifts\x12\x1e.payment.ListCashShiftsRequest\x1a\x1f.payment.ListCashShiftsResponseB\x1bZ\x19proto/paymentpb;paymentpbb\x06proto3"
 
 var (
 	file_payment_payment_proto_rawDescOnce sync.Once
@@ -1629,62 +4333,157 @@ func file_payment_payment_proto_rawDescGZIP() []byte {
 	return file_payment_payment_proto_rawDescData
 }
 
-var file_payment_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_payment_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_payment_payment_proto_goTypes = []any{
-	(*PaymentDetails)(nil),            // 0: payment.PaymentDetails
-	(*Payment)(nil),                   // 1: payment.Payment
-	(*ProcessPaymentRequest)(nil),     // 2: payment.ProcessPaymentRequest
-	(*PaymentCard)(nil),               // 3: payment.PaymentCard
-	(*ProcessPaymentResponse)(nil),    // 4: payment.ProcessPaymentResponse
-	(*GetPaymentRequest)(nil),         // 5: payment.GetPaymentRequest
-	(*GetPaymentResponse)(nil),        // 6: payment.GetPaymentResponse
-	(*ListPaymentsRequest)(nil),       // 7: payment.ListPaymentsRequest
-	(*ListPaymentsResponse)(nil),      // 8: payment.ListPaymentsResponse
-	(*ListOrderPaymentsRequest)(nil),  // 9: payment.ListOrderPaymentsRequest
-	(*ListOrderPaymentsResponse)(nil), // 10: payment.ListOrderPaymentsResponse
-	(*RefundPaymentRequest)(nil),      // 11: payment.RefundPaymentRequest
-	(*RefundPaymentResponse)(nil),     // 12: payment.RefundPaymentResponse
-	(*VerifyPaymentRequest)(nil),      // 13: payment.VerifyPaymentRequest
-	(*VerifyPaymentResponse)(nil),     // 14: payment.VerifyPaymentResponse
-	(*PaymentStatistics)(nil),         // 15: payment.PaymentStatistics
-	(*GetPaymentStatsRequest)(nil),    // 16: payment.GetPaymentStatsRequest
-	(*GetPaymentStatsResponse)(nil),   // 17: payment.GetPaymentStatsResponse
-	(*ValidatePaymentRequest)(nil),    // 18: payment.ValidatePaymentRequest
-	(*ValidatePaymentResponse)(nil),   // 19: payment.ValidatePaymentResponse
-	(*timestamppb.Timestamp)(nil),     // 20: google.protobuf.Timestamp
+	(*PaymentDetails)(nil),                    // 0: payment.PaymentDetails
+	(*Payment)(nil),                           // 1: payment.Payment
+	(*ProcessPaymentRequest)(nil),             // 2: payment.ProcessPaymentRequest
+	(*PaymentCard)(nil),                       // 3: payment.PaymentCard
+	(*TokenizeCardRequest)(nil),               // 4: payment.TokenizeCardRequest
+	(*TokenizeCardResponse)(nil),              // 5: payment.TokenizeCardResponse
+	(*ProcessPaymentResponse)(nil),            // 6: payment.ProcessPaymentResponse
+	(*GetPaymentRequest)(nil),                 // 7: payment.GetPaymentRequest
+	(*GetPaymentResponse)(nil),                // 8: payment.GetPaymentResponse
+	(*ListPaymentsRequest)(nil),               // 9: payment.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),              // 10: payment.ListPaymentsResponse
+	(*ListOrderPaymentsRequest)(nil),          // 11: payment.ListOrderPaymentsRequest
+	(*ListOrderPaymentsResponse)(nil),         // 12: payment.ListOrderPaymentsResponse
+	(*RefundPaymentRequest)(nil),              // 13: payment.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil),             // 14: payment.RefundPaymentResponse
+	(*Refund)(nil),                            // 15: payment.Refund
+	(*ListRefundsRequest)(nil),                // 16: payment.ListRefundsRequest
+	(*ListRefundsResponse)(nil),               // 17: payment.ListRefundsResponse
+	(*VerifyPaymentRequest)(nil),              // 18: payment.VerifyPaymentRequest
+	(*VerifyPaymentResponse)(nil),             // 19: payment.VerifyPaymentResponse
+	(*PaymentStatistics)(nil),                 // 20: payment.PaymentStatistics
+	(*PaymentStatsBreakdown)(nil),             // 21: payment.PaymentStatsBreakdown
+	(*PaymentStatsBucket)(nil),                // 22: payment.PaymentStatsBucket
+	(*GetPaymentStatsRequest)(nil),            // 23: payment.GetPaymentStatsRequest
+	(*GetPaymentStatsResponse)(nil),           // 24: payment.GetPaymentStatsResponse
+	(*ValidatePaymentRequest)(nil),            // 25: payment.ValidatePaymentRequest
+	(*ValidatePaymentResponse)(nil),           // 26: payment.ValidatePaymentResponse
+	(*AuthorizePaymentRequest)(nil),           // 27: payment.AuthorizePaymentRequest
+	(*AuthorizePaymentResponse)(nil),          // 28: payment.AuthorizePaymentResponse
+	(*CapturePaymentRequest)(nil),             // 29: payment.CapturePaymentRequest
+	(*CapturePaymentResponse)(nil),            // 30: payment.CapturePaymentResponse
+	(*VoidPaymentRequest)(nil),                // 31: payment.VoidPaymentRequest
+	(*VoidPaymentResponse)(nil),               // 32: payment.VoidPaymentResponse
+	(*LedgerLine)(nil),                        // 33: payment.LedgerLine
+	(*RecordStoreCreditMovementRequest)(nil),  // 34: payment.RecordStoreCreditMovementRequest
+	(*RecordStoreCreditMovementResponse)(nil), // 35: payment.RecordStoreCreditMovementResponse
+	(*AccountBalance)(nil),                    // 36: payment.AccountBalance
+	(*GetShopBalanceRequest)(nil),             // 37: payment.GetShopBalanceRequest
+	(*GetShopBalanceResponse)(nil),            // 38: payment.GetShopBalanceResponse
+	(*PaymentDiscrepancy)(nil),                // 39: payment.PaymentDiscrepancy
+	(*ReconcilePaymentsRequest)(nil),          // 40: payment.ReconcilePaymentsRequest
+	(*ReconcilePaymentsResponse)(nil),         // 41: payment.ReconcilePaymentsResponse
+	(*HandleWebhookEventRequest)(nil),         // 42: payment.HandleWebhookEventRequest
+	(*HandleWebhookEventResponse)(nil),        // 43: payment.HandleWebhookEventResponse
+	(*CashShift)(nil),                         // 44: payment.CashShift
+	(*CashMovement)(nil),                      // 45: payment.CashMovement
+	(*CashShiftReport)(nil),                   // 46: payment.CashShiftReport
+	(*OpenCashShiftRequest)(nil),              // 47: payment.OpenCashShiftRequest
+	(*RecordCashMovementRequest)(nil),         // 48: payment.RecordCashMovementRequest
+	(*CloseCashShiftRequest)(nil),             // 49: payment.CloseCashShiftRequest
+	(*GetCashShiftReportRequest)(nil),         // 50: payment.GetCashShiftReportRequest
+	(*ListCashShiftsRequest)(nil),             // 51: payment.ListCashShiftsRequest
+	(*ListCashShiftsResponse)(nil),            // 52: payment.ListCashShiftsResponse
+	(*timestamppb.Timestamp)(nil),             // 53: google.protobuf.Timestamp
+	(*moneypb.Money)(nil),                     // 54: money.Money
 }
 var file_payment_payment_proto_depIdxs = []int32{
-	20, // 0: payment.PaymentDetails.processed_at:type_name -> google.protobuf.Timestamp
-	20, // 1: payment.Payment.created_at:type_name -> google.protobuf.Timestamp
-	20, // 2: payment.Payment.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 3: payment.ProcessPaymentRequest.card:type_name -> payment.PaymentCard
-	20, // 4: payment.GetPaymentResponse.created_at:type_name -> google.protobuf.Timestamp
-	20, // 5: payment.GetPaymentResponse.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 6: payment.ListPaymentsResponse.payments:type_name -> payment.Payment
-	1,  // 7: payment.ListOrderPaymentsResponse.payments:type_name -> payment.Payment
-	20, // 8: payment.RefundPaymentResponse.created_at:type_name -> google.protobuf.Timestamp
-	15, // 9: payment.GetPaymentStatsResponse.statistics:type_name -> payment.PaymentStatistics
-	2,  // 10: payment.PaymentService.ProcessPayment:input_type -> payment.ProcessPaymentRequest
-	5,  // 11: payment.PaymentService.GetPayment:input_type -> payment.GetPaymentRequest
-	7,  // 12: payment.PaymentService.ListPayments:input_type -> payment.ListPaymentsRequest
-	9,  // 13: payment.PaymentService.ListOrderPayments:input_type -> payment.ListOrderPaymentsRequest
-	11, // 14: payment.PaymentService.RefundPayment:input_type -> payment.RefundPaymentRequest
-	13, // 15: payment.PaymentService.VerifyPayment:input_type -> payment.VerifyPaymentRequest
-	18, // 16: payment.PaymentService.ValidatePayment:input_type -> payment.ValidatePaymentRequest
-	16, // 17: payment.PaymentService.GetPaymentStats:input_type -> payment.GetPaymentStatsRequest
-	4,  // 18: payment.PaymentService.ProcessPayment:output_type -> payment.ProcessPaymentResponse
-	6,  // 19: payment.PaymentService.GetPayment:output_type -> payment.GetPaymentResponse
-	8,  // 20: payment.PaymentService.ListPayments:output_type -> payment.ListPaymentsResponse
-	10, // 21: payment.PaymentService.ListOrderPayments:output_type -> payment.ListOrderPaymentsResponse
-	12, // 22: payment.PaymentService.RefundPayment:output_type -> payment.RefundPaymentResponse
-	14, // 23: payment.PaymentService.VerifyPayment:output_type -> payment.VerifyPaymentResponse
-	19, // 24: payment.PaymentService.ValidatePayment:output_type -> payment.ValidatePaymentResponse
-	17, // 25: payment.PaymentService.GetPaymentStats:output_type -> payment.GetPaymentStatsResponse
-	18, // [18:26] is the sub-list for method output_type
-	10, // [10:18] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	53, // 0: payment.PaymentDetails.processed_at:type_name -> google.protobuf.Timestamp
+	53, // 1: payment.Payment.created_at:type_name -> google.protobuf.Timestamp
+	53, // 2: payment.Payment.updated_at:type_name -> google.protobuf.Timestamp
+	54, // 3: payment.Payment.amount_money:type_name -> money.Money
+	54, // 4: payment.ProcessPaymentRequest.amount_money:type_name -> money.Money
+	3,  // 5: payment.TokenizeCardRequest.card:type_name -> payment.PaymentCard
+	53, // 6: payment.TokenizeCardResponse.created_at:type_name -> google.protobuf.Timestamp
+	54, // 7: payment.ProcessPaymentResponse.amount_money:type_name -> money.Money
+	53, // 8: payment.GetPaymentResponse.created_at:type_name -> google.protobuf.Timestamp
+	53, // 9: payment.GetPaymentResponse.updated_at:type_name -> google.protobuf.Timestamp
+	54, // 10: payment.GetPaymentResponse.amount_money:type_name -> money.Money
+	1,  // 11: payment.ListPaymentsResponse.payments:type_name -> payment.Payment
+	1,  // 12: payment.ListOrderPaymentsResponse.payments:type_name -> payment.Payment
+	53, // 13: payment.RefundPaymentResponse.created_at:type_name -> google.protobuf.Timestamp
+	53, // 14: payment.Refund.created_at:type_name -> google.protobuf.Timestamp
+	15, // 15: payment.ListRefundsResponse.refunds:type_name -> payment.Refund
+	53, // 16: payment.PaymentStatsBucket.period_start:type_name -> google.protobuf.Timestamp
+	20, // 17: payment.PaymentStatsBucket.statistics:type_name -> payment.PaymentStatistics
+	21, // 18: payment.PaymentStatsBucket.by_method:type_name -> payment.PaymentStatsBreakdown
+	21, // 19: payment.PaymentStatsBucket.by_status:type_name -> payment.PaymentStatsBreakdown
+	53, // 20: payment.GetPaymentStatsRequest.from:type_name -> google.protobuf.Timestamp
+	53, // 21: payment.GetPaymentStatsRequest.to:type_name -> google.protobuf.Timestamp
+	20, // 22: payment.GetPaymentStatsResponse.statistics:type_name -> payment.PaymentStatistics
+	20, // 23: payment.GetPaymentStatsResponse.totals:type_name -> payment.PaymentStatistics
+	22, // 24: payment.GetPaymentStatsResponse.buckets:type_name -> payment.PaymentStatsBucket
+	54, // 25: payment.AuthorizePaymentRequest.amount_money:type_name -> money.Money
+	53, // 26: payment.AuthorizePaymentResponse.expires_at:type_name -> google.protobuf.Timestamp
+	54, // 27: payment.AuthorizePaymentResponse.amount_money:type_name -> money.Money
+	33, // 28: payment.RecordStoreCreditMovementResponse.lines:type_name -> payment.LedgerLine
+	53, // 29: payment.GetShopBalanceRequest.as_of:type_name -> google.protobuf.Timestamp
+	36, // 30: payment.GetShopBalanceResponse.balances:type_name -> payment.AccountBalance
+	53, // 31: payment.GetShopBalanceResponse.as_of:type_name -> google.protobuf.Timestamp
+	53, // 32: payment.ReconcilePaymentsRequest.from:type_name -> google.protobuf.Timestamp
+	53, // 33: payment.ReconcilePaymentsRequest.to:type_name -> google.protobuf.Timestamp
+	39, // 34: payment.ReconcilePaymentsResponse.discrepancies:type_name -> payment.PaymentDiscrepancy
+	53, // 35: payment.HandleWebhookEventRequest.occurred_at:type_name -> google.protobuf.Timestamp
+	53, // 36: payment.CashShift.opened_at:type_name -> google.protobuf.Timestamp
+	53, // 37: payment.CashShift.closed_at:type_name -> google.protobuf.Timestamp
+	53, // 38: payment.CashMovement.created_at:type_name -> google.protobuf.Timestamp
+	44, // 39: payment.CashShiftReport.shift:type_name -> payment.CashShift
+	45, // 40: payment.CashShiftReport.movements:type_name -> payment.CashMovement
+	53, // 41: payment.CashShiftReport.generated_at:type_name -> google.protobuf.Timestamp
+	44, // 42: payment.ListCashShiftsResponse.shifts:type_name -> payment.CashShift
+	4,  // 43: payment.PaymentService.TokenizeCard:input_type -> payment.TokenizeCardRequest
+	2,  // 44: payment.PaymentService.ProcessPayment:input_type -> payment.ProcessPaymentRequest
+	7,  // 45: payment.PaymentService.GetPayment:input_type -> payment.GetPaymentRequest
+	9,  // 46: payment.PaymentService.ListPayments:input_type -> payment.ListPaymentsRequest
+	11, // 47: payment.PaymentService.ListOrderPayments:input_type -> payment.ListOrderPaymentsRequest
+	13, // 48: payment.PaymentService.RefundPayment:input_type -> payment.RefundPaymentRequest
+	16, // 49: payment.PaymentService.ListRefunds:input_type -> payment.ListRefundsRequest
+	18, // 50: payment.PaymentService.VerifyPayment:input_type -> payment.VerifyPaymentRequest
+	25, // 51: payment.PaymentService.ValidatePayment:input_type -> payment.ValidatePaymentRequest
+	23, // 52: payment.PaymentService.GetPaymentStats:input_type -> payment.GetPaymentStatsRequest
+	27, // 53: payment.PaymentService.AuthorizePayment:input_type -> payment.AuthorizePaymentRequest
+	29, // 54: payment.PaymentService.CapturePayment:input_type -> payment.CapturePaymentRequest
+	31, // 55: payment.PaymentService.VoidPayment:input_type -> payment.VoidPaymentRequest
+	34, // 56: payment.PaymentService.RecordStoreCreditMovement:input_type -> payment.RecordStoreCreditMovementRequest
+	37, // 57: payment.PaymentService.GetShopBalance:input_type -> payment.GetShopBalanceRequest
+	40, // 58: payment.PaymentService.ReconcilePayments:input_type -> payment.ReconcilePaymentsRequest
+	42, // 59: payment.PaymentService.HandleWebhookEvent:input_type -> payment.HandleWebhookEventRequest
+	47, // 60: payment.PaymentService.OpenCashShift:input_type -> payment.OpenCashShiftRequest
+	48, // 61: payment.PaymentService.RecordCashMovement:input_type -> payment.RecordCashMovementRequest
+	49, // 62: payment.PaymentService.CloseCashShift:input_type -> payment.CloseCashShiftRequest
+	50, // 63: payment.PaymentService.GetCashShiftReport:input_type -> payment.GetCashShiftReportRequest
+	51, // 64: payment.PaymentService.ListCashShifts:input_type -> payment.ListCashShiftsRequest
+	5,  // 65: payment.PaymentService.TokenizeCard:output_type -> payment.TokenizeCardResponse
+	6,  // 66: payment.PaymentService.ProcessPayment:output_type -> payment.ProcessPaymentResponse
+	8,  // 67: payment.PaymentService.GetPayment:output_type -> payment.GetPaymentResponse
+	10, // 68: payment.PaymentService.ListPayments:output_type -> payment.ListPaymentsResponse
+	12, // 69: payment.PaymentService.ListOrderPayments:output_type -> payment.ListOrderPaymentsResponse
+	14, // 70: payment.PaymentService.RefundPayment:output_type -> payment.RefundPaymentResponse
+	17, // 71: payment.PaymentService.ListRefunds:output_type -> payment.ListRefundsResponse
+	19, // 72: payment.PaymentService.VerifyPayment:output_type -> payment.VerifyPaymentResponse
+	26, // 73: payment.PaymentService.ValidatePayment:output_type -> payment.ValidatePaymentResponse
+	24, // 74: payment.PaymentService.GetPaymentStats:output_type -> payment.GetPaymentStatsResponse
+	28, // 75: payment.PaymentService.AuthorizePayment:output_type -> payment.AuthorizePaymentResponse
+	30, // 76: payment.PaymentService.CapturePayment:output_type -> payment.CapturePaymentResponse
+	32, // 77: payment.PaymentService.VoidPayment:output_type -> payment.VoidPaymentResponse
+	35, // 78: payment.PaymentService.RecordStoreCreditMovement:output_type -> payment.RecordStoreCreditMovementResponse
+	38, // 79: payment.PaymentService.GetShopBalance:output_type -> payment.GetShopBalanceResponse
+	41, // 80: payment.PaymentService.ReconcilePayments:output_type -> payment.ReconcilePaymentsResponse
+	43, // 81: payment.PaymentService.HandleWebhookEvent:output_type -> payment.HandleWebhookEventResponse
+	44, // 82: payment.PaymentService.OpenCashShift:output_type -> payment.CashShift
+	45, // 83: payment.PaymentService.RecordCashMovement:output_type -> payment.CashMovement
+	46, // 84: payment.PaymentService.CloseCashShift:output_type -> payment.CashShiftReport
+	46, // 85: payment.PaymentService.GetCashShiftReport:output_type -> payment.CashShiftReport
+	52, // 86: payment.PaymentService.ListCashShifts:output_type -> payment.ListCashShiftsResponse
+	65, // [65:87] is the sub-list for method output_type
+	43, // [43:65] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_payment_payment_proto_init() }
@@ -1698,7 +4497,7 @@ func file_payment_payment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_payment_proto_rawDesc), len(file_payment_payment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
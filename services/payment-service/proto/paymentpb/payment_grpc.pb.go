@@ -19,28 +19,56 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_ProcessPayment_FullMethodName    = "/payment.PaymentService/ProcessPayment"
-	PaymentService_GetPayment_FullMethodName        = "/payment.PaymentService/GetPayment"
-	PaymentService_ListPayments_FullMethodName      = "/payment.PaymentService/ListPayments"
-	PaymentService_ListOrderPayments_FullMethodName = "/payment.PaymentService/ListOrderPayments"
-	PaymentService_RefundPayment_FullMethodName     = "/payment.PaymentService/RefundPayment"
-	PaymentService_VerifyPayment_FullMethodName     = "/payment.PaymentService/VerifyPayment"
-	PaymentService_ValidatePayment_FullMethodName   = "/payment.PaymentService/ValidatePayment"
-	PaymentService_GetPaymentStats_FullMethodName   = "/payment.PaymentService/GetPaymentStats"
+	PaymentService_TokenizeCard_FullMethodName              = "/payment.PaymentService/TokenizeCard"
+	PaymentService_ProcessPayment_FullMethodName            = "/payment.PaymentService/ProcessPayment"
+	PaymentService_GetPayment_FullMethodName                = "/payment.PaymentService/GetPayment"
+	PaymentService_ListPayments_FullMethodName              = "/payment.PaymentService/ListPayments"
+	PaymentService_ListOrderPayments_FullMethodName         = "/payment.PaymentService/ListOrderPayments"
+	PaymentService_RefundPayment_FullMethodName             = "/payment.PaymentService/RefundPayment"
+	PaymentService_ListRefunds_FullMethodName               = "/payment.PaymentService/ListRefunds"
+	PaymentService_VerifyPayment_FullMethodName             = "/payment.PaymentService/VerifyPayment"
+	PaymentService_ValidatePayment_FullMethodName           = "/payment.PaymentService/ValidatePayment"
+	PaymentService_GetPaymentStats_FullMethodName           = "/payment.PaymentService/GetPaymentStats"
+	PaymentService_AuthorizePayment_FullMethodName          = "/payment.PaymentService/AuthorizePayment"
+	PaymentService_CapturePayment_FullMethodName            = "/payment.PaymentService/CapturePayment"
+	PaymentService_VoidPayment_FullMethodName               = "/payment.PaymentService/VoidPayment"
+	PaymentService_RecordStoreCreditMovement_FullMethodName = "/payment.PaymentService/RecordStoreCreditMovement"
+	PaymentService_GetShopBalance_FullMethodName            = "/payment.PaymentService/GetShopBalance"
+	PaymentService_ReconcilePayments_FullMethodName         = "/payment.PaymentService/ReconcilePayments"
+	PaymentService_HandleWebhookEvent_FullMethodName        = "/payment.PaymentService/HandleWebhookEvent"
+	PaymentService_OpenCashShift_FullMethodName             = "/payment.PaymentService/OpenCashShift"
+	PaymentService_RecordCashMovement_FullMethodName        = "/payment.PaymentService/RecordCashMovement"
+	PaymentService_CloseCashShift_FullMethodName            = "/payment.PaymentService/CloseCashShift"
+	PaymentService_GetCashShiftReport_FullMethodName        = "/payment.PaymentService/GetCashShiftReport"
+	PaymentService_ListCashShifts_FullMethodName            = "/payment.PaymentService/ListCashShifts"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PaymentServiceClient interface {
+	TokenizeCard(ctx context.Context, in *TokenizeCardRequest, opts ...grpc.CallOption) (*TokenizeCardResponse, error)
 	ProcessPayment(ctx context.Context, in *ProcessPaymentRequest, opts ...grpc.CallOption) (*ProcessPaymentResponse, error)
 	GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error)
 	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
 	ListOrderPayments(ctx context.Context, in *ListOrderPaymentsRequest, opts ...grpc.CallOption) (*ListOrderPaymentsResponse, error)
 	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error)
+	ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error)
 	VerifyPayment(ctx context.Context, in *VerifyPaymentRequest, opts ...grpc.CallOption) (*VerifyPaymentResponse, error)
 	ValidatePayment(ctx context.Context, in *ValidatePaymentRequest, opts ...grpc.CallOption) (*ValidatePaymentResponse, error)
 	GetPaymentStats(ctx context.Context, in *GetPaymentStatsRequest, opts ...grpc.CallOption) (*GetPaymentStatsResponse, error)
+	AuthorizePayment(ctx context.Context, in *AuthorizePaymentRequest, opts ...grpc.CallOption) (*AuthorizePaymentResponse, error)
+	CapturePayment(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*CapturePaymentResponse, error)
+	VoidPayment(ctx context.Context, in *VoidPaymentRequest, opts ...grpc.CallOption) (*VoidPaymentResponse, error)
+	RecordStoreCreditMovement(ctx context.Context, in *RecordStoreCreditMovementRequest, opts ...grpc.CallOption) (*RecordStoreCreditMovementResponse, error)
+	GetShopBalance(ctx context.Context, in *GetShopBalanceRequest, opts ...grpc.CallOption) (*GetShopBalanceResponse, error)
+	ReconcilePayments(ctx context.Context, in *ReconcilePaymentsRequest, opts ...grpc.CallOption) (*ReconcilePaymentsResponse, error)
+	HandleWebhookEvent(ctx context.Context, in *HandleWebhookEventRequest, opts ...grpc.CallOption) (*HandleWebhookEventResponse, error)
+	OpenCashShift(ctx context.Context, in *OpenCashShiftRequest, opts ...grpc.CallOption) (*CashShift, error)
+	RecordCashMovement(ctx context.Context, in *RecordCashMovementRequest, opts ...grpc.CallOption) (*CashMovement, error)
+	CloseCashShift(ctx context.Context, in *CloseCashShiftRequest, opts ...grpc.CallOption) (*CashShiftReport, error)
+	GetCashShiftReport(ctx context.Context, in *GetCashShiftReportRequest, opts ...grpc.CallOption) (*CashShiftReport, error)
+	ListCashShifts(ctx context.Context, in *ListCashShiftsRequest, opts ...grpc.CallOption) (*ListCashShiftsResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -51,6 +79,16 @@ func NewPaymentServiceClient(cc grpc.ClientConnInterface) PaymentServiceClient {
 	return &paymentServiceClient{cc}
 }
 
+func (c *paymentServiceClient) TokenizeCard(ctx context.Context, in *TokenizeCardRequest, opts ...grpc.CallOption) (*TokenizeCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenizeCardResponse)
+	err := c.cc.Invoke(ctx, PaymentService_TokenizeCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) ProcessPayment(ctx context.Context, in *ProcessPaymentRequest, opts ...grpc.CallOption) (*ProcessPaymentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProcessPaymentResponse)
@@ -101,6 +139,16 @@ func (c *paymentServiceClient) RefundPayment(ctx context.Context, in *RefundPaym
 	return out, nil
 }
 
+func (c *paymentServiceClient) ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRefundsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) VerifyPayment(ctx context.Context, in *VerifyPaymentRequest, opts ...grpc.CallOption) (*VerifyPaymentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VerifyPaymentResponse)
@@ -131,18 +179,152 @@ func (c *paymentServiceClient) GetPaymentStats(ctx context.Context, in *GetPayme
 	return out, nil
 }
 
+func (c *paymentServiceClient) AuthorizePayment(ctx context.Context, in *AuthorizePaymentRequest, opts ...grpc.CallOption) (*AuthorizePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizePaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_AuthorizePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CapturePayment(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*CapturePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CapturePaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CapturePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) VoidPayment(ctx context.Context, in *VoidPaymentRequest, opts ...grpc.CallOption) (*VoidPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidPaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_VoidPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RecordStoreCreditMovement(ctx context.Context, in *RecordStoreCreditMovementRequest, opts ...grpc.CallOption) (*RecordStoreCreditMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordStoreCreditMovementResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RecordStoreCreditMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetShopBalance(ctx context.Context, in *GetShopBalanceRequest, opts ...grpc.CallOption) (*GetShopBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShopBalanceResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetShopBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ReconcilePayments(ctx context.Context, in *ReconcilePaymentsRequest, opts ...grpc.CallOption) (*ReconcilePaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcilePaymentsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ReconcilePayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) HandleWebhookEvent(ctx context.Context, in *HandleWebhookEventRequest, opts ...grpc.CallOption) (*HandleWebhookEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandleWebhookEventResponse)
+	err := c.cc.Invoke(ctx, PaymentService_HandleWebhookEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) OpenCashShift(ctx context.Context, in *OpenCashShiftRequest, opts ...grpc.CallOption) (*CashShift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashShift)
+	err := c.cc.Invoke(ctx, PaymentService_OpenCashShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RecordCashMovement(ctx context.Context, in *RecordCashMovementRequest, opts ...grpc.CallOption) (*CashMovement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashMovement)
+	err := c.cc.Invoke(ctx, PaymentService_RecordCashMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CloseCashShift(ctx context.Context, in *CloseCashShiftRequest, opts ...grpc.CallOption) (*CashShiftReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashShiftReport)
+	err := c.cc.Invoke(ctx, PaymentService_CloseCashShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetCashShiftReport(ctx context.Context, in *GetCashShiftReportRequest, opts ...grpc.CallOption) (*CashShiftReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashShiftReport)
+	err := c.cc.Invoke(ctx, PaymentService_GetCashShiftReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListCashShifts(ctx context.Context, in *ListCashShiftsRequest, opts ...grpc.CallOption) (*ListCashShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCashShiftsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListCashShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
 type PaymentServiceServer interface {
+	TokenizeCard(context.Context, *TokenizeCardRequest) (*TokenizeCardResponse, error)
 	ProcessPayment(context.Context, *ProcessPaymentRequest) (*ProcessPaymentResponse, error)
 	GetPayment(context.Context, *GetPaymentRequest) (*GetPaymentResponse, error)
 	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
 	ListOrderPayments(context.Context, *ListOrderPaymentsRequest) (*ListOrderPaymentsResponse, error)
 	RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error)
+	ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error)
 	VerifyPayment(context.Context, *VerifyPaymentRequest) (*VerifyPaymentResponse, error)
 	ValidatePayment(context.Context, *ValidatePaymentRequest) (*ValidatePaymentResponse, error)
 	GetPaymentStats(context.Context, *GetPaymentStatsRequest) (*GetPaymentStatsResponse, error)
+	AuthorizePayment(context.Context, *AuthorizePaymentRequest) (*AuthorizePaymentResponse, error)
+	CapturePayment(context.Context, *CapturePaymentRequest) (*CapturePaymentResponse, error)
+	VoidPayment(context.Context, *VoidPaymentRequest) (*VoidPaymentResponse, error)
+	RecordStoreCreditMovement(context.Context, *RecordStoreCreditMovementRequest) (*RecordStoreCreditMovementResponse, error)
+	GetShopBalance(context.Context, *GetShopBalanceRequest) (*GetShopBalanceResponse, error)
+	ReconcilePayments(context.Context, *ReconcilePaymentsRequest) (*ReconcilePaymentsResponse, error)
+	HandleWebhookEvent(context.Context, *HandleWebhookEventRequest) (*HandleWebhookEventResponse, error)
+	OpenCashShift(context.Context, *OpenCashShiftRequest) (*CashShift, error)
+	RecordCashMovement(context.Context, *RecordCashMovementRequest) (*CashMovement, error)
+	CloseCashShift(context.Context, *CloseCashShiftRequest) (*CashShiftReport, error)
+	GetCashShiftReport(context.Context, *GetCashShiftReportRequest) (*CashShiftReport, error)
+	ListCashShifts(context.Context, *ListCashShiftsRequest) (*ListCashShiftsResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -153,6 +335,9 @@ type PaymentServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedPaymentServiceServer struct{}
 
+func (UnimplementedPaymentServiceServer) TokenizeCard(context.Context, *TokenizeCardRequest) (*TokenizeCardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TokenizeCard not implemented")
+}
 func (UnimplementedPaymentServiceServer) ProcessPayment(context.Context, *ProcessPaymentRequest) (*ProcessPaymentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ProcessPayment not implemented")
 }
@@ -168,6 +353,9 @@ func (UnimplementedPaymentServiceServer) ListOrderPayments(context.Context, *Lis
 func (UnimplementedPaymentServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RefundPayment not implemented")
 }
+func (UnimplementedPaymentServiceServer) ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRefunds not implemented")
+}
 func (UnimplementedPaymentServiceServer) VerifyPayment(context.Context, *VerifyPaymentRequest) (*VerifyPaymentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method VerifyPayment not implemented")
 }
@@ -177,6 +365,42 @@ func (UnimplementedPaymentServiceServer) ValidatePayment(context.Context, *Valid
 func (UnimplementedPaymentServiceServer) GetPaymentStats(context.Context, *GetPaymentStatsRequest) (*GetPaymentStatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPaymentStats not implemented")
 }
+func (UnimplementedPaymentServiceServer) AuthorizePayment(context.Context, *AuthorizePaymentRequest) (*AuthorizePaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuthorizePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) CapturePayment(context.Context, *CapturePaymentRequest) (*CapturePaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CapturePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) VoidPayment(context.Context, *VoidPaymentRequest) (*VoidPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VoidPayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) RecordStoreCreditMovement(context.Context, *RecordStoreCreditMovementRequest) (*RecordStoreCreditMovementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordStoreCreditMovement not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetShopBalance(context.Context, *GetShopBalanceRequest) (*GetShopBalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetShopBalance not implemented")
+}
+func (UnimplementedPaymentServiceServer) ReconcilePayments(context.Context, *ReconcilePaymentsRequest) (*ReconcilePaymentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReconcilePayments not implemented")
+}
+func (UnimplementedPaymentServiceServer) HandleWebhookEvent(context.Context, *HandleWebhookEventRequest) (*HandleWebhookEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HandleWebhookEvent not implemented")
+}
+func (UnimplementedPaymentServiceServer) OpenCashShift(context.Context, *OpenCashShiftRequest) (*CashShift, error) {
+	return nil, status.Error(codes.Unimplemented, "method OpenCashShift not implemented")
+}
+func (UnimplementedPaymentServiceServer) RecordCashMovement(context.Context, *RecordCashMovementRequest) (*CashMovement, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordCashMovement not implemented")
+}
+func (UnimplementedPaymentServiceServer) CloseCashShift(context.Context, *CloseCashShiftRequest) (*CashShiftReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseCashShift not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetCashShiftReport(context.Context, *GetCashShiftReportRequest) (*CashShiftReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCashShiftReport not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListCashShifts(context.Context, *ListCashShiftsRequest) (*ListCashShiftsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCashShifts not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -198,6 +422,24 @@ func RegisterPaymentServiceServer(s grpc.ServiceRegistrar, srv PaymentServiceSer
 	s.RegisterService(&PaymentService_ServiceDesc, srv)
 }
 
+func _PaymentService_TokenizeCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenizeCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).TokenizeCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_TokenizeCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).TokenizeCard(ctx, req.(*TokenizeCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_ProcessPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProcessPaymentRequest)
 	if err := dec(in); err != nil {
@@ -288,6 +530,24 @@ func _PaymentService_RefundPayment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_ListRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListRefunds(ctx, req.(*ListRefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_VerifyPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyPaymentRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +602,222 @@ func _PaymentService_GetPaymentStats_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_AuthorizePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).AuthorizePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_AuthorizePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).AuthorizePayment(ctx, req.(*AuthorizePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CapturePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapturePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CapturePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CapturePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CapturePayment(ctx, req.(*CapturePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_VoidPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).VoidPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_VoidPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).VoidPayment(ctx, req.(*VoidPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RecordStoreCreditMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordStoreCreditMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RecordStoreCreditMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RecordStoreCreditMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RecordStoreCreditMovement(ctx, req.(*RecordStoreCreditMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetShopBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShopBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetShopBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetShopBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetShopBalance(ctx, req.(*GetShopBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ReconcilePayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcilePaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ReconcilePayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ReconcilePayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ReconcilePayments(ctx, req.(*ReconcilePaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_HandleWebhookEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleWebhookEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).HandleWebhookEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_HandleWebhookEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).HandleWebhookEvent(ctx, req.(*HandleWebhookEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_OpenCashShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenCashShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).OpenCashShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_OpenCashShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).OpenCashShift(ctx, req.(*OpenCashShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RecordCashMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordCashMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RecordCashMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RecordCashMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RecordCashMovement(ctx, req.(*RecordCashMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CloseCashShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseCashShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CloseCashShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CloseCashShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CloseCashShift(ctx, req.(*CloseCashShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetCashShiftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCashShiftReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetCashShiftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetCashShiftReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetCashShiftReport(ctx, req.(*GetCashShiftReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListCashShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCashShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListCashShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListCashShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListCashShifts(ctx, req.(*ListCashShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -349,6 +825,10 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "payment.PaymentService",
 	HandlerType: (*PaymentServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TokenizeCard",
+			Handler:    _PaymentService_TokenizeCard_Handler,
+		},
 		{
 			MethodName: "ProcessPayment",
 			Handler:    _PaymentService_ProcessPayment_Handler,
@@ -369,6 +849,10 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefundPayment",
 			Handler:    _PaymentService_RefundPayment_Handler,
 		},
+		{
+			MethodName: "ListRefunds",
+			Handler:    _PaymentService_ListRefunds_Handler,
+		},
 		{
 			MethodName: "VerifyPayment",
 			Handler:    _PaymentService_VerifyPayment_Handler,
@@ -381,6 +865,54 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPaymentStats",
 			Handler:    _PaymentService_GetPaymentStats_Handler,
 		},
+		{
+			MethodName: "AuthorizePayment",
+			Handler:    _PaymentService_AuthorizePayment_Handler,
+		},
+		{
+			MethodName: "CapturePayment",
+			Handler:    _PaymentService_CapturePayment_Handler,
+		},
+		{
+			MethodName: "VoidPayment",
+			Handler:    _PaymentService_VoidPayment_Handler,
+		},
+		{
+			MethodName: "RecordStoreCreditMovement",
+			Handler:    _PaymentService_RecordStoreCreditMovement_Handler,
+		},
+		{
+			MethodName: "GetShopBalance",
+			Handler:    _PaymentService_GetShopBalance_Handler,
+		},
+		{
+			MethodName: "ReconcilePayments",
+			Handler:    _PaymentService_ReconcilePayments_Handler,
+		},
+		{
+			MethodName: "HandleWebhookEvent",
+			Handler:    _PaymentService_HandleWebhookEvent_Handler,
+		},
+		{
+			MethodName: "OpenCashShift",
+			Handler:    _PaymentService_OpenCashShift_Handler,
+		},
+		{
+			MethodName: "RecordCashMovement",
+			Handler:    _PaymentService_RecordCashMovement_Handler,
+		},
+		{
+			MethodName: "CloseCashShift",
+			Handler:    _PaymentService_CloseCashShift_Handler,
+		},
+		{
+			MethodName: "GetCashShiftReport",
+			Handler:    _PaymentService_GetCashShiftReport_Handler,
+		},
+		{
+			MethodName: "ListCashShifts",
+			Handler:    _PaymentService_ListCashShifts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment/payment.proto",
@@ -1,16 +1,23 @@
 package main
 
 import (
+	"context"
 	"hpkg/db"
 	"hpkg/grpc/interceptor"
 	"log"
 	"log/slog"
 	"net"
 	"os"
+	"time"
 
 	"productservice/internal/repository"
+	catalog "productservice/internal/server"
 	service "productservice/internal/service"
 	productpb "productservice/proto/productpb"
+	"productservice/proto/v1/categorypb"
+	productv1 "productservice/proto/v1/productpb"
+	"productservice/proto/v1/tagpb"
+	"productservice/proto/v1/variantpb"
 
 	"google.golang.org/grpc"
 )
@@ -29,8 +36,18 @@ func main() {
 	repo := *repository.NewPostgresProductRepository(db, logger)
 	productServer := service.NewProductService(repo)
 
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.UserUnaryServerInterceptor(logger), interceptor.ShopUnaryServerInterceptor(logger), interceptor.ErrorUnaryInterceptor()))
+	// return stock held by orders that were never paid for
+	go productServer.ExpireReservations(context.Background(), time.Minute)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptor.UserUnaryServerInterceptor(logger), interceptor.ShopUnaryServerInterceptor(logger), interceptor.ErrorUnaryInterceptor()),
+		grpc.ChainStreamInterceptor(interceptor.UserStreamServerInterceptor(logger), interceptor.ShopStreamServerInterceptor(logger)),
+	)
 	productpb.RegisterProductServiceServer(grpcServer, productServer)
+	productv1.RegisterProductServiceServer(grpcServer, service.NewProductServiceV1(repo))
+	categorypb.RegisterCategoryServiceServer(grpcServer, catalog.NewCategoryService(*repository.NewPostgresCategoryRepository(db, logger)))
+	tagpb.RegisterTagServiceServer(grpcServer, catalog.NewTagService(*repository.NewPostgresTagRepository(db, logger)))
+	variantpb.RegisterVariantServiceServer(grpcServer, catalog.NewVariantService(*repository.NewPostgresVariantRepository(db, logger)))
 
 	log.Println("Product service listening on :50051")
 	if err := grpcServer.Serve(lis); err != nil {
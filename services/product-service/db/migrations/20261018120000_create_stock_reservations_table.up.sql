@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS stock_reservations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    shop_id UUID NOT NULL REFERENCES shops(id) ON DELETE CASCADE,
+    order_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    release_reason TEXT,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_shop_order_reservation UNIQUE(shop_id, order_id)
+);
+
+CREATE TABLE IF NOT EXISTS stock_reservation_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reservation_id UUID NOT NULL REFERENCES stock_reservations(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    variant_id UUID REFERENCES product_variants(id) ON DELETE CASCADE,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    -- false when the product does not track inventory, so nothing is put back
+    stock_deducted BOOLEAN NOT NULL DEFAULT true
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_reservations_expiry ON stock_reservations(status, expires_at);
+CREATE INDEX IF NOT EXISTS idx_stock_reservation_items_reservation_id ON stock_reservation_items(reservation_id);
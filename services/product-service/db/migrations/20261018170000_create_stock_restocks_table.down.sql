@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS stock_restock_items;
+DROP TABLE IF EXISTS stock_restocks;
@@ -0,0 +1,20 @@
+-- Stock put back outside a reservation, e.g. returned items. reference_id is
+-- the caller's ID for the restock and makes it idempotent.
+CREATE TABLE IF NOT EXISTS stock_restocks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    shop_id UUID NOT NULL REFERENCES shops(id) ON DELETE CASCADE,
+    reference_id VARCHAR(100) NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_shop_restock_reference UNIQUE(shop_id, reference_id)
+);
+
+CREATE TABLE IF NOT EXISTS stock_restock_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restock_id UUID NOT NULL REFERENCES stock_restocks(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    variant_id UUID REFERENCES product_variants(id) ON DELETE CASCADE,
+    quantity INT NOT NULL CHECK (quantity > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_restock_items_restock_id ON stock_restock_items(restock_id);
@@ -0,0 +1,4 @@
+-- Prices are in the product's own currency; the order service converts them
+-- with the shop's exchange rates when an order is in another currency.
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'USD';
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS unique_shop_category_slug;
+
+DROP INDEX IF EXISTS unique_shop_tag_slug;
+ALTER TABLE tags ADD CONSTRAINT unique_shop_tag UNIQUE(shop_id, slug);
+
+ALTER TABLE tags
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS updated_at;
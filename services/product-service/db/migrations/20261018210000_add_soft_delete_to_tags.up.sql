@@ -0,0 +1,9 @@
+ALTER TABLE tags
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+-- A deleted tag or category no longer holds on to its slug.
+ALTER TABLE tags DROP CONSTRAINT IF EXISTS unique_shop_tag;
+CREATE UNIQUE INDEX IF NOT EXISTS unique_shop_tag_slug ON tags(shop_id, slug) WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_shop_category_slug ON categories(shop_id, slug) WHERE deleted_at IS NULL;
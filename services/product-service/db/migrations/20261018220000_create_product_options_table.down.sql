@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS unique_variant_attributes;
+
+DROP INDEX IF EXISTS idx_product_options_product_id;
+DROP TABLE IF EXISTS product_options CASCADE;
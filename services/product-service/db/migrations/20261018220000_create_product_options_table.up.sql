@@ -0,0 +1,20 @@
+-- The options a product varies by, e.g. Size: S, M, L. Variant attributes
+-- map option names to one of their values.
+CREATE TABLE IF NOT EXISTS product_options (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    "values" TEXT[] NOT NULL DEFAULT '{}',
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_product_option_name UNIQUE(product_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_options_product_id ON product_options(product_id);
+
+-- No two variants of a product share an attribute combination. Variants
+-- without attributes are left alone.
+CREATE UNIQUE INDEX IF NOT EXISTS unique_variant_attributes
+    ON product_variants(product_id, attributes)
+    WHERE attributes IS NOT NULL AND attributes <> '{}'::jsonb;
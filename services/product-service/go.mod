@@ -2,13 +2,13 @@ module productservice
 
 go 1.25.5
 
-require google.golang.org/grpc v1.78.0
-
 require (
-	github.com/golang-migrate/migrate/v4 v4.19.1 // indirect
-	github.com/lib/pq v1.11.1 // indirect
+	github.com/lib/pq v1.11.1
+	google.golang.org/grpc v1.78.0
 )
 
+require github.com/golang-migrate/migrate/v4 v4.19.1 // indirect
+
 require (
 	golang.org/x/net v0.47.0 // indirect
 	golang.org/x/sys v0.38.0 // indirect
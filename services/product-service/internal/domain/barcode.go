@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrBarcodeCheckDigit = errors.New("barcode check digit does not match")
+
+const (
+	SymbologyEAN13 = "ean13"
+	SymbologyUPCA  = "upca"
+)
+
+// ScannedCode is a code read at the till, with every form of it that may be
+// stored on a product or variant.
+type ScannedCode struct {
+	Code      string
+	Symbology string
+	// CheckDigitOK is false for an EAN-13 or UPC-A whose check digit is
+	// wrong, which usually means a misread.
+	CheckDigitOK bool
+	// Candidates are matched in order; the exact code comes first.
+	Candidates []string
+	// Embedded holds the candidates that identify a price-embedded code by
+	// its item number, and EmbeddedAmount the price in minor units.
+	Embedded       map[string]bool
+	EmbeddedAmount int64
+}
+
+// CodeMatch is the product, or the variant and its product, a code belongs
+// to.
+type CodeMatch struct {
+	Product   *CatalogProduct
+	Variant   *ProductVariant
+	MatchedBy string
+	Code      string
+}
+
+// ParseScannedCode works out what to look a code up by. A UPC-A also
+// matches as the EAN-13 with a leading zero and vice versa. Codes in the
+// ranges kept for in-store use with a price in them (EAN-13 20-29, UPC-A
+// number system 2), laid out as prefix, 5 digit item number, 5 digit price
+// and check digit, also match on the item number.
+func ParseScannedCode(code string) ScannedCode {
+	code = strings.TrimSpace(code)
+	s := ScannedCode{
+		Code:         code,
+		CheckDigitOK: true,
+		Candidates:   []string{code},
+	}
+	if !isDigits(code) {
+		return s
+	}
+
+	var ean string
+	switch len(code) {
+	case 12:
+		s.Symbology = SymbologyUPCA
+		ean = "0" + code
+		s.Candidates = append(s.Candidates, ean)
+	case 13:
+		s.Symbology = SymbologyEAN13
+		ean = code
+		if code[0] == '0' {
+			s.Candidates = append(s.Candidates, code[1:])
+		}
+	default:
+		return s
+	}
+
+	if !ValidCheckDigit(ean) {
+		s.CheckDigitOK = false
+		return s
+	}
+
+	prefix := ""
+	switch {
+	case ean[0] == '2':
+		prefix = ean[:2]
+	case ean[:2] == "02":
+		prefix = "2"
+	default:
+		return s
+	}
+
+	item := ean[2:7]
+	amount, _ := strconv.ParseInt(ean[7:12], 10, 64)
+	zeroed := ean[:7] + "00000"
+	zeroed += strconv.Itoa(checkDigit(zeroed))
+	if s.Symbology == SymbologyUPCA {
+		zeroed = zeroed[1:]
+	}
+
+	s.Embedded = make(map[string]bool)
+	for _, c := range []string{zeroed, prefix + item, item} {
+		s.Candidates = append(s.Candidates, c)
+		s.Embedded[c] = true
+	}
+	s.EmbeddedAmount = amount
+	return s
+}
+
+// ValidCheckDigit reports whether the last digit of a GTIN (EAN-8, UPC-A,
+// EAN-13 ...) is the check digit of the others.
+func ValidCheckDigit(gtin string) bool {
+	if len(gtin) < 2 || !isDigits(gtin) {
+		return false
+	}
+	last := len(gtin) - 1
+	return int(gtin[last]-'0') == checkDigit(gtin[:last])
+}
+
+// checkDigit computes the GS1 check digit of digits: weights 3 and 1
+// alternate from the rightmost digit.
+func checkDigit(digits string) int {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10 - sum%10) % 10
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
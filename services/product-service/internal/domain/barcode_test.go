@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidCheckDigit(t *testing.T) {
+	tests := []struct {
+		gtin string
+		want bool
+	}{
+		{"4006381333931", true},  // EAN-13
+		{"036000291452", true},   // UPC-A
+		{"96385074", true},       // EAN-8
+		{"4006381333932", false}, // wrong check digit
+		{"036000291453", false},
+		{"40063813339a1", false},
+		{"4", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := ValidCheckDigit(tt.gtin); got != tt.want {
+			t.Errorf("ValidCheckDigit(%q) = %v, want %v", tt.gtin, got, tt.want)
+		}
+	}
+}
+
+func TestParseScannedCode(t *testing.T) {
+	tests := []struct {
+		name           string
+		code           string
+		symbology      string
+		checkDigitOK   bool
+		candidates     []string
+		embedded       []string
+		embeddedAmount int64
+	}{
+		{
+			name:         "EAN-13",
+			code:         "4006381333931",
+			symbology:    SymbologyEAN13,
+			checkDigitOK: true,
+			candidates:   []string{"4006381333931"},
+		},
+		{
+			name:         "UPC-A also matches as EAN-13",
+			code:         "036000291452",
+			symbology:    SymbologyUPCA,
+			checkDigitOK: true,
+			candidates:   []string{"036000291452", "0036000291452"},
+		},
+		{
+			name:         "EAN-13 with a leading zero also matches as UPC-A",
+			code:         "0036000291452",
+			symbology:    SymbologyEAN13,
+			checkDigitOK: true,
+			candidates:   []string{"0036000291452", "036000291452"},
+		},
+		{
+			name:         "surrounding space is ignored",
+			code:         " 4006381333931 ",
+			symbology:    SymbologyEAN13,
+			checkDigitOK: true,
+			candidates:   []string{"4006381333931"},
+		},
+		{
+			name:         "wrong check digit",
+			code:         "4006381333932",
+			symbology:    SymbologyEAN13,
+			checkDigitOK: false,
+			candidates:   []string{"4006381333932"},
+		},
+		{
+			name:         "not a GTIN",
+			code:         "SKU-0042",
+			checkDigitOK: true,
+			candidates:   []string{"SKU-0042"},
+		},
+		{
+			name:           "price-embedded EAN-13",
+			code:           "2112345012995",
+			symbology:      SymbologyEAN13,
+			checkDigitOK:   true,
+			candidates:     []string{"2112345012995", "2112345000008", "2112345", "12345"},
+			embedded:       []string{"2112345000008", "2112345", "12345"},
+			embeddedAmount: 1299,
+		},
+		{
+			name:           "price-embedded UPC-A",
+			code:           "212345012994",
+			symbology:      SymbologyUPCA,
+			checkDigitOK:   true,
+			candidates:     []string{"212345012994", "0212345012994", "212345000007", "212345", "12345"},
+			embedded:       []string{"212345000007", "212345", "12345"},
+			embeddedAmount: 1299,
+		},
+		{
+			name:         "price-embedded range with a wrong check digit",
+			code:         "2112345012996",
+			symbology:    SymbologyEAN13,
+			checkDigitOK: false,
+			candidates:   []string{"2112345012996"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseScannedCode(tt.code)
+			if got.Symbology != tt.symbology {
+				t.Errorf("Symbology = %q, want %q", got.Symbology, tt.symbology)
+			}
+			if got.CheckDigitOK != tt.checkDigitOK {
+				t.Errorf("CheckDigitOK = %v, want %v", got.CheckDigitOK, tt.checkDigitOK)
+			}
+			if !reflect.DeepEqual(got.Candidates, tt.candidates) {
+				t.Errorf("Candidates = %v, want %v", got.Candidates, tt.candidates)
+			}
+			if len(got.Embedded) != len(tt.embedded) {
+				t.Errorf("Embedded = %v, want %v", got.Embedded, tt.embedded)
+			}
+			for _, c := range tt.embedded {
+				if !got.Embedded[c] {
+					t.Errorf("Embedded lacks %q", c)
+				}
+			}
+			if got.EmbeddedAmount != tt.embeddedAmount {
+				t.Errorf("EmbeddedAmount = %d, want %d", got.EmbeddedAmount, tt.embeddedAmount)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var (
+	ErrCategoryNotFound = errors.New("category not found in shop")
+	ErrProductConflict  = errors.New("sku or barcode already used in shop")
+)
+
+// CatalogProduct is a full row of the products table, as served by the v1
+// catalog API.
+type CatalogProduct struct {
+	ID             string          `db:"id"`
+	ShopID         string          `db:"shop_id"`
+	CategoryID     *string         `db:"category_id"`
+	SKU            *string         `db:"sku"`
+	Barcode        *string         `db:"barcode"`
+	Name           string          `db:"name"`
+	Description    *string         `db:"description"`
+	Detail         *string         `db:"detail"`
+	Price          float64         `db:"price"`
+	CostPrice      float64         `db:"cost_price"`
+	CompareAtPrice *float64        `db:"compare_at_price"`
+	Currency       string          `db:"currency"`
+	StockQuantity  int32           `db:"stock_quantity"`
+	MinStockLevel  int32           `db:"min_stock_level"`
+	MaxStockLevel  *int32          `db:"max_stock_level"`
+	Unit           string          `db:"unit"`
+	Weight         *float64        `db:"weight"`
+	WeightUnit     string          `db:"weight_unit"`
+	ThumbnailIndex int32           `db:"thumbnail_index"`
+	IsActive       bool            `db:"is_active"`
+	IsTaxable      bool            `db:"is_taxable"`
+	TaxRate        float64         `db:"tax_rate"`
+	TrackInventory bool            `db:"track_inventory"`
+	AllowBackorder bool            `db:"allow_backorder"`
+	IsFeatured     bool            `db:"is_featured"`
+	SortOrder      int32           `db:"sort_order"`
+	Metadata       json.RawMessage `db:"metadata"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+	DeletedAt      *time.Time      `db:"deleted_at"`
+}
+
+// CatalogProductDetail is a product with whichever of its relations were
+// asked for.
+type CatalogProductDetail struct {
+	Product  *CatalogProduct
+	Category *CategoryInfo
+	Media    []*ProductMedia
+	Variants []*ProductVariant
+	Tags     []*Tag
+	Shop     *ShopInfo
+}
+
+type CategoryInfo struct {
+	ID       string  `db:"id"`
+	Name     string  `db:"name"`
+	Slug     string  `db:"slug"`
+	ParentID *string `db:"parent_id"`
+	Parent   *CategoryInfo
+}
+
+type ShopInfo struct {
+	ID      string  `db:"id"`
+	Name    string  `db:"name"`
+	LogoURL *string `db:"logo"`
+}
+
+type ProductMedia struct {
+	ID           string    `db:"id"`
+	ProductID    string    `db:"product_id"`
+	MediaURL     string    `db:"media_url"`
+	MediaType    string    `db:"media_type"`
+	DisplayOrder int32     `db:"display_order"`
+	IsThumbnail  bool      `db:"is_thumbnail"`
+	AltText      *string   `db:"alt_text"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+type ProductVariant struct {
+	ID             string          `db:"id"`
+	ProductID      string          `db:"product_id"`
+	SKU            *string         `db:"sku"`
+	Barcode        *string         `db:"barcode"`
+	Name           string          `db:"name"`
+	Price          *float64        `db:"price"`
+	CostPrice      *float64        `db:"cost_price"`
+	CompareAtPrice *float64        `db:"compare_at_price"`
+	StockQuantity  int32           `db:"stock_quantity"`
+	Weight         *float64        `db:"weight"`
+	Attributes     json.RawMessage `db:"attributes"`
+	IsActive       bool            `db:"is_active"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+}
+
+// DetailIncludes picks the relations loaded with a product.
+type DetailIncludes struct {
+	Category       bool
+	CategoryParent bool
+	Media          bool
+	Variants       bool
+	Tags           bool
+	Shop           bool
+}
+
+type CreateCatalogProductRequest struct {
+	ShopID         string
+	CategoryID     *string
+	SKU            *string
+	Barcode        *string
+	Name           string
+	Description    *string
+	Detail         *string
+	Price          float64
+	CostPrice      float64
+	CompareAtPrice *float64
+	Currency       string
+	StockQuantity  int32
+	MinStockLevel  int32
+	MaxStockLevel  *int32
+	Unit           string
+	Weight         *float64
+	WeightUnit     string
+	IsActive       bool
+	IsTaxable      bool
+	TaxRate        float64
+	TrackInventory bool
+	AllowBackorder bool
+	IsFeatured     bool
+	SortOrder      int32
+	Metadata       json.RawMessage
+	Media          []*ProductMedia
+	TagIDs         []string
+}
+
+// UpdateCatalogProductRequest changes only the fields that are not nil. An
+// empty CategoryID, SKU or Barcode clears it.
+type UpdateCatalogProductRequest struct {
+	ID             string
+	ShopID         string
+	CategoryID     *string
+	SKU            *string
+	Barcode        *string
+	Name           *string
+	Description    *string
+	Detail         *string
+	Price          *float64
+	CostPrice      *float64
+	CompareAtPrice *float64
+	Currency       *string
+	MinStockLevel  *int32
+	MaxStockLevel  *int32
+	Unit           *string
+	Weight         *float64
+	WeightUnit     *string
+	IsActive       *bool
+	IsFeatured     *bool
+	IsTaxable      *bool
+	TaxRate        *float64
+	TrackInventory *bool
+	AllowBackorder *bool
+	SortOrder      *int32
+	Metadata       json.RawMessage
+}
+
+type CatalogProductFilter struct {
+	ShopID       string
+	CategoryID   *string
+	Search       *string
+	IsActive     *bool
+	IsFeatured   *bool
+	TagIDs       []string
+	MinPrice     *float64
+	MaxPrice     *float64
+	LowStockOnly bool
+	SortBy       string
+	SortDesc     bool
+	Page         int
+	PageSize     int
+}
+
+// StockChange adds QuantityChange, which may be negative, to a product's
+// stock.
+type StockChange struct {
+	ProductID      string
+	QuantityChange int32
+	Reason         string
+}
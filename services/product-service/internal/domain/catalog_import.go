@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrImportIncomplete = errors.New("new product needs a name and a price")
+
+// CategoryPathSeparator joins a category to its ancestors in import and
+// export files, e.g. "Drinks > Hot".
+const CategoryPathSeparator = " > "
+
+// ImportFields are the fields an import file can set, in the column order of
+// an export.
+var ImportFields = []string{
+	"sku",
+	"name",
+	"barcode",
+	"description",
+	"price",
+	"cost_price",
+	"compare_at_price",
+	"currency",
+	"stock_quantity",
+	"min_stock_level",
+	"max_stock_level",
+	"unit",
+	"weight",
+	"weight_unit",
+	"is_active",
+	"is_taxable",
+	"tax_rate",
+	"track_inventory",
+	"allow_backorder",
+	"is_featured",
+	"sort_order",
+	"category",
+	"tags",
+}
+
+// ImportProduct is one row of an import, upserted by SKU. Create is used
+// when the shop has no product with the SKU yet and is nil when the row
+// cannot create one; Update, with the row's other columns, otherwise.
+type ImportProduct struct {
+	SKU           string
+	Create        *CreateCatalogProductRequest
+	Update        UpdateCatalogProductRequest
+	StockQuantity *int32
+	// CategoryPath names the category, top level first. Nil leaves the
+	// category alone and an empty path clears it.
+	CategoryPath []string
+	// Tags replace the product's tags. Nil leaves them alone.
+	Tags []string
+}
+
+// ImportResult is what importing a row did. In a dry run nothing was kept.
+type ImportResult struct {
+	ProductID         string
+	Created           bool
+	CreatedCategories []string
+	CreatedTags       []string
+}
+
+// ExportProduct is a product as written to an export file.
+type ExportProduct struct {
+	Product      *CatalogProduct
+	CategoryPath string
+	Tags         []string
+}
+
+// ParseCategoryPath splits "Drinks > Hot" into its names, dropping empty
+// ones.
+func ParseCategoryPath(s string) []string {
+	path := []string{}
+	for _, name := range strings.Split(s, ">") {
+		if name = strings.TrimSpace(name); name != "" {
+			path = append(path, name)
+		}
+	}
+	return path
+}
+
+// ParseTagList splits a comma separated list of tag names, dropping empty
+// ones and repeats.
+func ParseTagList(s string) []string {
+	tags := []string{}
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		slug := Slugify(name)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		tags = append(tags, name)
+	}
+	return tags
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	ErrCategoryConflict = errors.New("category slug already used in shop")
+	ErrCategoryCycle    = errors.New("category cannot be its own ancestor")
+)
+
+type Category struct {
+	ID           string     `db:"id"`
+	ShopID       string     `db:"shop_id"`
+	Name         string     `db:"name"`
+	Slug         string     `db:"slug"`
+	ParentID     *string    `db:"parent_id"`
+	Description  *string    `db:"description"`
+	ImageURL     *string    `db:"image_url"`
+	DisplayOrder int32      `db:"display_order"`
+	IsActive     bool       `db:"is_active"`
+	CreatedAt    time.Time  `db:"created_at"`
+	UpdatedAt    time.Time  `db:"updated_at"`
+	DeletedAt    *time.Time `db:"deleted_at"`
+
+	// Loaded on request.
+	Parent       *Category   `db:"-"`
+	Children     []*Category `db:"-"`
+	ProductCount int32       `db:"product_count"`
+}
+
+// CategoryProductSummary is a product as listed under a category.
+type CategoryProductSummary struct {
+	ID            string  `db:"id"`
+	Name          string  `db:"name"`
+	SKU           string  `db:"sku"`
+	Price         float64 `db:"price"`
+	StockQuantity int32   `db:"stock_quantity"`
+	IsActive      bool    `db:"is_active"`
+	ThumbnailURL  *string `db:"thumbnail_url"`
+}
+
+type CategoryWithProducts struct {
+	Category      *Category
+	Products      []*CategoryProductSummary
+	TotalProducts int32
+}
+
+type CategoryFilter struct {
+	ShopID          string
+	ParentID        *string
+	Search          string
+	IncludeInactive bool
+	IncludeCount    bool
+	Page            int
+	PageSize        int
+}
+
+// UpdateCategoryRequest changes only the fields that are not nil. An empty
+// ParentID moves the category to the top level.
+type UpdateCategoryRequest struct {
+	ID           string
+	ShopID       string
+	Name         *string
+	Slug         *string
+	ParentID     *string
+	Description  *string
+	ImageURL     *string
+	DisplayOrder *int32
+	IsActive     *bool
+}
+
+// Slugify turns a name into a lower-case, hyphen separated slug, e.g.
+// "Hot Drinks & Tea" becomes "hot-drinks-tea".
+func Slugify(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+			continue
+		}
+		hyphen = true
+	}
+	return b.String()
+}
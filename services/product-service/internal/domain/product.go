@@ -9,6 +9,7 @@ type Product struct {
 	Name        string     `db:"name"`
 	Category    string     `db:"category"`
 	Price       float64    `db:"price"`
+	Currency    string     `db:"currency"`
 	Description *string    `db:"description"`
 	Detail      *string    `db:"detail"`
 	CreatedAt   time.Time  `db:"created_at"`
@@ -23,6 +24,7 @@ type CreateProductRequest struct {
 	Description string
 	Category    string
 	Price       float64
+	Currency    string
 	Detail      string
 }
 
@@ -31,6 +33,7 @@ type UpdateProductRequest struct {
 	Name        string
 	Category    string
 	Price       float64
+	Currency    string
 	Description string
 	Detail      string
 }
@@ -42,3 +45,26 @@ type ProductSummary struct {
 	Price    float64 `db:"price"`
 	IsActive bool    `db:"is_active"`
 }
+
+// CatalogItem is the pricing view of a product used by the order service
+type CatalogItem struct {
+	ID         string  `db:"id"`
+	ShopID     string  `db:"shop_id"`
+	Name       string  `db:"name"`
+	Price      float64 `db:"price"`
+	Currency   string  `db:"currency"`
+	TaxRate    float64 `db:"tax_rate"`
+	IsTaxable  bool    `db:"is_taxable"`
+	IsActive   bool    `db:"is_active"`
+	CategoryID string  `db:"category_id"`
+}
+
+// CatalogVariant is the pricing view of a product variant. A nil Price means
+// the variant sells at its product's price.
+type CatalogVariant struct {
+	ID        string   `db:"id"`
+	ProductID string   `db:"product_id"`
+	Name      string   `db:"name"`
+	Price     *float64 `db:"price"`
+	IsActive  bool     `db:"is_active"`
+}
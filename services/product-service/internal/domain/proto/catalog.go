@@ -0,0 +1,146 @@
+package proto
+
+import (
+	"encoding/json"
+
+	"productservice/internal/domain"
+	"productservice/proto/v1/productpb"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MapCatalogProductToProto converts a full products row to the v1 Product.
+func MapCatalogProductToProto(p *domain.CatalogProduct) *productpb.Product {
+	out := &productpb.Product{
+		Id:             p.ID,
+		ShopId:         p.ShopID,
+		CategoryId:     p.CategoryID,
+		Sku:            p.SKU,
+		Barcode:        p.Barcode,
+		Name:           p.Name,
+		Description:    p.Description,
+		Detail:         p.Detail,
+		Price:          p.Price,
+		CostPrice:      p.CostPrice,
+		CompareAtPrice: p.CompareAtPrice,
+		StockQuantity:  p.StockQuantity,
+		MinStockLevel:  p.MinStockLevel,
+		MaxStockLevel:  p.MaxStockLevel,
+		Unit:           p.Unit,
+		Weight:         p.Weight,
+		WeightUnit:     p.WeightUnit,
+		ThumbnailIndex: p.ThumbnailIndex,
+		IsActive:       p.IsActive,
+		IsTaxable:      p.IsTaxable,
+		TaxRate:        p.TaxRate,
+		TrackInventory: p.TrackInventory,
+		AllowBackorder: p.AllowBackorder,
+		IsFeatured:     p.IsFeatured,
+		SortOrder:      p.SortOrder,
+		Metadata:       jsonToStruct(p.Metadata),
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+		UpdatedAt:      timestamppb.New(p.UpdatedAt),
+		Currency:       p.Currency,
+		PriceMoney:     priceMoney(p.Price, p.Currency),
+	}
+	if p.DeletedAt != nil {
+		out.DeletedAt = timestamppb.New(*p.DeletedAt)
+	}
+	return out
+}
+
+// MapCatalogDetailToProto converts a product and its loaded relations.
+func MapCatalogDetailToProto(d *domain.CatalogProductDetail) *productpb.ProductDetail {
+	out := &productpb.ProductDetail{
+		Product:  MapCatalogProductToProto(d.Product),
+		Category: MapCategoryInfoToProto(d.Category),
+		Media:    make([]*productpb.Media, 0, len(d.Media)),
+		Variants: make([]*productpb.Variant, 0, len(d.Variants)),
+		Tags:     make([]*productpb.Tag, 0, len(d.Tags)),
+	}
+	for _, m := range d.Media {
+		out.Media = append(out.Media, MapMediaToProto(m))
+	}
+	for _, v := range d.Variants {
+		out.Variants = append(out.Variants, MapVariantToProto(v))
+	}
+	for _, t := range d.Tags {
+		out.Tags = append(out.Tags, MapTagToProto(t))
+	}
+	if d.Shop != nil {
+		out.Shop = &productpb.ShopInfo{
+			Id:      d.Shop.ID,
+			Name:    d.Shop.Name,
+			LogoUrl: d.Shop.LogoURL,
+		}
+	}
+	return out
+}
+
+func MapCategoryInfoToProto(c *domain.CategoryInfo) *productpb.CategoryInfo {
+	if c == nil {
+		return nil
+	}
+	return &productpb.CategoryInfo{
+		Id:       c.ID,
+		Name:     c.Name,
+		Slug:     c.Slug,
+		ParentId: c.ParentID,
+		Parent:   MapCategoryInfoToProto(c.Parent),
+	}
+}
+
+func MapMediaToProto(m *domain.ProductMedia) *productpb.Media {
+	return &productpb.Media{
+		Id:           m.ID,
+		ProductId:    m.ProductID,
+		MediaUrl:     m.MediaURL,
+		MediaType:    m.MediaType,
+		DisplayOrder: m.DisplayOrder,
+		IsThumbnail:  m.IsThumbnail,
+		AltText:      m.AltText,
+		CreatedAt:    timestamppb.New(m.CreatedAt),
+	}
+}
+
+func MapVariantToProto(v *domain.ProductVariant) *productpb.Variant {
+	return &productpb.Variant{
+		Id:             v.ID,
+		ProductId:      v.ProductID,
+		Sku:            v.SKU,
+		Barcode:        v.Barcode,
+		Name:           v.Name,
+		Price:          v.Price,
+		CostPrice:      v.CostPrice,
+		CompareAtPrice: v.CompareAtPrice,
+		StockQuantity:  v.StockQuantity,
+		Weight:         v.Weight,
+		Attributes:     jsonToStruct(v.Attributes),
+		IsActive:       v.IsActive,
+		CreatedAt:      timestamppb.New(v.CreatedAt),
+		UpdatedAt:      timestamppb.New(v.UpdatedAt),
+	}
+}
+
+// StructToJSON encodes a Struct for a jsonb column, or returns nil when s is
+// not set.
+func StructToJSON(s *structpb.Struct) ([]byte, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return s.MarshalJSON()
+}
+
+// jsonToStruct decodes a jsonb object, leaving out anything that is not one.
+func jsonToStruct(b []byte) *structpb.Struct {
+	var m map[string]any
+	if len(b) == 0 || json.Unmarshal(b, &m) != nil {
+		return &structpb.Struct{}
+	}
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+		return &structpb.Struct{}
+	}
+	return s
+}
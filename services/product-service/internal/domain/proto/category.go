@@ -0,0 +1,78 @@
+package proto
+
+import (
+	"productservice/internal/domain"
+	"productservice/proto/v1/categorypb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MapCategoryToProto converts a domain.Category, with whichever of its
+// parent and children were loaded, to categorypb.Category
+func MapCategoryToProto(c *domain.Category) *categorypb.Category {
+	if c == nil {
+		return nil
+	}
+	out := &categorypb.Category{
+		Id:           c.ID,
+		ShopId:       c.ShopID,
+		Name:         c.Name,
+		Slug:         c.Slug,
+		ParentId:     c.ParentID,
+		Description:  c.Description,
+		ImageUrl:     c.ImageURL,
+		DisplayOrder: c.DisplayOrder,
+		IsActive:     c.IsActive,
+		CreatedAt:    timestamppb.New(c.CreatedAt),
+		UpdatedAt:    timestamppb.New(c.UpdatedAt),
+		Parent:       MapCategoryToProto(c.Parent),
+		Children:     make([]*categorypb.Category, 0, len(c.Children)),
+		ProductCount: c.ProductCount,
+	}
+	if c.DeletedAt != nil {
+		out.DeletedAt = timestamppb.New(*c.DeletedAt)
+	}
+	for _, child := range c.Children {
+		out.Children = append(out.Children, MapCategoryToProto(child))
+	}
+	return out
+}
+
+// MapCategoryTreeToProto converts top-level categories, with their
+// subcategories nested in Children, to tree nodes
+func MapCategoryTreeToProto(roots []*domain.Category) []*categorypb.CategoryTreeNode {
+	nodes := make([]*categorypb.CategoryTreeNode, 0, len(roots))
+	for _, c := range roots {
+		// the node carries the children, so the category itself need not
+		category := *c
+		category.Children = nil
+		nodes = append(nodes, &categorypb.CategoryTreeNode{
+			Category: MapCategoryToProto(&category),
+			Children: MapCategoryTreeToProto(c.Children),
+		})
+	}
+	return nodes
+}
+
+// MapCategoryWithProductsToProto converts a category and a page of its
+// products to categorypb.CategoryWithProducts
+func MapCategoryWithProductsToProto(c *domain.CategoryWithProducts) *categorypb.CategoryWithProducts {
+	products := make([]*categorypb.CategoryProductSummary, 0, len(c.Products))
+	for _, p := range c.Products {
+		products = append(products, &categorypb.CategoryProductSummary{
+			Id:            p.ID,
+			Name:          p.Name,
+			Sku:           p.SKU,
+			Price:         p.Price,
+			StockQuantity: p.StockQuantity,
+			IsActive:      p.IsActive,
+			ThumbnailUrl:  p.ThumbnailURL,
+		})
+	}
+
+	return &categorypb.CategoryWithProducts{
+		Category:      MapCategoryToProto(c.Category),
+		Products:      products,
+		TotalProducts: c.TotalProducts,
+	}
+}
@@ -1,6 +1,9 @@
 package proto
 
 import (
+	"hpkg/money"
+	"hpkg/money/moneypb"
+
 	"productservice/internal/domain"
 	"productservice/proto/productpb"
 
@@ -16,6 +19,8 @@ func MapProductToProto(p *domain.Product) *productpb.Product {
 		Name:        p.Name,
 		Category:    p.Category,
 		Price:       p.Price,
+		Currency:    p.Currency,
+		PriceMoney:  priceMoney(p.Price, p.Currency),
 		Description: nullableString(p.Description),
 		Detail:      nullableString(p.Detail),
 		CreatedAt:   timestamppb.New(p.CreatedAt),
@@ -29,3 +34,64 @@ func nullableString(s *string) *wrapperspb.StringValue {
 	}
 	return wrapperspb.String(*s)
 }
+
+// priceMoney returns a price as exact minor units, or nil when its currency
+// is not one money knows.
+func priceMoney(price float64, currency string) *moneypb.Money {
+	m, err := money.FromFloat(price, currency)
+	if err != nil {
+		return nil
+	}
+	return money.ToProto(m)
+}
+
+func MapCatalogItemToProto(c *domain.CatalogItem) *productpb.CatalogItem {
+	return &productpb.CatalogItem{
+		Id:         c.ID,
+		ShopId:     c.ShopID,
+		Name:       c.Name,
+		Price:      c.Price,
+		Currency:   c.Currency,
+		PriceMoney: priceMoney(c.Price, c.Currency),
+		TaxRate:    c.TaxRate,
+		IsTaxable:  c.IsTaxable,
+		IsActive:   c.IsActive,
+		CategoryId: c.CategoryID,
+	}
+}
+
+func MapCatalogVariantToProto(v *domain.CatalogVariant) *productpb.CatalogVariant {
+	return &productpb.CatalogVariant{
+		Id:        v.ID,
+		ProductId: v.ProductID,
+		Name:      v.Name,
+		Price:     v.Price,
+		IsActive:  v.IsActive,
+	}
+}
+
+func MapReservationToProto(r *domain.StockReservation) *productpb.StockReservation {
+	items := make([]*productpb.StockItem, 0, len(r.Items))
+	for _, item := range r.Items {
+		variantID := ""
+		if item.VariantID != nil {
+			variantID = *item.VariantID
+		}
+		items = append(items, &productpb.StockItem{
+			ProductId: item.ProductID,
+			VariantId: variantID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	return &productpb.StockReservation{
+		Id:        r.ID,
+		ShopId:    r.ShopID,
+		OrderId:   r.OrderID,
+		Status:    r.Status,
+		Items:     items,
+		ExpiresAt: timestamppb.New(r.ExpiresAt),
+		CreatedAt: timestamppb.New(r.CreatedAt),
+		UpdatedAt: timestamppb.New(r.UpdatedAt),
+	}
+}
@@ -0,0 +1,35 @@
+package proto
+
+import (
+	"productservice/internal/domain"
+	"productservice/proto/v1/productpb"
+	"productservice/proto/v1/variantpb"
+)
+
+// MapVariantDetailToProto converts a variant, and its product when it was
+// loaded, to variantpb.VariantDetail
+func MapVariantDetailToProto(v *domain.ProductVariant, p *domain.VariantProduct) *variantpb.VariantDetail {
+	detail := &variantpb.VariantDetail{Variant: MapVariantToProto(v)}
+	if p != nil {
+		detail.Product = &productpb.ProductSummary{
+			Id:       p.ID,
+			Name:     p.Name,
+			Sku:      p.SKU,
+			Price:    p.Price,
+			IsActive: p.IsActive,
+		}
+	}
+	return detail
+}
+
+// MapVariantOptionToProto converts a domain.VariantOption to
+// variantpb.VariantOption
+func MapVariantOptionToProto(o *domain.VariantOption) *variantpb.VariantOption {
+	return &variantpb.VariantOption{
+		Id:        o.ID,
+		ProductId: o.ProductID,
+		Name:      o.Name,
+		Values:    o.Values,
+		Position:  o.Position,
+	}
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	ReservationActive    = "active"
+	ReservationCommitted = "committed"
+	ReservationReleased  = "released"
+	ReservationExpired   = "expired"
+)
+
+var (
+	ErrStockProductGone  = errors.New("product not found or inactive")
+	ErrInsufficientStock = errors.New("insufficient stock")
+	ErrReservationClosed = errors.New("reservation already released or expired")
+	ErrReservationLapsed = errors.New("reservation expired")
+)
+
+// StockReservation holds stock for a single order until it is committed,
+// released or expires.
+type StockReservation struct {
+	ID            string                  `db:"id"`
+	ShopID        string                  `db:"shop_id"`
+	OrderID       string                  `db:"order_id"`
+	Status        string                  `db:"status"`
+	ReleaseReason *string                 `db:"release_reason"`
+	Items         []*StockReservationItem `db:"-"`
+	ExpiresAt     time.Time               `db:"expires_at"`
+	CreatedAt     time.Time               `db:"created_at"`
+	UpdatedAt     time.Time               `db:"updated_at"`
+}
+
+type StockReservationItem struct {
+	ProductID     string  `db:"product_id"`
+	VariantID     *string `db:"variant_id"`
+	Quantity      int32   `db:"quantity"`
+	StockDeducted bool    `db:"stock_deducted"`
+}
@@ -1,9 +1,12 @@
 package domain
 
 import (
+	"errors"
 	"time"
 )
 
+var ErrTagConflict = errors.New("tag slug already used in shop")
+
 type Tag struct {
 	ID        string     `db:"id"`
 	ShopID    string     `db:"shop_id"`
@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+var (
+	ErrVariantConflict           = errors.New("variant sku or barcode already used")
+	ErrVariantAttributesConflict = errors.New("variant attribute combination already used by product")
+	ErrVariantAttributesInvalid  = errors.New("variant attributes do not match product options")
+	ErrVariantOptionInvalid      = errors.New("variant option needs a unique name and distinct values")
+)
+
+const (
+	// MaxVariantOptions and MaxVariantOptionValues bound the option matrix,
+	// whose size is the product of the value counts.
+	MaxVariantOptions      = 3
+	MaxVariantOptionValues = 50
+)
+
+// VariantOption is one of the options a product varies by, e.g. Size with
+// values S, M and L.
+type VariantOption struct {
+	ID        string    `db:"id"`
+	ProductID string    `db:"product_id"`
+	Name      string    `db:"name"`
+	Values    []string  `db:"values"`
+	Position  int32     `db:"position"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// VariantProduct is the product a variant belongs to, as shown next to the
+// variant.
+type VariantProduct struct {
+	ID       string  `db:"id"`
+	Name     string  `db:"name"`
+	SKU      *string `db:"sku"`
+	Price    float64 `db:"price"`
+	IsActive bool    `db:"is_active"`
+}
+
+// UpdateVariantRequest changes only the fields that are not nil. An empty
+// SKU or Barcode clears it, as does ClearPrice for the price.
+type UpdateVariantRequest struct {
+	ID             string
+	ShopID         string
+	Name           *string
+	SKU            *string
+	Barcode        *string
+	Price          *float64
+	ClearPrice     bool
+	CostPrice      *float64
+	CompareAtPrice *float64
+	StockQuantity  *int32
+	Weight         *float64
+	IsActive       *bool
+	Attributes     []byte
+}
+
+// NormalizeOptions trims option names and values and checks that every
+// option has a unique name and at least one value, none repeated, and that
+// there are no more than MaxVariantOptions options of MaxVariantOptionValues
+// values each.
+func NormalizeOptions(options []*VariantOption) error {
+	if len(options) > MaxVariantOptions {
+		return ErrVariantOptionInvalid
+	}
+	names := make(map[string]bool, len(options))
+	for i, o := range options {
+		o.Name = strings.TrimSpace(o.Name)
+		key := strings.ToLower(o.Name)
+		if o.Name == "" || names[key] || len(o.Values) == 0 || len(o.Values) > MaxVariantOptionValues {
+			return ErrVariantOptionInvalid
+		}
+		names[key] = true
+
+		values := make(map[string]bool, len(o.Values))
+		for j, v := range o.Values {
+			v = strings.TrimSpace(v)
+			if v == "" || values[strings.ToLower(v)] {
+				return ErrVariantOptionInvalid
+			}
+			values[strings.ToLower(v)] = true
+			o.Values[j] = v
+		}
+		o.Position = int32(i)
+	}
+	return nil
+}
+
+// CheckAttributes checks that attrs gives each option exactly one of its
+// values and nothing else. A product without options takes any attributes.
+func CheckAttributes(options []*VariantOption, attrs map[string]any) error {
+	if len(options) == 0 {
+		return nil
+	}
+	if len(attrs) != len(options) {
+		return ErrVariantAttributesInvalid
+	}
+	for _, o := range options {
+		v, ok := attrs[o.Name].(string)
+		if !ok || !containsString(o.Values, v) {
+			return ErrVariantAttributesInvalid
+		}
+	}
+	return nil
+}
+
+// CountVariantCombinations returns how many combinations of the options'
+// values there are, without building them. A count too large for an int is
+// returned as math.MaxInt.
+func CountVariantCombinations(options []*VariantOption) int {
+	if len(options) == 0 {
+		return 0
+	}
+	n := 1
+	for _, o := range options {
+		k := len(o.Values)
+		if k == 0 {
+			return 0
+		}
+		if n > math.MaxInt/k {
+			return math.MaxInt
+		}
+		n *= k
+	}
+	return n
+}
+
+// VariantCombinations returns every combination of the options' values, one
+// value per option in option order, e.g. [S Red] [S Blue] [M Red] ...
+func VariantCombinations(options []*VariantOption) [][]string {
+	if len(options) == 0 {
+		return nil
+	}
+	combinations := [][]string{{}}
+	for _, o := range options {
+		next := make([][]string, 0, len(combinations)*len(o.Values))
+		for _, c := range combinations {
+			for _, v := range o.Values {
+				combination := append(append([]string{}, c...), v)
+				next = append(next, combination)
+			}
+		}
+		combinations = next
+	}
+	return combinations
+}
+
+// VariantName names a variant after its option values, e.g. "M / Red".
+func VariantName(values []string) string {
+	return strings.Join(values, " / ")
+}
+
+// VariantSKU appends the option values to prefix, e.g. TSHIRT-M-RED. It is
+// empty when prefix is.
+func VariantSKU(prefix string, values []string) string {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return ""
+	}
+	parts := []string{prefix}
+	for _, v := range values {
+		if part := strings.ToUpper(Slugify(v)); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,937 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"productservice/internal/domain"
+
+	"github.com/lib/pq"
+)
+
+const catalogProductColumns = `
+	id, shop_id, category_id::text, sku, barcode, name, description, detail,
+	price, COALESCE(cost_price, 0), compare_at_price, currency,
+	COALESCE(stock_quantity, 0), COALESCE(min_stock_level, 0), max_stock_level,
+	COALESCE(unit, 'pcs'), weight, COALESCE(weight_unit, 'kg'), COALESCE(thumbnail_index, 0),
+	COALESCE(is_active, true), COALESCE(is_taxable, true), COALESCE(tax_rate, 0),
+	COALESCE(track_inventory, true), COALESCE(allow_backorder, false), COALESCE(is_featured, false),
+	COALESCE(sort_order, 0), COALESCE(metadata, '{}'::jsonb), created_at, updated_at, deleted_at
+`
+
+// CreateCatalogProduct inserts a product with its media and tags. Tags that
+// are not the shop's are skipped.
+func (r *PostgresProductRepository) CreateCatalogProduct(
+	ctx context.Context,
+	req domain.CreateCatalogProductRequest,
+) (*domain.CatalogProduct, error) {
+
+	r.logger.DebugContext(ctx, "creating catalog product",
+		"shopID", req.ShopID,
+		"name", req.Name,
+		"sku", ptrValue(req.SKU),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := checkCategory(ctx, tx, req.ShopID, req.CategoryID); err != nil {
+		return nil, err
+	}
+
+	p, err := insertCatalogProduct(ctx, tx, req)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to create catalog product",
+			"error", err,
+			"shopID", req.ShopID,
+			"name", req.Name,
+		)
+		return nil, err
+	}
+
+	for _, m := range req.Media {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO product_media (product_id, media_url, media_type, display_order, is_thumbnail, alt_text)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, p.ID, m.MediaURL, m.MediaType, m.DisplayOrder, m.IsThumbnail, m.AltText); err != nil {
+			r.logger.ErrorContext(ctx, "failed to add product media",
+				"error", err,
+				"productID", p.ID,
+			)
+			return nil, err
+		}
+	}
+
+	if len(req.TagIDs) > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO product_tags (product_id, tag_id)
+			SELECT $1, id FROM tags WHERE shop_id = $2 AND id = ANY($3::uuid[]) AND deleted_at IS NULL
+			ON CONFLICT DO NOTHING
+		`, p.ID, req.ShopID, pq.Array(req.TagIDs)); err != nil {
+			r.logger.ErrorContext(ctx, "failed to tag product",
+				"error", err,
+				"productID", p.ID,
+			)
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "catalog product created",
+		"productID", p.ID,
+		"shopID", p.ShopID,
+	)
+
+	return p, nil
+}
+
+// GetCatalogProduct returns one of the shop's products that has not been
+// deleted.
+func (r *PostgresProductRepository) GetCatalogProduct(
+	ctx context.Context,
+	shopID string,
+	id string,
+) (*domain.CatalogProduct, error) {
+
+	p, err := scanCatalogProduct(r.db.QueryRowContext(ctx, `
+		SELECT `+catalogProductColumns+`
+		FROM products
+		WHERE id = $1
+		  AND shop_id = $2
+		  AND deleted_at IS NULL
+	`, id, shopID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "catalog product not found",
+				"productID", id,
+				"shopID", shopID,
+			)
+			return nil, err
+		}
+		r.logger.ErrorContext(ctx, "failed to get catalog product",
+			"error", err,
+			"productID", id,
+		)
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// GetCatalogProductDetail returns a product with the relations in inc.
+func (r *PostgresProductRepository) GetCatalogProductDetail(
+	ctx context.Context,
+	shopID string,
+	id string,
+	inc domain.DetailIncludes,
+) (*domain.CatalogProductDetail, error) {
+
+	p, err := r.GetCatalogProduct(ctx, shopID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := r.loadDetails(ctx, shopID, []*domain.CatalogProduct{p}, inc)
+	if err != nil {
+		return nil, err
+	}
+
+	return details[0], nil
+}
+
+// ListCatalogProducts returns a page of the shop's products matching f, with
+// the relations in inc, and how many products match in all.
+func (r *PostgresProductRepository) ListCatalogProducts(
+	ctx context.Context,
+	f domain.CatalogProductFilter,
+	inc domain.DetailIncludes,
+) ([]*domain.CatalogProductDetail, int64, error) {
+
+	where := `
+		FROM products
+		WHERE shop_id = $1
+		  AND deleted_at IS NULL
+	`
+	args := []any{f.ShopID}
+
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if f.CategoryID != nil {
+		where += " AND category_id = " + arg(*f.CategoryID)
+	}
+	if f.Search != nil && *f.Search != "" {
+		n := arg("%" + *f.Search + "%")
+		where += fmt.Sprintf(" AND (name ILIKE %s OR sku ILIKE %s OR barcode ILIKE %s)", n, n, n)
+	}
+	if f.IsActive != nil {
+		where += " AND COALESCE(is_active, true) = " + arg(*f.IsActive)
+	}
+	if f.IsFeatured != nil {
+		where += " AND COALESCE(is_featured, false) = " + arg(*f.IsFeatured)
+	}
+	if len(f.TagIDs) > 0 {
+		where += " AND EXISTS (SELECT 1 FROM product_tags pt WHERE pt.product_id = products.id AND pt.tag_id = ANY(" +
+			arg(pq.Array(f.TagIDs)) + "::uuid[]))"
+	}
+	if f.MinPrice != nil {
+		where += " AND price >= " + arg(*f.MinPrice)
+	}
+	if f.MaxPrice != nil {
+		where += " AND price <= " + arg(*f.MaxPrice)
+	}
+	if f.LowStockOnly {
+		where += " AND COALESCE(track_inventory, true) AND COALESCE(stock_quantity, 0) <= COALESCE(min_stock_level, 0)"
+	}
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(1) `+where, args...).Scan(&total); err != nil {
+		r.logger.ErrorContext(ctx, "failed to count catalog products",
+			"error", err,
+			"shopID", f.ShopID,
+		)
+		return nil, 0, err
+	}
+
+	order := "ASC"
+	if f.SortDesc {
+		order = "DESC"
+	}
+	query := `SELECT ` + catalogProductColumns + where +
+		fmt.Sprintf(" ORDER BY %s %s, id %s LIMIT %s OFFSET %s",
+			f.SortBy, order, order, arg(f.PageSize), arg((f.Page-1)*f.PageSize))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list catalog products",
+			"error", err,
+			"shopID", f.ShopID,
+		)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	products := make([]*domain.CatalogProduct, 0, f.PageSize)
+	for rows.Next() {
+		p, err := scanCatalogProduct(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		products = append(products, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	details, err := r.loadDetails(ctx, f.ShopID, products, inc)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return details, total, nil
+}
+
+// UpdateCatalogProduct changes the fields set in req.
+func (r *PostgresProductRepository) UpdateCatalogProduct(
+	ctx context.Context,
+	req domain.UpdateCatalogProductRequest,
+) (*domain.CatalogProduct, error) {
+
+	r.logger.DebugContext(ctx, "updating catalog product",
+		"productID", req.ID,
+		"shopID", req.ShopID,
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if req.CategoryID != nil && *req.CategoryID != "" {
+		if err := checkCategory(ctx, tx, req.ShopID, req.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	sets, args := catalogProductSets(req, []any{req.ID, req.ShopID})
+	sets = append(sets, "updated_at = now()")
+
+	p, err := scanCatalogProduct(tx.QueryRowContext(ctx, `
+		UPDATE products
+		SET `+strings.Join(sets, ", ")+`
+		WHERE id = $1
+		  AND shop_id = $2
+		  AND deleted_at IS NULL
+		RETURNING `+catalogProductColumns,
+		args...,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "catalog product not found for update",
+				"productID", req.ID,
+			)
+			return nil, err
+		}
+		r.logger.ErrorContext(ctx, "failed to update catalog product",
+			"error", err,
+			"productID", req.ID,
+		)
+		return nil, catalogWriteError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "catalog product updated",
+		"productID", p.ID,
+		"shopID", p.ShopID,
+	)
+
+	return p, nil
+}
+
+// DeleteCatalogProduct marks a product deleted, or removes it with its media,
+// variants and tags when soft is false.
+func (r *PostgresProductRepository) DeleteCatalogProduct(
+	ctx context.Context,
+	shopID string,
+	id string,
+	soft bool,
+) error {
+
+	query := `DELETE FROM products WHERE id = $1 AND shop_id = $2`
+	if soft {
+		query = `
+			UPDATE products
+			SET deleted_at = now(),
+			    updated_at = now()
+			WHERE id = $1
+			  AND shop_id = $2
+			  AND deleted_at IS NULL
+		`
+	}
+
+	res, err := r.db.ExecContext(ctx, query, id, shopID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete catalog product",
+			"error", err,
+			"productID", id,
+			"soft", soft,
+		)
+		return err
+	}
+
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		r.logger.WarnContext(ctx, "catalog product not found for deletion",
+			"productID", id,
+		)
+		return sql.ErrNoRows
+	}
+
+	r.logger.InfoContext(ctx, "catalog product deleted",
+		"productID", id,
+		"soft", soft,
+	)
+
+	return nil
+}
+
+// AdjustStock adds c.QuantityChange to a product's stock. Stock of a product
+// that tracks inventory without backorders cannot go below zero.
+func (r *PostgresProductRepository) AdjustStock(
+	ctx context.Context,
+	shopID string,
+	c domain.StockChange,
+) (*domain.CatalogProduct, error) {
+
+	p, err := scanCatalogProduct(r.db.QueryRowContext(ctx, `
+		UPDATE products
+		SET
+			stock_quantity = COALESCE(stock_quantity, 0) + $3,
+			updated_at = now()
+		WHERE id = $1
+		  AND shop_id = $2
+		  AND deleted_at IS NULL
+		  AND (
+			NOT COALESCE(track_inventory, true)
+			OR COALESCE(allow_backorder, false)
+			OR COALESCE(stock_quantity, 0) + $3 >= 0
+		  )
+		RETURNING `+catalogProductColumns,
+		c.ProductID, shopID, c.QuantityChange,
+	))
+	if err == sql.ErrNoRows {
+		// Tell a missing product from one without enough stock.
+		if _, err := r.GetCatalogProduct(ctx, shopID, c.ProductID); err != nil {
+			return nil, err
+		}
+		r.logger.WarnContext(ctx, "insufficient stock for adjustment",
+			"productID", c.ProductID,
+			"quantityChange", c.QuantityChange,
+		)
+		return nil, domain.ErrInsufficientStock
+	}
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to adjust stock",
+			"error", err,
+			"productID", c.ProductID,
+		)
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "stock adjusted",
+		"productID", p.ID,
+		"shopID", shopID,
+		"quantityChange", c.QuantityChange,
+		"stockQuantity", p.StockQuantity,
+		"reason", c.Reason,
+	)
+
+	return p, nil
+}
+
+// LookupByCode finds the product or variant whose barcode or SKU is one of
+// codes. Earlier codes win, and on the same code a barcode beats a SKU and a
+// product beats a variant. Inactive products and variants are skipped unless
+// includeInactive is set.
+func (r *PostgresProductRepository) LookupByCode(
+	ctx context.Context,
+	shopID string,
+	codes []string,
+	includeInactive bool,
+) (*domain.CodeMatch, error) {
+
+	active := "AND COALESCE(p.is_active, true)"
+	variantActive := active + " AND COALESCE(v.is_active, true)"
+	if includeInactive {
+		active, variantActive = "", ""
+	}
+
+	var match domain.CodeMatch
+	var productID string
+	var variantID sql.NullString
+	err := r.db.QueryRowContext(ctx, `
+		SELECT matched_by, code, product_id, variant_id
+		FROM (
+			SELECT 1 AS rank, 'barcode' AS matched_by, p.barcode AS code, p.id AS product_id, NULL::uuid AS variant_id
+			FROM products p
+			WHERE p.shop_id = $1 AND p.barcode = ANY($2::text[]) AND p.deleted_at IS NULL `+active+`
+			UNION ALL
+			SELECT 2, 'variant_barcode', v.barcode, p.id, v.id
+			FROM product_variants v
+			JOIN products p ON p.id = v.product_id
+			WHERE p.shop_id = $1 AND v.barcode = ANY($2::text[]) AND p.deleted_at IS NULL `+variantActive+`
+			UNION ALL
+			SELECT 3, 'sku', p.sku, p.id, NULL::uuid
+			FROM products p
+			WHERE p.shop_id = $1 AND p.sku = ANY($2::text[]) AND p.deleted_at IS NULL `+active+`
+			UNION ALL
+			SELECT 4, 'variant_sku', v.sku, p.id, v.id
+			FROM product_variants v
+			JOIN products p ON p.id = v.product_id
+			WHERE p.shop_id = $1 AND v.sku = ANY($2::text[]) AND p.deleted_at IS NULL `+variantActive+`
+		) m
+		ORDER BY array_position($2::text[], m.code::text), m.rank
+		LIMIT 1
+	`, shopID, pq.Array(codes)).Scan(&match.MatchedBy, &match.Code, &productID, &variantID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			r.logger.ErrorContext(ctx, "failed to look up code",
+				"error", err,
+				"shopID", shopID,
+			)
+		}
+		return nil, err
+	}
+
+	if match.Product, err = r.GetCatalogProduct(ctx, shopID, productID); err != nil {
+		return nil, err
+	}
+	if variantID.Valid {
+		match.Variant, err = scanVariant(r.db.QueryRowContext(ctx, `
+			SELECT `+variantColumns+`
+			FROM product_variants v
+			WHERE v.id = $1
+		`, variantID.String))
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to load matched variant",
+				"error", err,
+				"variantID", variantID.String,
+			)
+			return nil, err
+		}
+	}
+
+	return &match, nil
+}
+
+// loadDetails attaches the relations in inc to products, a query per
+// relation rather than per product.
+func (r *PostgresProductRepository) loadDetails(
+	ctx context.Context,
+	shopID string,
+	products []*domain.CatalogProduct,
+	inc domain.DetailIncludes,
+) ([]*domain.CatalogProductDetail, error) {
+
+	details := make([]*domain.CatalogProductDetail, 0, len(products))
+	ids := make([]string, 0, len(products))
+	for _, p := range products {
+		details = append(details, &domain.CatalogProductDetail{Product: p})
+		ids = append(ids, p.ID)
+	}
+	if len(products) == 0 {
+		return details, nil
+	}
+
+	if inc.Category || inc.CategoryParent {
+		categoryIDs := make([]string, 0, len(products))
+		for _, p := range products {
+			if p.CategoryID != nil {
+				categoryIDs = append(categoryIDs, *p.CategoryID)
+			}
+		}
+		categories, err := r.loadCategories(ctx, shopID, categoryIDs, inc.CategoryParent)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range details {
+			if d.Product.CategoryID != nil {
+				d.Category = categories[*d.Product.CategoryID]
+			}
+		}
+	}
+
+	if inc.Media {
+		media, err := r.loadMedia(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range details {
+			d.Media = media[d.Product.ID]
+		}
+	}
+
+	if inc.Variants {
+		variants, err := r.loadVariants(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range details {
+			d.Variants = variants[d.Product.ID]
+		}
+	}
+
+	if inc.Tags {
+		tags, err := r.loadTags(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range details {
+			d.Tags = tags[d.Product.ID]
+		}
+	}
+
+	if inc.Shop {
+		var s domain.ShopInfo
+		err := r.db.QueryRowContext(ctx, `
+			SELECT id, name, logo FROM shops WHERE id = $1
+		`, shopID).Scan(&s.ID, &s.Name, &s.LogoURL)
+		if err != nil && err != sql.ErrNoRows {
+			r.logger.ErrorContext(ctx, "failed to load shop",
+				"error", err,
+				"shopID", shopID,
+			)
+			return nil, err
+		}
+		if err == nil {
+			for _, d := range details {
+				d.Shop = &s
+			}
+		}
+	}
+
+	return details, nil
+}
+
+func (r *PostgresProductRepository) loadCategories(
+	ctx context.Context,
+	shopID string,
+	ids []string,
+	withParent bool,
+) (map[string]*domain.CategoryInfo, error) {
+
+	categories := make(map[string]*domain.CategoryInfo)
+	if len(ids) == 0 {
+		return categories, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, name, COALESCE(slug, ''), parent_id::text
+		FROM categories
+		WHERE shop_id = $1
+		  AND (id = ANY($2::uuid[]) OR ($3 AND id IN (
+			SELECT parent_id FROM categories WHERE id = ANY($2::uuid[])
+		  )))
+		  AND deleted_at IS NULL
+	`, shopID, pq.Array(ids), withParent)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to load categories",
+			"error", err,
+			"shopID", shopID,
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c domain.CategoryInfo
+		if err := rows.Scan(&c.ID, &c.Name, &c.Slug, &c.ParentID); err != nil {
+			return nil, err
+		}
+		categories[c.ID] = &c
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if withParent {
+		for _, c := range categories {
+			if c.ParentID != nil {
+				c.Parent = categories[*c.ParentID]
+			}
+		}
+	}
+
+	return categories, nil
+}
+
+func (r *PostgresProductRepository) loadMedia(
+	ctx context.Context,
+	productIDs []string,
+) (map[string][]*domain.ProductMedia, error) {
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, product_id, media_url, media_type, COALESCE(display_order, 0),
+		       COALESCE(is_thumbnail, false), alt_text, created_at
+		FROM product_media
+		WHERE product_id = ANY($1::uuid[])
+		ORDER BY display_order, created_at
+	`, pq.Array(productIDs))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to load product media",
+			"error", err,
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	media := make(map[string][]*domain.ProductMedia)
+	for rows.Next() {
+		var m domain.ProductMedia
+		if err := rows.Scan(
+			&m.ID, &m.ProductID, &m.MediaURL, &m.MediaType, &m.DisplayOrder,
+			&m.IsThumbnail, &m.AltText, &m.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		media[m.ProductID] = append(media[m.ProductID], &m)
+	}
+
+	return media, rows.Err()
+}
+
+func (r *PostgresProductRepository) loadVariants(
+	ctx context.Context,
+	productIDs []string,
+) (map[string][]*domain.ProductVariant, error) {
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, product_id, sku, barcode, name, price, cost_price, compare_at_price,
+		       COALESCE(stock_quantity, 0), weight, COALESCE(attributes, '{}'::jsonb),
+		       COALESCE(is_active, true), created_at, updated_at
+		FROM product_variants
+		WHERE product_id = ANY($1::uuid[])
+		ORDER BY created_at
+	`, pq.Array(productIDs))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to load product variants",
+			"error", err,
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	variants := make(map[string][]*domain.ProductVariant)
+	for rows.Next() {
+		var v domain.ProductVariant
+		var attributes []byte
+		if err := rows.Scan(
+			&v.ID, &v.ProductID, &v.SKU, &v.Barcode, &v.Name, &v.Price, &v.CostPrice, &v.CompareAtPrice,
+			&v.StockQuantity, &v.Weight, &attributes,
+			&v.IsActive, &v.CreatedAt, &v.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		v.Attributes = attributes
+		variants[v.ProductID] = append(variants[v.ProductID], &v)
+	}
+
+	return variants, rows.Err()
+}
+
+func (r *PostgresProductRepository) loadTags(
+	ctx context.Context,
+	productIDs []string,
+) (map[string][]*domain.Tag, error) {
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT pt.product_id, t.id, t.shop_id, t.name, COALESCE(t.slug, ''), t.created_at
+		FROM product_tags pt
+		JOIN tags t ON t.id = pt.tag_id
+		WHERE pt.product_id = ANY($1::uuid[])
+		  AND t.deleted_at IS NULL
+		ORDER BY t.name
+	`, pq.Array(productIDs))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to load product tags",
+			"error", err,
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make(map[string][]*domain.Tag)
+	for rows.Next() {
+		var productID string
+		var t domain.Tag
+		if err := rows.Scan(&productID, &t.ID, &t.ShopID, &t.Name, &t.Slug, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		tags[productID] = append(tags[productID], &t)
+	}
+
+	return tags, rows.Err()
+}
+
+// insertCatalogProduct inserts the products row of req, without its media
+// and tags.
+func insertCatalogProduct(
+	ctx context.Context,
+	tx *sql.Tx,
+	req domain.CreateCatalogProductRequest,
+) (*domain.CatalogProduct, error) {
+
+	p, err := scanCatalogProduct(tx.QueryRowContext(ctx, `
+		INSERT INTO products (
+			shop_id, category_id, sku, barcode, name, description, detail,
+			price, cost_price, compare_at_price, currency,
+			stock_quantity, min_stock_level, max_stock_level,
+			unit, weight, weight_unit,
+			is_active, is_taxable, tax_rate, track_inventory, allow_backorder, is_featured,
+			sort_order, metadata
+		)
+		VALUES (
+			$1, $2, $3, $4, $5, $6, $7,
+			$8, $9, $10, $11,
+			$12, $13, $14,
+			$15, $16, $17,
+			$18, $19, $20, $21, $22, $23,
+			$24, COALESCE($25::jsonb, '{}'::jsonb)
+		)
+		RETURNING `+catalogProductColumns,
+		req.ShopID, req.CategoryID, req.SKU, req.Barcode, req.Name, req.Description, req.Detail,
+		req.Price, req.CostPrice, req.CompareAtPrice, req.Currency,
+		req.StockQuantity, req.MinStockLevel, req.MaxStockLevel,
+		req.Unit, req.Weight, req.WeightUnit,
+		req.IsActive, req.IsTaxable, req.TaxRate, req.TrackInventory, req.AllowBackorder, req.IsFeatured,
+		req.SortOrder, nullJSON(req.Metadata),
+	))
+	if err != nil {
+		return nil, catalogWriteError(err)
+	}
+	return p, nil
+}
+
+// catalogProductSets returns the SET assignments for the fields of req that
+// are not nil, with their values appended to args.
+func catalogProductSets(req domain.UpdateCatalogProductRequest, args []any) ([]string, []any) {
+	var sets []string
+	set := func(column string, v any) {
+		args = append(args, v)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	clearable := func(column string, v *string) {
+		if v == nil {
+			return
+		}
+		if *v == "" {
+			sets = append(sets, column+" = NULL")
+			return
+		}
+		set(column, *v)
+	}
+
+	clearable("category_id", req.CategoryID)
+	clearable("sku", req.SKU)
+	clearable("barcode", req.Barcode)
+	if req.Name != nil {
+		set("name", *req.Name)
+	}
+	if req.Description != nil {
+		set("description", *req.Description)
+	}
+	if req.Detail != nil {
+		set("detail", *req.Detail)
+	}
+	if req.Price != nil {
+		set("price", *req.Price)
+	}
+	if req.CostPrice != nil {
+		set("cost_price", *req.CostPrice)
+	}
+	if req.CompareAtPrice != nil {
+		set("compare_at_price", *req.CompareAtPrice)
+	}
+	if req.Currency != nil {
+		set("currency", *req.Currency)
+	}
+	if req.MinStockLevel != nil {
+		set("min_stock_level", *req.MinStockLevel)
+	}
+	if req.MaxStockLevel != nil {
+		set("max_stock_level", *req.MaxStockLevel)
+	}
+	if req.Unit != nil {
+		set("unit", *req.Unit)
+	}
+	if req.Weight != nil {
+		set("weight", *req.Weight)
+	}
+	if req.WeightUnit != nil {
+		set("weight_unit", *req.WeightUnit)
+	}
+	if req.IsActive != nil {
+		set("is_active", *req.IsActive)
+	}
+	if req.IsFeatured != nil {
+		set("is_featured", *req.IsFeatured)
+	}
+	if req.IsTaxable != nil {
+		set("is_taxable", *req.IsTaxable)
+	}
+	if req.TaxRate != nil {
+		set("tax_rate", *req.TaxRate)
+	}
+	if req.TrackInventory != nil {
+		set("track_inventory", *req.TrackInventory)
+	}
+	if req.AllowBackorder != nil {
+		set("allow_backorder", *req.AllowBackorder)
+	}
+	if req.SortOrder != nil {
+		set("sort_order", *req.SortOrder)
+	}
+	if req.Metadata != nil {
+		set("metadata", string(req.Metadata))
+	}
+	return sets, args
+}
+
+// checkCategory returns domain.ErrCategoryNotFound unless id, when set, is
+// one of the shop's categories.
+func checkCategory(ctx context.Context, tx *sql.Tx, shopID string, id *string) error {
+	if id == nil {
+		return nil
+	}
+
+	var exists bool
+	err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM categories
+			WHERE id = $1 AND shop_id = $2 AND deleted_at IS NULL
+		)
+	`, *id, shopID).Scan(&exists)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "22P02" {
+			return domain.ErrCategoryNotFound
+		}
+		return err
+	}
+	if !exists {
+		return domain.ErrCategoryNotFound
+	}
+	return nil
+}
+
+// catalogWriteError turns a violated sku or barcode constraint into
+// domain.ErrProductConflict.
+func catalogWriteError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		return domain.ErrProductConflict
+	}
+	return err
+}
+
+// nullJSON passes a JSON document to Postgres as text, which it casts to
+// jsonb; bytes would be sent as bytea.
+func nullJSON(b []byte) any {
+	if len(b) == 0 {
+		return nil
+	}
+	return string(b)
+}
+
+func scanCatalogProduct(row interface{ Scan(...any) error }) (*domain.CatalogProduct, error) {
+	var p domain.CatalogProduct
+	var metadata []byte
+	err := row.Scan(
+		&p.ID,
+		&p.ShopID,
+		&p.CategoryID,
+		&p.SKU,
+		&p.Barcode,
+		&p.Name,
+		&p.Description,
+		&p.Detail,
+		&p.Price,
+		&p.CostPrice,
+		&p.CompareAtPrice,
+		&p.Currency,
+		&p.StockQuantity,
+		&p.MinStockLevel,
+		&p.MaxStockLevel,
+		&p.Unit,
+		&p.Weight,
+		&p.WeightUnit,
+		&p.ThumbnailIndex,
+		&p.IsActive,
+		&p.IsTaxable,
+		&p.TaxRate,
+		&p.TrackInventory,
+		&p.AllowBackorder,
+		&p.IsFeatured,
+		&p.SortOrder,
+		&metadata,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+		&p.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.Metadata = metadata
+	return &p, nil
+}
@@ -0,0 +1,294 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"productservice/internal/domain"
+
+	"github.com/lib/pq"
+)
+
+// maxSlugLength is the size of the slug columns of categories and tags.
+const maxSlugLength = 100
+
+// ImportCatalogProduct upserts one import row by the shop's SKU in a
+// transaction of its own: the product with the SKU is updated, and revived
+// if it was deleted, or a product is created from p.Create. Categories and
+// tags the row names are created when missing. A dry run does all of it and
+// rolls back, so it reports the same errors a real import would.
+func (r *PostgresProductRepository) ImportCatalogProduct(
+	ctx context.Context,
+	shopID string,
+	p domain.ImportProduct,
+	dryRun bool,
+) (*domain.ImportResult, error) {
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res := &domain.ImportResult{}
+
+	var categoryID *string
+	if p.CategoryPath != nil {
+		id := ""
+		if len(p.CategoryPath) > 0 {
+			if id, res.CreatedCategories, err = importCategoryPath(ctx, tx, shopID, p.CategoryPath); err != nil {
+				return nil, err
+			}
+		}
+		categoryID = &id
+	}
+
+	var deleted bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT id, deleted_at IS NOT NULL
+		FROM products
+		WHERE shop_id = $1
+		  AND sku = $2
+		FOR UPDATE
+	`, shopID, p.SKU).Scan(&res.ProductID, &deleted)
+
+	switch {
+	case err == sql.ErrNoRows:
+		if p.Create == nil {
+			return nil, domain.ErrImportIncomplete
+		}
+		req := *p.Create
+		req.ShopID = shopID
+		req.SKU = &p.SKU
+		if categoryID != nil && *categoryID != "" {
+			req.CategoryID = categoryID
+		}
+		created, err := insertCatalogProduct(ctx, tx, req)
+		if err != nil {
+			return nil, err
+		}
+		res.ProductID = created.ID
+		res.Created = true
+
+	case err != nil:
+		r.logger.ErrorContext(ctx, "failed to find product to import",
+			"error", err,
+			"shopID", shopID,
+			"sku", p.SKU,
+		)
+		return nil, err
+
+	default:
+		req := p.Update
+		req.CategoryID = categoryID
+		sets, args := catalogProductSets(req, []any{res.ProductID})
+		if p.StockQuantity != nil {
+			args = append(args, *p.StockQuantity)
+			sets = append(sets, fmt.Sprintf("stock_quantity = $%d", len(args)))
+		}
+		sets = append(sets, "deleted_at = NULL", "updated_at = now()")
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE products
+			SET `+strings.Join(sets, ", ")+`
+			WHERE id = $1
+		`, args...); err != nil {
+			return nil, catalogWriteError(err)
+		}
+		res.Created = deleted
+	}
+
+	if p.Tags != nil {
+		if res.CreatedTags, err = importTags(ctx, tx, shopID, res.ProductID, p.Tags); err != nil {
+			return nil, err
+		}
+	}
+
+	if dryRun {
+		return res, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	r.logger.DebugContext(ctx, "catalog product imported",
+		"productID", res.ProductID,
+		"shopID", shopID,
+		"created", res.Created,
+	)
+
+	return res, nil
+}
+
+// ExportCatalogProducts calls fn with each of the shop's products that has
+// not been deleted, in sort order, along with its category path and tag
+// names. It stops at the first error fn returns.
+func (r *PostgresProductRepository) ExportCatalogProducts(
+	ctx context.Context,
+	shopID string,
+	includeInactive bool,
+	fn func(*domain.ExportProduct) error,
+) error {
+
+	query := `
+		WITH RECURSIVE category_paths (category_path_id, category_path) AS (
+			SELECT id, name::text
+			FROM categories
+			WHERE shop_id = $1
+			  AND parent_id IS NULL
+			  AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, cp.category_path || $2::text || c.name
+			FROM categories c
+			JOIN category_paths cp ON cp.category_path_id = c.parent_id
+			WHERE c.deleted_at IS NULL
+		)
+		SELECT ` + catalogProductColumns + `,
+			COALESCE(category_path, ''),
+			ARRAY(
+				SELECT t.name
+				FROM product_tags pt
+				JOIN tags t ON t.id = pt.tag_id
+				WHERE pt.product_id = products.id
+				  AND t.deleted_at IS NULL
+				ORDER BY t.name
+			)
+		FROM products
+		LEFT JOIN category_paths ON category_path_id = products.category_id
+		WHERE shop_id = $1
+		  AND deleted_at IS NULL
+	`
+	if !includeInactive {
+		query += " AND COALESCE(is_active, true)"
+	}
+	query += " ORDER BY sort_order, name, id"
+
+	rows, err := r.db.QueryContext(ctx, query, shopID, domain.CategoryPathSeparator)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to export catalog products",
+			"error", err,
+			"shopID", shopID,
+		)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e domain.ExportProduct
+		var tags pq.StringArray
+		e.Product, err = scanCatalogProduct(exportRow{rows: rows, extra: []any{&e.CategoryPath, &tags}})
+		if err != nil {
+			return err
+		}
+		e.Tags = tags
+		if err := fn(&e); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// exportRow scans a product row followed by the extra columns of an export.
+type exportRow struct {
+	rows  *sql.Rows
+	extra []any
+}
+
+func (e exportRow) Scan(dest ...any) error {
+	return e.rows.Scan(append(dest, e.extra...)...)
+}
+
+// importCategoryPath finds the category at path, matching names without
+// regard to case, and creates the ones that are missing. It returns the
+// category's id and the paths of the categories it created.
+func importCategoryPath(ctx context.Context, tx *sql.Tx, shopID string, path []string) (string, []string, error) {
+	var parentID *string
+	var created []string
+
+	for i, name := range path {
+		var id string
+		err := tx.QueryRowContext(ctx, `
+			SELECT id
+			FROM categories
+			WHERE shop_id = $1
+			  AND parent_id IS NOT DISTINCT FROM $2::uuid
+			  AND lower(name) = lower($3)
+			  AND deleted_at IS NULL
+			ORDER BY created_at
+			LIMIT 1
+		`, shopID, parentID, name).Scan(&id)
+
+		if err == sql.ErrNoRows {
+			// Subcategories are slugged with their ancestors, so "Hot" under
+			// "Drinks" and under "Food" do not collide.
+			err = tx.QueryRowContext(ctx, `
+				INSERT INTO categories (shop_id, name, slug, parent_id)
+				VALUES ($1, $2, $3, $4)
+				ON CONFLICT (shop_id, slug) WHERE deleted_at IS NULL DO NOTHING
+				RETURNING id
+			`, shopID, name, importSlug(path[:i+1]...), parentID).Scan(&id)
+			if err == sql.ErrNoRows {
+				return "", nil, domain.ErrCategoryConflict
+			}
+			created = append(created, strings.Join(path[:i+1], domain.CategoryPathSeparator))
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		parentID = &id
+	}
+
+	return *parentID, created, nil
+}
+
+// importTags sets the product's tags to names, creating the shop's missing
+// tags, and returns the names of the ones it created.
+func importTags(ctx context.Context, tx *sql.Tx, shopID, productID string, names []string) ([]string, error) {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_tags WHERE product_id = $1`, productID); err != nil {
+		return nil, err
+	}
+
+	var created []string
+	for _, name := range names {
+		slug := importSlug(name)
+
+		var id string
+		err := tx.QueryRowContext(ctx, `
+			SELECT id FROM tags
+			WHERE shop_id = $1 AND slug = $2 AND deleted_at IS NULL
+		`, shopID, slug).Scan(&id)
+		if err == sql.ErrNoRows {
+			err = tx.QueryRowContext(ctx, `
+				INSERT INTO tags (shop_id, name, slug)
+				VALUES ($1, $2, $3)
+				RETURNING id
+			`, shopID, name, slug).Scan(&id)
+			created = append(created, name)
+		}
+		if err != nil {
+			return nil, tagWriteError(err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO product_tags (product_id, tag_id)
+			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING
+		`, productID, id); err != nil {
+			return nil, err
+		}
+	}
+
+	return created, nil
+}
+
+// importSlug slugs names joined, cut to fit the slug columns.
+func importSlug(names ...string) string {
+	slug := []rune(domain.Slugify(strings.Join(names, " ")))
+	if len(slug) > maxSlugLength {
+		slug = slug[:maxSlugLength]
+	}
+	return string(slug)
+}
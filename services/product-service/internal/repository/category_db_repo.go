@@ -0,0 +1,487 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"productservice/internal/domain"
+
+	"github.com/lib/pq"
+)
+
+type PostgresCategoryRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewPostgresCategoryRepository(db *sql.DB, logger *slog.Logger) *PostgresCategoryRepository {
+	return &PostgresCategoryRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// maxCategoryDepth stops a walk down the hierarchy should it ever loop.
+const maxCategoryDepth = 32
+
+const categoryColumns = `
+	id, shop_id, name, COALESCE(slug, ''), parent_id::text, description, image_url,
+	COALESCE(display_order, 0), COALESCE(is_active, true), created_at, updated_at, deleted_at
+`
+
+// productCountColumn counts the products directly in the category of table,
+// or selects 0 when the count was not asked for.
+func productCountColumn(table string, include bool) string {
+	if !include {
+		return "0"
+	}
+	return fmt.Sprintf(
+		"(SELECT COUNT(1) FROM products p WHERE p.category_id = %s.id AND p.deleted_at IS NULL)",
+		table,
+	)
+}
+
+// Create a category. Its parent, when set, must be one of the shop's
+// categories.
+func (r *PostgresCategoryRepository) Create(ctx context.Context, c domain.Category) (*domain.Category, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := checkCategory(ctx, tx, c.ShopID, c.ParentID); err != nil {
+		return nil, err
+	}
+
+	created, err := scanCategory(tx.QueryRowContext(ctx, `
+		INSERT INTO categories (shop_id, name, slug, parent_id, description, image_url, display_order, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING `+categoryColumns+`, 0
+	`, c.ShopID, c.Name, c.Slug, c.ParentID, c.Description, c.ImageURL, c.DisplayOrder, c.IsActive))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to create category", "error", err, "name", c.Name)
+		return nil, categoryWriteError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "category created", "categoryID", created.ID, "shopID", created.ShopID)
+	return created, nil
+}
+
+// GetByID fetches a category, optionally with its parent, its direct
+// children and product counts.
+func (r *PostgresCategoryRepository) GetByID(
+	ctx context.Context,
+	shopID, id string,
+	includeParent, includeChildren, includeCount bool,
+) (*domain.Category, error) {
+
+	c, err := r.get(ctx, shopID, id, includeCount)
+	if err != nil {
+		return nil, err
+	}
+
+	if includeParent && c.ParentID != nil {
+		parent, err := r.get(ctx, shopID, *c.ParentID, includeCount)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		c.Parent = parent
+	}
+
+	if includeChildren {
+		children, _, err := r.List(ctx, domain.CategoryFilter{
+			ShopID:          shopID,
+			ParentID:        &c.ID,
+			IncludeInactive: true,
+			IncludeCount:    includeCount,
+		})
+		if err != nil {
+			return nil, err
+		}
+		c.Children = children
+	}
+
+	return c, nil
+}
+
+func (r *PostgresCategoryRepository) get(ctx context.Context, shopID, id string, includeCount bool) (*domain.Category, error) {
+	c, err := scanCategory(r.db.QueryRowContext(ctx, `
+		SELECT `+categoryColumns+`, `+productCountColumn("categories", includeCount)+`
+		FROM categories
+		WHERE id = $1 AND shop_id = $2 AND deleted_at IS NULL
+	`, id, shopID))
+	if err != nil {
+		if err != sql.ErrNoRows {
+			r.logger.ErrorContext(ctx, "failed to get category", "error", err, "categoryID", id)
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// List the shop's categories under f.ParentID, or at the top level when it
+// is empty, ordered as they are displayed.
+func (r *PostgresCategoryRepository) List(ctx context.Context, f domain.CategoryFilter) ([]*domain.Category, int64, error) {
+	where := "WHERE shop_id = $1 AND deleted_at IS NULL"
+	args := []any{f.ShopID}
+
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if f.ParentID != nil {
+		if *f.ParentID == "" {
+			where += " AND parent_id IS NULL"
+		} else {
+			where += " AND parent_id = " + arg(*f.ParentID)
+		}
+	}
+	if f.Search != "" {
+		where += " AND name ILIKE " + arg("%"+f.Search+"%")
+	}
+	if !f.IncludeInactive {
+		where += " AND COALESCE(is_active, true)"
+	}
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, "SELECT count(1) FROM categories "+where, args...).Scan(&total); err != nil {
+		r.logger.ErrorContext(ctx, "failed to count categories", "error", err, "shopID", f.ShopID)
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT ` + categoryColumns + `, ` + productCountColumn("categories", f.IncludeCount) + `
+		FROM categories
+		` + where + `
+		ORDER BY display_order, name, id`
+	if f.PageSize > 0 {
+		query += fmt.Sprintf(" LIMIT %s OFFSET %s", arg(f.PageSize), arg((f.Page-1)*f.PageSize))
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list categories", "error", err, "shopID", f.ShopID)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	categories := make([]*domain.Category, 0)
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		categories = append(categories, c)
+	}
+
+	return categories, total, rows.Err()
+}
+
+// Update changes the fields set in req. A category cannot be moved under
+// itself or one of its subcategories.
+func (r *PostgresCategoryRepository) Update(ctx context.Context, req domain.UpdateCategoryRequest) (*domain.Category, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	args := []any{req.ID, req.ShopID}
+	var sets []string
+	set := func(column string, v any) {
+		args = append(args, v)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if req.ParentID != nil {
+		if *req.ParentID == "" {
+			sets = append(sets, "parent_id = NULL")
+		} else {
+			if err := checkCategory(ctx, tx, req.ShopID, req.ParentID); err != nil {
+				return nil, err
+			}
+
+			var cycle bool
+			err := tx.QueryRowContext(ctx, `
+				WITH RECURSIVE subtree AS (
+					SELECT id FROM categories WHERE id = $1
+					UNION
+					SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+				)
+				SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2)
+			`, req.ID, *req.ParentID).Scan(&cycle)
+			if err != nil {
+				return nil, err
+			}
+			if cycle {
+				return nil, domain.ErrCategoryCycle
+			}
+			set("parent_id", *req.ParentID)
+		}
+	}
+	if req.Name != nil {
+		set("name", *req.Name)
+	}
+	if req.Slug != nil {
+		set("slug", *req.Slug)
+	}
+	if req.Description != nil {
+		set("description", *req.Description)
+	}
+	if req.ImageURL != nil {
+		set("image_url", *req.ImageURL)
+	}
+	if req.DisplayOrder != nil {
+		set("display_order", *req.DisplayOrder)
+	}
+	if req.IsActive != nil {
+		set("is_active", *req.IsActive)
+	}
+	sets = append(sets, "updated_at = now()")
+
+	c, err := scanCategory(tx.QueryRowContext(ctx, `
+		UPDATE categories
+		SET `+strings.Join(sets, ", ")+`
+		WHERE id = $1 AND shop_id = $2 AND deleted_at IS NULL
+		RETURNING `+categoryColumns+`, 0
+	`, args...))
+	if err != nil {
+		if err != sql.ErrNoRows {
+			r.logger.ErrorContext(ctx, "failed to update category", "error", err, "categoryID", req.ID)
+		}
+		return nil, categoryWriteError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "category updated", "categoryID", c.ID, "shopID", c.ShopID)
+	return c, nil
+}
+
+// Delete a category (soft delete). Its subcategories move up to its parent
+// and its products are left without a category.
+func (r *PostgresCategoryRepository) Delete(ctx context.Context, shopID, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var parentID sql.NullString
+	err = tx.QueryRowContext(ctx, `
+		UPDATE categories
+		SET deleted_at = now(), updated_at = now()
+		WHERE id = $1 AND shop_id = $2 AND deleted_at IS NULL
+		RETURNING parent_id::text
+	`, id, shopID).Scan(&parentID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			r.logger.ErrorContext(ctx, "failed to delete category", "error", err, "categoryID", id)
+		}
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE categories SET parent_id = $2, updated_at = now()
+		WHERE parent_id = $1 AND deleted_at IS NULL
+	`, id, parentID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE products SET category_id = NULL, updated_at = now()
+		WHERE category_id = $1
+	`, id); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	r.logger.InfoContext(ctx, "category deleted", "categoryID", id, "shopID", shopID)
+	return nil
+}
+
+// GetTree returns the shop's top-level categories with their subcategories
+// nested under them. Inactive categories, and everything under them, are
+// left out unless includeInactive is set.
+func (r *PostgresCategoryRepository) GetTree(
+	ctx context.Context,
+	shopID string,
+	includeInactive, includeCount bool,
+) ([]*domain.Category, error) {
+
+	rows, err := r.db.QueryContext(ctx, `
+		WITH RECURSIVE tree AS (
+			SELECT categories.*, 0 AS depth
+			FROM categories
+			WHERE shop_id = $1
+			  AND parent_id IS NULL
+			  AND deleted_at IS NULL
+			  AND ($2 OR COALESCE(is_active, true))
+			UNION ALL
+			SELECT c.*, t.depth + 1
+			FROM categories c
+			JOIN tree t ON c.parent_id = t.id
+			WHERE c.deleted_at IS NULL
+			  AND ($2 OR COALESCE(c.is_active, true))
+			  AND t.depth < $3
+		)
+		SELECT `+categoryColumns+`, `+productCountColumn("tree", includeCount)+`
+		FROM tree
+		ORDER BY depth, display_order, name, id
+	`, shopID, includeInactive, maxCategoryDepth)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to load category tree", "error", err, "shopID", shopID)
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Parents come before their children, so each child finds its parent
+	// already in byID.
+	byID := make(map[string]*domain.Category)
+	roots := make([]*domain.Category, 0)
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		byID[c.ID] = c
+		if c.ParentID == nil {
+			roots = append(roots, c)
+			continue
+		}
+		if parent, ok := byID[*c.ParentID]; ok {
+			parent.Children = append(parent.Children, c)
+		}
+	}
+
+	return roots, rows.Err()
+}
+
+// GetWithProducts returns a category and a page of the products in it or in
+// any of its subcategories.
+func (r *PostgresCategoryRepository) GetWithProducts(
+	ctx context.Context,
+	shopID, id string,
+	page, pageSize int,
+) (*domain.CategoryWithProducts, error) {
+
+	c, err := r.get(ctx, shopID, id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	subtree := `
+		WITH RECURSIVE subtree AS (
+			SELECT id, 0 AS depth FROM categories WHERE id = $1
+			UNION ALL
+			SELECT c.id, s.depth + 1
+			FROM categories c
+			JOIN subtree s ON c.parent_id = s.id
+			WHERE c.deleted_at IS NULL AND s.depth < $3
+		)
+	`
+
+	var total int32
+	err = r.db.QueryRowContext(ctx, subtree+`
+		SELECT count(1)
+		FROM products
+		WHERE shop_id = $2
+		  AND deleted_at IS NULL
+		  AND category_id IN (SELECT id FROM subtree)
+	`, id, shopID, maxCategoryDepth).Scan(&total)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to count category products", "error", err, "categoryID", id)
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, subtree+`
+		SELECT
+			p.id,
+			p.name,
+			COALESCE(p.sku, ''),
+			p.price,
+			COALESCE(p.stock_quantity, 0),
+			COALESCE(p.is_active, true),
+			(
+				SELECT m.media_url FROM product_media m
+				WHERE m.product_id = p.id AND m.media_type = 'image'
+				ORDER BY m.is_thumbnail DESC, m.display_order, m.created_at
+				LIMIT 1
+			)
+		FROM products p
+		WHERE p.shop_id = $2
+		  AND p.deleted_at IS NULL
+		  AND p.category_id IN (SELECT id FROM subtree)
+		ORDER BY p.sort_order, p.name, p.id
+		LIMIT $4 OFFSET $5
+	`, id, shopID, maxCategoryDepth, pageSize, (page-1)*pageSize)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list category products", "error", err, "categoryID", id)
+		return nil, err
+	}
+	defer rows.Close()
+
+	products := make([]*domain.CategoryProductSummary, 0)
+	for rows.Next() {
+		var p domain.CategoryProductSummary
+		if err := rows.Scan(&p.ID, &p.Name, &p.SKU, &p.Price, &p.StockQuantity, &p.IsActive, &p.ThumbnailURL); err != nil {
+			return nil, err
+		}
+		products = append(products, &p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &domain.CategoryWithProducts{
+		Category:      c,
+		Products:      products,
+		TotalProducts: total,
+	}, nil
+}
+
+// categoryWriteError turns a violated slug index into
+// domain.ErrCategoryConflict.
+func categoryWriteError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		return domain.ErrCategoryConflict
+	}
+	return err
+}
+
+func scanCategory(row interface{ Scan(...any) error }) (*domain.Category, error) {
+	var c domain.Category
+	err := row.Scan(
+		&c.ID,
+		&c.ShopID,
+		&c.Name,
+		&c.Slug,
+		&c.ParentID,
+		&c.Description,
+		&c.ImageURL,
+		&c.DisplayOrder,
+		&c.IsActive,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+		&c.DeletedAt,
+		&c.ProductCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -8,6 +8,8 @@ import (
 
 	pagination "hpkg/constants"
 	"productservice/internal/domain"
+
+	"github.com/lib/pq"
 )
 
 type PostgresProductRepository struct {
@@ -105,7 +107,7 @@ func (r *PostgresProductRepository) ListByShopID(
 	listArgPos := argPos
 
 	listQuery := `
-		SELECT id, shop_id, owner_id, name, category, price,
+		SELECT id, shop_id, owner_id, name, category, price, currency,
 		       description, detail, created_at, updated_at, deleted_at
 	` + baseWhere
 
@@ -159,7 +161,7 @@ func (r *PostgresProductRepository) ListByShopID(
 		var p domain.Product
 		if err := rows.Scan(
 			&p.ID, &p.ShopID, &p.OwnerID,
-			&p.Name, &p.Category, &p.Price,
+			&p.Name, &p.Category, &p.Price, &p.Currency,
 			&p.Description, &p.Detail,
 			&p.CreatedAt, &p.UpdatedAt, &p.DeletedAt,
 		); err != nil {
@@ -209,6 +211,8 @@ func (r *PostgresProductRepository) GetByID(
 			name,
 			category,
 			price,
+			currency,
+			description,
 			detail,
 			created_at,
 			updated_at,
@@ -226,6 +230,7 @@ func (r *PostgresProductRepository) GetByID(
 		&p.Name,
 		&p.Category,
 		&p.Price,
+		&p.Currency,
 		&p.Description,
 		&p.Detail,
 		&p.CreatedAt,
@@ -273,11 +278,12 @@ func (r *PostgresProductRepository) Create(
 			owner_id,
 			name,
 			price,
+			currency,
 			category,
 			description,
 			detail
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 		RETURNING
 			id,
 			shop_id,
@@ -285,6 +291,7 @@ func (r *PostgresProductRepository) Create(
 			name,
 			category,
 			price,
+			currency,
 			description,
 			detail,
 			created_at,
@@ -294,6 +301,7 @@ func (r *PostgresProductRepository) Create(
 		req.OwnerID,
 		req.Name,
 		req.Price,
+		req.Currency,
 		req.Category,
 		req.Description,
 		req.Detail,
@@ -307,6 +315,7 @@ func (r *PostgresProductRepository) Create(
 		&p.Name,
 		&p.Category,
 		&p.Price,
+		&p.Currency,
 		&p.Description,
 		&p.Detail,
 		&p.CreatedAt,
@@ -349,6 +358,7 @@ func (r *PostgresProductRepository) Update(
 			price = $4,
 			description = $5,
 			detail = $6,
+			currency = COALESCE(NULLIF($7, ''), currency),
 			updated_at = now()
 		WHERE id = $1
 		  AND deleted_at IS NULL
@@ -359,6 +369,8 @@ func (r *PostgresProductRepository) Update(
 			name,
 			category,
 			price,
+			currency,
+			description,
 			detail,
 			created_at,
 			updated_at
@@ -367,7 +379,9 @@ func (r *PostgresProductRepository) Update(
 		req.Name,
 		req.Category,
 		req.Price,
+		req.Description,
 		req.Detail,
+		req.Currency,
 	)
 
 	var p domain.Product
@@ -378,6 +392,7 @@ func (r *PostgresProductRepository) Update(
 		&p.Name,
 		&p.Category,
 		&p.Price,
+		&p.Currency,
 		&p.Description,
 		&p.Detail,
 		&p.CreatedAt,
@@ -443,3 +458,122 @@ func (r *PostgresProductRepository) Delete(
 
 	return nil
 }
+
+func (r *PostgresProductRepository) GetCatalogItems(
+	ctx context.Context,
+	shopID string,
+	ids []string,
+) ([]*domain.CatalogItem, error) {
+
+	r.logger.DebugContext(ctx, "fetching catalog items",
+		"shopID", shopID,
+		"count", len(ids),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			id,
+			shop_id,
+			name,
+			price,
+			currency,
+			COALESCE(tax_rate, 0),
+			COALESCE(is_taxable, true),
+			COALESCE(is_active, true),
+			COALESCE(category_id::text, '')
+		FROM products
+		WHERE shop_id = $1
+		  AND id = ANY($2::uuid[])
+		  AND deleted_at IS NULL
+	`, shopID, pq.Array(ids))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query catalog items",
+			"error", err,
+			"shopID", shopID,
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*domain.CatalogItem, 0, len(ids))
+	for rows.Next() {
+		var item domain.CatalogItem
+		if err := rows.Scan(
+			&item.ID,
+			&item.ShopID,
+			&item.Name,
+			&item.Price,
+			&item.Currency,
+			&item.TaxRate,
+			&item.IsTaxable,
+			&item.IsActive,
+			&item.CategoryID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// GetCatalogVariants fetches the pricing view of variants of the shop's
+// products, skipping IDs that are not among them.
+func (r *PostgresProductRepository) GetCatalogVariants(
+	ctx context.Context,
+	shopID string,
+	ids []string,
+) ([]*domain.CatalogVariant, error) {
+
+	r.logger.DebugContext(ctx, "fetching catalog variants",
+		"shopID", shopID,
+		"count", len(ids),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			v.id,
+			v.product_id,
+			v.name,
+			v.price,
+			COALESCE(v.is_active, true)
+		FROM product_variants v
+		JOIN products p ON p.id = v.product_id
+		WHERE p.shop_id = $1
+		  AND v.id = ANY($2::uuid[])
+		  AND p.deleted_at IS NULL
+	`, shopID, pq.Array(ids))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query catalog variants",
+			"error", err,
+			"shopID", shopID,
+		)
+		return nil, err
+	}
+	defer rows.Close()
+
+	variants := make([]*domain.CatalogVariant, 0, len(ids))
+	for rows.Next() {
+		var v domain.CatalogVariant
+		if err := rows.Scan(
+			&v.ID,
+			&v.ProductID,
+			&v.Name,
+			&v.Price,
+			&v.IsActive,
+		); err != nil {
+			return nil, err
+		}
+		variants = append(variants, &v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return variants, nil
+}
@@ -0,0 +1,738 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"productservice/internal/domain"
+)
+
+const reservationColumns = `
+	id,
+	shop_id,
+	order_id,
+	status,
+	release_reason,
+	expires_at,
+	created_at,
+	updated_at
+`
+
+// querier is satisfied by both *sql.DB and *sql.Tx.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// ReserveStock deducts the requested quantities and records them against the
+// order. Stock is only taken from products that track inventory, and never
+// below zero unless the product allows backorders; the conditional UPDATE
+// makes the check and the deduction a single atomic step, and items are locked
+// in product order so concurrent checkouts cannot deadlock.
+//
+// Reserving an order that already has a reservation returns the existing one,
+// or ErrReservationClosed or ErrReservationLapsed when it was released or has
+// expired.
+func (r *PostgresProductRepository) ReserveStock(
+	ctx context.Context,
+	shopID string,
+	orderID string,
+	items []*domain.StockReservationItem,
+	expiresAt time.Time,
+) (*domain.StockReservation, error) {
+
+	r.logger.DebugContext(ctx, "reserving stock",
+		"shopID", shopID,
+		"orderID", orderID,
+		"items", len(items),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to begin reservation transaction",
+			"error", err,
+			"orderID", orderID,
+		)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := scanReservation(tx.QueryRowContext(ctx, `
+		INSERT INTO stock_reservations (shop_id, order_id, status, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (shop_id, order_id) DO NOTHING
+		RETURNING `+reservationColumns,
+		shopID, orderID, domain.ReservationActive, expiresAt,
+	))
+	if err == sql.ErrNoRows {
+		return r.existingReservation(ctx, tx, shopID, orderID)
+	}
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to create reservation",
+			"error", err,
+			"orderID", orderID,
+		)
+		return nil, err
+	}
+
+	sorted := make([]*domain.StockReservationItem, len(items))
+	copy(sorted, items)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].ProductID != sorted[j].ProductID {
+			return sorted[i].ProductID < sorted[j].ProductID
+		}
+		return ptrValue(sorted[i].VariantID) < ptrValue(sorted[j].VariantID)
+	})
+
+	for _, item := range sorted {
+		if err := r.deductStock(ctx, tx, shopID, item); err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO stock_reservation_items (reservation_id, product_id, variant_id, quantity, stock_deducted)
+			VALUES ($1, $2, $3, $4, $5)
+		`, res.ID, item.ProductID, item.VariantID, item.Quantity, item.StockDeducted); err != nil {
+			r.logger.ErrorContext(ctx, "failed to insert reservation item",
+				"error", err,
+				"orderID", orderID,
+				"productID", item.ProductID,
+			)
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.ErrorContext(ctx, "failed to commit reservation",
+			"error", err,
+			"orderID", orderID,
+		)
+		return nil, err
+	}
+
+	res.Items = sorted
+
+	r.logger.InfoContext(ctx, "stock reserved successfully",
+		"reservationID", res.ID,
+		"orderID", orderID,
+		"expiresAt", res.ExpiresAt,
+	)
+
+	return res, nil
+}
+
+// existingReservation returns the order's reservation when ReserveStock finds
+// one already made. Only a reservation still holding its stock counts; one
+// released or expired gave its stock back and cannot stand for a new one.
+func (r *PostgresProductRepository) existingReservation(
+	ctx context.Context,
+	tx *sql.Tx,
+	shopID string,
+	orderID string,
+) (*domain.StockReservation, error) {
+
+	res, err := r.lockReservation(ctx, tx, shopID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.Status == domain.ReservationReleased:
+		return nil, domain.ErrReservationClosed
+	case res.Status == domain.ReservationExpired,
+		res.Status == domain.ReservationActive && !res.ExpiresAt.After(time.Now()):
+		return nil, domain.ErrReservationLapsed
+	}
+
+	if res.Items, err = loadReservationItems(ctx, tx, res.ID); err != nil {
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "stock already reserved for order",
+		"reservationID", res.ID,
+		"orderID", orderID,
+		"status", res.Status,
+	)
+
+	return res, nil
+}
+
+func (r *PostgresProductRepository) deductStock(
+	ctx context.Context,
+	tx *sql.Tx,
+	shopID string,
+	item *domain.StockReservationItem,
+) error {
+
+	var trackInventory, allowBackorder bool
+	if err := tx.QueryRowContext(ctx, `
+		SELECT
+			COALESCE(track_inventory, true),
+			COALESCE(allow_backorder, false)
+		FROM products
+		WHERE id = $1
+		  AND shop_id = $2
+		  AND COALESCE(is_active, true)
+		  AND deleted_at IS NULL
+	`, item.ProductID, shopID).Scan(&trackInventory, &allowBackorder); err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "product not found for reservation",
+				"productID", item.ProductID,
+			)
+			return domain.ErrStockProductGone
+		}
+		r.logger.ErrorContext(ctx, "failed to load product inventory settings",
+			"error", err,
+			"productID", item.ProductID,
+		)
+		return err
+	}
+
+	if !trackInventory {
+		item.StockDeducted = false
+		return nil
+	}
+
+	var res sql.Result
+	var err error
+	if item.VariantID != nil {
+		res, err = tx.ExecContext(ctx, `
+			UPDATE product_variants
+			SET
+				stock_quantity = COALESCE(stock_quantity, 0) - $3,
+				updated_at = now()
+			WHERE id = $1
+			  AND product_id = $2
+			  AND COALESCE(is_active, true)
+			  AND ($4 OR COALESCE(stock_quantity, 0) >= $3)
+		`, *item.VariantID, item.ProductID, item.Quantity, allowBackorder)
+	} else {
+		res, err = tx.ExecContext(ctx, `
+			UPDATE products
+			SET
+				stock_quantity = COALESCE(stock_quantity, 0) - $3,
+				updated_at = now()
+			WHERE id = $1
+			  AND shop_id = $2
+			  AND ($4 OR COALESCE(stock_quantity, 0) >= $3)
+		`, item.ProductID, shopID, item.Quantity, allowBackorder)
+	}
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to deduct stock",
+			"error", err,
+			"productID", item.ProductID,
+		)
+		return err
+	}
+
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		r.logger.WarnContext(ctx, "insufficient stock for reservation",
+			"productID", item.ProductID,
+			"variantID", ptrValue(item.VariantID),
+			"quantity", item.Quantity,
+		)
+		return domain.ErrInsufficientStock
+	}
+
+	item.StockDeducted = true
+	return nil
+}
+
+// ReleaseStock returns the reserved quantities to stock. Committed
+// reservations can be released too, e.g. when a confirmed order is cancelled
+// before it ships. Releasing twice is a no-op.
+func (r *PostgresProductRepository) ReleaseStock(
+	ctx context.Context,
+	shopID string,
+	orderID string,
+	reason *string,
+) (*domain.StockReservation, error) {
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to begin release transaction",
+			"error", err,
+			"orderID", orderID,
+		)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := r.lockReservation(ctx, tx, shopID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Status == domain.ReservationActive || res.Status == domain.ReservationCommitted {
+		if err := r.restock(ctx, tx, res.ID); err != nil {
+			return nil, err
+		}
+		if res, err = r.setReservationStatus(ctx, tx, res.ID, domain.ReservationReleased, reason); err != nil {
+			return nil, err
+		}
+	}
+
+	if res.Items, err = loadReservationItems(ctx, tx, res.ID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.ErrorContext(ctx, "failed to commit release",
+			"error", err,
+			"orderID", orderID,
+		)
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "stock reservation released",
+		"reservationID", res.ID,
+		"orderID", orderID,
+	)
+
+	return res, nil
+}
+
+// CommitStock makes an active reservation permanent so it no longer expires.
+// Committing twice is a no-op.
+func (r *PostgresProductRepository) CommitStock(
+	ctx context.Context,
+	shopID string,
+	orderID string,
+) (*domain.StockReservation, error) {
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to begin commit transaction",
+			"error", err,
+			"orderID", orderID,
+		)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := r.lockReservation(ctx, tx, shopID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.Status == domain.ReservationReleased:
+		return nil, domain.ErrReservationClosed
+	case res.Status == domain.ReservationExpired,
+		res.Status == domain.ReservationActive && !res.ExpiresAt.After(time.Now()):
+		return nil, domain.ErrReservationLapsed
+	case res.Status == domain.ReservationActive:
+		if res, err = r.setReservationStatus(ctx, tx, res.ID, domain.ReservationCommitted, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if res.Items, err = loadReservationItems(ctx, tx, res.ID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.ErrorContext(ctx, "failed to commit reservation status",
+			"error", err,
+			"orderID", orderID,
+		)
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "stock reservation committed",
+		"reservationID", res.ID,
+		"orderID", orderID,
+	)
+
+	return res, nil
+}
+
+func (r *PostgresProductRepository) GetReservation(
+	ctx context.Context,
+	shopID string,
+	orderID string,
+) (*domain.StockReservation, error) {
+
+	res, err := scanReservation(r.db.QueryRowContext(ctx, `
+		SELECT `+reservationColumns+`
+		FROM stock_reservations
+		WHERE shop_id = $1
+		  AND order_id = $2
+	`, shopID, orderID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "stock reservation not found",
+				"orderID", orderID,
+			)
+			return nil, err
+		}
+		r.logger.ErrorContext(ctx, "failed to fetch stock reservation",
+			"error", err,
+			"orderID", orderID,
+		)
+		return nil, err
+	}
+
+	if res.Items, err = loadReservationItems(ctx, r.db, res.ID); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ExpireReservations releases up to limit active reservations whose hold has
+// run out and returns how many were expired. Rows locked by another worker
+// are skipped.
+func (r *PostgresProductRepository) ExpireReservations(
+	ctx context.Context,
+	limit int,
+) (int, error) {
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to begin expiry transaction",
+			"error", err,
+		)
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id
+		FROM stock_reservations
+		WHERE status = $1
+		  AND expires_at <= now()
+		ORDER BY expires_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`, domain.ReservationActive, limit)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to query expired reservations",
+			"error", err,
+		)
+		return 0, err
+	}
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, id := range ids {
+		if err := r.restock(ctx, tx, id); err != nil {
+			return 0, err
+		}
+		if _, err := r.setReservationStatus(ctx, tx, id, domain.ReservationExpired, nil); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.ErrorContext(ctx, "failed to commit expired reservations",
+			"error", err,
+		)
+		return 0, err
+	}
+
+	if len(ids) > 0 {
+		r.logger.InfoContext(ctx, "expired stock reservations",
+			"count", len(ids),
+		)
+	}
+
+	return len(ids), nil
+}
+
+func (r *PostgresProductRepository) lockReservation(
+	ctx context.Context,
+	tx *sql.Tx,
+	shopID string,
+	orderID string,
+) (*domain.StockReservation, error) {
+
+	res, err := scanReservation(tx.QueryRowContext(ctx, `
+		SELECT `+reservationColumns+`
+		FROM stock_reservations
+		WHERE shop_id = $1
+		  AND order_id = $2
+		FOR UPDATE
+	`, shopID, orderID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "stock reservation not found",
+				"orderID", orderID,
+			)
+			return nil, err
+		}
+		r.logger.ErrorContext(ctx, "failed to lock stock reservation",
+			"error", err,
+			"orderID", orderID,
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *PostgresProductRepository) setReservationStatus(
+	ctx context.Context,
+	tx *sql.Tx,
+	reservationID string,
+	status string,
+	reason *string,
+) (*domain.StockReservation, error) {
+
+	res, err := scanReservation(tx.QueryRowContext(ctx, `
+		UPDATE stock_reservations
+		SET
+			status = $2,
+			release_reason = COALESCE($3, release_reason),
+			updated_at = now()
+		WHERE id = $1
+		RETURNING `+reservationColumns,
+		reservationID, status, reason,
+	))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to update reservation status",
+			"error", err,
+			"reservationID", reservationID,
+			"status", status,
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// restock adds the deducted quantities of a reservation back to its products
+// and variants.
+func (r *PostgresProductRepository) restock(
+	ctx context.Context,
+	tx *sql.Tx,
+	reservationID string,
+) error {
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE products p
+		SET
+			stock_quantity = COALESCE(p.stock_quantity, 0) + i.quantity,
+			updated_at = now()
+		FROM (
+			SELECT product_id, SUM(quantity) AS quantity
+			FROM stock_reservation_items
+			WHERE reservation_id = $1
+			  AND stock_deducted
+			  AND variant_id IS NULL
+			GROUP BY product_id
+		) i
+		WHERE p.id = i.product_id
+	`, reservationID); err != nil {
+		r.logger.ErrorContext(ctx, "failed to restock products",
+			"error", err,
+			"reservationID", reservationID,
+		)
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE product_variants v
+		SET
+			stock_quantity = COALESCE(v.stock_quantity, 0) + i.quantity,
+			updated_at = now()
+		FROM (
+			SELECT variant_id, SUM(quantity) AS quantity
+			FROM stock_reservation_items
+			WHERE reservation_id = $1
+			  AND stock_deducted
+			  AND variant_id IS NOT NULL
+			GROUP BY variant_id
+		) i
+		WHERE v.id = i.variant_id
+	`, reservationID); err != nil {
+		r.logger.ErrorContext(ctx, "failed to restock variants",
+			"error", err,
+			"reservationID", reservationID,
+		)
+		return err
+	}
+
+	return nil
+}
+
+func loadReservationItems(
+	ctx context.Context,
+	q querier,
+	reservationID string,
+) ([]*domain.StockReservationItem, error) {
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT product_id, variant_id, quantity, stock_deducted
+		FROM stock_reservation_items
+		WHERE reservation_id = $1
+		ORDER BY product_id, variant_id
+	`, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*domain.StockReservationItem, 0)
+	for rows.Next() {
+		var item domain.StockReservationItem
+		if err := rows.Scan(
+			&item.ProductID,
+			&item.VariantID,
+			&item.Quantity,
+			&item.StockDeducted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+
+	return items, rows.Err()
+}
+
+func scanReservation(row interface{ Scan(...any) error }) (*domain.StockReservation, error) {
+	var res domain.StockReservation
+	if err := row.Scan(
+		&res.ID,
+		&res.ShopID,
+		&res.OrderID,
+		&res.Status,
+		&res.ReleaseReason,
+		&res.ExpiresAt,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func ptrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// RestockItems adds quantities back to products and variants outside any
+// reservation, e.g. for returned goods. Products that do not track inventory
+// are recorded but left unchanged. A restock whose reference was already
+// applied for the shop is skipped and reported by the returned bool.
+func (r *PostgresProductRepository) RestockItems(
+	ctx context.Context,
+	shopID string,
+	referenceID string,
+	items []*domain.StockReservationItem,
+	reason *string,
+) (bool, error) {
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to begin restock transaction",
+			"error", err,
+			"referenceID", referenceID,
+		)
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var restockID string
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO stock_restocks (shop_id, reference_id, reason)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (shop_id, reference_id) DO NOTHING
+		RETURNING id
+	`, shopID, referenceID, reason).Scan(&restockID)
+	if err == sql.ErrNoRows {
+		return true, nil
+	}
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to insert restock",
+			"error", err,
+			"referenceID", referenceID,
+		)
+		return false, err
+	}
+
+	for _, item := range items {
+		var trackInventory bool
+		if err := tx.QueryRowContext(ctx, `
+			SELECT COALESCE(track_inventory, true)
+			FROM products
+			WHERE id = $1 AND shop_id = $2
+		`, item.ProductID, shopID).Scan(&trackInventory); err != nil {
+			if err == sql.ErrNoRows {
+				return false, domain.ErrStockProductGone
+			}
+			return false, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO stock_restock_items (restock_id, product_id, variant_id, quantity)
+			VALUES ($1, $2, $3, $4)
+		`, restockID, item.ProductID, item.VariantID, item.Quantity); err != nil {
+			r.logger.ErrorContext(ctx, "failed to insert restock item",
+				"error", err,
+				"productID", item.ProductID,
+			)
+			return false, err
+		}
+
+		if !trackInventory {
+			continue
+		}
+
+		if item.VariantID != nil {
+			_, err = tx.ExecContext(ctx, `
+				UPDATE product_variants
+				SET
+					stock_quantity = COALESCE(stock_quantity, 0) + $3,
+					updated_at = now()
+				WHERE id = $1 AND product_id = $2
+			`, *item.VariantID, item.ProductID, item.Quantity)
+		} else {
+			_, err = tx.ExecContext(ctx, `
+				UPDATE products
+				SET
+					stock_quantity = COALESCE(stock_quantity, 0) + $3,
+					updated_at = now()
+				WHERE id = $1 AND shop_id = $2
+			`, item.ProductID, shopID, item.Quantity)
+		}
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to restock item",
+				"error", err,
+				"productID", item.ProductID,
+			)
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.ErrorContext(ctx, "failed to commit restock",
+			"error", err,
+			"referenceID", referenceID,
+		)
+		return false, err
+	}
+
+	r.logger.InfoContext(ctx, "items restocked",
+		"referenceID", referenceID,
+		"items", len(items),
+	)
+
+	return false, nil
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"log/slog"
 	"productservice/internal/domain"
+	"strings"
+
+	"github.com/lib/pq"
 )
 
 type PostgresTagRepository struct {
@@ -33,16 +36,50 @@ func (r *PostgresTagRepository) Create(ctx context.Context, tag domain.Tag) (*do
 
 	if err != nil {
 		r.logger.ErrorContext(ctx, "failed to create tag", "error", err, "name", tag.Name)
-		return nil, err
+		return nil, tagWriteError(err)
 	}
 	return &tag, nil
 }
 
+// Update changes the name and slug of a tag, when they are given
+func (r *PostgresTagRepository) Update(ctx context.Context, shopID, id string, name, slug *string) (*domain.Tag, error) {
+	args := []any{id, shopID}
+	sets := []string{"updated_at = now()"}
+	if name != nil {
+		args = append(args, *name)
+		sets = append(sets, fmt.Sprintf("name = $%d", len(args)))
+	}
+	if slug != nil {
+		args = append(args, *slug)
+		sets = append(sets, fmt.Sprintf("slug = $%d", len(args)))
+	}
+
+	query := `
+		UPDATE tags SET ` + strings.Join(sets, ", ") + `
+		WHERE id = $1 AND shop_id = $2 AND deleted_at IS NULL
+		RETURNING id, shop_id, name, slug, created_at, updated_at
+	`
+	var t domain.Tag
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(
+		&t.ID, &t.ShopID, &t.Name, &t.Slug, &t.CreatedAt, &t.UpdatedAt,
+	)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			r.logger.ErrorContext(ctx, "failed to update tag", "error", err, "tagID", id)
+		}
+		return nil, tagWriteError(err)
+	}
+	return &t, nil
+}
+
 // GetByID fetches a single tag, optionally with the product count
 func (r *PostgresTagRepository) GetByID(ctx context.Context, shopID, id string, includeCount bool) (*domain.Tag, int32, error) {
 	countSubquery := "0 as product_count"
 	if includeCount {
-		countSubquery = "(SELECT count(1) FROM product_tags pt WHERE pt.tag_id = tags.id) as product_count"
+		countSubquery = `(
+			SELECT count(1) FROM product_tags pt JOIN products p ON p.id = pt.product_id
+			WHERE pt.tag_id = tags.id AND p.deleted_at IS NULL
+		) as product_count`
 	}
 
 	query := fmt.Sprintf(`
@@ -79,7 +116,10 @@ func (r *PostgresTagRepository) GetDetail(ctx context.Context, shopID, id string
 	// 2. Get Total Count of products for this tag
 	var total int32
 	err = r.db.QueryRowContext(ctx, `
-		SELECT count(1) FROM product_tags WHERE tag_id = $1
+		SELECT count(1)
+		FROM product_tags pt
+		JOIN products p ON p.id = pt.product_id
+		WHERE pt.tag_id = $1 AND p.deleted_at IS NULL
 	`, id).Scan(&total)
 	if err != nil {
 		return nil, nil, 0, err
@@ -150,7 +190,10 @@ func (r *PostgresTagRepository) List(
 	// Main Query
 	countSubquery := "0 as product_count"
 	if includeCount {
-		countSubquery = "(SELECT count(1) FROM product_tags pt WHERE pt.tag_id = tags.id) as product_count"
+		countSubquery = `(
+			SELECT count(1) FROM product_tags pt JOIN products p ON p.id = pt.product_id
+			WHERE pt.tag_id = tags.id AND p.deleted_at IS NULL
+		) as product_count`
 	}
 
 	query := fmt.Sprintf(`
@@ -195,6 +238,18 @@ func (r *PostgresTagRepository) AssignToProduct(
 	}
 	defer tx.Rollback()
 
+	// Only the shop's own products can be tagged
+	var exists bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM products WHERE id = $1 AND shop_id = $2 AND deleted_at IS NULL)
+	`, productID, shopID).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, sql.ErrNoRows
+	}
+
 	if replace {
 		_, err = tx.ExecContext(ctx, "DELETE FROM product_tags WHERE product_id = $1", productID)
 		if err != nil {
@@ -206,7 +261,7 @@ func (r *PostgresTagRepository) AssignToProduct(
 		// Ensure tag belongs to the shop before assigning
 		_, err = tx.ExecContext(ctx, `
 			INSERT INTO product_tags (product_id, tag_id)
-			SELECT $1, $2 WHERE EXISTS (SELECT 1 FROM tags WHERE id = $2 AND shop_id = $3)
+			SELECT $1, $2 WHERE EXISTS (SELECT 1 FROM tags WHERE id = $2 AND shop_id = $3 AND deleted_at IS NULL)
 			ON CONFLICT DO NOTHING
 		`, productID, tagID, shopID)
 		if err != nil {
@@ -244,11 +299,11 @@ func (r *PostgresTagRepository) RemoveFromProduct(
 		WHERE product_id = $1
 		  AND tag_id IN (
 			  SELECT id FROM tags 
-			  WHERE id = ANY($2) AND shop_id = $3
+			  WHERE id = ANY($2::uuid[]) AND shop_id = $3
 		  )
 	`
 
-	res, err := r.db.ExecContext(ctx, query, productID, tagIDs, shopID)
+	res, err := r.db.ExecContext(ctx, query, productID, pq.Array(tagIDs), shopID)
 	if err != nil {
 		r.logger.ErrorContext(ctx, "failed to remove tags from product",
 			"error", err,
@@ -292,11 +347,37 @@ func (r *PostgresTagRepository) GetByProductID(ctx context.Context, shopID, prod
 	return tags, nil
 }
 
-// Delete a tag (Soft Delete)
+// Delete a tag (Soft Delete). Products keep no link to a deleted tag.
 func (r *PostgresTagRepository) Delete(ctx context.Context, shopID, id string) error {
-	_, err := r.db.ExecContext(ctx, `
-		UPDATE tags SET deleted_at = now() 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE tags SET deleted_at = now(), updated_at = now()
 		WHERE id = $1 AND shop_id = $2 AND deleted_at IS NULL
 	`, id, shopID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete tag", "error", err, "tagID", id)
+		return err
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM product_tags WHERE tag_id = $1", id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// tagWriteError turns a violated slug index into domain.ErrTagConflict
+func tagWriteError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		return domain.ErrTagConflict
+	}
 	return err
 }
@@ -0,0 +1,427 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"productservice/internal/domain"
+
+	"github.com/lib/pq"
+)
+
+type PostgresVariantRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewPostgresVariantRepository(db *sql.DB, logger *slog.Logger) *PostgresVariantRepository {
+	return &PostgresVariantRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const variantColumns = `
+	v.id, v.product_id, v.sku, v.barcode, v.name, v.price, v.cost_price, v.compare_at_price,
+	COALESCE(v.stock_quantity, 0), v.weight, COALESCE(v.attributes, '{}'::jsonb),
+	COALESCE(v.is_active, true), v.created_at, v.updated_at
+`
+
+// GetProduct fetches the shop's product that variants hang off.
+func (r *PostgresVariantRepository) GetProduct(ctx context.Context, shopID, productID string) (*domain.VariantProduct, error) {
+	var p domain.VariantProduct
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, name, sku, price, COALESCE(is_active, true)
+		FROM products
+		WHERE id = $1 AND shop_id = $2 AND deleted_at IS NULL
+	`, productID, shopID).Scan(&p.ID, &p.Name, &p.SKU, &p.Price, &p.IsActive)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			r.logger.ErrorContext(ctx, "failed to get variant product", "error", err, "productID", productID)
+		}
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ListOptions returns the product's options in order.
+func (r *PostgresVariantRepository) ListOptions(ctx context.Context, shopID, productID string) ([]*domain.VariantOption, error) {
+	if _, err := r.GetProduct(ctx, shopID, productID); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, product_id, name, "values", position, created_at, updated_at
+		FROM product_options
+		WHERE product_id = $1
+		ORDER BY position, name
+	`, productID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list product options", "error", err, "productID", productID)
+		return nil, err
+	}
+	defer rows.Close()
+
+	options := make([]*domain.VariantOption, 0)
+	for rows.Next() {
+		var o domain.VariantOption
+		if err := rows.Scan(
+			&o.ID, &o.ProductID, &o.Name, pq.Array(&o.Values), &o.Position, &o.CreatedAt, &o.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		options = append(options, &o)
+	}
+	return options, rows.Err()
+}
+
+// SetOptions replaces the product's options. Existing variants are kept as
+// they are, even when their attributes no longer match.
+func (r *PostgresVariantRepository) SetOptions(
+	ctx context.Context,
+	shopID, productID string,
+	options []*domain.VariantOption,
+) ([]*domain.VariantOption, error) {
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := checkProduct(ctx, tx, shopID, productID); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_options WHERE product_id = $1`, productID); err != nil {
+		r.logger.ErrorContext(ctx, "failed to clear product options", "error", err, "productID", productID)
+		return nil, err
+	}
+
+	saved := make([]*domain.VariantOption, 0, len(options))
+	for _, o := range options {
+		var out domain.VariantOption
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO product_options (product_id, name, "values", position)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id, product_id, name, "values", position, created_at, updated_at
+		`, productID, o.Name, pq.Array(o.Values), o.Position).Scan(
+			&out.ID, &out.ProductID, &out.Name, pq.Array(&out.Values), &out.Position, &out.CreatedAt, &out.UpdatedAt,
+		)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to save product option", "error", err, "productID", productID)
+			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+				return nil, domain.ErrVariantOptionInvalid
+			}
+			return nil, err
+		}
+		saved = append(saved, &out)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "product options set", "productID", productID, "count", len(saved))
+	return saved, nil
+}
+
+// Create adds variants to one of the shop's products, all or none.
+func (r *PostgresVariantRepository) Create(
+	ctx context.Context,
+	shopID, productID string,
+	variants []*domain.ProductVariant,
+) ([]*domain.ProductVariant, error) {
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := checkProduct(ctx, tx, shopID, productID); err != nil {
+		return nil, err
+	}
+
+	created := make([]*domain.ProductVariant, 0, len(variants))
+	for _, v := range variants {
+		out, err := scanVariant(tx.QueryRowContext(ctx, `
+			INSERT INTO product_variants AS v (
+				product_id, sku, barcode, name, price, cost_price, compare_at_price,
+				stock_quantity, weight, attributes, is_active
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10::jsonb, '{}'::jsonb), $11)
+			RETURNING `+variantColumns,
+			productID, v.SKU, v.Barcode, v.Name, v.Price, v.CostPrice, v.CompareAtPrice,
+			v.StockQuantity, v.Weight, nullJSON(v.Attributes), v.IsActive,
+		))
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to create variant", "error", err, "productID", productID, "name", v.Name)
+			return nil, variantWriteError(err)
+		}
+		created = append(created, out)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "variants created", "productID", productID, "shopID", shopID, "count", len(created))
+	return created, nil
+}
+
+// GetByID fetches a variant of one of the shop's products.
+func (r *PostgresVariantRepository) GetByID(ctx context.Context, shopID, id string) (*domain.ProductVariant, error) {
+	v, err := scanVariant(r.db.QueryRowContext(ctx, `
+		SELECT `+variantColumns+`
+		FROM product_variants v
+		JOIN products p ON p.id = v.product_id
+		WHERE v.id = $1 AND p.shop_id = $2 AND p.deleted_at IS NULL
+	`, id, shopID))
+	if err != nil {
+		if err != sql.ErrNoRows {
+			r.logger.ErrorContext(ctx, "failed to get variant", "error", err, "variantID", id)
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+// List the product's variants in the order they were created.
+func (r *PostgresVariantRepository) List(
+	ctx context.Context,
+	shopID, productID string,
+	isActive *bool,
+) ([]*domain.ProductVariant, error) {
+
+	if _, err := r.GetProduct(ctx, shopID, productID); err != nil {
+		return nil, err
+	}
+
+	where := "WHERE v.product_id = $1"
+	args := []any{productID}
+	if isActive != nil {
+		args = append(args, *isActive)
+		where += fmt.Sprintf(" AND COALESCE(v.is_active, true) = $%d", len(args))
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+variantColumns+`
+		FROM product_variants v
+		`+where+`
+		ORDER BY v.created_at, v.name
+	`, args...)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list variants", "error", err, "productID", productID)
+		return nil, err
+	}
+	defer rows.Close()
+
+	variants := make([]*domain.ProductVariant, 0)
+	for rows.Next() {
+		v, err := scanVariant(rows)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, v)
+	}
+	return variants, rows.Err()
+}
+
+// Update changes the fields of req that are set.
+func (r *PostgresVariantRepository) Update(ctx context.Context, req domain.UpdateVariantRequest) (*domain.ProductVariant, error) {
+	args := []any{req.ID, req.ShopID}
+	var sets []string
+	set := func(column string, v any) {
+		args = append(args, v)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if req.Name != nil {
+		set("name", *req.Name)
+	}
+	if req.SKU != nil {
+		set("sku", nonEmptyString(*req.SKU))
+	}
+	if req.Barcode != nil {
+		set("barcode", nonEmptyString(*req.Barcode))
+	}
+	if req.ClearPrice {
+		sets = append(sets, "price = NULL")
+	} else if req.Price != nil {
+		set("price", *req.Price)
+	}
+	if req.CostPrice != nil {
+		set("cost_price", *req.CostPrice)
+	}
+	if req.CompareAtPrice != nil {
+		set("compare_at_price", *req.CompareAtPrice)
+	}
+	if req.StockQuantity != nil {
+		set("stock_quantity", *req.StockQuantity)
+	}
+	if req.Weight != nil {
+		set("weight", *req.Weight)
+	}
+	if req.IsActive != nil {
+		set("is_active", *req.IsActive)
+	}
+	if req.Attributes != nil {
+		args = append(args, string(req.Attributes))
+		sets = append(sets, fmt.Sprintf("attributes = $%d::jsonb", len(args)))
+	}
+	sets = append(sets, "updated_at = now()")
+
+	v, err := scanVariant(r.db.QueryRowContext(ctx, `
+		UPDATE product_variants v
+		SET `+strings.Join(sets, ", ")+`
+		FROM products p
+		WHERE v.id = $1
+		  AND p.id = v.product_id
+		  AND p.shop_id = $2
+		  AND p.deleted_at IS NULL
+		RETURNING `+variantColumns,
+		args...,
+	))
+	if err != nil {
+		if err != sql.ErrNoRows {
+			r.logger.ErrorContext(ctx, "failed to update variant", "error", err, "variantID", req.ID)
+		}
+		return nil, variantWriteError(err)
+	}
+
+	r.logger.InfoContext(ctx, "variant updated", "variantID", v.ID, "productID", v.ProductID)
+	return v, nil
+}
+
+// Delete removes a variant. Reservations and restocks that name it go with
+// it.
+func (r *PostgresVariantRepository) Delete(ctx context.Context, shopID, id string) error {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM product_variants v
+		USING products p
+		WHERE v.id = $1 AND p.id = v.product_id AND p.shop_id = $2
+	`, id, shopID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete variant", "error", err, "variantID", id)
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+
+	r.logger.InfoContext(ctx, "variant deleted", "variantID", id, "shopID", shopID)
+	return nil
+}
+
+// AdjustStock adds c.QuantityChange, which may be negative, to the variant's
+// stock. Stock may only go below zero when the product does not track
+// inventory or allows backorders.
+func (r *PostgresVariantRepository) AdjustStock(
+	ctx context.Context,
+	shopID, variantID string,
+	c domain.StockChange,
+) (*domain.ProductVariant, error) {
+
+	v, err := scanVariant(r.db.QueryRowContext(ctx, `
+		UPDATE product_variants v
+		SET
+			stock_quantity = COALESCE(v.stock_quantity, 0) + $3,
+			updated_at = now()
+		FROM products p
+		WHERE v.id = $1
+		  AND p.id = v.product_id
+		  AND p.shop_id = $2
+		  AND p.deleted_at IS NULL
+		  AND (
+			NOT COALESCE(p.track_inventory, true)
+			OR COALESCE(p.allow_backorder, false)
+			OR COALESCE(v.stock_quantity, 0) + $3 >= 0
+		  )
+		RETURNING `+variantColumns,
+		variantID, shopID, c.QuantityChange,
+	))
+	if err == sql.ErrNoRows {
+		// Tell a missing variant from one without enough stock.
+		if _, err := r.GetByID(ctx, shopID, variantID); err != nil {
+			return nil, err
+		}
+		r.logger.WarnContext(ctx, "insufficient variant stock for adjustment",
+			"variantID", variantID,
+			"quantityChange", c.QuantityChange,
+		)
+		return nil, domain.ErrInsufficientStock
+	}
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to adjust variant stock",
+			"error", err,
+			"variantID", variantID,
+		)
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "variant stock adjusted",
+		"variantID", v.ID,
+		"productID", v.ProductID,
+		"quantityChange", c.QuantityChange,
+		"stockQuantity", v.StockQuantity,
+		"reason", c.Reason,
+	)
+
+	return v, nil
+}
+
+// checkProduct reports sql.ErrNoRows unless productID is one of the shop's
+// products.
+func checkProduct(ctx context.Context, tx *sql.Tx, shopID, productID string) error {
+	var exists bool
+	err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM products WHERE id = $1 AND shop_id = $2 AND deleted_at IS NULL
+		)
+	`, productID, shopID).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// variantWriteError tells a clash on the attribute combination from one on
+// the SKU or barcode.
+func variantWriteError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		if pqErr.Constraint == "unique_variant_attributes" {
+			return domain.ErrVariantAttributesConflict
+		}
+		return domain.ErrVariantConflict
+	}
+	return err
+}
+
+func nonEmptyString(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func scanVariant(row interface{ Scan(...any) error }) (*domain.ProductVariant, error) {
+	var v domain.ProductVariant
+	var attributes []byte
+	err := row.Scan(
+		&v.ID, &v.ProductID, &v.SKU, &v.Barcode, &v.Name, &v.Price, &v.CostPrice, &v.CompareAtPrice,
+		&v.StockQuantity, &v.Weight, &attributes,
+		&v.IsActive, &v.CreatedAt, &v.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	v.Attributes = attributes
+	return &v, nil
+}
@@ -2,11 +2,23 @@ package server
 
 import (
 	"context"
+	"database/sql"
+	"strings"
+
+	errors "hpkg/constants/responses"
 	pkg "hpkg/grpc"
 	"productservice/internal/domain"
 	"productservice/internal/domain/proto"
 	"productservice/internal/repository"
 	"productservice/proto/v1/categorypb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
 )
 
 type CategoryService struct {
@@ -18,51 +30,200 @@ func NewCategoryService(repo repository.PostgresCategoryRepository) *CategorySer
 	return &CategoryService{repo: repo}
 }
 
-func (s *CategoryService) Create(ctx context.Context, req *categorypb.CreateRequest) (*categorypb.CreateResponse, error) {
-	shopID, _ := pkg.MustGetShopID(ctx)
+func (s *CategoryService) Create(ctx context.Context, req *categorypb.CreateCategoryRequest) (*categorypb.CreateCategoryResponse, error) {
+	shopID, err := requestShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	slug := domain.Slugify(req.GetSlug())
+	if slug == "" {
+		slug = domain.Slugify(name)
+	}
+	if name == "" || slug == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrCategoryInvalidCode, errors.ErrCategoryInvalidMsg)
+	}
+
+	var parentID *string
+	if req.GetParentId() != "" {
+		parentID = req.ParentId
+	}
+
 	category, err := s.repo.Create(ctx, domain.Category{
-		ShopID:   shopID,
-		Name:     req.Name,
-		Slug:     req.GetSlug(),
-		ParentID: req.GetParentId(),
+		ShopID:       shopID,
+		Name:         name,
+		Slug:         slug,
+		ParentID:     parentID,
+		Description:  req.Description,
+		ImageURL:     req.ImageUrl,
+		DisplayOrder: req.DisplayOrder,
+		IsActive:     req.IsActive == nil || *req.IsActive,
 	})
 	if err != nil {
-		return nil, err
+		return nil, categoryError(err)
 	}
-	return &categorypb.CreateResponse{Category: proto.MapCategoryToProto(category)}, nil
+	return &categorypb.CreateCategoryResponse{Category: proto.MapCategoryToProto(category)}, nil
 }
 
-func (s *CategoryService) Get(ctx context.Context, req *categorypb.GetRequest) (*categorypb.GetResponse, error) {
-	shopID, _ := pkg.MustGetShopID(ctx)
-	category, err := s.repo.GetByID(ctx, shopID, req.Id)
+func (s *CategoryService) Get(ctx context.Context, req *categorypb.GetCategoryRequest) (*categorypb.GetCategoryResponse, error) {
+	shopID, err := requestShopID(ctx, req.ShopId)
 	if err != nil {
 		return nil, err
 	}
-	return &categorypb.GetResponse{Category: proto.MapCategoryToProto(category)}, nil
+
+	category, err := s.repo.GetByID(ctx, shopID, req.Id, req.IncludeParent, req.IncludeChildren, req.IncludeProductCount)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+	return &categorypb.GetCategoryResponse{Category: proto.MapCategoryToProto(category)}, nil
 }
 
-func (s *CategoryService) List(ctx context.Context, req *categorypb.ListRequest) (*categorypb.ListResponse, error) {
-	shopID, _ := pkg.MustGetShopID(ctx)
-	categories, total, err := s.repo.List(ctx, shopID, req.Search, int(req.Page), int(req.PageSize))
+func (s *CategoryService) List(ctx context.Context, req *categorypb.ListCategoriesRequest) (*categorypb.ListCategoriesResponse, error) {
+	shopID, err := requestShopID(ctx, req.ShopId)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &categorypb.ListResponse{
-		Categories: make([]*categorypb.CategoryInfo, 0),
+	page, pageSize := pagination(req.Page, req.PageSize)
+	categories, total, err := s.repo.List(ctx, domain.CategoryFilter{
+		ShopID:          shopID,
+		ParentID:        req.ParentId,
+		Search:          req.GetSearch(),
+		IncludeInactive: req.IncludeInactive,
+		IncludeCount:    req.IncludeProductCount,
+		Page:            page,
+		PageSize:        pageSize,
+	})
+	if err != nil {
+		return nil, categoryError(err)
+	}
+
+	resp := &categorypb.ListCategoriesResponse{
+		Categories: make([]*categorypb.Category, 0, len(categories)),
 		Total:      int32(total),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
 	}
 	for _, c := range categories {
-		resp.Categories = append(resp.Categories, proto.MapCategoryToInfo(c))
+		resp.Categories = append(resp.Categories, proto.MapCategoryToProto(c))
 	}
 	return resp, nil
 }
 
-func (s *CategoryService) GetTree(ctx context.Context, req *categorypb.GetTreeRequest) (*categorypb.GetTreeResponse, error) {
-	shopID, _ := pkg.MustGetShopID(ctx)
-	tree, err := s.repo.GetTree(ctx, shopID)
+func (s *CategoryService) Update(ctx context.Context, req *categorypb.UpdateCategoryRequest) (*categorypb.UpdateCategoryResponse, error) {
+	shopID, err := requestShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	var name, slug *string
+	if req.Name != nil {
+		n := strings.TrimSpace(*req.Name)
+		if n == "" {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrCategoryInvalidCode, errors.ErrCategoryInvalidMsg)
+		}
+		name = &n
+	}
+	if req.Slug != nil {
+		sl := domain.Slugify(*req.Slug)
+		if sl == "" {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrCategoryInvalidCode, errors.ErrCategoryInvalidMsg)
+		}
+		slug = &sl
+	}
+
+	category, err := s.repo.Update(ctx, domain.UpdateCategoryRequest{
+		ID:           req.Id,
+		ShopID:       shopID,
+		Name:         name,
+		Slug:         slug,
+		ParentID:     req.ParentId,
+		Description:  req.Description,
+		ImageURL:     req.ImageUrl,
+		DisplayOrder: req.DisplayOrder,
+		IsActive:     req.IsActive,
+	})
+	if err != nil {
+		return nil, categoryError(err)
+	}
+	return &categorypb.UpdateCategoryResponse{Category: proto.MapCategoryToProto(category)}, nil
+}
+
+func (s *CategoryService) Delete(ctx context.Context, req *categorypb.DeleteCategoryRequest) (*emptypb.Empty, error) {
+	shopID, err := requestShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, shopID, req.Id); err != nil {
+		return nil, categoryError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CategoryService) GetTree(ctx context.Context, req *categorypb.GetCategoryTreeRequest) (*categorypb.GetCategoryTreeResponse, error) {
+	shopID, err := requestShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := s.repo.GetTree(ctx, shopID, req.IncludeInactive, req.IncludeProductCount)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+	return &categorypb.GetCategoryTreeResponse{Nodes: proto.MapCategoryTreeToProto(tree)}, nil
+}
+
+func (s *CategoryService) GetWithProducts(ctx context.Context, req *categorypb.GetCategoryWithProductsRequest) (*categorypb.GetCategoryWithProductsResponse, error) {
+	shopID, err := requestShopID(ctx, req.ShopId)
 	if err != nil {
 		return nil, err
 	}
-	return &categorypb.GetTreeResponse{Categories: proto.MapCategoryTreeToProto(tree)}, nil
+
+	page, pageSize := pagination(req.Page, req.PageSize)
+	data, err := s.repo.GetWithProducts(ctx, shopID, req.Id, page, pageSize)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+	return &categorypb.GetCategoryWithProductsResponse{Data: proto.MapCategoryWithProductsToProto(data)}, nil
+}
+
+func categoryError(err error) error {
+	switch err {
+	case sql.ErrNoRows, domain.ErrCategoryNotFound:
+		return errors.GRPC(codes.NotFound, errors.ErrProductCategoryNotFoundCode, errors.ErrProductCategoryNotFoundMsg)
+	case domain.ErrCategoryConflict:
+		return errors.GRPC(codes.AlreadyExists, errors.ErrCategoryConflictCode, errors.ErrCategoryConflictMsg)
+	case domain.ErrCategoryCycle:
+		return errors.GRPC(codes.FailedPrecondition, errors.ErrCategoryParentInvalidCode, errors.ErrCategoryParentInvalidMsg)
+	default:
+		return errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+}
+
+// requestShopID returns the caller's shop. A shop_id sent in the request
+// must be that shop.
+func requestShopID(ctx context.Context, requested string) (string, error) {
+	shopID, err := pkg.MustGetShopID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if requested != "" && requested != shopID {
+		return "", errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+	return shopID, nil
+}
+
+func pagination(page, pageSize int32) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return int(page), int(pageSize)
 }
@@ -2,13 +2,17 @@ package server
 
 import (
 	"context"
-	pkg "hpkg/grpc"
+	"database/sql"
+	"strings"
+
+	errors "hpkg/constants/responses"
 	"productservice/internal/domain"
 	"productservice/internal/domain/proto"
 	"productservice/internal/repository"
 	"productservice/proto/v1/productpb"
 	"productservice/proto/v1/tagpb"
 
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -22,50 +26,77 @@ func NewTagService(repo repository.PostgresTagRepository) *TagService {
 }
 
 func (s *TagService) Create(ctx context.Context, req *tagpb.TagCreateRequest) (*tagpb.TagResponse, error) {
-	shopID, _ := pkg.MustGetShopID(ctx)
+	shopID, err := requestShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	slug := domain.Slugify(req.GetSlug())
+	if slug == "" {
+		slug = domain.Slugify(name)
+	}
+	if name == "" || slug == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrTagInvalidCode, errors.ErrTagInvalidMsg)
+	}
+
 	tag, err := s.repo.Create(ctx, domain.Tag{
 		ShopID: shopID,
-		Name:   req.Name,
-		Slug:   req.GetSlug(),
+		Name:   name,
+		Slug:   slug,
 	})
 	if err != nil {
-		return nil, err
+		return nil, tagError(err)
 	}
 	return &tagpb.TagResponse{Tag: proto.MapTagToStats(tag, 0)}, nil
 }
 
 func (s *TagService) Get(ctx context.Context, req *tagpb.TagGetRequest) (*tagpb.TagResponse, error) {
-	shopID, _ := pkg.MustGetShopID(ctx)
-	tag, count, err := s.repo.GetByID(ctx, shopID, req.Id, req.IncludeProductCount)
+	shopID, err := requestShopID(ctx, req.ShopId)
 	if err != nil {
 		return nil, err
 	}
+
+	tag, count, err := s.repo.GetByID(ctx, shopID, req.Id, req.IncludeProductCount)
+	if err != nil {
+		return nil, tagError(err)
+	}
 	return &tagpb.TagResponse{Tag: proto.MapTagToStats(tag, count)}, nil
 }
 
 func (s *TagService) GetDetail(ctx context.Context, req *tagpb.TagGetDetailRequest) (*tagpb.TagDetailResponse, error) {
-	shopID, _ := pkg.MustGetShopID(ctx)
-	tag, products, total, err := s.repo.GetDetail(ctx, shopID, req.Id, int(req.Page), int(req.PageSize))
+	shopID, err := requestShopID(ctx, req.ShopId)
 	if err != nil {
 		return nil, err
 	}
+
+	page, pageSize := pagination(req.Page, req.PageSize)
+	tag, products, total, err := s.repo.GetDetail(ctx, shopID, req.Id, page, pageSize)
+	if err != nil {
+		return nil, tagError(err)
+	}
 	return &tagpb.TagDetailResponse{
 		TagDetail: proto.MapTagToDetail(tag, products, total),
 	}, nil
 }
 
 func (s *TagService) List(ctx context.Context, req *tagpb.TagListRequest) (*tagpb.TagListResponse, error) {
-	shopID, _ := pkg.MustGetShopID(ctx)
-	tags, total, err := s.repo.List(ctx, shopID, *req.Search, req.IncludeProductCount, int(req.Page), int(req.PageSize))
+	shopID, err := requestShopID(ctx, req.ShopId)
 	if err != nil {
 		return nil, err
 	}
 
+	page, pageSize := pagination(req.Page, req.PageSize)
+	tags, total, err := s.repo.List(ctx, shopID, req.GetSearch(), req.IncludeProductCount, page, pageSize)
+	if err != nil {
+		return nil, tagError(err)
+	}
+
 	resp := &tagpb.TagListResponse{
 		Tags:     make([]*tagpb.TagStats, 0),
 		Total:    int32(total),
-		Page:     req.Page,
-		PageSize: req.PageSize,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
 	}
 	for _, t := range tags {
 		resp.Tags = append(resp.Tags, proto.MapTagToStats(&t.Tag, t.ProductCount))
@@ -73,13 +104,61 @@ func (s *TagService) List(ctx context.Context, req *tagpb.TagListRequest) (*tagp
 	return resp, nil
 }
 
+func (s *TagService) Update(ctx context.Context, req *tagpb.TagUpdateRequest) (*tagpb.TagResponse, error) {
+	shopID, err := requestShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	var name, slug *string
+	if req.Name != nil {
+		n := strings.TrimSpace(*req.Name)
+		if n == "" {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrTagInvalidCode, errors.ErrTagInvalidMsg)
+		}
+		name = &n
+	}
+	if req.Slug != nil {
+		sl := domain.Slugify(*req.Slug)
+		if sl == "" {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrTagInvalidCode, errors.ErrTagInvalidMsg)
+		}
+		slug = &sl
+	}
+
+	tag, err := s.repo.Update(ctx, shopID, req.Id, name, slug)
+	if err != nil {
+		return nil, tagError(err)
+	}
+	return &tagpb.TagResponse{Tag: proto.MapTagToStats(tag, 0)}, nil
+}
+
+func (s *TagService) Delete(ctx context.Context, req *tagpb.TagDeleteRequest) (*emptypb.Empty, error) {
+	shopID, err := requestShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, shopID, req.Id); err != nil {
+		return nil, tagError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *TagService) AssignToProduct(ctx context.Context, req *tagpb.TagAssignRequest) (*tagpb.TagAssignResponse, error) {
-	shopID, _ := pkg.MustGetShopID(ctx)
-	tags, err := s.repo.AssignToProduct(ctx, shopID, req.ProductId, req.TagIds, req.ReplaceExisting)
+	shopID, err := requestShopID(ctx, req.ShopId)
 	if err != nil {
 		return nil, err
 	}
 
+	tags, err := s.repo.AssignToProduct(ctx, shopID, req.ProductId, req.TagIds, req.ReplaceExisting)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.ErrProductNotFoundCode, errors.ErrProductNotFoundMsg)
+		}
+		return nil, tagError(err)
+	}
+
 	resp := &tagpb.TagAssignResponse{AssignedTags: make([]*productpb.Tag, 0)}
 	for _, t := range tags {
 		resp.AssignedTags = append(resp.AssignedTags, proto.MapTagToProto(t))
@@ -88,23 +167,42 @@ func (s *TagService) AssignToProduct(ctx context.Context, req *tagpb.TagAssignRe
 }
 
 func (s *TagService) RemoveFromProduct(ctx context.Context, req *tagpb.TagRemoveRequest) (*emptypb.Empty, error) {
-	shopID, _ := pkg.MustGetShopID(ctx)
-	if err := s.repo.RemoveFromProduct(ctx, shopID, req.ProductId, req.TagIds); err != nil {
+	shopID, err := requestShopID(ctx, req.ShopId)
+	if err != nil {
 		return nil, err
 	}
+
+	if err := s.repo.RemoveFromProduct(ctx, shopID, req.ProductId, req.TagIds); err != nil {
+		return nil, tagError(err)
+	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *TagService) GetByProduct(ctx context.Context, req *tagpb.TagGetByProductRequest) (*tagpb.TagGetByProductResponse, error) {
-	shopID, _ := pkg.MustGetShopID(ctx)
-	tags, err := s.repo.GetByProductID(ctx, shopID, req.ProductId)
+	shopID, err := requestShopID(ctx, req.ShopId)
 	if err != nil {
 		return nil, err
 	}
 
+	tags, err := s.repo.GetByProductID(ctx, shopID, req.ProductId)
+	if err != nil {
+		return nil, tagError(err)
+	}
+
 	resp := &tagpb.TagGetByProductResponse{Tags: make([]*productpb.Tag, 0)}
 	for _, t := range tags {
 		resp.Tags = append(resp.Tags, proto.MapTagToProto(t))
 	}
 	return resp, nil
 }
+
+func tagError(err error) error {
+	switch err {
+	case sql.ErrNoRows:
+		return errors.GRPC(codes.NotFound, errors.ErrTagNotFoundCode, errors.ErrTagNotFoundMsg)
+	case domain.ErrTagConflict:
+		return errors.GRPC(codes.AlreadyExists, errors.ErrTagConflictCode, errors.ErrTagConflictMsg)
+	default:
+		return errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+}
@@ -0,0 +1,463 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+
+	errors "hpkg/constants/responses"
+	"productservice/internal/domain"
+	"productservice/internal/domain/proto"
+	"productservice/internal/repository"
+	"productservice/proto/v1/productpb"
+	"productservice/proto/v1/variantpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// maxBulkVariants caps BulkCreate and the matrix Generate may build.
+	maxBulkVariants = 100
+)
+
+type VariantService struct {
+	variantpb.UnimplementedVariantServiceServer
+	repo repository.PostgresVariantRepository
+}
+
+func NewVariantService(repo repository.PostgresVariantRepository) *VariantService {
+	return &VariantService{repo: repo}
+}
+
+func (s *VariantService) Create(ctx context.Context, req *variantpb.CreateVariantRequest) (*variantpb.VariantResponse, error) {
+	shopID, err := requestShopID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := s.repo.ListOptions(ctx, shopID, req.ProductId)
+	if err != nil {
+		return nil, productVariantError(err)
+	}
+	v, err := newVariant(req, options)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, shopID, req.ProductId, []*domain.ProductVariant{v})
+	if err != nil {
+		return nil, productVariantError(err)
+	}
+	return &variantpb.VariantResponse{Variant: proto.MapVariantDetailToProto(created[0], nil)}, nil
+}
+
+// BulkCreate adds all the variants or none of them.
+func (s *VariantService) BulkCreate(ctx context.Context, req *variantpb.BulkCreateRequest) (*variantpb.BulkCreateResponse, error) {
+	shopID, err := requestShopID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Variants) == 0 || len(req.Variants) > maxBulkVariants {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrVariantInvalidCode, errors.ErrVariantInvalidMsg)
+	}
+
+	options, err := s.repo.ListOptions(ctx, shopID, req.ProductId)
+	if err != nil {
+		return nil, productVariantError(err)
+	}
+
+	variants := make([]*domain.ProductVariant, 0, len(req.Variants))
+	for _, r := range req.Variants {
+		if r.ProductId != "" && r.ProductId != req.ProductId {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrVariantInvalidCode, errors.ErrVariantInvalidMsg)
+		}
+		v, err := newVariant(r, options)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, v)
+	}
+
+	created, err := s.repo.Create(ctx, shopID, req.ProductId, variants)
+	if err != nil {
+		return nil, productVariantError(err)
+	}
+	return bulkCreateResponse(created), nil
+}
+
+func (s *VariantService) Get(ctx context.Context, req *variantpb.GetVariantRequest) (*variantpb.VariantResponse, error) {
+	shopID, err := requestShopID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := s.repo.GetByID(ctx, shopID, req.Id)
+	if err != nil {
+		return nil, variantError(err)
+	}
+
+	var product *domain.VariantProduct
+	if req.IncludeProduct {
+		if product, err = s.repo.GetProduct(ctx, shopID, v.ProductID); err != nil {
+			return nil, variantError(err)
+		}
+	}
+	return &variantpb.VariantResponse{Variant: proto.MapVariantDetailToProto(v, product)}, nil
+}
+
+func (s *VariantService) List(ctx context.Context, req *variantpb.ListVariantsRequest) (*variantpb.ListVariantsResponse, error) {
+	shopID, err := requestShopID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	variants, err := s.repo.List(ctx, shopID, req.ProductId, req.IsActive)
+	if err != nil {
+		return nil, productVariantError(err)
+	}
+
+	var product *domain.VariantProduct
+	if req.IncludeProduct {
+		if product, err = s.repo.GetProduct(ctx, shopID, req.ProductId); err != nil {
+			return nil, productVariantError(err)
+		}
+	}
+
+	resp := &variantpb.ListVariantsResponse{
+		Variants: make([]*variantpb.VariantDetail, 0, len(variants)),
+		Total:    int32(len(variants)),
+	}
+	for _, v := range variants {
+		resp.Variants = append(resp.Variants, proto.MapVariantDetailToProto(v, product))
+	}
+	return resp, nil
+}
+
+func (s *VariantService) Update(ctx context.Context, req *variantpb.UpdateVariantRequest) (*variantpb.VariantResponse, error) {
+	shopID, err := requestShopID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPrice() < 0 || req.GetCostPrice() < 0 || req.GetCompareAtPrice() < 0 ||
+		req.GetStockQuantity() < 0 || req.GetWeight() < 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrVariantInvalidCode, errors.ErrVariantInvalidMsg)
+	}
+
+	update := domain.UpdateVariantRequest{
+		ID:             req.Id,
+		ShopID:         shopID,
+		SKU:            trimmed(req.Sku),
+		Barcode:        trimmed(req.Barcode),
+		Price:          req.Price,
+		ClearPrice:     req.ClearPrice,
+		CostPrice:      req.CostPrice,
+		CompareAtPrice: req.CompareAtPrice,
+		StockQuantity:  req.StockQuantity,
+		Weight:         req.Weight,
+		IsActive:       req.IsActive,
+	}
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrVariantInvalidCode, errors.ErrVariantInvalidMsg)
+		}
+		update.Name = &name
+	}
+	if req.Attributes != nil {
+		current, err := s.repo.GetByID(ctx, shopID, req.Id)
+		if err != nil {
+			return nil, variantError(err)
+		}
+		options, err := s.repo.ListOptions(ctx, shopID, current.ProductID)
+		if err != nil {
+			return nil, variantError(err)
+		}
+		if err := domain.CheckAttributes(options, req.Attributes.AsMap()); err != nil {
+			return nil, variantError(err)
+		}
+		if update.Attributes, err = proto.StructToJSON(req.Attributes); err != nil {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrVariantInvalidCode, errors.ErrVariantInvalidMsg)
+		}
+	}
+
+	v, err := s.repo.Update(ctx, update)
+	if err != nil {
+		return nil, variantError(err)
+	}
+	return &variantpb.VariantResponse{Variant: proto.MapVariantDetailToProto(v, nil)}, nil
+}
+
+func (s *VariantService) Delete(ctx context.Context, req *variantpb.DeleteVariantRequest) (*emptypb.Empty, error) {
+	shopID, err := requestShopID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, shopID, req.Id); err != nil {
+		return nil, variantError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *VariantService) UpdateStock(ctx context.Context, req *variantpb.VariantStockRequest) (*variantpb.VariantResponse, error) {
+	shopID, err := requestShopID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.VariantId == "" || req.QuantityChange == 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	v, err := s.repo.AdjustStock(ctx, shopID, req.VariantId, domain.StockChange{
+		QuantityChange: req.QuantityChange,
+		Reason:         req.Reason,
+	})
+	if err != nil {
+		return nil, variantError(err)
+	}
+	return &variantpb.VariantResponse{Variant: proto.MapVariantDetailToProto(v, nil)}, nil
+}
+
+func (s *VariantService) SetOptions(ctx context.Context, req *variantpb.SetVariantOptionsRequest) (*variantpb.VariantOptionsResponse, error) {
+	shopID, err := requestShopID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]*domain.VariantOption, 0, len(req.Options))
+	for _, o := range req.Options {
+		options = append(options, &domain.VariantOption{
+			Name:   o.Name,
+			Values: append([]string{}, o.Values...),
+		})
+	}
+	if err := domain.NormalizeOptions(options); err != nil {
+		return nil, variantError(err)
+	}
+
+	saved, err := s.repo.SetOptions(ctx, shopID, req.ProductId, options)
+	if err != nil {
+		return nil, productVariantError(err)
+	}
+	return optionsResponse(saved), nil
+}
+
+func (s *VariantService) ListOptions(ctx context.Context, req *variantpb.ListVariantOptionsRequest) (*variantpb.VariantOptionsResponse, error) {
+	shopID, err := requestShopID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := s.repo.ListOptions(ctx, shopID, req.ProductId)
+	if err != nil {
+		return nil, productVariantError(err)
+	}
+	return optionsResponse(options), nil
+}
+
+// Generate fills in the variant matrix: one variant per combination of
+// option values the product does not have a variant for yet, so it can be
+// run again after an option gains a value.
+func (s *VariantService) Generate(ctx context.Context, req *variantpb.GenerateVariantsRequest) (*variantpb.BulkCreateResponse, error) {
+	shopID, err := requestShopID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPrice() < 0 || req.StockQuantity < 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrVariantInvalidCode, errors.ErrVariantInvalidMsg)
+	}
+
+	product, err := s.repo.GetProduct(ctx, shopID, req.ProductId)
+	if err != nil {
+		return nil, productVariantError(err)
+	}
+	options, err := s.repo.ListOptions(ctx, shopID, req.ProductId)
+	if err != nil {
+		return nil, productVariantError(err)
+	}
+	if len(options) == 0 {
+		return nil, errors.GRPC(codes.FailedPrecondition, errors.ErrVariantOptionInvalidCode, errors.ErrVariantOptionInvalidMsg)
+	}
+	// Options saved before they were capped may still make a matrix far too
+	// large to build.
+	if domain.CountVariantCombinations(options) > maxBulkVariants {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrVariantOptionInvalidCode, errors.ErrVariantOptionInvalidMsg)
+	}
+	existing, err := s.repo.List(ctx, shopID, req.ProductId, nil)
+	if err != nil {
+		return nil, productVariantError(err)
+	}
+
+	taken := make(map[string]bool, len(existing))
+	for _, v := range existing {
+		var attrs map[string]any
+		if json.Unmarshal(v.Attributes, &attrs) == nil {
+			taken[attributesKey(options, attrs)] = true
+		}
+	}
+
+	prefix := req.GetSkuPrefix()
+	if req.SkuPrefix == nil && product.SKU != nil {
+		prefix = *product.SKU
+	}
+
+	var variants []*domain.ProductVariant
+	for _, values := range domain.VariantCombinations(options) {
+		attrs := make(map[string]any, len(options))
+		for i, o := range options {
+			attrs[o.Name] = values[i]
+		}
+		if taken[attributesKey(options, attrs)] {
+			continue
+		}
+
+		attributes, err := json.Marshal(attrs)
+		if err != nil {
+			return nil, errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+		}
+		v := &domain.ProductVariant{
+			ProductID:     product.ID,
+			Name:          domain.VariantName(values),
+			Price:         req.Price,
+			StockQuantity: req.StockQuantity,
+			Attributes:    attributes,
+			IsActive:      req.IsActive == nil || *req.IsActive,
+		}
+		if sku := domain.VariantSKU(prefix, values); sku != "" {
+			v.SKU = &sku
+		}
+		variants = append(variants, v)
+	}
+
+	if len(variants) == 0 {
+		return bulkCreateResponse(nil), nil
+	}
+
+	created, err := s.repo.Create(ctx, shopID, product.ID, variants)
+	if err != nil {
+		return nil, productVariantError(err)
+	}
+	return bulkCreateResponse(created), nil
+}
+
+// newVariant checks a variant sent by a client against the product's
+// options. Without a name, the variant is named after its option values.
+func newVariant(req *variantpb.CreateVariantRequest, options []*domain.VariantOption) (*domain.ProductVariant, error) {
+	if req.GetPrice() < 0 || req.GetCostPrice() < 0 || req.GetCompareAtPrice() < 0 ||
+		req.StockQuantity < 0 || req.GetWeight() < 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrVariantInvalidCode, errors.ErrVariantInvalidMsg)
+	}
+
+	attrs := req.Attributes.AsMap()
+	if err := domain.CheckAttributes(options, attrs); err != nil {
+		return nil, variantError(err)
+	}
+	attributes, err := proto.StructToJSON(req.Attributes)
+	if err != nil {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrVariantInvalidCode, errors.ErrVariantInvalidMsg)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" && len(options) > 0 {
+		values := make([]string, 0, len(options))
+		for _, o := range options {
+			values = append(values, attrs[o.Name].(string))
+		}
+		name = domain.VariantName(values)
+	}
+	if name == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrVariantInvalidCode, errors.ErrVariantInvalidMsg)
+	}
+
+	return &domain.ProductVariant{
+		ProductID:      req.ProductId,
+		SKU:            nonEmpty(trimmed(req.Sku)),
+		Barcode:        nonEmpty(trimmed(req.Barcode)),
+		Name:           name,
+		Price:          req.Price,
+		CostPrice:      req.CostPrice,
+		CompareAtPrice: req.CompareAtPrice,
+		StockQuantity:  req.StockQuantity,
+		Weight:         req.Weight,
+		Attributes:     attributes,
+		IsActive:       req.IsActive == nil || *req.IsActive,
+	}, nil
+}
+
+// attributesKey identifies a combination of option values.
+func attributesKey(options []*domain.VariantOption, attrs map[string]any) string {
+	values := make([]string, 0, len(options))
+	for _, o := range options {
+		v, _ := attrs[o.Name].(string)
+		values = append(values, v)
+	}
+	return strings.Join(values, "\x00")
+}
+
+func bulkCreateResponse(variants []*domain.ProductVariant) *variantpb.BulkCreateResponse {
+	resp := &variantpb.BulkCreateResponse{
+		Variants:     make([]*productpb.Variant, 0, len(variants)),
+		CreatedCount: int32(len(variants)),
+	}
+	for _, v := range variants {
+		resp.Variants = append(resp.Variants, proto.MapVariantToProto(v))
+	}
+	return resp
+}
+
+func optionsResponse(options []*domain.VariantOption) *variantpb.VariantOptionsResponse {
+	resp := &variantpb.VariantOptionsResponse{Options: make([]*variantpb.VariantOption, 0, len(options))}
+	for _, o := range options {
+		resp.Options = append(resp.Options, proto.MapVariantOptionToProto(o))
+	}
+	return resp
+}
+
+func trimmed(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	t := strings.TrimSpace(*s)
+	return &t
+}
+
+func nonEmpty(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return s
+}
+
+// productVariantError is variantError for calls addressed to a product, where
+// a missing row is the product.
+func productVariantError(err error) error {
+	if err == sql.ErrNoRows {
+		return errors.GRPC(codes.NotFound, errors.ErrProductNotFoundCode, errors.ErrProductNotFoundMsg)
+	}
+	return variantError(err)
+}
+
+func variantError(err error) error {
+	switch err {
+	case sql.ErrNoRows:
+		return errors.GRPC(codes.NotFound, errors.ErrVariantNotFoundCode, errors.ErrVariantNotFoundMsg)
+	case domain.ErrVariantConflict:
+		return errors.GRPC(codes.AlreadyExists, errors.ErrVariantConflictCode, errors.ErrVariantConflictMsg)
+	case domain.ErrVariantAttributesConflict:
+		return errors.GRPC(codes.AlreadyExists, errors.ErrVariantAttributesConflictCode, errors.ErrVariantAttributesConflictMsg)
+	case domain.ErrVariantAttributesInvalid:
+		return errors.GRPC(codes.InvalidArgument, errors.ErrVariantAttributesInvalidCode, errors.ErrVariantAttributesInvalidMsg)
+	case domain.ErrVariantOptionInvalid:
+		return errors.GRPC(codes.InvalidArgument, errors.ErrVariantOptionInvalidCode, errors.ErrVariantOptionInvalidMsg)
+	case domain.ErrInsufficientStock:
+		return errors.GRPC(codes.FailedPrecondition, errors.ErrProductOutOfStockCode, errors.ErrProductOutOfStockMsg)
+	default:
+		return errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+}
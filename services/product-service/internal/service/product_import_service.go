@@ -0,0 +1,520 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	errors "hpkg/constants/responses"
+
+	"productservice/internal/domain"
+	"productservice/internal/sheet"
+	productv1 "productservice/proto/v1/productpb"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	maxImportBytes  = 32 << 20
+	maxImportRows   = 10000
+	maxSKULength    = 100
+	exportChunkSize = 64 << 10
+)
+
+// importColumn is a column of an import file and the field it is read as.
+type importColumn struct {
+	index  int
+	header string
+	field  string
+}
+
+// ---------------------------
+// IMPORT
+// ---------------------------
+
+// ImportProducts reads the options and then the file off the stream and
+// upserts the file's rows one at a time. A row that fails is reported and
+// skipped; the rows around it are still imported.
+func (s *ProductServiceV1) ImportProducts(stream productv1.ProductService_ImportProductsServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	opts := first.GetOptions()
+	if opts == nil || !sheet.ValidFormat(opts.Format) {
+		return errors.GRPC(codes.InvalidArgument, errors.ErrImportInvalidCode, errors.ErrImportInvalidMsg)
+	}
+
+	shopID, err := catalogShopID(ctx, opts.ShopId)
+	if err != nil {
+		return err
+	}
+
+	var data bytes.Buffer
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if data.Len()+len(msg.GetChunk()) > maxImportBytes {
+			return errors.GRPC(codes.InvalidArgument, errors.ErrImportTooLargeCode, errors.ErrImportTooLargeMsg)
+		}
+		data.Write(msg.GetChunk())
+	}
+
+	rows, err := sheet.Read(opts.Format, data.Bytes(), opts.GetSheet())
+	switch {
+	case err == sheet.ErrTooLarge || len(rows) > maxImportRows+1:
+		return errors.GRPC(codes.InvalidArgument, errors.ErrImportTooLargeCode, errors.ErrImportTooLargeMsg)
+	case err != nil || len(rows) == 0:
+		return errors.GRPC(codes.InvalidArgument, errors.ErrImportFileInvalidCode, errors.ErrImportFileInvalidMsg)
+	}
+
+	columns, ignored, ok := importColumns(rows[0], opts.ColumnMap)
+	if !ok {
+		return errors.GRPC(codes.InvalidArgument, errors.ErrImportInvalidCode, errors.ErrImportInvalidMsg)
+	}
+
+	resp := &productv1.ImportProductsResponse{
+		DryRun:         opts.DryRun,
+		Columns:        make(map[string]string, len(columns)),
+		IgnoredColumns: ignored,
+	}
+	for _, c := range columns {
+		resp.Columns[c.header] = c.field
+	}
+
+	// A dry run rolls every row back, so a missing category is "created"
+	// again by each row that names it.
+	createdCategories := make(map[string]bool)
+	createdTags := make(map[string]bool)
+	seen := make(map[string]bool)
+
+	for i, record := range rows[1:] {
+		row := int32(i + 2)
+		if blankRecord(record) {
+			continue
+		}
+		resp.TotalRows++
+
+		p, rowErr := parseImportRow(record, columns)
+		if rowErr == nil && seen[p.SKU] {
+			rowErr = &productv1.ImportRowError{Column: skuHeader(columns), Message: errors.ErrImportSKUDuplicateMsg}
+		}
+		if rowErr != nil {
+			rowErr.Row = row
+			rowErr.Sku = importCell(record, columns, "sku")
+			resp.Errors = append(resp.Errors, rowErr)
+			resp.Failed++
+			continue
+		}
+		seen[p.SKU] = true
+
+		res, err := s.repo.ImportCatalogProduct(ctx, shopID, *p, opts.DryRun)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			resp.Errors = append(resp.Errors, &productv1.ImportRowError{
+				Row:     row,
+				Sku:     p.SKU,
+				Message: importErrorMessage(err),
+			})
+			resp.Failed++
+			continue
+		}
+
+		if res.Created {
+			resp.Created++
+		} else {
+			resp.Updated++
+		}
+		for _, c := range res.CreatedCategories {
+			if !createdCategories[c] {
+				createdCategories[c] = true
+				resp.CreatedCategories = append(resp.CreatedCategories, c)
+			}
+		}
+		for _, t := range res.CreatedTags {
+			if !createdTags[t] {
+				createdTags[t] = true
+				resp.CreatedTags = append(resp.CreatedTags, t)
+			}
+		}
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+// importColumns works out which field each header of an import file is read
+// as: the one columnMap gives it, or else the field of the same name, e.g.
+// "Cost Price" for cost_price. A field is read from its first column only.
+// It fails when columnMap names a field that does not exist or no column is
+// read as the sku.
+func importColumns(header []string, columnMap map[string]string) ([]importColumn, []string, bool) {
+	fields := make(map[string]bool, len(domain.ImportFields))
+	for _, f := range domain.ImportFields {
+		fields[f] = true
+	}
+
+	mapped := make(map[string]string, len(columnMap))
+	for h, f := range columnMap {
+		f = strings.TrimSpace(f)
+		if f != "" && !fields[f] {
+			return nil, nil, false
+		}
+		mapped[strings.ToLower(strings.TrimSpace(h))] = f
+	}
+
+	var columns []importColumn
+	var ignored []string
+	used := make(map[string]bool)
+	for i, h := range header {
+		h = strings.TrimSpace(h)
+		if h == "" {
+			continue
+		}
+
+		field, ok := mapped[strings.ToLower(h)]
+		if !ok {
+			field = strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(h))
+			if !fields[field] {
+				field = ""
+			}
+		}
+		if field == "" || used[field] {
+			ignored = append(ignored, h)
+			continue
+		}
+
+		used[field] = true
+		columns = append(columns, importColumn{index: i, header: h, field: field})
+	}
+
+	return columns, ignored, used["sku"]
+}
+
+// parseImportRow reads a row of an import file. Empty cells are left out, so
+// they do not change an existing product. The error, if any, names the first
+// column that could not be read.
+func parseImportRow(record []string, columns []importColumn) (*domain.ImportProduct, *productv1.ImportRowError) {
+	p := &domain.ImportProduct{}
+	u := &p.Update
+
+	for _, c := range columns {
+		if c.index >= len(record) {
+			continue
+		}
+		v := strings.TrimSpace(record[c.index])
+		if v == "" {
+			continue
+		}
+
+		ok := true
+		switch c.field {
+		case "sku":
+			p.SKU = v
+			ok = len(v) <= maxSKULength
+		case "name":
+			u.Name = &v
+		case "barcode":
+			u.Barcode = &v
+		case "description":
+			u.Description = &v
+		case "unit":
+			u.Unit = &v
+		case "weight_unit":
+			u.WeightUnit = &v
+		case "currency":
+			var code string
+			if code, ok = parseImportCurrency(v); ok {
+				u.Currency = &code
+			}
+		case "price":
+			u.Price, ok = parseImportAmount(v)
+		case "cost_price":
+			u.CostPrice, ok = parseImportAmount(v)
+		case "compare_at_price":
+			u.CompareAtPrice, ok = parseImportAmount(v)
+		case "weight":
+			u.Weight, ok = parseImportAmount(v)
+		case "tax_rate":
+			u.TaxRate, ok = parseImportAmount(v)
+		case "stock_quantity":
+			p.StockQuantity, ok = parseImportCount(v)
+		case "min_stock_level":
+			u.MinStockLevel, ok = parseImportCount(v)
+		case "max_stock_level":
+			u.MaxStockLevel, ok = parseImportCount(v)
+		case "sort_order":
+			u.SortOrder, ok = parseImportInt(v)
+		case "is_active":
+			u.IsActive, ok = parseImportBool(v)
+		case "is_taxable":
+			u.IsTaxable, ok = parseImportBool(v)
+		case "track_inventory":
+			u.TrackInventory, ok = parseImportBool(v)
+		case "allow_backorder":
+			u.AllowBackorder, ok = parseImportBool(v)
+		case "is_featured":
+			u.IsFeatured, ok = parseImportBool(v)
+		case "category":
+			if path := domain.ParseCategoryPath(v); len(path) > 0 {
+				p.CategoryPath = path
+			}
+		case "tags":
+			p.Tags = domain.ParseTagList(v)
+		}
+		if !ok {
+			return nil, &productv1.ImportRowError{Column: c.header, Message: errors.ErrImportValueInvalidMsg}
+		}
+	}
+
+	if p.SKU == "" {
+		return nil, &productv1.ImportRowError{Column: skuHeader(columns), Message: errors.ErrImportSKUMissingMsg}
+	}
+
+	if u.Name != nil && u.Price != nil {
+		p.Create = &domain.CreateCatalogProductRequest{
+			Barcode:        u.Barcode,
+			Name:           *u.Name,
+			Description:    u.Description,
+			Price:          *u.Price,
+			CostPrice:      valueOr(u.CostPrice, 0),
+			CompareAtPrice: u.CompareAtPrice,
+			Currency:       valueOr(u.Currency, defaultCurrency),
+			StockQuantity:  valueOr(p.StockQuantity, 0),
+			MinStockLevel:  valueOr(u.MinStockLevel, 0),
+			MaxStockLevel:  u.MaxStockLevel,
+			Unit:           withDefault(u.Unit, "pcs"),
+			Weight:         u.Weight,
+			WeightUnit:     withDefault(u.WeightUnit, "kg"),
+			IsActive:       valueOr(u.IsActive, true),
+			IsTaxable:      valueOr(u.IsTaxable, true),
+			TaxRate:        valueOr(u.TaxRate, 0),
+			TrackInventory: valueOr(u.TrackInventory, true),
+			AllowBackorder: valueOr(u.AllowBackorder, false),
+			IsFeatured:     valueOr(u.IsFeatured, false),
+			SortOrder:      valueOr(u.SortOrder, 0),
+		}
+	}
+
+	return p, nil
+}
+
+// parseImportAmount reads a non-negative decimal. A comma is taken as the
+// decimal point when there is no dot, as spreadsheet apps in many locales
+// write "19,99".
+func parseImportAmount(v string) (*float64, bool) {
+	if !strings.Contains(v, ".") {
+		v = strings.Replace(v, ",", ".", 1)
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return nil, false
+	}
+	return &f, true
+}
+
+// parseImportInt reads a whole number. XLSX files store numbers as
+// decimals, so "12.0" is taken as 12.
+func parseImportInt(v string) (*int32, bool) {
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f != math.Trunc(f) || f < math.MinInt32 || f > math.MaxInt32 {
+		return nil, false
+	}
+	n := int32(f)
+	return &n, true
+}
+
+func parseImportCount(v string) (*int32, bool) {
+	n, ok := parseImportInt(v)
+	if !ok || *n < 0 {
+		return nil, false
+	}
+	return n, true
+}
+
+func parseImportBool(v string) (*bool, bool) {
+	var b bool
+	switch strings.ToLower(v) {
+	case "true", "yes", "y", "1":
+		b = true
+	case "false", "no", "n", "0":
+		b = false
+	default:
+		return nil, false
+	}
+	return &b, true
+}
+
+func parseImportCurrency(v string) (string, bool) {
+	code, err := productCurrency(strings.ToUpper(v))
+	return code, err == nil
+}
+
+func importErrorMessage(err error) string {
+	switch err {
+	case domain.ErrImportIncomplete:
+		return errors.ErrImportIncompleteMsg
+	case domain.ErrProductConflict:
+		return errors.ErrProductConflictMsg
+	case domain.ErrCategoryConflict:
+		return errors.ErrCategoryConflictMsg
+	case domain.ErrTagConflict:
+		return errors.ErrTagConflictMsg
+	default:
+		return errors.ErrDatabaseMsg
+	}
+}
+
+// importCell returns the row's value of field, or "" if it has none.
+func importCell(record []string, columns []importColumn, field string) string {
+	for _, c := range columns {
+		if c.field == field && c.index < len(record) {
+			return strings.TrimSpace(record[c.index])
+		}
+	}
+	return ""
+}
+
+func skuHeader(columns []importColumn) string {
+	for _, c := range columns {
+		if c.field == "sku" {
+			return c.header
+		}
+	}
+	return ""
+}
+
+func blankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func valueOr[T any](v *T, def T) T {
+	if v == nil {
+		return def
+	}
+	return *v
+}
+
+// ---------------------------
+// EXPORT
+// ---------------------------
+
+// ExportProducts streams the shop's catalog as a file with a column for each
+// of domain.ImportFields, so that it can be edited and imported again.
+func (s *ProductServiceV1) ExportProducts(
+	req *productv1.ExportProductsRequest,
+	stream productv1.ProductService_ExportProductsServer,
+) error {
+
+	ctx := stream.Context()
+
+	shopID, err := catalogShopID(ctx, req.ShopId)
+	if err != nil {
+		return err
+	}
+	if !sheet.ValidFormat(req.Format) {
+		return errors.GRPC(codes.InvalidArgument, errors.ErrImportInvalidCode, errors.ErrImportInvalidMsg)
+	}
+
+	out := bufio.NewWriterSize(exportChunkWriter{stream: stream}, exportChunkSize)
+	w, err := sheet.NewWriter(req.Format, out)
+	if err != nil {
+		return errors.GRPC(codes.Internal, errors.ErrInternalCode, errors.ErrInternalMsg)
+	}
+
+	header := make([]sheet.Cell, len(domain.ImportFields))
+	for i, f := range domain.ImportFields {
+		header[i] = sheet.Text(f)
+	}
+	if err := w.WriteRow(header); err != nil {
+		return err
+	}
+
+	err = s.repo.ExportCatalogProducts(ctx, shopID, req.IncludeInactive, func(e *domain.ExportProduct) error {
+		return w.WriteRow(exportCells(e))
+	})
+	if err != nil {
+		return catalogError(err)
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+// exportChunkWriter sends what is written to it as file chunks.
+type exportChunkWriter struct {
+	stream productv1.ProductService_ExportProductsServer
+}
+
+func (w exportChunkWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&productv1.ExportProductsChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// exportCells lays a product out in the columns of domain.ImportFields.
+func exportCells(e *domain.ExportProduct) []sheet.Cell {
+	p := e.Product
+	optionalNumber := func(f *float64) sheet.Cell {
+		if f == nil {
+			return sheet.Cell{}
+		}
+		return sheet.Number(*f)
+	}
+	flag := func(b bool) sheet.Cell {
+		return sheet.Text(strconv.FormatBool(b))
+	}
+
+	values := map[string]sheet.Cell{
+		"sku":              sheet.Text(valueOr(p.SKU, "")),
+		"name":             sheet.Text(p.Name),
+		"barcode":          sheet.Text(valueOr(p.Barcode, "")),
+		"description":      sheet.Text(valueOr(p.Description, "")),
+		"price":            sheet.Number(p.Price),
+		"cost_price":       sheet.Number(p.CostPrice),
+		"compare_at_price": optionalNumber(p.CompareAtPrice),
+		"currency":         sheet.Text(p.Currency),
+		"stock_quantity":   sheet.Number(float64(p.StockQuantity)),
+		"min_stock_level":  sheet.Number(float64(p.MinStockLevel)),
+		"unit":             sheet.Text(p.Unit),
+		"weight":           optionalNumber(p.Weight),
+		"weight_unit":      sheet.Text(p.WeightUnit),
+		"is_active":        flag(p.IsActive),
+		"is_taxable":       flag(p.IsTaxable),
+		"tax_rate":         sheet.Number(p.TaxRate),
+		"track_inventory":  flag(p.TrackInventory),
+		"allow_backorder":  flag(p.AllowBackorder),
+		"is_featured":      flag(p.IsFeatured),
+		"sort_order":       sheet.Number(float64(p.SortOrder)),
+		"category":         sheet.Text(e.CategoryPath),
+		"tags":             sheet.Text(strings.Join(e.Tags, ", ")),
+	}
+	if p.MaxStockLevel != nil {
+		values["max_stock_level"] = sheet.Number(float64(*p.MaxStockLevel))
+	}
+
+	cells := make([]sheet.Cell, len(domain.ImportFields))
+	for i, f := range domain.ImportFields {
+		cells[i] = values[f]
+	}
+	return cells
+}
@@ -4,16 +4,22 @@ import (
 	"context"
 	"fmt"
 
+	errors "hpkg/constants/responses"
 	pkg "hpkg/grpc"
+	"hpkg/money"
 
 	"productservice/internal/domain"
 	"productservice/internal/domain/proto"
 	"productservice/internal/repository"
 	"productservice/proto/productpb"
 
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// defaultCurrency prices products created without a currency.
+const defaultCurrency = "USD"
+
 type ProductService struct {
 	productpb.UnimplementedProductServiceServer
 	repo repository.PostgresProductRepository
@@ -119,6 +125,15 @@ func (s *ProductService) CreateProduct(
 	userID, _ := pkg.MustGetUserID(ctx)
 	shopID, _ := pkg.MustGetShopID(ctx)
 
+	currency := defaultCurrency
+	if req.Currency != "" {
+		c, err := productCurrency(req.Currency)
+		if err != nil {
+			return nil, err
+		}
+		currency = c
+	}
+
 	product, err := s.repo.Create(ctx, domain.CreateProductRequest{
 		ShopID:      shopID,
 		OwnerID:     userID,
@@ -126,6 +141,7 @@ func (s *ProductService) CreateProduct(
 		Description: req.Description,
 		Category:    req.Category,
 		Price:       req.Price,
+		Currency:    currency,
 		Detail:      req.Detail,
 	})
 
@@ -146,12 +162,22 @@ func (s *ProductService) UpdateProduct(
 	req *productpb.UpdateProductRequest,
 ) (*productpb.UpdateProductResponse, error) {
 
+	var currency string
+	if req.Currency != "" {
+		c, err := productCurrency(req.Currency)
+		if err != nil {
+			return nil, err
+		}
+		currency = c
+	}
+
 	// Map gRPC request to domain update
 	updateReq := domain.UpdateProductRequest{
 		ID:          req.ProductId,
 		Name:        req.Name,
 		Category:    req.Category,
 		Price:       req.Price,
+		Currency:    currency,
 		Description: req.Description,
 		Detail:      req.Detail,
 	}
@@ -182,3 +208,55 @@ func (s *ProductService) DeleteProduct(
 		Message: "product deleted successfully",
 	}, nil
 }
+
+// ---------------------------
+// BATCH GET PRODUCTS
+// ---------------------------
+func (s *ProductService) BatchGetProducts(
+	ctx context.Context,
+	req *productpb.BatchGetProductsRequest,
+) (*productpb.BatchGetProductsResponse, error) {
+
+	shopID, err := pkg.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.ProductIds) == 0 {
+		return &productpb.BatchGetProductsResponse{}, nil
+	}
+
+	items, err := s.repo.GetCatalogItems(ctx, shopID, req.ProductIds)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &productpb.BatchGetProductsResponse{
+		Products: make([]*productpb.CatalogItem, 0, len(items)),
+	}
+	for _, item := range items {
+		resp.Products = append(resp.Products, proto.MapCatalogItemToProto(item))
+	}
+
+	if len(req.VariantIds) > 0 {
+		variants, err := s.repo.GetCatalogVariants(ctx, shopID, req.VariantIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range variants {
+			resp.Variants = append(resp.Variants, proto.MapCatalogVariantToProto(v))
+		}
+	}
+
+	return resp, nil
+}
+
+// productCurrency checks a currency code sent for a product and returns it in
+// its canonical form.
+func productCurrency(code string) (string, error) {
+	c, err := money.Lookup(code)
+	if err != nil {
+		return "", errors.GRPC(codes.InvalidArgument, errors.CurrencyUnsupportedCode, errors.CurrencyUnsupportedMsg)
+	}
+	return c.Code, nil
+}
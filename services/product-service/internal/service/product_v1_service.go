@@ -0,0 +1,568 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"strings"
+
+	errors "hpkg/constants/responses"
+	pkg "hpkg/grpc"
+	"hpkg/money"
+
+	"productservice/internal/domain"
+	"productservice/internal/domain/proto"
+	"productservice/internal/repository"
+	productv1 "productservice/proto/v1/productpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	maxBulkUpdates  = 500
+)
+
+// catalogSortColumns are the columns List can sort by.
+var catalogSortColumns = map[string]string{
+	"":               "created_at",
+	"created_at":     "created_at",
+	"updated_at":     "updated_at",
+	"name":           "name",
+	"price":          "price",
+	"stock_quantity": "stock_quantity",
+	"sort_order":     "sort_order",
+}
+
+// ProductServiceV1 serves the product.v1 catalog API over every column of
+// the products table, next to the product.ProductService that order-service
+// uses for pricing and stock reservations.
+type ProductServiceV1 struct {
+	productv1.UnimplementedProductServiceServer
+	repo repository.PostgresProductRepository
+}
+
+func NewProductServiceV1(repo repository.PostgresProductRepository) *ProductServiceV1 {
+	return &ProductServiceV1{
+		repo: repo,
+	}
+}
+
+// ---------------------------
+// CREATE
+// ---------------------------
+func (s *ProductServiceV1) Create(
+	ctx context.Context,
+	req *productv1.CreateRequest,
+) (*productv1.ProductResponse, error) {
+
+	shopID, err := catalogShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(req.Name) == "" || req.Price < 0 || req.GetCostPrice() < 0 ||
+		req.GetCompareAtPrice() < 0 || req.StockQuantity < 0 || req.GetTaxRate() < 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrProductInvalidCode, errors.ErrProductInvalidMsg)
+	}
+
+	currency := defaultCurrency
+	if req.Currency != nil {
+		if currency, err = productCurrency(req.GetCurrency()); err != nil {
+			return nil, err
+		}
+	}
+
+	metadata, err := proto.StructToJSON(req.Metadata)
+	if err != nil {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrProductInvalidCode, errors.ErrProductInvalidMsg)
+	}
+
+	media := make([]*domain.ProductMedia, 0, len(req.Media))
+	for _, m := range req.Media {
+		if m.MediaUrl == "" || (m.MediaType != "image" && m.MediaType != "video") {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrProductInvalidCode, errors.ErrProductInvalidMsg)
+		}
+		media = append(media, &domain.ProductMedia{
+			MediaURL:     m.MediaUrl,
+			MediaType:    m.MediaType,
+			DisplayOrder: m.DisplayOrder,
+			IsThumbnail:  m.IsThumbnail,
+			AltText:      m.AltText,
+		})
+	}
+
+	p, err := s.repo.CreateCatalogProduct(ctx, domain.CreateCatalogProductRequest{
+		ShopID:         shopID,
+		CategoryID:     nonEmpty(req.CategoryId),
+		SKU:            nonEmpty(req.Sku),
+		Barcode:        nonEmpty(req.Barcode),
+		Name:           strings.TrimSpace(req.Name),
+		Description:    req.Description,
+		Detail:         req.Detail,
+		Price:          req.Price,
+		CostPrice:      req.GetCostPrice(),
+		CompareAtPrice: req.CompareAtPrice,
+		Currency:       currency,
+		StockQuantity:  req.StockQuantity,
+		MinStockLevel:  req.GetMinStockLevel(),
+		MaxStockLevel:  req.MaxStockLevel,
+		Unit:           withDefault(req.Unit, "pcs"),
+		Weight:         req.Weight,
+		WeightUnit:     withDefault(req.WeightUnit, "kg"),
+		IsActive:       req.IsActive == nil || *req.IsActive,
+		IsTaxable:      req.IsTaxable == nil || *req.IsTaxable,
+		TaxRate:        req.GetTaxRate(),
+		TrackInventory: req.TrackInventory == nil || *req.TrackInventory,
+		AllowBackorder: req.AllowBackorder,
+		IsFeatured:     req.IsFeatured,
+		SortOrder:      req.GetSortOrder(),
+		Metadata:       metadata,
+		Media:          media,
+		TagIDs:         req.TagIds,
+	})
+	if err != nil {
+		return nil, catalogError(err)
+	}
+
+	// Read back with what was attached on create.
+	d, err := s.repo.GetCatalogProductDetail(ctx, shopID, p.ID, domain.DetailIncludes{
+		Category: true,
+		Media:    true,
+		Tags:     true,
+	})
+	if err != nil {
+		return nil, catalogError(err)
+	}
+
+	return &productv1.ProductResponse{
+		Product: proto.MapCatalogDetailToProto(d),
+	}, nil
+}
+
+// ---------------------------
+// GET
+// ---------------------------
+func (s *ProductServiceV1) Get(
+	ctx context.Context,
+	req *productv1.GetRequest,
+) (*productv1.ProductResponse, error) {
+
+	shopID, err := catalogShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.repo.GetCatalogProduct(ctx, shopID, req.Id)
+	if err != nil {
+		return nil, catalogError(err)
+	}
+
+	return &productv1.ProductResponse{
+		Product: proto.MapCatalogDetailToProto(&domain.CatalogProductDetail{Product: p}),
+	}, nil
+}
+
+// ---------------------------
+// GET DETAIL
+// ---------------------------
+func (s *ProductServiceV1) GetDetail(
+	ctx context.Context,
+	req *productv1.GetDetailRequest,
+) (*productv1.DetailResponse, error) {
+
+	shopID, err := catalogShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := s.repo.GetCatalogProductDetail(ctx, shopID, req.Id, domain.DetailIncludes{
+		Category:       req.IncludeCategory || req.IncludeCategoryParent,
+		CategoryParent: req.IncludeCategoryParent,
+		Media:          req.IncludeMedia,
+		Variants:       req.IncludeVariants,
+		Tags:           req.IncludeTags,
+		Shop:           req.IncludeShop,
+	})
+	if err != nil {
+		return nil, catalogError(err)
+	}
+
+	return &productv1.DetailResponse{
+		Product: proto.MapCatalogDetailToProto(d),
+	}, nil
+}
+
+// ---------------------------
+// LIST
+// ---------------------------
+func (s *ProductServiceV1) List(
+	ctx context.Context,
+	req *productv1.ListRequest,
+) (*productv1.ListResponse, error) {
+
+	shopID, err := catalogShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	sortColumn, ok := catalogSortColumns[req.SortBy]
+	if !ok {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	var sortDesc bool
+	switch strings.ToLower(req.SortOrder) {
+	case "asc":
+	case "desc":
+		sortDesc = true
+	case "":
+		// newest first unless sorting by something else
+		sortDesc = sortColumn == "created_at" || sortColumn == "updated_at"
+	default:
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	details, total, err := s.repo.ListCatalogProducts(ctx, domain.CatalogProductFilter{
+		ShopID:       shopID,
+		CategoryID:   nonEmpty(req.CategoryId),
+		Search:       req.Search,
+		IsActive:     req.IsActive,
+		IsFeatured:   req.IsFeatured,
+		TagIDs:       req.TagIds,
+		MinPrice:     req.MinPrice,
+		MaxPrice:     req.MaxPrice,
+		LowStockOnly: req.LowStockOnly,
+		SortBy:       sortColumn,
+		SortDesc:     sortDesc,
+		Page:         page,
+		PageSize:     pageSize,
+	}, domain.DetailIncludes{
+		Category: req.IncludeCategory,
+		Media:    req.IncludeMedia,
+		Tags:     req.IncludeTags,
+	})
+	if err != nil {
+		return nil, catalogError(err)
+	}
+
+	resp := &productv1.ListResponse{
+		Products: make([]*productv1.ProductDetail, 0, len(details)),
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}
+	for _, d := range details {
+		resp.Products = append(resp.Products, proto.MapCatalogDetailToProto(d))
+	}
+
+	return resp, nil
+}
+
+// ---------------------------
+// UPDATE
+// ---------------------------
+func (s *ProductServiceV1) Update(
+	ctx context.Context,
+	req *productv1.UpdateRequest,
+) (*productv1.ProductResponse, error) {
+
+	shopID, err := catalogShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	if (req.Name != nil && strings.TrimSpace(*req.Name) == "") ||
+		req.GetPrice() < 0 || req.GetCostPrice() < 0 || req.GetCompareAtPrice() < 0 || req.GetTaxRate() < 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrProductInvalidCode, errors.ErrProductInvalidMsg)
+	}
+
+	var currency *string
+	if req.Currency != nil {
+		c, err := productCurrency(req.GetCurrency())
+		if err != nil {
+			return nil, err
+		}
+		currency = &c
+	}
+
+	var metadata []byte
+	if req.Metadata != nil {
+		if metadata, err = proto.StructToJSON(req.Metadata); err != nil {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrProductInvalidCode, errors.ErrProductInvalidMsg)
+		}
+	}
+
+	var name *string
+	if req.Name != nil {
+		n := strings.TrimSpace(*req.Name)
+		name = &n
+	}
+
+	p, err := s.repo.UpdateCatalogProduct(ctx, domain.UpdateCatalogProductRequest{
+		ID:             req.Id,
+		ShopID:         shopID,
+		CategoryID:     req.CategoryId,
+		SKU:            req.Sku,
+		Barcode:        req.Barcode,
+		Name:           name,
+		Description:    req.Description,
+		Detail:         req.Detail,
+		Price:          req.Price,
+		CostPrice:      req.CostPrice,
+		CompareAtPrice: req.CompareAtPrice,
+		Currency:       currency,
+		MinStockLevel:  req.MinStockLevel,
+		MaxStockLevel:  req.MaxStockLevel,
+		Unit:           req.Unit,
+		Weight:         req.Weight,
+		WeightUnit:     req.WeightUnit,
+		IsActive:       req.IsActive,
+		IsFeatured:     req.IsFeatured,
+		IsTaxable:      req.IsTaxable,
+		TaxRate:        req.TaxRate,
+		TrackInventory: req.TrackInventory,
+		AllowBackorder: req.AllowBackorder,
+		SortOrder:      req.SortOrder,
+		Metadata:       metadata,
+	})
+	if err != nil {
+		return nil, catalogError(err)
+	}
+
+	return &productv1.ProductResponse{
+		Product: proto.MapCatalogDetailToProto(&domain.CatalogProductDetail{Product: p}),
+	}, nil
+}
+
+// ---------------------------
+// DELETE
+// ---------------------------
+func (s *ProductServiceV1) Delete(
+	ctx context.Context,
+	req *productv1.DeleteRequest,
+) (*emptypb.Empty, error) {
+
+	shopID, err := catalogShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DeleteCatalogProduct(ctx, shopID, req.Id, req.SoftDelete); err != nil {
+		return nil, catalogError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ---------------------------
+// UPDATE STOCK
+// ---------------------------
+func (s *ProductServiceV1) UpdateStock(
+	ctx context.Context,
+	req *productv1.StockRequest,
+) (*productv1.ProductResponse, error) {
+
+	shopID, err := catalogShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ProductId == "" || req.QuantityChange == 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	p, err := s.repo.AdjustStock(ctx, shopID, domain.StockChange{
+		ProductID:      req.ProductId,
+		QuantityChange: req.QuantityChange,
+		Reason:         req.Reason,
+	})
+	if err != nil {
+		return nil, catalogError(err)
+	}
+
+	return &productv1.ProductResponse{
+		Product: proto.MapCatalogDetailToProto(&domain.CatalogProductDetail{Product: p}),
+	}, nil
+}
+
+// ---------------------------
+// BULK UPDATE STOCK
+// ---------------------------
+// Each update is applied on its own; one that fails is reported in errors
+// and does not undo the others.
+func (s *ProductServiceV1) BulkUpdateStock(
+	ctx context.Context,
+	req *productv1.BulkStockRequest,
+) (*productv1.BulkStockResponse, error) {
+
+	shopID, err := catalogShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Updates) == 0 || len(req.Updates) > maxBulkUpdates {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	resp := &productv1.BulkStockResponse{
+		Products: make([]*productv1.ProductDetail, 0, len(req.Updates)),
+		Errors:   make([]*productv1.StockError, 0),
+	}
+	for _, u := range req.Updates {
+		if u.ProductId == "" || u.QuantityChange == 0 {
+			resp.Errors = append(resp.Errors, &productv1.StockError{
+				ProductId:    u.ProductId,
+				ErrorMessage: errors.ErrInvalidInputMsg,
+			})
+			continue
+		}
+
+		p, err := s.repo.AdjustStock(ctx, shopID, domain.StockChange{
+			ProductID:      u.ProductId,
+			QuantityChange: u.QuantityChange,
+			Reason:         u.Reason,
+		})
+		if err != nil {
+			resp.Errors = append(resp.Errors, &productv1.StockError{
+				ProductId:    u.ProductId,
+				ErrorMessage: stockErrorMessage(err),
+			})
+			continue
+		}
+		resp.Products = append(resp.Products, proto.MapCatalogDetailToProto(&domain.CatalogProductDetail{Product: p}))
+	}
+	resp.SuccessCount = int32(len(resp.Products))
+	resp.ErrorCount = int32(len(resp.Errors))
+
+	return resp, nil
+}
+
+// ---------------------------
+// LOOKUP BY CODE
+// ---------------------------
+// Resolves a code scanned at the till. A code that matches nothing and has a
+// wrong check digit was most likely misread, so it is reported as such
+// rather than as an unknown product.
+func (s *ProductServiceV1) LookupByCode(
+	ctx context.Context,
+	req *productv1.LookupByCodeRequest,
+) (*productv1.LookupByCodeResponse, error) {
+
+	shopID, err := catalogShopID(ctx, req.ShopId)
+	if err != nil {
+		return nil, err
+	}
+
+	scanned := domain.ParseScannedCode(req.Code)
+	if scanned.Code == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	match, err := s.repo.LookupByCode(ctx, shopID, scanned.Candidates, req.IncludeInactive)
+	if err == sql.ErrNoRows && !scanned.CheckDigitOK {
+		err = domain.ErrBarcodeCheckDigit
+	}
+	if err != nil {
+		return nil, catalogError(err)
+	}
+
+	resp := &productv1.LookupByCodeResponse{
+		Product:   proto.MapCatalogProductToProto(match.Product),
+		MatchedBy: match.MatchedBy,
+		Code:      match.Code,
+		Symbology: scanned.Symbology,
+		UnitPrice: match.Product.Price,
+	}
+	if match.Variant != nil {
+		resp.Variant = proto.MapVariantToProto(match.Variant)
+		if match.Variant.Price != nil {
+			resp.UnitPrice = *match.Variant.Price
+		}
+	}
+
+	if scanned.Embedded[match.Code] {
+		// The embedded price is in minor units of the product's currency.
+		if m, err := money.New(scanned.EmbeddedAmount, match.Product.Currency); err == nil {
+			price := m.Float()
+			resp.PriceEmbedded = true
+			resp.EmbeddedPrice = &price
+			if resp.UnitPrice > 0 {
+				quantity := math.Round(price/resp.UnitPrice*1000) / 1000
+				resp.Quantity = &quantity
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+// catalogShopID returns the caller's shop. A shop_id sent in the request must
+// be that shop.
+func catalogShopID(ctx context.Context, requested string) (string, error) {
+	shopID, err := pkg.MustGetShopID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if requested != "" && requested != shopID {
+		return "", errors.GRPC(codes.PermissionDenied, errors.ErrForbiddenCode, errors.ErrForbiddenMsg)
+	}
+	return shopID, nil
+}
+
+func catalogError(err error) error {
+	switch err {
+	case sql.ErrNoRows:
+		return errors.GRPC(codes.NotFound, errors.ErrProductNotFoundCode, errors.ErrProductNotFoundMsg)
+	case domain.ErrCategoryNotFound:
+		return errors.GRPC(codes.NotFound, errors.ErrProductCategoryNotFoundCode, errors.ErrProductCategoryNotFoundMsg)
+	case domain.ErrProductConflict:
+		return errors.GRPC(codes.AlreadyExists, errors.ErrProductConflictCode, errors.ErrProductConflictMsg)
+	case domain.ErrInsufficientStock:
+		return errors.GRPC(codes.FailedPrecondition, errors.ErrProductOutOfStockCode, errors.ErrProductOutOfStockMsg)
+	case domain.ErrBarcodeCheckDigit:
+		return errors.GRPC(codes.InvalidArgument, errors.ErrBarcodeInvalidCode, errors.ErrBarcodeInvalidMsg)
+	default:
+		return errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+}
+
+func stockErrorMessage(err error) string {
+	switch err {
+	case sql.ErrNoRows:
+		return errors.ErrProductNotFoundMsg
+	case domain.ErrInsufficientStock:
+		return errors.ErrProductOutOfStockMsg
+	default:
+		return errors.ErrDatabaseMsg
+	}
+}
+
+// nonEmpty treats an empty optional string as unset.
+func nonEmpty(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return s
+}
+
+func withDefault(s *string, def string) string {
+	if s == nil || *s == "" {
+		return def
+	}
+	return *s
+}
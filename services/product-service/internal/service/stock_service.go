@@ -0,0 +1,244 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	errors "hpkg/constants/responses"
+	pkg "hpkg/grpc"
+
+	"productservice/internal/domain"
+	"productservice/internal/domain/proto"
+	"productservice/proto/productpb"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// DefaultReservationTTL is how long stock stays held for an order that
+	// has not been paid for.
+	DefaultReservationTTL = 15 * time.Minute
+	maxReservationTTL     = 24 * time.Hour
+)
+
+// ---------------------------
+// RESERVE STOCK
+// ---------------------------
+func (s *ProductService) ReserveStock(
+	ctx context.Context,
+	req *productpb.ReserveStockRequest,
+) (*productpb.ReserveStockResponse, error) {
+
+	shopID, err := pkg.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.OrderId == "" || len(req.Items) == 0 || req.TtlSeconds < 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	items := make([]*domain.StockReservationItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item.ProductId == "" || item.Quantity <= 0 {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrProductInvalidCode, errors.ErrProductInvalidMsg)
+		}
+		var variantID *string
+		if item.VariantId != "" {
+			variantID = &item.VariantId
+		}
+		items = append(items, &domain.StockReservationItem{
+			ProductID: item.ProductId,
+			VariantID: variantID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	ttl := DefaultReservationTTL
+	if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+		if ttl > maxReservationTTL {
+			ttl = maxReservationTTL
+		}
+	}
+
+	res, err := s.repo.ReserveStock(ctx, shopID, req.OrderId, items, time.Now().Add(ttl))
+	if err != nil {
+		return nil, stockError(err)
+	}
+
+	return &productpb.ReserveStockResponse{
+		Reservation: proto.MapReservationToProto(res),
+	}, nil
+}
+
+// ---------------------------
+// RELEASE STOCK
+// ---------------------------
+func (s *ProductService) ReleaseStock(
+	ctx context.Context,
+	req *productpb.ReleaseStockRequest,
+) (*productpb.ReleaseStockResponse, error) {
+
+	shopID, err := pkg.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.OrderId == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	var reason *string
+	if req.Reason != "" {
+		reason = &req.Reason
+	}
+
+	res, err := s.repo.ReleaseStock(ctx, shopID, req.OrderId, reason)
+	if err != nil {
+		return nil, stockError(err)
+	}
+
+	return &productpb.ReleaseStockResponse{
+		Reservation: proto.MapReservationToProto(res),
+	}, nil
+}
+
+// ---------------------------
+// COMMIT STOCK
+// ---------------------------
+func (s *ProductService) CommitStock(
+	ctx context.Context,
+	req *productpb.CommitStockRequest,
+) (*productpb.CommitStockResponse, error) {
+
+	shopID, err := pkg.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.OrderId == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	res, err := s.repo.CommitStock(ctx, shopID, req.OrderId)
+	if err != nil {
+		return nil, stockError(err)
+	}
+
+	return &productpb.CommitStockResponse{
+		Reservation: proto.MapReservationToProto(res),
+	}, nil
+}
+
+// ---------------------------
+// GET STOCK RESERVATION
+// ---------------------------
+func (s *ProductService) GetStockReservation(
+	ctx context.Context,
+	req *productpb.GetStockReservationRequest,
+) (*productpb.GetStockReservationResponse, error) {
+
+	shopID, err := pkg.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.repo.GetReservation(ctx, shopID, req.OrderId)
+	if err != nil {
+		return nil, stockError(err)
+	}
+
+	return &productpb.GetStockReservationResponse{
+		Reservation: proto.MapReservationToProto(res),
+	}, nil
+}
+
+// ExpireReservations periodically returns the stock of reservations whose
+// order was never paid for. It blocks until ctx is cancelled.
+func (s *ProductService) ExpireReservations(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Drain in batches so a backlog clears within one tick.
+			for {
+				n, err := s.repo.ExpireReservations(ctx, 100)
+				if err != nil || n < 100 {
+					break
+				}
+			}
+		}
+	}
+}
+
+// ---------------------------
+// RESTOCK ITEMS
+// ---------------------------
+func (s *ProductService) RestockItems(
+	ctx context.Context,
+	req *productpb.RestockItemsRequest,
+) (*productpb.RestockItemsResponse, error) {
+
+	shopID, err := pkg.MustGetShopID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ReferenceId == "" || len(req.Items) == 0 {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	items := make([]*domain.StockReservationItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item.ProductId == "" || item.Quantity <= 0 {
+			return nil, errors.GRPC(codes.InvalidArgument, errors.ErrProductInvalidCode, errors.ErrProductInvalidMsg)
+		}
+		var variantID *string
+		if item.VariantId != "" {
+			variantID = &item.VariantId
+		}
+		items = append(items, &domain.StockReservationItem{
+			ProductID: item.ProductId,
+			VariantID: variantID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	var reason *string
+	if req.Reason != "" {
+		reason = &req.Reason
+	}
+
+	done, err := s.repo.RestockItems(ctx, shopID, req.ReferenceId, items, reason)
+	if err != nil {
+		return nil, stockError(err)
+	}
+
+	return &productpb.RestockItemsResponse{
+		ReferenceId:      req.ReferenceId,
+		AlreadyRestocked: done,
+	}, nil
+}
+
+func stockError(err error) error {
+	switch err {
+	case sql.ErrNoRows:
+		return errors.GRPC(codes.NotFound, errors.ErrStockReservationNotFoundCode, errors.ErrStockReservationNotFoundMsg)
+	case domain.ErrStockProductGone:
+		return errors.GRPC(codes.NotFound, errors.ErrProductNotFoundCode, errors.ErrProductNotFoundMsg)
+	case domain.ErrInsufficientStock:
+		return errors.GRPC(codes.FailedPrecondition, errors.ErrProductOutOfStockCode, errors.ErrProductOutOfStockMsg)
+	case domain.ErrReservationLapsed:
+		return errors.GRPC(codes.FailedPrecondition, errors.ErrStockReservationExpiredCode, errors.ErrStockReservationExpiredMsg)
+	case domain.ErrReservationClosed:
+		return errors.GRPC(codes.FailedPrecondition, errors.ErrStockReservationClosedCode, errors.ErrStockReservationClosedMsg)
+	default:
+		return errors.GRPC(codes.Internal, errors.ErrDatabaseCode, errors.ErrDatabaseMsg)
+	}
+}
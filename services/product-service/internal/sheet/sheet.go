@@ -0,0 +1,142 @@
+// Package sheet reads and writes the CSV and XLSX files of catalog imports
+// and exports.
+package sheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown sheet format")
+	ErrNoSheet       = errors.New("workbook has no such sheet")
+	ErrTooLarge      = errors.New("sheet is too large")
+)
+
+// ValidFormat reports whether format is one Read and NewWriter handle.
+func ValidFormat(format string) bool {
+	return format == FormatCSV || format == FormatXLSX
+}
+
+// ContentType is the MIME type of files in format.
+func ContentType(format string) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv"
+}
+
+// Read returns the rows of a file, row i being line i+1 of a CSV or row i+1
+// of the XLSX sheet named sheetName, or the first sheet if it is empty.
+func Read(format string, data []byte, sheetName string) ([][]string, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(data)
+	case FormatXLSX:
+		return readXLSX(data, sheetName)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+// readCSV reads comma, semicolon or tab separated values, whichever the
+// header line has most of; spreadsheet apps in some locales save with
+// semicolons.
+func readCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	header := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		header = data[:i]
+	}
+	comma := ','
+	most := bytes.Count(header, []byte{','})
+	for _, d := range []rune{';', '\t'} {
+		if n := bytes.Count(header, []byte(string(d))); n > most {
+			comma, most = d, n
+		}
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = comma
+	r.FieldsPerRecord = -1
+
+	// The reader skips blank lines and a quoted value may span lines; rows
+	// are placed by the line their record starts on, so row numbers in an
+	// import report match the file.
+	var rows [][]string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		for len(rows) < line-1 {
+			rows = append(rows, nil)
+		}
+		rows = append(rows, record)
+	}
+}
+
+// Cell is a value written to a file. Numbers are written as numbers to XLSX,
+// text as text, so codes such as barcodes keep their leading zeros.
+type Cell struct {
+	Text   string
+	Number bool
+}
+
+func Text(s string) Cell {
+	return Cell{Text: s}
+}
+
+func Number(f float64) Cell {
+	return Cell{Text: strconv.FormatFloat(f, 'f', -1, 64), Number: true}
+}
+
+// Writer writes a file row by row. Close must be called to finish the file;
+// it does not close the underlying writer.
+type Writer interface {
+	WriteRow(cells []Cell) error
+	Close() error
+}
+
+// NewWriter returns a Writer of a file in format.
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+type csvWriter struct {
+	w      *csv.Writer
+	record []string
+}
+
+func (c *csvWriter) WriteRow(cells []Cell) error {
+	c.record = c.record[:0]
+	for _, cell := range cells {
+		c.record = append(c.record, cell.Text)
+	}
+	return c.w.Write(c.record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,1471 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: product/category.dev.proto
+
+package categorypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShopId        string                 `protobuf:"bytes,2,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	ParentId      *string                `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ImageUrl      *string                `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	DisplayOrder  int32                  `protobuf:"varint,8,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	IsActive      bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	Parent        *Category              `protobuf:"bytes,13,opt,name=parent,proto3,oneof" json:"parent,omitempty"`
+	Children      []*Category            `protobuf:"bytes,14,rep,name=children,proto3" json:"children,omitempty"`
+	ProductCount  int32                  `protobuf:"varint,15,opt,name=product_count,json=productCount,proto3" json:"product_count,omitempty"` // products directly in the category
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_product_category_dev_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Category) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Category) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Category) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Category) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *Category) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Category) GetParent() *Category {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *Category) GetChildren() []*Category {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Category) GetProductCount() int32 {
+	if x != nil {
+		return x.ProductCount
+	}
+	return 0
+}
+
+type CategoryProductSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sku           string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	StockQuantity int32                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	IsActive      bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ThumbnailUrl  *string                `protobuf:"bytes,7,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryProductSummary) Reset() {
+	*x = CategoryProductSummary{}
+	mi := &file_product_category_dev_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryProductSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryProductSummary) ProtoMessage() {}
+
+func (x *CategoryProductSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryProductSummary.ProtoReflect.Descriptor instead.
+func (*CategoryProductSummary) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CategoryProductSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CategoryProductSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryProductSummary) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CategoryProductSummary) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CategoryProductSummary) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *CategoryProductSummary) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CategoryProductSummary) GetThumbnailUrl() string {
+	if x != nil && x.ThumbnailUrl != nil {
+		return *x.ThumbnailUrl
+	}
+	return ""
+}
+
+type CategoryWithProducts struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Category      *Category                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Products      []*CategoryProductSummary `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	TotalProducts int32                     `protobuf:"varint,3,opt,name=total_products,json=totalProducts,proto3" json:"total_products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryWithProducts) Reset() {
+	*x = CategoryWithProducts{}
+	mi := &file_product_category_dev_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryWithProducts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryWithProducts) ProtoMessage() {}
+
+func (x *CategoryWithProducts) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryWithProducts.ProtoReflect.Descriptor instead.
+func (*CategoryWithProducts) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CategoryWithProducts) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryWithProducts) GetProducts() []*CategoryProductSummary {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *CategoryWithProducts) GetTotalProducts() int32 {
+	if x != nil {
+		return x.TotalProducts
+	}
+	return 0
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShopId        string                 `protobuf:"bytes,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          *string                `protobuf:"bytes,3,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	ParentId      *string                `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ImageUrl      *string                `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	DisplayOrder  int32                  `protobuf:"varint,7,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"` // defaults to true
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_product_category_dev_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateCategoryRequest) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *CreateCategoryRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type GetCategoryRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShopId              string                 `protobuf:"bytes,2,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	IncludeParent       bool                   `protobuf:"varint,3,opt,name=include_parent,json=includeParent,proto3" json:"include_parent,omitempty"`
+	IncludeChildren     bool                   `protobuf:"varint,4,opt,name=include_children,json=includeChildren,proto3" json:"include_children,omitempty"`
+	IncludeProductCount bool                   `protobuf:"varint,5,opt,name=include_product_count,json=includeProductCount,proto3" json:"include_product_count,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_product_category_dev_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetCategoryRequest) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+func (x *GetCategoryRequest) GetIncludeParent() bool {
+	if x != nil {
+		return x.IncludeParent
+	}
+	return false
+}
+
+func (x *GetCategoryRequest) GetIncludeChildren() bool {
+	if x != nil {
+		return x.IncludeChildren
+	}
+	return false
+}
+
+func (x *GetCategoryRequest) GetIncludeProductCount() bool {
+	if x != nil {
+		return x.IncludeProductCount
+	}
+	return false
+}
+
+type ListCategoriesRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ShopId              string                 `protobuf:"bytes,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	ParentId            *string                `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"` // empty lists the top-level categories
+	IncludeInactive     bool                   `protobuf:"varint,3,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	IncludeProductCount bool                   `protobuf:"varint,4,opt,name=include_product_count,json=includeProductCount,proto3" json:"include_product_count,omitempty"`
+	Page                int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize            int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Search              *string                `protobuf:"bytes,7,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_product_category_dev_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCategoriesRequest) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+func (x *ListCategoriesRequest) GetIncludeProductCount() bool {
+	if x != nil {
+		return x.IncludeProductCount
+	}
+	return false
+}
+
+func (x *ListCategoriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+// An empty parent_id moves the category to the top level.
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShopId        string                 `protobuf:"bytes,2,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Slug          *string                `protobuf:"bytes,4,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	ParentId      *string                `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ImageUrl      *string                `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	DisplayOrder  *int32                 `protobuf:"varint,8,opt,name=display_order,json=displayOrder,proto3,oneof" json:"display_order,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_product_category_dev_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDisplayOrder() int32 {
+	if x != nil && x.DisplayOrder != nil {
+		return *x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShopId        string                 `protobuf:"bytes,2,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_product_category_dev_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCategoryRequest) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+type GetCategoryTreeRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ShopId              string                 `protobuf:"bytes,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	IncludeInactive     bool                   `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	IncludeProductCount bool                   `protobuf:"varint,3,opt,name=include_product_count,json=includeProductCount,proto3" json:"include_product_count,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeRequest) Reset() {
+	*x = GetCategoryTreeRequest{}
+	mi := &file_product_category_dev_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeRequest) ProtoMessage() {}
+
+func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCategoryTreeRequest) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+func (x *GetCategoryTreeRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+func (x *GetCategoryTreeRequest) GetIncludeProductCount() bool {
+	if x != nil {
+		return x.IncludeProductCount
+	}
+	return false
+}
+
+// Products of the category's subcategories are included.
+type GetCategoryWithProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShopId        string                 `protobuf:"bytes,2,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryWithProductsRequest) Reset() {
+	*x = GetCategoryWithProductsRequest{}
+	mi := &file_product_category_dev_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryWithProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryWithProductsRequest) ProtoMessage() {}
+
+func (x *GetCategoryWithProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryWithProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryWithProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetCategoryWithProductsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetCategoryWithProductsRequest) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+func (x *GetCategoryWithProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCategoryWithProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_product_category_dev_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type GetCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_product_category_dev_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryResponse) ProtoMessage() {}
+
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_product_category_dev_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_product_category_dev_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListCategoriesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCategoriesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCategoriesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetCategoryWithProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *CategoryWithProducts  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryWithProductsResponse) Reset() {
+	*x = GetCategoryWithProductsResponse{}
+	mi := &file_product_category_dev_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryWithProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryWithProductsResponse) ProtoMessage() {}
+
+func (x *GetCategoryWithProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryWithProductsResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryWithProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCategoryWithProductsResponse) GetData() *CategoryWithProducts {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CategoryTreeNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Children      []*CategoryTreeNode    `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTreeNode) Reset() {
+	*x = CategoryTreeNode{}
+	mi := &file_product_category_dev_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTreeNode) ProtoMessage() {}
+
+func (x *CategoryTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTreeNode.ProtoReflect.Descriptor instead.
+func (*CategoryTreeNode) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CategoryTreeNode) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryTreeNode) GetChildren() []*CategoryTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type GetCategoryTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*CategoryTreeNode    `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeResponse) Reset() {
+	*x = GetCategoryTreeResponse{}
+	mi := &file_product_category_dev_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeResponse) ProtoMessage() {}
+
+func (x *GetCategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_category_dev_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_product_category_dev_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCategoryTreeResponse) GetNodes() []*CategoryTreeNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+var File_product_category_dev_proto protoreflect.FileDescriptor
+
+const file_product_category_dev_proto_rawDesc = "" +
+	"\n" +
+	"\x1aproduct/category.dev.proto\x12\n" +
+	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x8e\x05\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\ashop_id\x18\x02 \x01(\tR\x06shopId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\x12 \n" +
+	"\tparent_id\x18\x05 \x01(\tH\x00R\bparentId\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x01R\vdescription\x88\x01\x01\x12 \n" +
+	"\timage_url\x18\a \x01(\tH\x02R\bimageUrl\x88\x01\x01\x12#\n" +
+	"\rdisplay_order\x18\b \x01(\x05R\fdisplayOrder\x12\x1b\n" +
+	"\tis_active\x18\t \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tdeletedAt\x88\x01\x01\x121\n" +
+	"\x06parent\x18\r \x01(\v2\x14.product.v1.CategoryH\x04R\x06parent\x88\x01\x01\x120\n" +
+	"\bchildren\x18\x0e \x03(\v2\x14.product.v1.CategoryR\bchildren\x12#\n" +
+	"\rproduct_count\x18\x0f \x01(\x05R\fproductCountB\f\n" +
+	"\n" +
+	"_parent_idB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_image_urlB\r\n" +
+	"\v_deleted_atB\t\n" +
+	"\a_parent\"\xe4\x01\n" +
+	"\x16CategoryProductSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12%\n" +
+	"\x0estock_quantity\x18\x05 \x01(\x05R\rstockQuantity\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\x12(\n" +
+	"\rthumbnail_url\x18\a \x01(\tH\x00R\fthumbnailUrl\x88\x01\x01B\x10\n" +
+	"\x0e_thumbnail_url\"\xaf\x01\n" +
+	"\x14CategoryWithProducts\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\x12>\n" +
+	"\bproducts\x18\x02 \x03(\v2\".product.v1.CategoryProductSummaryR\bproducts\x12%\n" +
+	"\x0etotal_products\x18\x03 \x01(\x05R\rtotalProducts\"\xd2\x02\n" +
+	"\x15CreateCategoryRequest\x12\x17\n" +
+	"\ashop_id\x18\x01 \x01(\tR\x06shopId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\x04slug\x18\x03 \x01(\tH\x00R\x04slug\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\x04 \x01(\tH\x01R\bparentId\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x02R\vdescription\x88\x01\x01\x12 \n" +
+	"\timage_url\x18\x06 \x01(\tH\x03R\bimageUrl\x88\x01\x01\x12#\n" +
+	"\rdisplay_order\x18\a \x01(\x05R\fdisplayOrder\x12 \n" +
+	"\tis_active\x18\b \x01(\bH\x04R\bisActive\x88\x01\x01B\a\n" +
+	"\x05_slugB\f\n" +
+	"\n" +
+	"_parent_idB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_image_urlB\f\n" +
+	"\n" +
+	"_is_active\"\xc3\x01\n" +
+	"\x12GetCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\ashop_id\x18\x02 \x01(\tR\x06shopId\x12%\n" +
+	"\x0einclude_parent\x18\x03 \x01(\bR\rincludeParent\x12)\n" +
+	"\x10include_children\x18\x04 \x01(\bR\x0fincludeChildren\x122\n" +
+	"\x15include_product_count\x18\x05 \x01(\bR\x13includeProductCount\"\x98\x02\n" +
+	"\x15ListCategoriesRequest\x12\x17\n" +
+	"\ashop_id\x18\x01 \x01(\tR\x06shopId\x12 \n" +
+	"\tparent_id\x18\x02 \x01(\tH\x00R\bparentId\x88\x01\x01\x12)\n" +
+	"\x10include_inactive\x18\x03 \x01(\bR\x0fincludeInactive\x122\n" +
+	"\x15include_product_count\x18\x04 \x01(\bR\x13includeProductCount\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1b\n" +
+	"\x06search\x18\a \x01(\tH\x01R\x06search\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_idB\t\n" +
+	"\a_search\"\x87\x03\n" +
+	"\x15UpdateCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\ashop_id\x18\x02 \x01(\tR\x06shopId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04slug\x18\x04 \x01(\tH\x01R\x04slug\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\x05 \x01(\tH\x02R\bparentId\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x03R\vdescription\x88\x01\x01\x12 \n" +
+	"\timage_url\x18\a \x01(\tH\x04R\bimageUrl\x88\x01\x01\x12(\n" +
+	"\rdisplay_order\x18\b \x01(\x05H\x05R\fdisplayOrder\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\t \x01(\bH\x06R\bisActive\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_slugB\f\n" +
+	"\n" +
+	"_parent_idB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_image_urlB\x10\n" +
+	"\x0e_display_orderB\f\n" +
+	"\n" +
+	"_is_active\"@\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\ashop_id\x18\x02 \x01(\tR\x06shopId\"\x90\x01\n" +
+	"\x16GetCategoryTreeRequest\x12\x17\n" +
+	"\ashop_id\x18\x01 \x01(\tR\x06shopId\x12)\n" +
+	"\x10include_inactive\x18\x02 \x01(\bR\x0fincludeInactive\x122\n" +
+	"\x15include_product_count\x18\x03 \x01(\bR\x13includeProductCount\"z\n" +
+	"\x1eGetCategoryWithProductsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\ashop_id\x18\x02 \x01(\tR\x06shopId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"J\n" +
+	"\x16CreateCategoryResponse\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\"G\n" +
+	"\x13GetCategoryResponse\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\"J\n" +
+	"\x16UpdateCategoryResponse\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\"\x95\x01\n" +
+	"\x16ListCategoriesResponse\x124\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x14.product.v1.CategoryR\n" +
+	"categories\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"W\n" +
+	"\x1fGetCategoryWithProductsResponse\x124\n" +
+	"\x04data\x18\x01 \x01(\v2 .product.v1.CategoryWithProductsR\x04data\"~\n" +
+	"\x10CategoryTreeNode\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.product.v1.CategoryR\bcategory\x128\n" +
+	"\bchildren\x18\x02 \x03(\v2\x1c.product.v1.CategoryTreeNodeR\bchildren\"M\n" +
+	"\x17GetCategoryTreeResponse\x122\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1c.product.v1.CategoryTreeNodeR\x05nodes2\xcf\x04\n" +
+	"\x0fCategoryService\x12O\n" +
+	"\x06Create\x12!.product.v1.CreateCategoryRequest\x1a\".product.v1.CreateCategoryResponse\x12F\n" +
+	"\x03Get\x12\x1e.product.v1.GetCategoryRequest\x1a\x1f.product.v1.GetCategoryResponse\x12M\n" +
+	"\x04List\x12!.product.v1.ListCategoriesRequest\x1a\".product.v1.ListCategoriesResponse\x12O\n" +
+	"\x06Update\x12!.product.v1.UpdateCategoryRequest\x1a\".product.v1.UpdateCategoryResponse\x12C\n" +
+	"\x06Delete\x12!.product.v1.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\aGetTree\x12\".product.v1.GetCategoryTreeRequest\x1a#.product.v1.GetCategoryTreeResponse\x12j\n" +
+	"\x0fGetWithProducts\x12*.product.v1.GetCategoryWithProductsRequest\x1a+.product.v1.GetCategoryWithProductsResponseB Z\x1eproto/v1/categorypb;categorypbb\x06proto3"
+
+var (
+	file_product_category_dev_proto_rawDescOnce sync.Once
+	file_product_category_dev_proto_rawDescData []byte
+)
+
+func file_product_category_dev_proto_rawDescGZIP() []byte {
+	file_product_category_dev_proto_rawDescOnce.Do(func() {
+		file_product_category_dev_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_product_category_dev_proto_rawDesc), len(file_product_category_dev_proto_rawDesc)))
+	})
+	return file_product_category_dev_proto_rawDescData
+}
+
+var file_product_category_dev_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_product_category_dev_proto_goTypes = []any{
+	(*Category)(nil),                        // 0: product.v1.Category
+	(*CategoryProductSummary)(nil),          // 1: product.v1.CategoryProductSummary
+	(*CategoryWithProducts)(nil),            // 2: product.v1.CategoryWithProducts
+	(*CreateCategoryRequest)(nil),           // 3: product.v1.CreateCategoryRequest
+	(*GetCategoryRequest)(nil),              // 4: product.v1.GetCategoryRequest
+	(*ListCategoriesRequest)(nil),           // 5: product.v1.ListCategoriesRequest
+	(*UpdateCategoryRequest)(nil),           // 6: product.v1.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),           // 7: product.v1.DeleteCategoryRequest
+	(*GetCategoryTreeRequest)(nil),          // 8: product.v1.GetCategoryTreeRequest
+	(*GetCategoryWithProductsRequest)(nil),  // 9: product.v1.GetCategoryWithProductsRequest
+	(*CreateCategoryResponse)(nil),          // 10: product.v1.CreateCategoryResponse
+	(*GetCategoryResponse)(nil),             // 11: product.v1.GetCategoryResponse
+	(*UpdateCategoryResponse)(nil),          // 12: product.v1.UpdateCategoryResponse
+	(*ListCategoriesResponse)(nil),          // 13: product.v1.ListCategoriesResponse
+	(*GetCategoryWithProductsResponse)(nil), // 14: product.v1.GetCategoryWithProductsResponse
+	(*CategoryTreeNode)(nil),                // 15: product.v1.CategoryTreeNode
+	(*GetCategoryTreeResponse)(nil),         // 16: product.v1.GetCategoryTreeResponse
+	(*timestamppb.Timestamp)(nil),           // 17: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 18: google.protobuf.Empty
+}
+var file_product_category_dev_proto_depIdxs = []int32{
+	17, // 0: product.v1.Category.created_at:type_name -> google.protobuf.Timestamp
+	17, // 1: product.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 2: product.v1.Category.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: product.v1.Category.parent:type_name -> product.v1.Category
+	0,  // 4: product.v1.Category.children:type_name -> product.v1.Category
+	0,  // 5: product.v1.CategoryWithProducts.category:type_name -> product.v1.Category
+	1,  // 6: product.v1.CategoryWithProducts.products:type_name -> product.v1.CategoryProductSummary
+	0,  // 7: product.v1.CreateCategoryResponse.category:type_name -> product.v1.Category
+	0,  // 8: product.v1.GetCategoryResponse.category:type_name -> product.v1.Category
+	0,  // 9: product.v1.UpdateCategoryResponse.category:type_name -> product.v1.Category
+	0,  // 10: product.v1.ListCategoriesResponse.categories:type_name -> product.v1.Category
+	2,  // 11: product.v1.GetCategoryWithProductsResponse.data:type_name -> product.v1.CategoryWithProducts
+	0,  // 12: product.v1.CategoryTreeNode.category:type_name -> product.v1.Category
+	15, // 13: product.v1.CategoryTreeNode.children:type_name -> product.v1.CategoryTreeNode
+	15, // 14: product.v1.GetCategoryTreeResponse.nodes:type_name -> product.v1.CategoryTreeNode
+	3,  // 15: product.v1.CategoryService.Create:input_type -> product.v1.CreateCategoryRequest
+	4,  // 16: product.v1.CategoryService.Get:input_type -> product.v1.GetCategoryRequest
+	5,  // 17: product.v1.CategoryService.List:input_type -> product.v1.ListCategoriesRequest
+	6,  // 18: product.v1.CategoryService.Update:input_type -> product.v1.UpdateCategoryRequest
+	7,  // 19: product.v1.CategoryService.Delete:input_type -> product.v1.DeleteCategoryRequest
+	8,  // 20: product.v1.CategoryService.GetTree:input_type -> product.v1.GetCategoryTreeRequest
+	9,  // 21: product.v1.CategoryService.GetWithProducts:input_type -> product.v1.GetCategoryWithProductsRequest
+	10, // 22: product.v1.CategoryService.Create:output_type -> product.v1.CreateCategoryResponse
+	11, // 23: product.v1.CategoryService.Get:output_type -> product.v1.GetCategoryResponse
+	13, // 24: product.v1.CategoryService.List:output_type -> product.v1.ListCategoriesResponse
+	12, // 25: product.v1.CategoryService.Update:output_type -> product.v1.UpdateCategoryResponse
+	18, // 26: product.v1.CategoryService.Delete:output_type -> google.protobuf.Empty
+	16, // 27: product.v1.CategoryService.GetTree:output_type -> product.v1.GetCategoryTreeResponse
+	14, // 28: product.v1.CategoryService.GetWithProducts:output_type -> product.v1.GetCategoryWithProductsResponse
+	22, // [22:29] is the sub-list for method output_type
+	15, // [15:22] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_product_category_dev_proto_init() }
+func file_product_category_dev_proto_init() {
+	if File_product_category_dev_proto != nil {
+		return
+	}
+	file_product_category_dev_proto_msgTypes[0].OneofWrappers = []any{}
+	file_product_category_dev_proto_msgTypes[1].OneofWrappers = []any{}
+	file_product_category_dev_proto_msgTypes[3].OneofWrappers = []any{}
+	file_product_category_dev_proto_msgTypes[5].OneofWrappers = []any{}
+	file_product_category_dev_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_category_dev_proto_rawDesc), len(file_product_category_dev_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_product_category_dev_proto_goTypes,
+		DependencyIndexes: file_product_category_dev_proto_depIdxs,
+		MessageInfos:      file_product_category_dev_proto_msgTypes,
+	}.Build()
+	File_product_category_dev_proto = out.File
+	file_product_category_dev_proto_goTypes = nil
+	file_product_category_dev_proto_depIdxs = nil
+}
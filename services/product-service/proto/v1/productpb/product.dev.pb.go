@@ -12,6 +12,7 @@ import (
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	moneypb "hpkg/money/moneypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -55,6 +56,8 @@ type Product struct {
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeletedAt      *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	Currency       string                 `protobuf:"bytes,30,opt,name=currency,proto3" json:"currency,omitempty"`                       // ISO 4217 code of price, cost_price and compare_at_price
+	PriceMoney     *moneypb.Money         `protobuf:"bytes,31,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"` // price in the currency's minor units
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -292,6 +295,20 @@ func (x *Product) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Product) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Product) GetPriceMoney() *moneypb.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
 type ProductSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -930,15 +947,17 @@ type CreateRequest struct {
 	Unit           *string                `protobuf:"bytes,14,opt,name=unit,proto3,oneof" json:"unit,omitempty"`
 	Weight         *float64               `protobuf:"fixed64,15,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
 	WeightUnit     *string                `protobuf:"bytes,16,opt,name=weight_unit,json=weightUnit,proto3,oneof" json:"weight_unit,omitempty"`
-	IsActive       bool                   `protobuf:"varint,17,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsTaxable      bool                   `protobuf:"varint,18,opt,name=is_taxable,json=isTaxable,proto3" json:"is_taxable,omitempty"`
+	IsActive       *bool                  `protobuf:"varint,17,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`    // defaults to true
+	IsTaxable      *bool                  `protobuf:"varint,18,opt,name=is_taxable,json=isTaxable,proto3,oneof" json:"is_taxable,omitempty"` // defaults to true
 	TaxRate        *float64               `protobuf:"fixed64,19,opt,name=tax_rate,json=taxRate,proto3,oneof" json:"tax_rate,omitempty"`
-	TrackInventory bool                   `protobuf:"varint,20,opt,name=track_inventory,json=trackInventory,proto3" json:"track_inventory,omitempty"`
+	TrackInventory *bool                  `protobuf:"varint,20,opt,name=track_inventory,json=trackInventory,proto3,oneof" json:"track_inventory,omitempty"` // defaults to true
 	AllowBackorder bool                   `protobuf:"varint,21,opt,name=allow_backorder,json=allowBackorder,proto3" json:"allow_backorder,omitempty"`
 	IsFeatured     bool                   `protobuf:"varint,22,opt,name=is_featured,json=isFeatured,proto3" json:"is_featured,omitempty"`
 	Media          []*MediaRequest        `protobuf:"bytes,23,rep,name=media,proto3" json:"media,omitempty"`
 	TagIds         []string               `protobuf:"bytes,24,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
 	Metadata       *structpb.Struct       `protobuf:"bytes,25,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Currency       *string                `protobuf:"bytes,26,opt,name=currency,proto3,oneof" json:"currency,omitempty"` // defaults to USD
+	SortOrder      *int32                 `protobuf:"varint,27,opt,name=sort_order,json=sortOrder,proto3,oneof" json:"sort_order,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1086,15 +1105,15 @@ func (x *CreateRequest) GetWeightUnit() string {
 }
 
 func (x *CreateRequest) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
 	}
 	return false
 }
 
 func (x *CreateRequest) GetIsTaxable() bool {
-	if x != nil {
-		return x.IsTaxable
+	if x != nil && x.IsTaxable != nil {
+		return *x.IsTaxable
 	}
 	return false
 }
@@ -1107,8 +1126,8 @@ func (x *CreateRequest) GetTaxRate() float64 {
 }
 
 func (x *CreateRequest) GetTrackInventory() bool {
-	if x != nil {
-		return x.TrackInventory
+	if x != nil && x.TrackInventory != nil {
+		return *x.TrackInventory
 	}
 	return false
 }
@@ -1148,6 +1167,20 @@ func (x *CreateRequest) GetMetadata() *structpb.Struct {
 	return nil
 }
 
+func (x *CreateRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetSortOrder() int32 {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return 0
+}
+
 type MediaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MediaUrl      string                 `protobuf:"bytes,1,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
@@ -1540,6 +1573,8 @@ func (x *ListRequest) GetIncludeTags() bool {
 	return false
 }
 
+// Fields left unset are not changed. An empty category_id, sku or barcode
+// clears it.
 type UpdateRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1558,6 +1593,15 @@ type UpdateRequest struct {
 	IsActive       *bool                  `protobuf:"varint,14,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
 	IsFeatured     *bool                  `protobuf:"varint,15,opt,name=is_featured,json=isFeatured,proto3,oneof" json:"is_featured,omitempty"`
 	Metadata       *structpb.Struct       `protobuf:"bytes,16,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	Currency       *string                `protobuf:"bytes,17,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	Unit           *string                `protobuf:"bytes,18,opt,name=unit,proto3,oneof" json:"unit,omitempty"`
+	Weight         *float64               `protobuf:"fixed64,19,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	WeightUnit     *string                `protobuf:"bytes,20,opt,name=weight_unit,json=weightUnit,proto3,oneof" json:"weight_unit,omitempty"`
+	IsTaxable      *bool                  `protobuf:"varint,21,opt,name=is_taxable,json=isTaxable,proto3,oneof" json:"is_taxable,omitempty"`
+	TaxRate        *float64               `protobuf:"fixed64,22,opt,name=tax_rate,json=taxRate,proto3,oneof" json:"tax_rate,omitempty"`
+	TrackInventory *bool                  `protobuf:"varint,23,opt,name=track_inventory,json=trackInventory,proto3,oneof" json:"track_inventory,omitempty"`
+	AllowBackorder *bool                  `protobuf:"varint,24,opt,name=allow_backorder,json=allowBackorder,proto3,oneof" json:"allow_backorder,omitempty"`
+	SortOrder      *int32                 `protobuf:"varint,25,opt,name=sort_order,json=sortOrder,proto3,oneof" json:"sort_order,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1704,6 +1748,69 @@ func (x *UpdateRequest) GetMetadata() *structpb.Struct {
 	return nil
 }
 
+func (x *UpdateRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetUnit() string {
+	if x != nil && x.Unit != nil {
+		return *x.Unit
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetWeightUnit() string {
+	if x != nil && x.WeightUnit != nil {
+		return *x.WeightUnit
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetIsTaxable() bool {
+	if x != nil && x.IsTaxable != nil {
+		return *x.IsTaxable
+	}
+	return false
+}
+
+func (x *UpdateRequest) GetTaxRate() float64 {
+	if x != nil && x.TaxRate != nil {
+		return *x.TaxRate
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetTrackInventory() bool {
+	if x != nil && x.TrackInventory != nil {
+		return *x.TrackInventory
+	}
+	return false
+}
+
+func (x *UpdateRequest) GetAllowBackorder() bool {
+	if x != nil && x.AllowBackorder != nil {
+		return *x.AllowBackorder
+	}
+	return false
+}
+
+func (x *UpdateRequest) GetSortOrder() int32 {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return 0
+}
+
 type DeleteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2220,11 +2327,645 @@ func (x *StockError) GetErrorMessage() string {
 	return ""
 }
 
+// LookupByCodeRequest resolves a scanned or typed code against the barcodes
+// and SKUs of the shop's products and variants. EAN-13 and UPC-A codes also
+// match their other form, and in-store codes starting with 2 (EAN-13 20-29,
+// UPC-A number system 2) match on their item number with the price read
+// from the code.
+type LookupByCodeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShopId          string                 `protobuf:"bytes,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,3,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LookupByCodeRequest) Reset() {
+	*x = LookupByCodeRequest{}
+	mi := &file_product_product_dev_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupByCodeRequest) ProtoMessage() {}
+
+func (x *LookupByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_dev_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupByCodeRequest.ProtoReflect.Descriptor instead.
+func (*LookupByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_dev_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LookupByCodeRequest) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+func (x *LookupByCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LookupByCodeRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type LookupByCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Variant       *Variant               `protobuf:"bytes,2,opt,name=variant,proto3,oneof" json:"variant,omitempty"`
+	MatchedBy     string                 `protobuf:"bytes,3,opt,name=matched_by,json=matchedBy,proto3" json:"matched_by,omitempty"`   // barcode, sku, variant_barcode or variant_sku
+	Code          string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`                              // the code as stored on the product or variant
+	Symbology     string                 `protobuf:"bytes,5,opt,name=symbology,proto3" json:"symbology,omitempty"`                    // ean13, upca or empty for any other code
+	UnitPrice     float64                `protobuf:"fixed64,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // the variant's price, or the product's
+	PriceEmbedded bool                   `protobuf:"varint,7,opt,name=price_embedded,json=priceEmbedded,proto3" json:"price_embedded,omitempty"`
+	EmbeddedPrice *float64               `protobuf:"fixed64,8,opt,name=embedded_price,json=embeddedPrice,proto3,oneof" json:"embedded_price,omitempty"` // line price read from the code
+	Quantity      *float64               `protobuf:"fixed64,9,opt,name=quantity,proto3,oneof" json:"quantity,omitempty"`                                // embedded_price / unit_price, e.g. the weight
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupByCodeResponse) Reset() {
+	*x = LookupByCodeResponse{}
+	mi := &file_product_product_dev_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupByCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupByCodeResponse) ProtoMessage() {}
+
+func (x *LookupByCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_dev_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupByCodeResponse.ProtoReflect.Descriptor instead.
+func (*LookupByCodeResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_dev_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LookupByCodeResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *LookupByCodeResponse) GetVariant() *Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+func (x *LookupByCodeResponse) GetMatchedBy() string {
+	if x != nil {
+		return x.MatchedBy
+	}
+	return ""
+}
+
+func (x *LookupByCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LookupByCodeResponse) GetSymbology() string {
+	if x != nil {
+		return x.Symbology
+	}
+	return ""
+}
+
+func (x *LookupByCodeResponse) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *LookupByCodeResponse) GetPriceEmbedded() bool {
+	if x != nil {
+		return x.PriceEmbedded
+	}
+	return false
+}
+
+func (x *LookupByCodeResponse) GetEmbeddedPrice() float64 {
+	if x != nil && x.EmbeddedPrice != nil {
+		return *x.EmbeddedPrice
+	}
+	return 0
+}
+
+func (x *LookupByCodeResponse) GetQuantity() float64 {
+	if x != nil && x.Quantity != nil {
+		return *x.Quantity
+	}
+	return 0
+}
+
+// ImportProductsRequest is streamed: the first message carries the options
+// and the ones after it the file, in chunks.
+type ImportProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportProductsRequest_Options
+	//	*ImportProductsRequest_Chunk
+	Payload       isImportProductsRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsRequest) Reset() {
+	*x = ImportProductsRequest{}
+	mi := &file_product_product_dev_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsRequest) ProtoMessage() {}
+
+func (x *ImportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_dev_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_dev_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportProductsRequest) GetPayload() isImportProductsRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportProductsRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportProductsRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportProductsRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportProductsRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportProductsRequest_Payload interface {
+	isImportProductsRequest_Payload()
+}
+
+type ImportProductsRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportProductsRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportProductsRequest_Options) isImportProductsRequest_Payload() {}
+
+func (*ImportProductsRequest_Chunk) isImportProductsRequest_Payload() {}
+
+// ImportOptions describes an import file. Its first row holds the column
+// headers. Rows are upserted by sku: a row whose sku the shop does not have
+// yet creates a product and needs name and price, any other row updates the
+// product with the columns that are not empty. Categories ("Drinks > Hot")
+// and tags ("vegan, new") that do not exist yet are created.
+type ImportOptions struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ShopId string                 `protobuf:"bytes,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	Format string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // csv or xlsx
+	// column_map maps file headers to fields, e.g. "Item Code" to "sku";
+	// mapping a header to "" skips the column. Other headers are matched to
+	// fields by name, so an exported file imports as is.
+	ColumnMap     map[string]string `protobuf:"bytes,3,rep,name=column_map,json=columnMap,proto3" json:"column_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DryRun        bool              `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // validate every row, write nothing
+	Sheet         *string           `protobuf:"bytes,5,opt,name=sheet,proto3,oneof" json:"sheet,omitempty"`            // xlsx sheet, defaults to the first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_product_product_dev_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_dev_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_product_product_dev_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportOptions) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetColumnMap() map[string]string {
+	if x != nil {
+		return x.ColumnMap
+	}
+	return nil
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportOptions) GetSheet() string {
+	if x != nil && x.Sheet != nil {
+		return *x.Sheet
+	}
+	return ""
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // row of the file, the header row being 1
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Column        string                 `protobuf:"bytes,3,opt,name=column,proto3" json:"column,omitempty"` // header of the offending column, if any
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_product_product_dev_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_dev_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_product_product_dev_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ImportProductsResponse reports what the import did; for a dry run, what it
+// would have done.
+type ImportProductsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DryRun            bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	TotalRows         int32                  `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	Created           int32                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated           int32                  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed            int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors            []*ImportRowError      `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	CreatedCategories []string               `protobuf:"bytes,7,rep,name=created_categories,json=createdCategories,proto3" json:"created_categories,omitempty"`
+	CreatedTags       []string               `protobuf:"bytes,8,rep,name=created_tags,json=createdTags,proto3" json:"created_tags,omitempty"`
+	Columns           map[string]string      `protobuf:"bytes,9,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // file header to the field it was read as
+	IgnoredColumns    []string               `protobuf:"bytes,10,rep,name=ignored_columns,json=ignoredColumns,proto3" json:"ignored_columns,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ImportProductsResponse) Reset() {
+	*x = ImportProductsResponse{}
+	mi := &file_product_product_dev_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsResponse) ProtoMessage() {}
+
+func (x *ImportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_dev_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ImportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_dev_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportProductsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportProductsResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportProductsResponse) GetCreatedCategories() []string {
+	if x != nil {
+		return x.CreatedCategories
+	}
+	return nil
+}
+
+func (x *ImportProductsResponse) GetCreatedTags() []string {
+	if x != nil {
+		return x.CreatedTags
+	}
+	return nil
+}
+
+func (x *ImportProductsResponse) GetColumns() map[string]string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportProductsResponse) GetIgnoredColumns() []string {
+	if x != nil {
+		return x.IgnoredColumns
+	}
+	return nil
+}
+
+type ExportProductsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShopId          string                 `protobuf:"bytes,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	Format          string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // csv or xlsx
+	IncludeInactive bool                   `protobuf:"varint,3,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExportProductsRequest) Reset() {
+	*x = ExportProductsRequest{}
+	mi := &file_product_product_dev_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsRequest) ProtoMessage() {}
+
+func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_dev_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_dev_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExportProductsRequest) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
+func (x *ExportProductsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportProductsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+// ExportProductsChunk is a piece of the exported file; the file is the
+// chunks in the order they are sent.
+type ExportProductsChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsChunk) Reset() {
+	*x = ExportProductsChunk{}
+	mi := &file_product_product_dev_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsChunk) ProtoMessage() {}
+
+func (x *ExportProductsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_dev_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsChunk.ProtoReflect.Descriptor instead.
+func (*ExportProductsChunk) Descriptor() ([]byte, []int) {
+	return file_product_product_dev_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExportProductsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_product_product_dev_proto protoreflect.FileDescriptor
 
 const file_product_product_dev_proto_rawDesc = "" +
 	"\n" +
-	"\x19product/product.dev.proto\x12\aproduct\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x97\t\n" +
+	"\x19product/product.dev.proto\x12\n" +
+	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x11money/money.proto\"\xe2\t\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\ashop_id\x18\x02 \x01(\tR\x06shopId\x12$\n" +
@@ -2264,7 +3005,10 @@ const file_product_product_dev_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
 	"\n" +
-	"deleted_at\x18\x1d \x01(\v2\x1a.google.protobuf.TimestampH\bR\tdeletedAt\x88\x01\x01B\x0e\n" +
+	"deleted_at\x18\x1d \x01(\v2\x1a.google.protobuf.TimestampH\bR\tdeletedAt\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\x1e \x01(\tR\bcurrency\x12-\n" +
+	"\vprice_money\x18\x1f \x01(\v2\f.money.MoneyR\n" +
+	"priceMoneyB\x0e\n" +
 	"\f_category_idB\x06\n" +
 	"\x04_skuB\n" +
 	"\n" +
@@ -2281,22 +3025,22 @@ const file_product_product_dev_proto_rawDesc = "" +
 	"\x03sku\x18\x03 \x01(\tH\x00R\x03sku\x88\x01\x01\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
 	"\tis_active\x18\x05 \x01(\bR\bisActiveB\x06\n" +
-	"\x04_sku\"\xab\x02\n" +
-	"\rProductDetail\x12*\n" +
-	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\x126\n" +
-	"\bcategory\x18\x02 \x01(\v2\x15.product.CategoryInfoH\x00R\bcategory\x88\x01\x01\x12$\n" +
-	"\x05media\x18\x03 \x03(\v2\x0e.product.MediaR\x05media\x12,\n" +
-	"\bvariants\x18\x04 \x03(\v2\x10.product.VariantR\bvariants\x12 \n" +
-	"\x04tags\x18\x05 \x03(\v2\f.product.TagR\x04tags\x12*\n" +
-	"\x04shop\x18\x06 \x01(\v2\x11.product.ShopInfoH\x01R\x04shop\x88\x01\x01B\v\n" +
+	"\x04_sku\"\xbd\x02\n" +
+	"\rProductDetail\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\x129\n" +
+	"\bcategory\x18\x02 \x01(\v2\x18.product.v1.CategoryInfoH\x00R\bcategory\x88\x01\x01\x12'\n" +
+	"\x05media\x18\x03 \x03(\v2\x11.product.v1.MediaR\x05media\x12/\n" +
+	"\bvariants\x18\x04 \x03(\v2\x13.product.v1.VariantR\bvariants\x12#\n" +
+	"\x04tags\x18\x05 \x03(\v2\x0f.product.v1.TagR\x04tags\x12-\n" +
+	"\x04shop\x18\x06 \x01(\v2\x14.product.v1.ShopInfoH\x01R\x04shop\x88\x01\x01B\v\n" +
 	"\t_categoryB\a\n" +
-	"\x05_shop\"\xb5\x01\n" +
+	"\x05_shop\"\xb8\x01\n" +
 	"\fCategoryInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
-	"\tparent_id\x18\x04 \x01(\tH\x00R\bparentId\x88\x01\x01\x122\n" +
-	"\x06parent\x18\x05 \x01(\v2\x15.product.CategoryInfoH\x01R\x06parent\x88\x01\x01B\f\n" +
+	"\tparent_id\x18\x04 \x01(\tH\x00R\bparentId\x88\x01\x01\x125\n" +
+	"\x06parent\x18\x05 \x01(\v2\x18.product.v1.CategoryInfoH\x01R\x06parent\x88\x01\x01B\f\n" +
 	"\n" +
 	"_parent_idB\t\n" +
 	"\a_parent\"[\n" +
@@ -2353,7 +3097,7 @@ const file_product_product_dev_proto_rawDesc = "" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
 	"\x04slug\x18\x04 \x01(\tR\x04slug\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa8\b\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xcc\t\n" +
 	"\rCreateRequest\x12\x17\n" +
 	"\ashop_id\x18\x01 \x01(\tR\x06shopId\x12$\n" +
 	"\vcategory_id\x18\x02 \x01(\tH\x00R\n" +
@@ -2375,18 +3119,21 @@ const file_product_product_dev_proto_rawDesc = "" +
 	"\x06weight\x18\x0f \x01(\x01H\n" +
 	"R\x06weight\x88\x01\x01\x12$\n" +
 	"\vweight_unit\x18\x10 \x01(\tH\vR\n" +
-	"weightUnit\x88\x01\x01\x12\x1b\n" +
-	"\tis_active\x18\x11 \x01(\bR\bisActive\x12\x1d\n" +
+	"weightUnit\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x11 \x01(\bH\fR\bisActive\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_taxable\x18\x12 \x01(\bR\tisTaxable\x12\x1e\n" +
-	"\btax_rate\x18\x13 \x01(\x01H\fR\ataxRate\x88\x01\x01\x12'\n" +
-	"\x0ftrack_inventory\x18\x14 \x01(\bR\x0etrackInventory\x12'\n" +
+	"is_taxable\x18\x12 \x01(\bH\rR\tisTaxable\x88\x01\x01\x12\x1e\n" +
+	"\btax_rate\x18\x13 \x01(\x01H\x0eR\ataxRate\x88\x01\x01\x12,\n" +
+	"\x0ftrack_inventory\x18\x14 \x01(\bH\x0fR\x0etrackInventory\x88\x01\x01\x12'\n" +
 	"\x0fallow_backorder\x18\x15 \x01(\bR\x0eallowBackorder\x12\x1f\n" +
 	"\vis_featured\x18\x16 \x01(\bR\n" +
-	"isFeatured\x12+\n" +
-	"\x05media\x18\x17 \x03(\v2\x15.product.MediaRequestR\x05media\x12\x17\n" +
+	"isFeatured\x12.\n" +
+	"\x05media\x18\x17 \x03(\v2\x18.product.v1.MediaRequestR\x05media\x12\x17\n" +
 	"\atag_ids\x18\x18 \x03(\tR\x06tagIds\x123\n" +
-	"\bmetadata\x18\x19 \x01(\v2\x17.google.protobuf.StructR\bmetadataB\x0e\n" +
+	"\bmetadata\x18\x19 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12\x1f\n" +
+	"\bcurrency\x18\x1a \x01(\tH\x10R\bcurrency\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"sort_order\x18\x1b \x01(\x05H\x11R\tsortOrder\x88\x01\x01B\x0e\n" +
 	"\f_category_idB\x06\n" +
 	"\x04_skuB\n" +
 	"\n" +
@@ -2399,8 +3146,14 @@ const file_product_product_dev_proto_rawDesc = "" +
 	"\x10_max_stock_levelB\a\n" +
 	"\x05_unitB\t\n" +
 	"\a_weightB\x0e\n" +
-	"\f_weight_unitB\v\n" +
-	"\t_tax_rate\"\xbf\x01\n" +
+	"\f_weight_unitB\f\n" +
+	"\n" +
+	"_is_activeB\r\n" +
+	"\v_is_taxableB\v\n" +
+	"\t_tax_rateB\x12\n" +
+	"\x10_track_inventoryB\v\n" +
+	"\t_currencyB\r\n" +
+	"\v_sort_order\"\xbf\x01\n" +
 	"\fMediaRequest\x12\x1b\n" +
 	"\tmedia_url\x18\x01 \x01(\tR\bmediaUrl\x12\x1d\n" +
 	"\n" +
@@ -2451,7 +3204,7 @@ const file_product_product_dev_proto_rawDesc = "" +
 	"\n" +
 	"_min_priceB\f\n" +
 	"\n" +
-	"_max_price\"\x84\x06\n" +
+	"_max_price\"\xc9\t\n" +
 	"\rUpdateRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\ashop_id\x18\x02 \x01(\tR\x06shopId\x12$\n" +
@@ -2473,7 +3226,19 @@ const file_product_product_dev_proto_rawDesc = "" +
 	"\tis_active\x18\x0e \x01(\bH\vR\bisActive\x88\x01\x01\x12$\n" +
 	"\vis_featured\x18\x0f \x01(\bH\fR\n" +
 	"isFeatured\x88\x01\x01\x128\n" +
-	"\bmetadata\x18\x10 \x01(\v2\x17.google.protobuf.StructH\rR\bmetadata\x88\x01\x01B\x0e\n" +
+	"\bmetadata\x18\x10 \x01(\v2\x17.google.protobuf.StructH\rR\bmetadata\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x11 \x01(\tH\x0eR\bcurrency\x88\x01\x01\x12\x17\n" +
+	"\x04unit\x18\x12 \x01(\tH\x0fR\x04unit\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\x13 \x01(\x01H\x10R\x06weight\x88\x01\x01\x12$\n" +
+	"\vweight_unit\x18\x14 \x01(\tH\x11R\n" +
+	"weightUnit\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_taxable\x18\x15 \x01(\bH\x12R\tisTaxable\x88\x01\x01\x12\x1e\n" +
+	"\btax_rate\x18\x16 \x01(\x01H\x13R\ataxRate\x88\x01\x01\x12,\n" +
+	"\x0ftrack_inventory\x18\x17 \x01(\bH\x14R\x0etrackInventory\x88\x01\x01\x12,\n" +
+	"\x0fallow_backorder\x18\x18 \x01(\bH\x15R\x0eallowBackorder\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"sort_order\x18\x19 \x01(\x05H\x16R\tsortOrder\x88\x01\x01B\x0e\n" +
 	"\f_category_idB\x06\n" +
 	"\x04_skuB\n" +
 	"\n" +
@@ -2489,7 +3254,16 @@ const file_product_product_dev_proto_rawDesc = "" +
 	"\n" +
 	"_is_activeB\x0e\n" +
 	"\f_is_featuredB\v\n" +
-	"\t_metadata\"Y\n" +
+	"\t_metadataB\v\n" +
+	"\t_currencyB\a\n" +
+	"\x05_unitB\t\n" +
+	"\a_weightB\x0e\n" +
+	"\f_weight_unitB\r\n" +
+	"\v_is_taxableB\v\n" +
+	"\t_tax_rateB\x12\n" +
+	"\x10_track_inventoryB\x12\n" +
+	"\x10_allow_backorderB\r\n" +
+	"\v_sort_order\"Y\n" +
 	"\rDeleteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\ashop_id\x18\x02 \x01(\tR\x06shopId\x12\x1f\n" +
@@ -2500,27 +3274,27 @@ const file_product_product_dev_proto_rawDesc = "" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
 	"\ashop_id\x18\x02 \x01(\tR\x06shopId\x12'\n" +
 	"\x0fquantity_change\x18\x03 \x01(\x05R\x0equantityChange\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\"[\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"^\n" +
 	"\x10BulkStockRequest\x12\x17\n" +
-	"\ashop_id\x18\x01 \x01(\tR\x06shopId\x12.\n" +
-	"\aupdates\x18\x02 \x03(\v2\x14.product.StockUpdateR\aupdates\"m\n" +
+	"\ashop_id\x18\x01 \x01(\tR\x06shopId\x121\n" +
+	"\aupdates\x18\x02 \x03(\v2\x17.product.v1.StockUpdateR\aupdates\"m\n" +
 	"\vStockUpdate\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12'\n" +
 	"\x0fquantity_change\x18\x02 \x01(\x05R\x0equantityChange\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"C\n" +
-	"\x0fProductResponse\x120\n" +
-	"\aproduct\x18\x01 \x01(\v2\x16.product.ProductDetailR\aproduct\"B\n" +
-	"\x0eDetailResponse\x120\n" +
-	"\aproduct\x18\x01 \x01(\v2\x16.product.ProductDetailR\aproduct\"\x89\x01\n" +
-	"\fListResponse\x122\n" +
-	"\bproducts\x18\x01 \x03(\v2\x16.product.ProductDetailR\bproducts\x12\x14\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"F\n" +
+	"\x0fProductResponse\x123\n" +
+	"\aproduct\x18\x01 \x01(\v2\x19.product.v1.ProductDetailR\aproduct\"E\n" +
+	"\x0eDetailResponse\x123\n" +
+	"\aproduct\x18\x01 \x01(\v2\x19.product.v1.ProductDetailR\aproduct\"\x8c\x01\n" +
+	"\fListResponse\x125\n" +
+	"\bproducts\x18\x01 \x03(\v2\x19.product.v1.ProductDetailR\bproducts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xba\x01\n" +
-	"\x11BulkStockResponse\x122\n" +
-	"\bproducts\x18\x01 \x03(\v2\x16.product.ProductDetailR\bproducts\x12+\n" +
-	"\x06errors\x18\x02 \x03(\v2\x13.product.StockErrorR\x06errors\x12#\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xc0\x01\n" +
+	"\x11BulkStockResponse\x125\n" +
+	"\bproducts\x18\x01 \x03(\v2\x19.product.v1.ProductDetailR\bproducts\x12.\n" +
+	"\x06errors\x18\x02 \x03(\v2\x16.product.v1.StockErrorR\x06errors\x12#\n" +
 	"\rsuccess_count\x18\x03 \x01(\x05R\fsuccessCount\x12\x1f\n" +
 	"\verror_count\x18\x04 \x01(\x05R\n" +
 	"errorCount\"P\n" +
@@ -2528,16 +3302,81 @@ const file_product_product_dev_proto_rawDesc = "" +
 	"StockError\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage2\xf8\x03\n" +
-	"\x0eProductService\x12:\n" +
-	"\x06Create\x12\x16.product.CreateRequest\x1a\x18.product.ProductResponse\x124\n" +
-	"\x03Get\x12\x13.product.GetRequest\x1a\x18.product.ProductResponse\x12?\n" +
-	"\tGetDetail\x12\x19.product.GetDetailRequest\x1a\x17.product.DetailResponse\x123\n" +
-	"\x04List\x12\x14.product.ListRequest\x1a\x15.product.ListResponse\x12:\n" +
-	"\x06Update\x12\x16.product.UpdateRequest\x1a\x18.product.ProductResponse\x128\n" +
-	"\x06Delete\x12\x16.product.DeleteRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
-	"\vUpdateStock\x12\x15.product.StockRequest\x1a\x18.product.ProductResponse\x12H\n" +
-	"\x0fBulkUpdateStock\x12\x19.product.BulkStockRequest\x1a\x1a.product.BulkStockResponseB\x1eZ\x1cproto/v1/productpb;productpbb\x06proto3"
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"m\n" +
+	"\x13LookupByCodeRequest\x12\x17\n" +
+	"\ashop_id\x18\x01 \x01(\tR\x06shopId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12)\n" +
+	"\x10include_inactive\x18\x03 \x01(\bR\x0fincludeInactive\"\x89\x03\n" +
+	"\x14LookupByCodeResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\x122\n" +
+	"\avariant\x18\x02 \x01(\v2\x13.product.v1.VariantH\x00R\avariant\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"matched_by\x18\x03 \x01(\tR\tmatchedBy\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\x1c\n" +
+	"\tsymbology\x18\x05 \x01(\tR\tsymbology\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x06 \x01(\x01R\tunitPrice\x12%\n" +
+	"\x0eprice_embedded\x18\a \x01(\bR\rpriceEmbedded\x12*\n" +
+	"\x0eembedded_price\x18\b \x01(\x01H\x01R\rembeddedPrice\x88\x01\x01\x12\x1f\n" +
+	"\bquantity\x18\t \x01(\x01H\x02R\bquantity\x88\x01\x01B\n" +
+	"\n" +
+	"\b_variantB\x11\n" +
+	"\x0f_embedded_priceB\v\n" +
+	"\t_quantity\"q\n" +
+	"\x15ImportProductsRequest\x125\n" +
+	"\aoptions\x18\x01 \x01(\v2\x19.product.v1.ImportOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\x85\x02\n" +
+	"\rImportOptions\x12\x17\n" +
+	"\ashop_id\x18\x01 \x01(\tR\x06shopId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12G\n" +
+	"\n" +
+	"column_map\x18\x03 \x03(\v2(.product.v1.ImportOptions.ColumnMapEntryR\tcolumnMap\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x19\n" +
+	"\x05sheet\x18\x05 \x01(\tH\x00R\x05sheet\x88\x01\x01\x1a<\n" +
+	"\x0eColumnMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_sheet\"f\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x16\n" +
+	"\x06column\x18\x03 \x01(\tR\x06column\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xd2\x03\n" +
+	"\x16ImportProductsResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x02 \x01(\x05R\ttotalRows\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x122\n" +
+	"\x06errors\x18\x06 \x03(\v2\x1a.product.v1.ImportRowErrorR\x06errors\x12-\n" +
+	"\x12created_categories\x18\a \x03(\tR\x11createdCategories\x12!\n" +
+	"\fcreated_tags\x18\b \x03(\tR\vcreatedTags\x12I\n" +
+	"\acolumns\x18\t \x03(\v2/.product.v1.ImportProductsResponse.ColumnsEntryR\acolumns\x12'\n" +
+	"\x0fignored_columns\x18\n" +
+	" \x03(\tR\x0eignoredColumns\x1a:\n" +
+	"\fColumnsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"s\n" +
+	"\x15ExportProductsRequest\x12\x17\n" +
+	"\ashop_id\x18\x01 \x01(\tR\x06shopId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12)\n" +
+	"\x10include_inactive\x18\x03 \x01(\bR\x0fincludeInactive\")\n" +
+	"\x13ExportProductsChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data2\xab\x06\n" +
+	"\x0eProductService\x12@\n" +
+	"\x06Create\x12\x19.product.v1.CreateRequest\x1a\x1b.product.v1.ProductResponse\x12:\n" +
+	"\x03Get\x12\x16.product.v1.GetRequest\x1a\x1b.product.v1.ProductResponse\x12E\n" +
+	"\tGetDetail\x12\x1c.product.v1.GetDetailRequest\x1a\x1a.product.v1.DetailResponse\x129\n" +
+	"\x04List\x12\x17.product.v1.ListRequest\x1a\x18.product.v1.ListResponse\x12@\n" +
+	"\x06Update\x12\x19.product.v1.UpdateRequest\x1a\x1b.product.v1.ProductResponse\x12;\n" +
+	"\x06Delete\x12\x19.product.v1.DeleteRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\vUpdateStock\x12\x18.product.v1.StockRequest\x1a\x1b.product.v1.ProductResponse\x12N\n" +
+	"\x0fBulkUpdateStock\x12\x1c.product.v1.BulkStockRequest\x1a\x1d.product.v1.BulkStockResponse\x12Q\n" +
+	"\fLookupByCode\x12\x1f.product.v1.LookupByCodeRequest\x1a .product.v1.LookupByCodeResponse\x12Y\n" +
+	"\x0eImportProducts\x12!.product.v1.ImportProductsRequest\x1a\".product.v1.ImportProductsResponse(\x01\x12V\n" +
+	"\x0eExportProducts\x12!.product.v1.ExportProductsRequest\x1a\x1f.product.v1.ExportProductsChunk0\x01B\x1eZ\x1cproto/v1/productpb;productpbb\x06proto3"
 
 var (
 	file_product_product_dev_proto_rawDescOnce sync.Once
@@ -2551,82 +3390,106 @@ func file_product_product_dev_proto_rawDescGZIP() []byte {
 	return file_product_product_dev_proto_rawDescData
 }
 
-var file_product_product_dev_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_product_product_dev_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_product_product_dev_proto_goTypes = []any{
-	(*Product)(nil),               // 0: product.Product
-	(*ProductSummary)(nil),        // 1: product.ProductSummary
-	(*ProductDetail)(nil),         // 2: product.ProductDetail
-	(*CategoryInfo)(nil),          // 3: product.CategoryInfo
-	(*ShopInfo)(nil),              // 4: product.ShopInfo
-	(*Media)(nil),                 // 5: product.Media
-	(*Variant)(nil),               // 6: product.Variant
-	(*Tag)(nil),                   // 7: product.Tag
-	(*CreateRequest)(nil),         // 8: product.CreateRequest
-	(*MediaRequest)(nil),          // 9: product.MediaRequest
-	(*GetRequest)(nil),            // 10: product.GetRequest
-	(*GetDetailRequest)(nil),      // 11: product.GetDetailRequest
-	(*ListRequest)(nil),           // 12: product.ListRequest
-	(*UpdateRequest)(nil),         // 13: product.UpdateRequest
-	(*DeleteRequest)(nil),         // 14: product.DeleteRequest
-	(*StockRequest)(nil),          // 15: product.StockRequest
-	(*BulkStockRequest)(nil),      // 16: product.BulkStockRequest
-	(*StockUpdate)(nil),           // 17: product.StockUpdate
-	(*ProductResponse)(nil),       // 18: product.ProductResponse
-	(*DetailResponse)(nil),        // 19: product.DetailResponse
-	(*ListResponse)(nil),          // 20: product.ListResponse
-	(*BulkStockResponse)(nil),     // 21: product.BulkStockResponse
-	(*StockError)(nil),            // 22: product.StockError
-	(*structpb.Struct)(nil),       // 23: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil), // 24: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 25: google.protobuf.Empty
+	(*Product)(nil),                // 0: product.v1.Product
+	(*ProductSummary)(nil),         // 1: product.v1.ProductSummary
+	(*ProductDetail)(nil),          // 2: product.v1.ProductDetail
+	(*CategoryInfo)(nil),           // 3: product.v1.CategoryInfo
+	(*ShopInfo)(nil),               // 4: product.v1.ShopInfo
+	(*Media)(nil),                  // 5: product.v1.Media
+	(*Variant)(nil),                // 6: product.v1.Variant
+	(*Tag)(nil),                    // 7: product.v1.Tag
+	(*CreateRequest)(nil),          // 8: product.v1.CreateRequest
+	(*MediaRequest)(nil),           // 9: product.v1.MediaRequest
+	(*GetRequest)(nil),             // 10: product.v1.GetRequest
+	(*GetDetailRequest)(nil),       // 11: product.v1.GetDetailRequest
+	(*ListRequest)(nil),            // 12: product.v1.ListRequest
+	(*UpdateRequest)(nil),          // 13: product.v1.UpdateRequest
+	(*DeleteRequest)(nil),          // 14: product.v1.DeleteRequest
+	(*StockRequest)(nil),           // 15: product.v1.StockRequest
+	(*BulkStockRequest)(nil),       // 16: product.v1.BulkStockRequest
+	(*StockUpdate)(nil),            // 17: product.v1.StockUpdate
+	(*ProductResponse)(nil),        // 18: product.v1.ProductResponse
+	(*DetailResponse)(nil),         // 19: product.v1.DetailResponse
+	(*ListResponse)(nil),           // 20: product.v1.ListResponse
+	(*BulkStockResponse)(nil),      // 21: product.v1.BulkStockResponse
+	(*StockError)(nil),             // 22: product.v1.StockError
+	(*LookupByCodeRequest)(nil),    // 23: product.v1.LookupByCodeRequest
+	(*LookupByCodeResponse)(nil),   // 24: product.v1.LookupByCodeResponse
+	(*ImportProductsRequest)(nil),  // 25: product.v1.ImportProductsRequest
+	(*ImportOptions)(nil),          // 26: product.v1.ImportOptions
+	(*ImportRowError)(nil),         // 27: product.v1.ImportRowError
+	(*ImportProductsResponse)(nil), // 28: product.v1.ImportProductsResponse
+	(*ExportProductsRequest)(nil),  // 29: product.v1.ExportProductsRequest
+	(*ExportProductsChunk)(nil),    // 30: product.v1.ExportProductsChunk
+	nil,                            // 31: product.v1.ImportOptions.ColumnMapEntry
+	nil,                            // 32: product.v1.ImportProductsResponse.ColumnsEntry
+	(*structpb.Struct)(nil),        // 33: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),  // 34: google.protobuf.Timestamp
+	(*moneypb.Money)(nil),          // 35: money.Money
+	(*emptypb.Empty)(nil),          // 36: google.protobuf.Empty
 }
 var file_product_product_dev_proto_depIdxs = []int32{
-	23, // 0: product.Product.metadata:type_name -> google.protobuf.Struct
-	24, // 1: product.Product.created_at:type_name -> google.protobuf.Timestamp
-	24, // 2: product.Product.updated_at:type_name -> google.protobuf.Timestamp
-	24, // 3: product.Product.deleted_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: product.ProductDetail.product:type_name -> product.Product
-	3,  // 5: product.ProductDetail.category:type_name -> product.CategoryInfo
-	5,  // 6: product.ProductDetail.media:type_name -> product.Media
-	6,  // 7: product.ProductDetail.variants:type_name -> product.Variant
-	7,  // 8: product.ProductDetail.tags:type_name -> product.Tag
-	4,  // 9: product.ProductDetail.shop:type_name -> product.ShopInfo
-	3,  // 10: product.CategoryInfo.parent:type_name -> product.CategoryInfo
-	24, // 11: product.Media.created_at:type_name -> google.protobuf.Timestamp
-	23, // 12: product.Variant.attributes:type_name -> google.protobuf.Struct
-	24, // 13: product.Variant.created_at:type_name -> google.protobuf.Timestamp
-	24, // 14: product.Variant.updated_at:type_name -> google.protobuf.Timestamp
-	24, // 15: product.Tag.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 16: product.CreateRequest.media:type_name -> product.MediaRequest
-	23, // 17: product.CreateRequest.metadata:type_name -> google.protobuf.Struct
-	23, // 18: product.UpdateRequest.metadata:type_name -> google.protobuf.Struct
-	17, // 19: product.BulkStockRequest.updates:type_name -> product.StockUpdate
-	2,  // 20: product.ProductResponse.product:type_name -> product.ProductDetail
-	2,  // 21: product.DetailResponse.product:type_name -> product.ProductDetail
-	2,  // 22: product.ListResponse.products:type_name -> product.ProductDetail
-	2,  // 23: product.BulkStockResponse.products:type_name -> product.ProductDetail
-	22, // 24: product.BulkStockResponse.errors:type_name -> product.StockError
-	8,  // 25: product.ProductService.Create:input_type -> product.CreateRequest
-	10, // 26: product.ProductService.Get:input_type -> product.GetRequest
-	11, // 27: product.ProductService.GetDetail:input_type -> product.GetDetailRequest
-	12, // 28: product.ProductService.List:input_type -> product.ListRequest
-	13, // 29: product.ProductService.Update:input_type -> product.UpdateRequest
-	14, // 30: product.ProductService.Delete:input_type -> product.DeleteRequest
-	15, // 31: product.ProductService.UpdateStock:input_type -> product.StockRequest
-	16, // 32: product.ProductService.BulkUpdateStock:input_type -> product.BulkStockRequest
-	18, // 33: product.ProductService.Create:output_type -> product.ProductResponse
-	18, // 34: product.ProductService.Get:output_type -> product.ProductResponse
-	19, // 35: product.ProductService.GetDetail:output_type -> product.DetailResponse
-	20, // 36: product.ProductService.List:output_type -> product.ListResponse
-	18, // 37: product.ProductService.Update:output_type -> product.ProductResponse
-	25, // 38: product.ProductService.Delete:output_type -> google.protobuf.Empty
-	18, // 39: product.ProductService.UpdateStock:output_type -> product.ProductResponse
-	21, // 40: product.ProductService.BulkUpdateStock:output_type -> product.BulkStockResponse
-	33, // [33:41] is the sub-list for method output_type
-	25, // [25:33] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	33, // 0: product.v1.Product.metadata:type_name -> google.protobuf.Struct
+	34, // 1: product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
+	34, // 2: product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
+	34, // 3: product.v1.Product.deleted_at:type_name -> google.protobuf.Timestamp
+	35, // 4: product.v1.Product.price_money:type_name -> money.Money
+	0,  // 5: product.v1.ProductDetail.product:type_name -> product.v1.Product
+	3,  // 6: product.v1.ProductDetail.category:type_name -> product.v1.CategoryInfo
+	5,  // 7: product.v1.ProductDetail.media:type_name -> product.v1.Media
+	6,  // 8: product.v1.ProductDetail.variants:type_name -> product.v1.Variant
+	7,  // 9: product.v1.ProductDetail.tags:type_name -> product.v1.Tag
+	4,  // 10: product.v1.ProductDetail.shop:type_name -> product.v1.ShopInfo
+	3,  // 11: product.v1.CategoryInfo.parent:type_name -> product.v1.CategoryInfo
+	34, // 12: product.v1.Media.created_at:type_name -> google.protobuf.Timestamp
+	33, // 13: product.v1.Variant.attributes:type_name -> google.protobuf.Struct
+	34, // 14: product.v1.Variant.created_at:type_name -> google.protobuf.Timestamp
+	34, // 15: product.v1.Variant.updated_at:type_name -> google.protobuf.Timestamp
+	34, // 16: product.v1.Tag.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 17: product.v1.CreateRequest.media:type_name -> product.v1.MediaRequest
+	33, // 18: product.v1.CreateRequest.metadata:type_name -> google.protobuf.Struct
+	33, // 19: product.v1.UpdateRequest.metadata:type_name -> google.protobuf.Struct
+	17, // 20: product.v1.BulkStockRequest.updates:type_name -> product.v1.StockUpdate
+	2,  // 21: product.v1.ProductResponse.product:type_name -> product.v1.ProductDetail
+	2,  // 22: product.v1.DetailResponse.product:type_name -> product.v1.ProductDetail
+	2,  // 23: product.v1.ListResponse.products:type_name -> product.v1.ProductDetail
+	2,  // 24: product.v1.BulkStockResponse.products:type_name -> product.v1.ProductDetail
+	22, // 25: product.v1.BulkStockResponse.errors:type_name -> product.v1.StockError
+	0,  // 26: product.v1.LookupByCodeResponse.product:type_name -> product.v1.Product
+	6,  // 27: product.v1.LookupByCodeResponse.variant:type_name -> product.v1.Variant
+	26, // 28: product.v1.ImportProductsRequest.options:type_name -> product.v1.ImportOptions
+	31, // 29: product.v1.ImportOptions.column_map:type_name -> product.v1.ImportOptions.ColumnMapEntry
+	27, // 30: product.v1.ImportProductsResponse.errors:type_name -> product.v1.ImportRowError
+	32, // 31: product.v1.ImportProductsResponse.columns:type_name -> product.v1.ImportProductsResponse.ColumnsEntry
+	8,  // 32: product.v1.ProductService.Create:input_type -> product.v1.CreateRequest
+	10, // 33: product.v1.ProductService.Get:input_type -> product.v1.GetRequest
+	11, // 34: product.v1.ProductService.GetDetail:input_type -> product.v1.GetDetailRequest
+	12, // 35: product.v1.ProductService.List:input_type -> product.v1.ListRequest
+	13, // 36: product.v1.ProductService.Update:input_type -> product.v1.UpdateRequest
+	14, // 37: product.v1.ProductService.Delete:input_type -> product.v1.DeleteRequest
+	15, // 38: product.v1.ProductService.UpdateStock:input_type -> product.v1.StockRequest
+	16, // 39: product.v1.ProductService.BulkUpdateStock:input_type -> product.v1.BulkStockRequest
+	23, // 40: product.v1.ProductService.LookupByCode:input_type -> product.v1.LookupByCodeRequest
+	25, // 41: product.v1.ProductService.ImportProducts:input_type -> product.v1.ImportProductsRequest
+	29, // 42: product.v1.ProductService.ExportProducts:input_type -> product.v1.ExportProductsRequest
+	18, // 43: product.v1.ProductService.Create:output_type -> product.v1.ProductResponse
+	18, // 44: product.v1.ProductService.Get:output_type -> product.v1.ProductResponse
+	19, // 45: product.v1.ProductService.GetDetail:output_type -> product.v1.DetailResponse
+	20, // 46: product.v1.ProductService.List:output_type -> product.v1.ListResponse
+	18, // 47: product.v1.ProductService.Update:output_type -> product.v1.ProductResponse
+	36, // 48: product.v1.ProductService.Delete:output_type -> google.protobuf.Empty
+	18, // 49: product.v1.ProductService.UpdateStock:output_type -> product.v1.ProductResponse
+	21, // 50: product.v1.ProductService.BulkUpdateStock:output_type -> product.v1.BulkStockResponse
+	24, // 51: product.v1.ProductService.LookupByCode:output_type -> product.v1.LookupByCodeResponse
+	28, // 52: product.v1.ProductService.ImportProducts:output_type -> product.v1.ImportProductsResponse
+	30, // 53: product.v1.ProductService.ExportProducts:output_type -> product.v1.ExportProductsChunk
+	43, // [43:54] is the sub-list for method output_type
+	32, // [32:43] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_product_product_dev_proto_init() }
@@ -2645,13 +3508,19 @@ func file_product_product_dev_proto_init() {
 	file_product_product_dev_proto_msgTypes[9].OneofWrappers = []any{}
 	file_product_product_dev_proto_msgTypes[12].OneofWrappers = []any{}
 	file_product_product_dev_proto_msgTypes[13].OneofWrappers = []any{}
+	file_product_product_dev_proto_msgTypes[24].OneofWrappers = []any{}
+	file_product_product_dev_proto_msgTypes[25].OneofWrappers = []any{
+		(*ImportProductsRequest_Options)(nil),
+		(*ImportProductsRequest_Chunk)(nil),
+	}
+	file_product_product_dev_proto_msgTypes[26].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_product_dev_proto_rawDesc), len(file_product_product_dev_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,14 +20,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_Create_FullMethodName          = "/product.ProductService/Create"
-	ProductService_Get_FullMethodName             = "/product.ProductService/Get"
-	ProductService_GetDetail_FullMethodName       = "/product.ProductService/GetDetail"
-	ProductService_List_FullMethodName            = "/product.ProductService/List"
-	ProductService_Update_FullMethodName          = "/product.ProductService/Update"
-	ProductService_Delete_FullMethodName          = "/product.ProductService/Delete"
-	ProductService_UpdateStock_FullMethodName     = "/product.ProductService/UpdateStock"
-	ProductService_BulkUpdateStock_FullMethodName = "/product.ProductService/BulkUpdateStock"
+	ProductService_Create_FullMethodName          = "/product.v1.ProductService/Create"
+	ProductService_Get_FullMethodName             = "/product.v1.ProductService/Get"
+	ProductService_GetDetail_FullMethodName       = "/product.v1.ProductService/GetDetail"
+	ProductService_List_FullMethodName            = "/product.v1.ProductService/List"
+	ProductService_Update_FullMethodName          = "/product.v1.ProductService/Update"
+	ProductService_Delete_FullMethodName          = "/product.v1.ProductService/Delete"
+	ProductService_UpdateStock_FullMethodName     = "/product.v1.ProductService/UpdateStock"
+	ProductService_BulkUpdateStock_FullMethodName = "/product.v1.ProductService/BulkUpdateStock"
+	ProductService_LookupByCode_FullMethodName    = "/product.v1.ProductService/LookupByCode"
+	ProductService_ImportProducts_FullMethodName  = "/product.v1.ProductService/ImportProducts"
+	ProductService_ExportProducts_FullMethodName  = "/product.v1.ProductService/ExportProducts"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -42,6 +45,9 @@ type ProductServiceClient interface {
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateStock(ctx context.Context, in *StockRequest, opts ...grpc.CallOption) (*ProductResponse, error)
 	BulkUpdateStock(ctx context.Context, in *BulkStockRequest, opts ...grpc.CallOption) (*BulkStockResponse, error)
+	LookupByCode(ctx context.Context, in *LookupByCodeRequest, opts ...grpc.CallOption) (*LookupByCodeResponse, error)
+	ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse], error)
+	ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsChunk], error)
 }
 
 type productServiceClient struct {
@@ -132,6 +138,48 @@ func (c *productServiceClient) BulkUpdateStock(ctx context.Context, in *BulkStoc
 	return out, nil
 }
 
+func (c *productServiceClient) LookupByCode(ctx context.Context, in *LookupByCodeRequest, opts ...grpc.CallOption) (*LookupByCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupByCodeResponse)
+	err := c.cc.Invoke(ctx, ProductService_LookupByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], ProductService_ImportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportProductsRequest, ImportProductsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ImportProductsClient = grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse]
+
+func (c *productServiceClient) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[1], ProductService_ExportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportProductsRequest, ExportProductsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ExportProductsClient = grpc.ServerStreamingClient[ExportProductsChunk]
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -144,6 +192,9 @@ type ProductServiceServer interface {
 	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
 	UpdateStock(context.Context, *StockRequest) (*ProductResponse, error)
 	BulkUpdateStock(context.Context, *BulkStockRequest) (*BulkStockResponse, error)
+	LookupByCode(context.Context, *LookupByCodeRequest) (*LookupByCodeResponse, error)
+	ImportProducts(grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]) error
+	ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsChunk]) error
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -178,6 +229,15 @@ func (UnimplementedProductServiceServer) UpdateStock(context.Context, *StockRequ
 func (UnimplementedProductServiceServer) BulkUpdateStock(context.Context, *BulkStockRequest) (*BulkStockResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BulkUpdateStock not implemented")
 }
+func (UnimplementedProductServiceServer) LookupByCode(context.Context, *LookupByCodeRequest) (*LookupByCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LookupByCode not implemented")
+}
+func (UnimplementedProductServiceServer) ImportProducts(grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportProducts not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -343,11 +403,47 @@ func _ProductService_BulkUpdateStock_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_LookupByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).LookupByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_LookupByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).LookupByCode(ctx, req.(*LookupByCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ImportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductServiceServer).ImportProducts(&grpc.GenericServerStream[ImportProductsRequest, ImportProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ImportProductsServer = grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]
+
+func _ProductService_ExportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).ExportProducts(m, &grpc.GenericServerStream[ExportProductsRequest, ExportProductsChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ExportProductsServer = grpc.ServerStreamingServer[ExportProductsChunk]
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var ProductService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "product.ProductService",
+	ServiceName: "product.v1.ProductService",
 	HandlerType: (*ProductServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -382,7 +478,22 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkUpdateStock",
 			Handler:    _ProductService_BulkUpdateStock_Handler,
 		},
+		{
+			MethodName: "LookupByCode",
+			Handler:    _ProductService_LookupByCode_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportProducts",
+			Handler:       _ProductService_ImportProducts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportProducts",
+			Handler:       _ProductService_ExportProducts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "product/product.dev.proto",
 }
@@ -10,7 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	productpb "proto/v1/productpb"
+	productpb "productservice/proto/v1/productpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -947,13 +947,14 @@ var File_product_tag_dev_proto protoreflect.FileDescriptor
 
 const file_product_tag_dev_proto_rawDesc = "" +
 	"\n" +
-	"\x15product/tag.dev.proto\x12\aproduct\x1a\x1bgoogle/protobuf/empty.proto\x1a\x19product/product.dev.proto\"O\n" +
-	"\bTagStats\x12\x1e\n" +
-	"\x03tag\x18\x01 \x01(\v2\f.product.TagR\x03tag\x12#\n" +
-	"\rproduct_count\x18\x02 \x01(\x05R\fproductCount\"\x87\x01\n" +
-	"\tTagDetail\x12\x1e\n" +
-	"\x03tag\x18\x01 \x01(\v2\f.product.TagR\x03tag\x123\n" +
-	"\bproducts\x18\x02 \x03(\v2\x17.product.ProductSummaryR\bproducts\x12%\n" +
+	"\x15product/tag.dev.proto\x12\n" +
+	"product.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x19product/product.dev.proto\"R\n" +
+	"\bTagStats\x12!\n" +
+	"\x03tag\x18\x01 \x01(\v2\x0f.product.v1.TagR\x03tag\x12#\n" +
+	"\rproduct_count\x18\x02 \x01(\x05R\fproductCount\"\x8d\x01\n" +
+	"\tTagDetail\x12!\n" +
+	"\x03tag\x18\x01 \x01(\v2\x0f.product.v1.TagR\x03tag\x126\n" +
+	"\bproducts\x18\x02 \x03(\v2\x1a.product.v1.ProductSummaryR\bproducts\x12%\n" +
 	"\x0etotal_products\x18\x03 \x01(\x05R\rtotalProducts\"a\n" +
 	"\x10TagCreateRequest\x12\x17\n" +
 	"\ashop_id\x18\x01 \x01(\tR\x06shopId\x12\x12\n" +
@@ -1000,32 +1001,32 @@ const file_product_tag_dev_proto_rawDesc = "" +
 	"\x16TagGetByProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
-	"\ashop_id\x18\x02 \x01(\tR\x06shopId\"2\n" +
-	"\vTagResponse\x12#\n" +
-	"\x03tag\x18\x01 \x01(\v2\x11.product.TagStatsR\x03tag\"F\n" +
-	"\x11TagDetailResponse\x121\n" +
+	"\ashop_id\x18\x02 \x01(\tR\x06shopId\"5\n" +
+	"\vTagResponse\x12&\n" +
+	"\x03tag\x18\x01 \x01(\v2\x14.product.v1.TagStatsR\x03tag\"I\n" +
+	"\x11TagDetailResponse\x124\n" +
 	"\n" +
-	"tag_detail\x18\x01 \x01(\v2\x12.product.TagDetailR\ttagDetail\"\x7f\n" +
-	"\x0fTagListResponse\x12%\n" +
-	"\x04tags\x18\x01 \x03(\v2\x11.product.TagStatsR\x04tags\x12\x14\n" +
+	"tag_detail\x18\x01 \x01(\v2\x15.product.v1.TagDetailR\ttagDetail\"\x82\x01\n" +
+	"\x0fTagListResponse\x12(\n" +
+	"\x04tags\x18\x01 \x03(\v2\x14.product.v1.TagStatsR\x04tags\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"F\n" +
-	"\x11TagAssignResponse\x121\n" +
-	"\rassigned_tags\x18\x01 \x03(\v2\f.product.TagR\fassignedTags\";\n" +
-	"\x17TagGetByProductResponse\x12 \n" +
-	"\x04tags\x18\x01 \x03(\v2\f.product.TagR\x04tags2\xdb\x04\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"I\n" +
+	"\x11TagAssignResponse\x124\n" +
+	"\rassigned_tags\x18\x01 \x03(\v2\x0f.product.v1.TagR\fassignedTags\">\n" +
+	"\x17TagGetByProductResponse\x12#\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0f.product.v1.TagR\x04tags2\x8b\x05\n" +
 	"\n" +
-	"TagService\x129\n" +
-	"\x06Create\x12\x19.product.TagCreateRequest\x1a\x14.product.TagResponse\x123\n" +
-	"\x03Get\x12\x16.product.TagGetRequest\x1a\x14.product.TagResponse\x12E\n" +
-	"\tGetDetail\x12\x1c.product.TagGetDetailRequest\x1a\x1a.product.TagDetailResponse\x129\n" +
-	"\x04List\x12\x17.product.TagListRequest\x1a\x18.product.TagListResponse\x129\n" +
-	"\x06Update\x12\x19.product.TagUpdateRequest\x1a\x14.product.TagResponse\x12;\n" +
-	"\x06Delete\x12\x19.product.TagDeleteRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
-	"\x0fAssignToProduct\x12\x19.product.TagAssignRequest\x1a\x1a.product.TagAssignResponse\x12F\n" +
-	"\x11RemoveFromProduct\x12\x19.product.TagRemoveRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
-	"\fGetByProduct\x12\x1f.product.TagGetByProductRequest\x1a .product.TagGetByProductResponseB\x16Z\x14proto/v1/tagpb;tagpbb\x06proto3"
+	"TagService\x12?\n" +
+	"\x06Create\x12\x1c.product.v1.TagCreateRequest\x1a\x17.product.v1.TagResponse\x129\n" +
+	"\x03Get\x12\x19.product.v1.TagGetRequest\x1a\x17.product.v1.TagResponse\x12K\n" +
+	"\tGetDetail\x12\x1f.product.v1.TagGetDetailRequest\x1a\x1d.product.v1.TagDetailResponse\x12?\n" +
+	"\x04List\x12\x1a.product.v1.TagListRequest\x1a\x1b.product.v1.TagListResponse\x12?\n" +
+	"\x06Update\x12\x1c.product.v1.TagUpdateRequest\x1a\x17.product.v1.TagResponse\x12>\n" +
+	"\x06Delete\x12\x1c.product.v1.TagDeleteRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\x0fAssignToProduct\x12\x1c.product.v1.TagAssignRequest\x1a\x1d.product.v1.TagAssignResponse\x12I\n" +
+	"\x11RemoveFromProduct\x12\x1c.product.v1.TagRemoveRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"\fGetByProduct\x12\".product.v1.TagGetByProductRequest\x1a#.product.v1.TagGetByProductResponseB\x16Z\x14proto/v1/tagpb;tagpbb\x06proto3"
 
 var (
 	file_product_tag_dev_proto_rawDescOnce sync.Once
@@ -1041,53 +1042,53 @@ func file_product_tag_dev_proto_rawDescGZIP() []byte {
 
 var file_product_tag_dev_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_product_tag_dev_proto_goTypes = []any{
-	(*TagStats)(nil),                 // 0: product.TagStats
-	(*TagDetail)(nil),                // 1: product.TagDetail
-	(*TagCreateRequest)(nil),         // 2: product.TagCreateRequest
-	(*TagGetRequest)(nil),            // 3: product.TagGetRequest
-	(*TagGetDetailRequest)(nil),      // 4: product.TagGetDetailRequest
-	(*TagListRequest)(nil),           // 5: product.TagListRequest
-	(*TagUpdateRequest)(nil),         // 6: product.TagUpdateRequest
-	(*TagDeleteRequest)(nil),         // 7: product.TagDeleteRequest
-	(*TagAssignRequest)(nil),         // 8: product.TagAssignRequest
-	(*TagRemoveRequest)(nil),         // 9: product.TagRemoveRequest
-	(*TagGetByProductRequest)(nil),   // 10: product.TagGetByProductRequest
-	(*TagResponse)(nil),              // 11: product.TagResponse
-	(*TagDetailResponse)(nil),        // 12: product.TagDetailResponse
-	(*TagListResponse)(nil),          // 13: product.TagListResponse
-	(*TagAssignResponse)(nil),        // 14: product.TagAssignResponse
-	(*TagGetByProductResponse)(nil),  // 15: product.TagGetByProductResponse
-	(*productpb.Tag)(nil),            // 16: product.Tag
-	(*productpb.ProductSummary)(nil), // 17: product.ProductSummary
+	(*TagStats)(nil),                 // 0: product.v1.TagStats
+	(*TagDetail)(nil),                // 1: product.v1.TagDetail
+	(*TagCreateRequest)(nil),         // 2: product.v1.TagCreateRequest
+	(*TagGetRequest)(nil),            // 3: product.v1.TagGetRequest
+	(*TagGetDetailRequest)(nil),      // 4: product.v1.TagGetDetailRequest
+	(*TagListRequest)(nil),           // 5: product.v1.TagListRequest
+	(*TagUpdateRequest)(nil),         // 6: product.v1.TagUpdateRequest
+	(*TagDeleteRequest)(nil),         // 7: product.v1.TagDeleteRequest
+	(*TagAssignRequest)(nil),         // 8: product.v1.TagAssignRequest
+	(*TagRemoveRequest)(nil),         // 9: product.v1.TagRemoveRequest
+	(*TagGetByProductRequest)(nil),   // 10: product.v1.TagGetByProductRequest
+	(*TagResponse)(nil),              // 11: product.v1.TagResponse
+	(*TagDetailResponse)(nil),        // 12: product.v1.TagDetailResponse
+	(*TagListResponse)(nil),          // 13: product.v1.TagListResponse
+	(*TagAssignResponse)(nil),        // 14: product.v1.TagAssignResponse
+	(*TagGetByProductResponse)(nil),  // 15: product.v1.TagGetByProductResponse
+	(*productpb.Tag)(nil),            // 16: product.v1.Tag
+	(*productpb.ProductSummary)(nil), // 17: product.v1.ProductSummary
 	(*emptypb.Empty)(nil),            // 18: google.protobuf.Empty
 }
 var file_product_tag_dev_proto_depIdxs = []int32{
-	16, // 0: product.TagStats.tag:type_name -> product.Tag
-	16, // 1: product.TagDetail.tag:type_name -> product.Tag
-	17, // 2: product.TagDetail.products:type_name -> product.ProductSummary
-	0,  // 3: product.TagResponse.tag:type_name -> product.TagStats
-	1,  // 4: product.TagDetailResponse.tag_detail:type_name -> product.TagDetail
-	0,  // 5: product.TagListResponse.tags:type_name -> product.TagStats
-	16, // 6: product.TagAssignResponse.assigned_tags:type_name -> product.Tag
-	16, // 7: product.TagGetByProductResponse.tags:type_name -> product.Tag
-	2,  // 8: product.TagService.Create:input_type -> product.TagCreateRequest
-	3,  // 9: product.TagService.Get:input_type -> product.TagGetRequest
-	4,  // 10: product.TagService.GetDetail:input_type -> product.TagGetDetailRequest
-	5,  // 11: product.TagService.List:input_type -> product.TagListRequest
-	6,  // 12: product.TagService.Update:input_type -> product.TagUpdateRequest
-	7,  // 13: product.TagService.Delete:input_type -> product.TagDeleteRequest
-	8,  // 14: product.TagService.AssignToProduct:input_type -> product.TagAssignRequest
-	9,  // 15: product.TagService.RemoveFromProduct:input_type -> product.TagRemoveRequest
-	10, // 16: product.TagService.GetByProduct:input_type -> product.TagGetByProductRequest
-	11, // 17: product.TagService.Create:output_type -> product.TagResponse
-	11, // 18: product.TagService.Get:output_type -> product.TagResponse
-	12, // 19: product.TagService.GetDetail:output_type -> product.TagDetailResponse
-	13, // 20: product.TagService.List:output_type -> product.TagListResponse
-	11, // 21: product.TagService.Update:output_type -> product.TagResponse
-	18, // 22: product.TagService.Delete:output_type -> google.protobuf.Empty
-	14, // 23: product.TagService.AssignToProduct:output_type -> product.TagAssignResponse
-	18, // 24: product.TagService.RemoveFromProduct:output_type -> google.protobuf.Empty
-	15, // 25: product.TagService.GetByProduct:output_type -> product.TagGetByProductResponse
+	16, // 0: product.v1.TagStats.tag:type_name -> product.v1.Tag
+	16, // 1: product.v1.TagDetail.tag:type_name -> product.v1.Tag
+	17, // 2: product.v1.TagDetail.products:type_name -> product.v1.ProductSummary
+	0,  // 3: product.v1.TagResponse.tag:type_name -> product.v1.TagStats
+	1,  // 4: product.v1.TagDetailResponse.tag_detail:type_name -> product.v1.TagDetail
+	0,  // 5: product.v1.TagListResponse.tags:type_name -> product.v1.TagStats
+	16, // 6: product.v1.TagAssignResponse.assigned_tags:type_name -> product.v1.Tag
+	16, // 7: product.v1.TagGetByProductResponse.tags:type_name -> product.v1.Tag
+	2,  // 8: product.v1.TagService.Create:input_type -> product.v1.TagCreateRequest
+	3,  // 9: product.v1.TagService.Get:input_type -> product.v1.TagGetRequest
+	4,  // 10: product.v1.TagService.GetDetail:input_type -> product.v1.TagGetDetailRequest
+	5,  // 11: product.v1.TagService.List:input_type -> product.v1.TagListRequest
+	6,  // 12: product.v1.TagService.Update:input_type -> product.v1.TagUpdateRequest
+	7,  // 13: product.v1.TagService.Delete:input_type -> product.v1.TagDeleteRequest
+	8,  // 14: product.v1.TagService.AssignToProduct:input_type -> product.v1.TagAssignRequest
+	9,  // 15: product.v1.TagService.RemoveFromProduct:input_type -> product.v1.TagRemoveRequest
+	10, // 16: product.v1.TagService.GetByProduct:input_type -> product.v1.TagGetByProductRequest
+	11, // 17: product.v1.TagService.Create:output_type -> product.v1.TagResponse
+	11, // 18: product.v1.TagService.Get:output_type -> product.v1.TagResponse
+	12, // 19: product.v1.TagService.GetDetail:output_type -> product.v1.TagDetailResponse
+	13, // 20: product.v1.TagService.List:output_type -> product.v1.TagListResponse
+	11, // 21: product.v1.TagService.Update:output_type -> product.v1.TagResponse
+	18, // 22: product.v1.TagService.Delete:output_type -> google.protobuf.Empty
+	14, // 23: product.v1.TagService.AssignToProduct:output_type -> product.v1.TagAssignResponse
+	18, // 24: product.v1.TagService.RemoveFromProduct:output_type -> google.protobuf.Empty
+	15, // 25: product.v1.TagService.GetByProduct:output_type -> product.v1.TagGetByProductResponse
 	17, // [17:26] is the sub-list for method output_type
 	8,  // [8:17] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
@@ -20,15 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TagService_Create_FullMethodName            = "/product.TagService/Create"
-	TagService_Get_FullMethodName               = "/product.TagService/Get"
-	TagService_GetDetail_FullMethodName         = "/product.TagService/GetDetail"
-	TagService_List_FullMethodName              = "/product.TagService/List"
-	TagService_Update_FullMethodName            = "/product.TagService/Update"
-	TagService_Delete_FullMethodName            = "/product.TagService/Delete"
-	TagService_AssignToProduct_FullMethodName   = "/product.TagService/AssignToProduct"
-	TagService_RemoveFromProduct_FullMethodName = "/product.TagService/RemoveFromProduct"
-	TagService_GetByProduct_FullMethodName      = "/product.TagService/GetByProduct"
+	TagService_Create_FullMethodName            = "/product.v1.TagService/Create"
+	TagService_Get_FullMethodName               = "/product.v1.TagService/Get"
+	TagService_GetDetail_FullMethodName         = "/product.v1.TagService/GetDetail"
+	TagService_List_FullMethodName              = "/product.v1.TagService/List"
+	TagService_Update_FullMethodName            = "/product.v1.TagService/Update"
+	TagService_Delete_FullMethodName            = "/product.v1.TagService/Delete"
+	TagService_AssignToProduct_FullMethodName   = "/product.v1.TagService/AssignToProduct"
+	TagService_RemoveFromProduct_FullMethodName = "/product.v1.TagService/RemoveFromProduct"
+	TagService_GetByProduct_FullMethodName      = "/product.v1.TagService/GetByProduct"
 )
 
 // TagServiceClient is the client API for TagService service.
@@ -383,7 +383,7 @@ func _TagService_GetByProduct_Handler(srv interface{}, ctx context.Context, dec
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var TagService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "product.TagService",
+	ServiceName: "product.v1.TagService",
 	HandlerType: (*TagServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
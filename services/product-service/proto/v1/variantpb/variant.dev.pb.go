@@ -0,0 +1,1349 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: product/variant.dev.proto
+
+package variantpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	productpb "productservice/proto/v1/productpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VariantDetail struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Variant       *productpb.Variant        `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	Product       *productpb.ProductSummary `protobuf:"bytes,2,opt,name=product,proto3,oneof" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantDetail) Reset() {
+	*x = VariantDetail{}
+	mi := &file_product_variant_dev_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantDetail) ProtoMessage() {}
+
+func (x *VariantDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantDetail.ProtoReflect.Descriptor instead.
+func (*VariantDetail) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VariantDetail) GetVariant() *productpb.Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+func (x *VariantDetail) GetProduct() *productpb.ProductSummary {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// An option a product varies by, e.g. name "Size" with values S, M and L.
+// Variant attributes map option names to one of their values.
+type VariantOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []string               `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantOption) Reset() {
+	*x = VariantOption{}
+	mi := &file_product_variant_dev_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantOption) ProtoMessage() {}
+
+func (x *VariantOption) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantOption.ProtoReflect.Descriptor instead.
+func (*VariantOption) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VariantOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VariantOption) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *VariantOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariantOption) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *VariantOption) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// Attributes must name each of the product's options once, with one of its
+// values, when the product has options. No two variants of a product may
+// share the same attributes.
+type CreateVariantRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku            *string                `protobuf:"bytes,2,opt,name=sku,proto3,oneof" json:"sku,omitempty"`
+	Barcode        *string                `protobuf:"bytes,3,opt,name=barcode,proto3,oneof" json:"barcode,omitempty"`
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`           // defaults to the attribute values, e.g. "M / Red"
+	Price          *float64               `protobuf:"fixed64,5,opt,name=price,proto3,oneof" json:"price,omitempty"` // unset: the product's price
+	CostPrice      *float64               `protobuf:"fixed64,6,opt,name=cost_price,json=costPrice,proto3,oneof" json:"cost_price,omitempty"`
+	CompareAtPrice *float64               `protobuf:"fixed64,7,opt,name=compare_at_price,json=compareAtPrice,proto3,oneof" json:"compare_at_price,omitempty"`
+	StockQuantity  int32                  `protobuf:"varint,8,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	Weight         *float64               `protobuf:"fixed64,9,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	Attributes     *structpb.Struct       `protobuf:"bytes,10,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	IsActive       *bool                  `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"` // defaults to true
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateVariantRequest) Reset() {
+	*x = CreateVariantRequest{}
+	mi := &file_product_variant_dev_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVariantRequest) ProtoMessage() {}
+
+func (x *CreateVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVariantRequest.ProtoReflect.Descriptor instead.
+func (*CreateVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateVariantRequest) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *CreateVariantRequest) GetBarcode() string {
+	if x != nil && x.Barcode != nil {
+		return *x.Barcode
+	}
+	return ""
+}
+
+func (x *CreateVariantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVariantRequest) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *CreateVariantRequest) GetCostPrice() float64 {
+	if x != nil && x.CostPrice != nil {
+		return *x.CostPrice
+	}
+	return 0
+}
+
+func (x *CreateVariantRequest) GetCompareAtPrice() float64 {
+	if x != nil && x.CompareAtPrice != nil {
+		return *x.CompareAtPrice
+	}
+	return 0
+}
+
+func (x *CreateVariantRequest) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *CreateVariantRequest) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *CreateVariantRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateVariantRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type BulkCreateRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ProductId     string                  `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Variants      []*CreateVariantRequest `protobuf:"bytes,2,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateRequest) Reset() {
+	*x = BulkCreateRequest{}
+	mi := &file_product_variant_dev_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateRequest) ProtoMessage() {}
+
+func (x *BulkCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateRequest) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BulkCreateRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BulkCreateRequest) GetVariants() []*CreateVariantRequest {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type BulkCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variants      []*productpb.Variant   `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	CreatedCount  int32                  `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateResponse) Reset() {
+	*x = BulkCreateResponse{}
+	mi := &file_product_variant_dev_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateResponse) ProtoMessage() {}
+
+func (x *BulkCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateResponse) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BulkCreateResponse) GetVariants() []*productpb.Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *BulkCreateResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+type GetVariantRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeProduct bool                   `protobuf:"varint,2,opt,name=include_product,json=includeProduct,proto3" json:"include_product,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetVariantRequest) Reset() {
+	*x = GetVariantRequest{}
+	mi := &file_product_variant_dev_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVariantRequest) ProtoMessage() {}
+
+func (x *GetVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVariantRequest.ProtoReflect.Descriptor instead.
+func (*GetVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetVariantRequest) GetIncludeProduct() bool {
+	if x != nil {
+		return x.IncludeProduct
+	}
+	return false
+}
+
+type ListVariantsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	IsActive       *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	IncludeProduct bool                   `protobuf:"varint,3,opt,name=include_product,json=includeProduct,proto3" json:"include_product,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListVariantsRequest) Reset() {
+	*x = ListVariantsRequest{}
+	mi := &file_product_variant_dev_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVariantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVariantsRequest) ProtoMessage() {}
+
+func (x *ListVariantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVariantsRequest.ProtoReflect.Descriptor instead.
+func (*ListVariantsRequest) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListVariantsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListVariantsRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListVariantsRequest) GetIncludeProduct() bool {
+	if x != nil {
+		return x.IncludeProduct
+	}
+	return false
+}
+
+type ListVariantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variants      []*VariantDetail       `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVariantsResponse) Reset() {
+	*x = ListVariantsResponse{}
+	mi := &file_product_variant_dev_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVariantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVariantsResponse) ProtoMessage() {}
+
+func (x *ListVariantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVariantsResponse.ProtoReflect.Descriptor instead.
+func (*ListVariantsResponse) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListVariantsResponse) GetVariants() []*VariantDetail {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *ListVariantsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Only the fields that are set are changed. An empty sku or barcode clears
+// it; clear_price drops the variant's own price so it sells at the product's.
+type UpdateVariantRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Sku            *string                `protobuf:"bytes,3,opt,name=sku,proto3,oneof" json:"sku,omitempty"`
+	Barcode        *string                `protobuf:"bytes,4,opt,name=barcode,proto3,oneof" json:"barcode,omitempty"`
+	Price          *float64               `protobuf:"fixed64,5,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	CostPrice      *float64               `protobuf:"fixed64,6,opt,name=cost_price,json=costPrice,proto3,oneof" json:"cost_price,omitempty"`
+	StockQuantity  *int32                 `protobuf:"varint,7,opt,name=stock_quantity,json=stockQuantity,proto3,oneof" json:"stock_quantity,omitempty"`
+	IsActive       *bool                  `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Attributes     *structpb.Struct       `protobuf:"bytes,9,opt,name=attributes,proto3,oneof" json:"attributes,omitempty"`
+	CompareAtPrice *float64               `protobuf:"fixed64,10,opt,name=compare_at_price,json=compareAtPrice,proto3,oneof" json:"compare_at_price,omitempty"`
+	Weight         *float64               `protobuf:"fixed64,11,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	ClearPrice     bool                   `protobuf:"varint,12,opt,name=clear_price,json=clearPrice,proto3" json:"clear_price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateVariantRequest) Reset() {
+	*x = UpdateVariantRequest{}
+	mi := &file_product_variant_dev_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariantRequest) ProtoMessage() {}
+
+func (x *UpdateVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVariantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetBarcode() string {
+	if x != nil && x.Barcode != nil {
+		return *x.Barcode
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *UpdateVariantRequest) GetCostPrice() float64 {
+	if x != nil && x.CostPrice != nil {
+		return *x.CostPrice
+	}
+	return 0
+}
+
+func (x *UpdateVariantRequest) GetStockQuantity() int32 {
+	if x != nil && x.StockQuantity != nil {
+		return *x.StockQuantity
+	}
+	return 0
+}
+
+func (x *UpdateVariantRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateVariantRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateVariantRequest) GetCompareAtPrice() float64 {
+	if x != nil && x.CompareAtPrice != nil {
+		return *x.CompareAtPrice
+	}
+	return 0
+}
+
+func (x *UpdateVariantRequest) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *UpdateVariantRequest) GetClearPrice() bool {
+	if x != nil {
+		return x.ClearPrice
+	}
+	return false
+}
+
+type DeleteVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVariantRequest) Reset() {
+	*x = DeleteVariantRequest{}
+	mi := &file_product_variant_dev_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVariantRequest) ProtoMessage() {}
+
+func (x *DeleteVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVariantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VariantStockRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VariantId      string                 `protobuf:"bytes,1,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	QuantityChange int32                  `protobuf:"varint,2,opt,name=quantity_change,json=quantityChange,proto3" json:"quantity_change,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VariantStockRequest) Reset() {
+	*x = VariantStockRequest{}
+	mi := &file_product_variant_dev_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantStockRequest) ProtoMessage() {}
+
+func (x *VariantStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantStockRequest.ProtoReflect.Descriptor instead.
+func (*VariantStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VariantStockRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *VariantStockRequest) GetQuantityChange() int32 {
+	if x != nil {
+		return x.QuantityChange
+	}
+	return 0
+}
+
+func (x *VariantStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type VariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variant       *VariantDetail         `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantResponse) Reset() {
+	*x = VariantResponse{}
+	mi := &file_product_variant_dev_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantResponse) ProtoMessage() {}
+
+func (x *VariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantResponse.ProtoReflect.Descriptor instead.
+func (*VariantResponse) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VariantResponse) GetVariant() *VariantDetail {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+type VariantOptionInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantOptionInput) Reset() {
+	*x = VariantOptionInput{}
+	mi := &file_product_variant_dev_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantOptionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantOptionInput) ProtoMessage() {}
+
+func (x *VariantOptionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantOptionInput.ProtoReflect.Descriptor instead.
+func (*VariantOptionInput) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VariantOptionInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariantOptionInput) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// SetVariantOptionsRequest replaces the product's options. Options are kept
+// in the order given.
+type SetVariantOptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Options       []*VariantOptionInput  `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVariantOptionsRequest) Reset() {
+	*x = SetVariantOptionsRequest{}
+	mi := &file_product_variant_dev_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVariantOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVariantOptionsRequest) ProtoMessage() {}
+
+func (x *SetVariantOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVariantOptionsRequest.ProtoReflect.Descriptor instead.
+func (*SetVariantOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetVariantOptionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetVariantOptionsRequest) GetOptions() []*VariantOptionInput {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListVariantOptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVariantOptionsRequest) Reset() {
+	*x = ListVariantOptionsRequest{}
+	mi := &file_product_variant_dev_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVariantOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVariantOptionsRequest) ProtoMessage() {}
+
+func (x *ListVariantOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVariantOptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListVariantOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListVariantOptionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type VariantOptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       []*VariantOption       `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantOptionsResponse) Reset() {
+	*x = VariantOptionsResponse{}
+	mi := &file_product_variant_dev_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantOptionsResponse) ProtoMessage() {}
+
+func (x *VariantOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantOptionsResponse.ProtoReflect.Descriptor instead.
+func (*VariantOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VariantOptionsResponse) GetOptions() []*VariantOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// GenerateVariantsRequest creates a variant for every combination of the
+// product's option values that has none yet. SKUs are sku_prefix (the
+// product's SKU by default) followed by the values, e.g. TSHIRT-M-RED.
+type GenerateVariantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SkuPrefix     *string                `protobuf:"bytes,2,opt,name=sku_prefix,json=skuPrefix,proto3,oneof" json:"sku_prefix,omitempty"`
+	Price         *float64               `protobuf:"fixed64,3,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	StockQuantity int32                  `protobuf:"varint,4,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"` // defaults to true
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateVariantsRequest) Reset() {
+	*x = GenerateVariantsRequest{}
+	mi := &file_product_variant_dev_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateVariantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateVariantsRequest) ProtoMessage() {}
+
+func (x *GenerateVariantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_variant_dev_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateVariantsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateVariantsRequest) Descriptor() ([]byte, []int) {
+	return file_product_variant_dev_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GenerateVariantsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GenerateVariantsRequest) GetSkuPrefix() string {
+	if x != nil && x.SkuPrefix != nil {
+		return *x.SkuPrefix
+	}
+	return ""
+}
+
+func (x *GenerateVariantsRequest) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *GenerateVariantsRequest) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *GenerateVariantsRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+var File_product_variant_dev_proto protoreflect.FileDescriptor
+
+const file_product_variant_dev_proto_rawDesc = "" +
+	"\n" +
+	"\x19product/variant.dev.proto\x12\n" +
+	"product.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x19product/product.dev.proto\"\x85\x01\n" +
+	"\rVariantDetail\x12-\n" +
+	"\avariant\x18\x01 \x01(\v2\x13.product.v1.VariantR\avariant\x129\n" +
+	"\aproduct\x18\x02 \x01(\v2\x1a.product.v1.ProductSummaryH\x00R\aproduct\x88\x01\x01B\n" +
+	"\n" +
+	"\b_product\"\x86\x01\n" +
+	"\rVariantOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x04 \x03(\tR\x06values\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\"\xe7\x03\n" +
+	"\x14CreateVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x15\n" +
+	"\x03sku\x18\x02 \x01(\tH\x00R\x03sku\x88\x01\x01\x12\x1d\n" +
+	"\abarcode\x18\x03 \x01(\tH\x01R\abarcode\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x19\n" +
+	"\x05price\x18\x05 \x01(\x01H\x02R\x05price\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"cost_price\x18\x06 \x01(\x01H\x03R\tcostPrice\x88\x01\x01\x12-\n" +
+	"\x10compare_at_price\x18\a \x01(\x01H\x04R\x0ecompareAtPrice\x88\x01\x01\x12%\n" +
+	"\x0estock_quantity\x18\b \x01(\x05R\rstockQuantity\x12\x1b\n" +
+	"\x06weight\x18\t \x01(\x01H\x05R\x06weight\x88\x01\x01\x127\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x12 \n" +
+	"\tis_active\x18\v \x01(\bH\x06R\bisActive\x88\x01\x01B\x06\n" +
+	"\x04_skuB\n" +
+	"\n" +
+	"\b_barcodeB\b\n" +
+	"\x06_priceB\r\n" +
+	"\v_cost_priceB\x13\n" +
+	"\x11_compare_at_priceB\t\n" +
+	"\a_weightB\f\n" +
+	"\n" +
+	"_is_active\"p\n" +
+	"\x11BulkCreateRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12<\n" +
+	"\bvariants\x18\x02 \x03(\v2 .product.v1.CreateVariantRequestR\bvariants\"j\n" +
+	"\x12BulkCreateResponse\x12/\n" +
+	"\bvariants\x18\x01 \x03(\v2\x13.product.v1.VariantR\bvariants\x12#\n" +
+	"\rcreated_count\x18\x02 \x01(\x05R\fcreatedCount\"L\n" +
+	"\x11GetVariantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_product\x18\x02 \x01(\bR\x0eincludeProduct\"\x8d\x01\n" +
+	"\x13ListVariantsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12 \n" +
+	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01\x12'\n" +
+	"\x0finclude_product\x18\x03 \x01(\bR\x0eincludeProductB\f\n" +
+	"\n" +
+	"_is_active\"c\n" +
+	"\x14ListVariantsResponse\x125\n" +
+	"\bvariants\x18\x01 \x03(\v2\x19.product.v1.VariantDetailR\bvariants\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xb3\x04\n" +
+	"\x14UpdateVariantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x15\n" +
+	"\x03sku\x18\x03 \x01(\tH\x01R\x03sku\x88\x01\x01\x12\x1d\n" +
+	"\abarcode\x18\x04 \x01(\tH\x02R\abarcode\x88\x01\x01\x12\x19\n" +
+	"\x05price\x18\x05 \x01(\x01H\x03R\x05price\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"cost_price\x18\x06 \x01(\x01H\x04R\tcostPrice\x88\x01\x01\x12*\n" +
+	"\x0estock_quantity\x18\a \x01(\x05H\x05R\rstockQuantity\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\b \x01(\bH\x06R\bisActive\x88\x01\x01\x12<\n" +
+	"\n" +
+	"attributes\x18\t \x01(\v2\x17.google.protobuf.StructH\aR\n" +
+	"attributes\x88\x01\x01\x12-\n" +
+	"\x10compare_at_price\x18\n" +
+	" \x01(\x01H\bR\x0ecompareAtPrice\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\v \x01(\x01H\tR\x06weight\x88\x01\x01\x12\x1f\n" +
+	"\vclear_price\x18\f \x01(\bR\n" +
+	"clearPriceB\a\n" +
+	"\x05_nameB\x06\n" +
+	"\x04_skuB\n" +
+	"\n" +
+	"\b_barcodeB\b\n" +
+	"\x06_priceB\r\n" +
+	"\v_cost_priceB\x11\n" +
+	"\x0f_stock_quantityB\f\n" +
+	"\n" +
+	"_is_activeB\r\n" +
+	"\v_attributesB\x13\n" +
+	"\x11_compare_at_priceB\t\n" +
+	"\a_weight\"&\n" +
+	"\x14DeleteVariantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"u\n" +
+	"\x13VariantStockRequest\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x01 \x01(\tR\tvariantId\x12'\n" +
+	"\x0fquantity_change\x18\x02 \x01(\x05R\x0equantityChange\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"F\n" +
+	"\x0fVariantResponse\x123\n" +
+	"\avariant\x18\x01 \x01(\v2\x19.product.v1.VariantDetailR\avariant\"@\n" +
+	"\x12VariantOptionInput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"s\n" +
+	"\x18SetVariantOptionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x128\n" +
+	"\aoptions\x18\x02 \x03(\v2\x1e.product.v1.VariantOptionInputR\aoptions\":\n" +
+	"\x19ListVariantOptionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"M\n" +
+	"\x16VariantOptionsResponse\x123\n" +
+	"\aoptions\x18\x01 \x03(\v2\x19.product.v1.VariantOptionR\aoptions\"\xe7\x01\n" +
+	"\x17GenerateVariantsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\"\n" +
+	"\n" +
+	"sku_prefix\x18\x02 \x01(\tH\x00R\tskuPrefix\x88\x01\x01\x12\x19\n" +
+	"\x05price\x18\x03 \x01(\x01H\x01R\x05price\x88\x01\x01\x12%\n" +
+	"\x0estock_quantity\x18\x04 \x01(\x05R\rstockQuantity\x12 \n" +
+	"\tis_active\x18\x05 \x01(\bH\x02R\bisActive\x88\x01\x01B\r\n" +
+	"\v_sku_prefixB\b\n" +
+	"\x06_priceB\f\n" +
+	"\n" +
+	"_is_active2\x91\x06\n" +
+	"\x0eVariantService\x12G\n" +
+	"\x06Create\x12 .product.v1.CreateVariantRequest\x1a\x1b.product.v1.VariantResponse\x12K\n" +
+	"\n" +
+	"BulkCreate\x12\x1d.product.v1.BulkCreateRequest\x1a\x1e.product.v1.BulkCreateResponse\x12A\n" +
+	"\x03Get\x12\x1d.product.v1.GetVariantRequest\x1a\x1b.product.v1.VariantResponse\x12I\n" +
+	"\x04List\x12\x1f.product.v1.ListVariantsRequest\x1a .product.v1.ListVariantsResponse\x12G\n" +
+	"\x06Update\x12 .product.v1.UpdateVariantRequest\x1a\x1b.product.v1.VariantResponse\x12B\n" +
+	"\x06Delete\x12 .product.v1.DeleteVariantRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\vUpdateStock\x12\x1f.product.v1.VariantStockRequest\x1a\x1b.product.v1.VariantResponse\x12V\n" +
+	"\n" +
+	"SetOptions\x12$.product.v1.SetVariantOptionsRequest\x1a\".product.v1.VariantOptionsResponse\x12X\n" +
+	"\vListOptions\x12%.product.v1.ListVariantOptionsRequest\x1a\".product.v1.VariantOptionsResponse\x12O\n" +
+	"\bGenerate\x12#.product.v1.GenerateVariantsRequest\x1a\x1e.product.v1.BulkCreateResponseB\x1eZ\x1cproto/v1/variantpb;variantpbb\x06proto3"
+
+var (
+	file_product_variant_dev_proto_rawDescOnce sync.Once
+	file_product_variant_dev_proto_rawDescData []byte
+)
+
+func file_product_variant_dev_proto_rawDescGZIP() []byte {
+	file_product_variant_dev_proto_rawDescOnce.Do(func() {
+		file_product_variant_dev_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_product_variant_dev_proto_rawDesc), len(file_product_variant_dev_proto_rawDesc)))
+	})
+	return file_product_variant_dev_proto_rawDescData
+}
+
+var file_product_variant_dev_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_product_variant_dev_proto_goTypes = []any{
+	(*VariantDetail)(nil),             // 0: product.v1.VariantDetail
+	(*VariantOption)(nil),             // 1: product.v1.VariantOption
+	(*CreateVariantRequest)(nil),      // 2: product.v1.CreateVariantRequest
+	(*BulkCreateRequest)(nil),         // 3: product.v1.BulkCreateRequest
+	(*BulkCreateResponse)(nil),        // 4: product.v1.BulkCreateResponse
+	(*GetVariantRequest)(nil),         // 5: product.v1.GetVariantRequest
+	(*ListVariantsRequest)(nil),       // 6: product.v1.ListVariantsRequest
+	(*ListVariantsResponse)(nil),      // 7: product.v1.ListVariantsResponse
+	(*UpdateVariantRequest)(nil),      // 8: product.v1.UpdateVariantRequest
+	(*DeleteVariantRequest)(nil),      // 9: product.v1.DeleteVariantRequest
+	(*VariantStockRequest)(nil),       // 10: product.v1.VariantStockRequest
+	(*VariantResponse)(nil),           // 11: product.v1.VariantResponse
+	(*VariantOptionInput)(nil),        // 12: product.v1.VariantOptionInput
+	(*SetVariantOptionsRequest)(nil),  // 13: product.v1.SetVariantOptionsRequest
+	(*ListVariantOptionsRequest)(nil), // 14: product.v1.ListVariantOptionsRequest
+	(*VariantOptionsResponse)(nil),    // 15: product.v1.VariantOptionsResponse
+	(*GenerateVariantsRequest)(nil),   // 16: product.v1.GenerateVariantsRequest
+	(*productpb.Variant)(nil),         // 17: product.v1.Variant
+	(*productpb.ProductSummary)(nil),  // 18: product.v1.ProductSummary
+	(*structpb.Struct)(nil),           // 19: google.protobuf.Struct
+	(*emptypb.Empty)(nil),             // 20: google.protobuf.Empty
+}
+var file_product_variant_dev_proto_depIdxs = []int32{
+	17, // 0: product.v1.VariantDetail.variant:type_name -> product.v1.Variant
+	18, // 1: product.v1.VariantDetail.product:type_name -> product.v1.ProductSummary
+	19, // 2: product.v1.CreateVariantRequest.attributes:type_name -> google.protobuf.Struct
+	2,  // 3: product.v1.BulkCreateRequest.variants:type_name -> product.v1.CreateVariantRequest
+	17, // 4: product.v1.BulkCreateResponse.variants:type_name -> product.v1.Variant
+	0,  // 5: product.v1.ListVariantsResponse.variants:type_name -> product.v1.VariantDetail
+	19, // 6: product.v1.UpdateVariantRequest.attributes:type_name -> google.protobuf.Struct
+	0,  // 7: product.v1.VariantResponse.variant:type_name -> product.v1.VariantDetail
+	12, // 8: product.v1.SetVariantOptionsRequest.options:type_name -> product.v1.VariantOptionInput
+	1,  // 9: product.v1.VariantOptionsResponse.options:type_name -> product.v1.VariantOption
+	2,  // 10: product.v1.VariantService.Create:input_type -> product.v1.CreateVariantRequest
+	3,  // 11: product.v1.VariantService.BulkCreate:input_type -> product.v1.BulkCreateRequest
+	5,  // 12: product.v1.VariantService.Get:input_type -> product.v1.GetVariantRequest
+	6,  // 13: product.v1.VariantService.List:input_type -> product.v1.ListVariantsRequest
+	8,  // 14: product.v1.VariantService.Update:input_type -> product.v1.UpdateVariantRequest
+	9,  // 15: product.v1.VariantService.Delete:input_type -> product.v1.DeleteVariantRequest
+	10, // 16: product.v1.VariantService.UpdateStock:input_type -> product.v1.VariantStockRequest
+	13, // 17: product.v1.VariantService.SetOptions:input_type -> product.v1.SetVariantOptionsRequest
+	14, // 18: product.v1.VariantService.ListOptions:input_type -> product.v1.ListVariantOptionsRequest
+	16, // 19: product.v1.VariantService.Generate:input_type -> product.v1.GenerateVariantsRequest
+	11, // 20: product.v1.VariantService.Create:output_type -> product.v1.VariantResponse
+	4,  // 21: product.v1.VariantService.BulkCreate:output_type -> product.v1.BulkCreateResponse
+	11, // 22: product.v1.VariantService.Get:output_type -> product.v1.VariantResponse
+	7,  // 23: product.v1.VariantService.List:output_type -> product.v1.ListVariantsResponse
+	11, // 24: product.v1.VariantService.Update:output_type -> product.v1.VariantResponse
+	20, // 25: product.v1.VariantService.Delete:output_type -> google.protobuf.Empty
+	11, // 26: product.v1.VariantService.UpdateStock:output_type -> product.v1.VariantResponse
+	15, // 27: product.v1.VariantService.SetOptions:output_type -> product.v1.VariantOptionsResponse
+	15, // 28: product.v1.VariantService.ListOptions:output_type -> product.v1.VariantOptionsResponse
+	4,  // 29: product.v1.VariantService.Generate:output_type -> product.v1.BulkCreateResponse
+	20, // [20:30] is the sub-list for method output_type
+	10, // [10:20] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_product_variant_dev_proto_init() }
+func file_product_variant_dev_proto_init() {
+	if File_product_variant_dev_proto != nil {
+		return
+	}
+	file_product_variant_dev_proto_msgTypes[0].OneofWrappers = []any{}
+	file_product_variant_dev_proto_msgTypes[2].OneofWrappers = []any{}
+	file_product_variant_dev_proto_msgTypes[6].OneofWrappers = []any{}
+	file_product_variant_dev_proto_msgTypes[8].OneofWrappers = []any{}
+	file_product_variant_dev_proto_msgTypes[16].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_variant_dev_proto_rawDesc), len(file_product_variant_dev_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_product_variant_dev_proto_goTypes,
+		DependencyIndexes: file_product_variant_dev_proto_depIdxs,
+		MessageInfos:      file_product_variant_dev_proto_msgTypes,
+	}.Build()
+	File_product_variant_dev_proto = out.File
+	file_product_variant_dev_proto_goTypes = nil
+	file_product_variant_dev_proto_depIdxs = nil
+}
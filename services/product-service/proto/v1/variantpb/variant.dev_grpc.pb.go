@@ -0,0 +1,468 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.1
+// source: product/variant.dev.proto
+
+package variantpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VariantService_Create_FullMethodName      = "/product.v1.VariantService/Create"
+	VariantService_BulkCreate_FullMethodName  = "/product.v1.VariantService/BulkCreate"
+	VariantService_Get_FullMethodName         = "/product.v1.VariantService/Get"
+	VariantService_List_FullMethodName        = "/product.v1.VariantService/List"
+	VariantService_Update_FullMethodName      = "/product.v1.VariantService/Update"
+	VariantService_Delete_FullMethodName      = "/product.v1.VariantService/Delete"
+	VariantService_UpdateStock_FullMethodName = "/product.v1.VariantService/UpdateStock"
+	VariantService_SetOptions_FullMethodName  = "/product.v1.VariantService/SetOptions"
+	VariantService_ListOptions_FullMethodName = "/product.v1.VariantService/ListOptions"
+	VariantService_Generate_FullMethodName    = "/product.v1.VariantService/Generate"
+)
+
+// VariantServiceClient is the client API for VariantService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VariantServiceClient interface {
+	Create(ctx context.Context, in *CreateVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error)
+	BulkCreate(ctx context.Context, in *BulkCreateRequest, opts ...grpc.CallOption) (*BulkCreateResponse, error)
+	Get(ctx context.Context, in *GetVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error)
+	List(ctx context.Context, in *ListVariantsRequest, opts ...grpc.CallOption) (*ListVariantsResponse, error)
+	Update(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error)
+	Delete(ctx context.Context, in *DeleteVariantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateStock(ctx context.Context, in *VariantStockRequest, opts ...grpc.CallOption) (*VariantResponse, error)
+	// Option definitions (e.g. size and color) and the variant matrix built
+	// from them.
+	SetOptions(ctx context.Context, in *SetVariantOptionsRequest, opts ...grpc.CallOption) (*VariantOptionsResponse, error)
+	ListOptions(ctx context.Context, in *ListVariantOptionsRequest, opts ...grpc.CallOption) (*VariantOptionsResponse, error)
+	Generate(ctx context.Context, in *GenerateVariantsRequest, opts ...grpc.CallOption) (*BulkCreateResponse, error)
+}
+
+type variantServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVariantServiceClient(cc grpc.ClientConnInterface) VariantServiceClient {
+	return &variantServiceClient{cc}
+}
+
+func (c *variantServiceClient) Create(ctx context.Context, in *CreateVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VariantResponse)
+	err := c.cc.Invoke(ctx, VariantService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) BulkCreate(ctx context.Context, in *BulkCreateRequest, opts ...grpc.CallOption) (*BulkCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkCreateResponse)
+	err := c.cc.Invoke(ctx, VariantService_BulkCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) Get(ctx context.Context, in *GetVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VariantResponse)
+	err := c.cc.Invoke(ctx, VariantService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) List(ctx context.Context, in *ListVariantsRequest, opts ...grpc.CallOption) (*ListVariantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVariantsResponse)
+	err := c.cc.Invoke(ctx, VariantService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) Update(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VariantResponse)
+	err := c.cc.Invoke(ctx, VariantService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) Delete(ctx context.Context, in *DeleteVariantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, VariantService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) UpdateStock(ctx context.Context, in *VariantStockRequest, opts ...grpc.CallOption) (*VariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VariantResponse)
+	err := c.cc.Invoke(ctx, VariantService_UpdateStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) SetOptions(ctx context.Context, in *SetVariantOptionsRequest, opts ...grpc.CallOption) (*VariantOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VariantOptionsResponse)
+	err := c.cc.Invoke(ctx, VariantService_SetOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) ListOptions(ctx context.Context, in *ListVariantOptionsRequest, opts ...grpc.CallOption) (*VariantOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VariantOptionsResponse)
+	err := c.cc.Invoke(ctx, VariantService_ListOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) Generate(ctx context.Context, in *GenerateVariantsRequest, opts ...grpc.CallOption) (*BulkCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkCreateResponse)
+	err := c.cc.Invoke(ctx, VariantService_Generate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VariantServiceServer is the server API for VariantService service.
+// All implementations must embed UnimplementedVariantServiceServer
+// for forward compatibility.
+type VariantServiceServer interface {
+	Create(context.Context, *CreateVariantRequest) (*VariantResponse, error)
+	BulkCreate(context.Context, *BulkCreateRequest) (*BulkCreateResponse, error)
+	Get(context.Context, *GetVariantRequest) (*VariantResponse, error)
+	List(context.Context, *ListVariantsRequest) (*ListVariantsResponse, error)
+	Update(context.Context, *UpdateVariantRequest) (*VariantResponse, error)
+	Delete(context.Context, *DeleteVariantRequest) (*emptypb.Empty, error)
+	UpdateStock(context.Context, *VariantStockRequest) (*VariantResponse, error)
+	// Option definitions (e.g. size and color) and the variant matrix built
+	// from them.
+	SetOptions(context.Context, *SetVariantOptionsRequest) (*VariantOptionsResponse, error)
+	ListOptions(context.Context, *ListVariantOptionsRequest) (*VariantOptionsResponse, error)
+	Generate(context.Context, *GenerateVariantsRequest) (*BulkCreateResponse, error)
+	mustEmbedUnimplementedVariantServiceServer()
+}
+
+// UnimplementedVariantServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVariantServiceServer struct{}
+
+func (UnimplementedVariantServiceServer) Create(context.Context, *CreateVariantRequest) (*VariantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedVariantServiceServer) BulkCreate(context.Context, *BulkCreateRequest) (*BulkCreateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkCreate not implemented")
+}
+func (UnimplementedVariantServiceServer) Get(context.Context, *GetVariantRequest) (*VariantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedVariantServiceServer) List(context.Context, *ListVariantsRequest) (*ListVariantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedVariantServiceServer) Update(context.Context, *UpdateVariantRequest) (*VariantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedVariantServiceServer) Delete(context.Context, *DeleteVariantRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedVariantServiceServer) UpdateStock(context.Context, *VariantStockRequest) (*VariantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateStock not implemented")
+}
+func (UnimplementedVariantServiceServer) SetOptions(context.Context, *SetVariantOptionsRequest) (*VariantOptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetOptions not implemented")
+}
+func (UnimplementedVariantServiceServer) ListOptions(context.Context, *ListVariantOptionsRequest) (*VariantOptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOptions not implemented")
+}
+func (UnimplementedVariantServiceServer) Generate(context.Context, *GenerateVariantsRequest) (*BulkCreateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Generate not implemented")
+}
+func (UnimplementedVariantServiceServer) mustEmbedUnimplementedVariantServiceServer() {}
+func (UnimplementedVariantServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeVariantServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VariantServiceServer will
+// result in compilation errors.
+type UnsafeVariantServiceServer interface {
+	mustEmbedUnimplementedVariantServiceServer()
+}
+
+func RegisterVariantServiceServer(s grpc.ServiceRegistrar, srv VariantServiceServer) {
+	// If the following call panics, it indicates UnimplementedVariantServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VariantService_ServiceDesc, srv)
+}
+
+func _VariantService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).Create(ctx, req.(*CreateVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_BulkCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).BulkCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_BulkCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).BulkCreate(ctx, req.(*BulkCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).Get(ctx, req.(*GetVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).List(ctx, req.(*ListVariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).Update(ctx, req.(*UpdateVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).Delete(ctx, req.(*DeleteVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_UpdateStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariantStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).UpdateStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_UpdateStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).UpdateStock(ctx, req.(*VariantStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_SetOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVariantOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).SetOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_SetOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).SetOptions(ctx, req.(*SetVariantOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_ListOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVariantOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).ListOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_ListOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).ListOptions(ctx, req.(*ListVariantOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_Generate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateVariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).Generate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_Generate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).Generate(ctx, req.(*GenerateVariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VariantService_ServiceDesc is the grpc.ServiceDesc for VariantService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VariantService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product.v1.VariantService",
+	HandlerType: (*VariantServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _VariantService_Create_Handler,
+		},
+		{
+			MethodName: "BulkCreate",
+			Handler:    _VariantService_BulkCreate_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _VariantService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _VariantService_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _VariantService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _VariantService_Delete_Handler,
+		},
+		{
+			MethodName: "UpdateStock",
+			Handler:    _VariantService_UpdateStock_Handler,
+		},
+		{
+			MethodName: "SetOptions",
+			Handler:    _VariantService_SetOptions_Handler,
+		},
+		{
+			MethodName: "ListOptions",
+			Handler:    _VariantService_ListOptions_Handler,
+		},
+		{
+			MethodName: "Generate",
+			Handler:    _VariantService_Generate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "product/variant.dev.proto",
+}
@@ -34,3 +34,7 @@ func (h *ShopHandler) UpdateShop(ctx context.Context, req *shoppb.UpdateShopRequ
 func (h *ShopHandler) DeleteShop(ctx context.Context, req *shoppb.DeleteShopRequest) (*shoppb.DeleteShopResponse, error) {
 	return h.svc.DeleteShop(ctx, req)
 }
+
+func (h *ShopHandler) GetShop(ctx context.Context, req *shoppb.GetShopRequest) (*shoppb.ShopResponse, error) {
+	return h.svc.GetShop(ctx, req)
+}
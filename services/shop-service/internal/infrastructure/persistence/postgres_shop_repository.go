@@ -14,6 +14,7 @@ type ShopRepository interface {
 	CreateShop(ctx context.Context, shop *dto.ShopDTO) (string, error)
 	ListByShopOwner(ctx context.Context, ownerID string) ([]*dto.ShopDTO, error)
 	GetBySlug(ctx context.Context, slug string) (bool, error)
+	GetByID(ctx context.Context, shopID string) (*dto.ShopDTO, error)
 	UpdateShop(ctx context.Context, shop *dto.ShopDTO) (*dto.ShopDTO, error)
 	DeleteByOwnerID(ctx context.Context, ownerID string, shopID string) (int64, error)
 }
@@ -36,6 +37,11 @@ const (
 		FROM shops
 		WHERE owner_id = $1 AND id = $2 AND is_active = TRUE AND deleted_at IS NULL
 	`
+	queryShopByID = `
+		SELECT id, owner_id, name, slug, description, logo, is_active, created_at, updated_at
+		FROM shops
+		WHERE id = $1 AND deleted_at IS NULL
+	`
 	queryShopsByOwnerID = `
 		SELECT id, owner_id, name, slug, description, logo, is_active, created_at, updated_at
 		FROM shops
@@ -160,6 +166,24 @@ func (r *PostgresShopRepository) GetBySlug(ctx context.Context, slug string) (bo
 	return exists, nil
 }
 
+func (r *PostgresShopRepository) GetByID(ctx context.Context, shopID string) (*dto.ShopDTO, error) {
+	shop, err := scanShop(r.db.QueryRowContext(ctx, queryShopByID, shopID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.logger.DebugContext(ctx, "shop not found",
+				slog.String("shop_id", shopID),
+			)
+			return nil, err
+		}
+		r.logger.ErrorContext(ctx, "failed to query shop",
+			slog.String("shop_id", shopID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return shop, nil
+}
+
 func (r *PostgresShopRepository) UpdateShop(ctx context.Context, shop *dto.ShopDTO) (*dto.ShopDTO, error) {
 	row := r.db.QueryRowContext(ctx, queryUpdateShop,
 		shop.Name, nullStr(shop.Description), nullStr(shop.Logo), shop.IsActive, shop.OwnerID, shop.ShopID,
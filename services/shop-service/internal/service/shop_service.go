@@ -151,6 +151,22 @@ func (s *ShopService) ListOwnedShops(ctx context.Context, req *shoppb.ListOwnedS
 	return toShopsResponse(shops), nil
 }
 
+func (s *ShopService) GetShop(ctx context.Context, req *shoppb.GetShopRequest) (*shoppb.ShopResponse, error) {
+	if req.ShopId == "" {
+		return nil, errors.GRPC(codes.InvalidArgument, errors.ErrInvalidInputCode, errors.ErrInvalidInputMsg)
+	}
+
+	shop, err := s.repo.GetByID(ctx, req.ShopId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.GRPC(codes.NotFound, errors.ShopNotFoundCode, errors.ShopNotFoundMsg)
+		}
+		return nil, errors.GRPC(codes.Internal, errors.ShopValidateFailedCode, errors.ShopValidateFailedMsg)
+	}
+
+	return toShopResponse(shop), nil
+}
+
 func (s *ShopService) UpdateShop(ctx context.Context, req *shoppb.UpdateShopRequest) (*shoppb.ShopResponse, error) {
 	ownerID, err := reqCtx.MustGetUserID(ctx)
 
@@ -494,6 +494,52 @@ func (x *UpdateShopRequest) GetIsActive() bool {
 	return false
 }
 
+// GetShop returns a shop's public profile, e.g. for printing its name and
+// logo on receipts. Unlike ValidateShop it does not require ownership.
+type GetShopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShopId        string                 `protobuf:"bytes,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShopRequest) Reset() {
+	*x = GetShopRequest{}
+	mi := &file_shop_shop_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShopRequest) ProtoMessage() {}
+
+func (x *GetShopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shop_shop_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShopRequest.ProtoReflect.Descriptor instead.
+func (*GetShopRequest) Descriptor() ([]byte, []int) {
+	return file_shop_shop_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetShopRequest) GetShopId() string {
+	if x != nil {
+		return x.ShopId
+	}
+	return ""
+}
+
 type DeleteShopRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ShopId        string                 `protobuf:"bytes,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
@@ -503,7 +549,7 @@ type DeleteShopRequest struct {
 
 func (x *DeleteShopRequest) Reset() {
 	*x = DeleteShopRequest{}
-	mi := &file_shop_shop_proto_msgTypes[8]
+	mi := &file_shop_shop_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -515,7 +561,7 @@ func (x *DeleteShopRequest) String() string {
 func (*DeleteShopRequest) ProtoMessage() {}
 
 func (x *DeleteShopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_shop_proto_msgTypes[8]
+	mi := &file_shop_shop_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +574,7 @@ func (x *DeleteShopRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteShopRequest.ProtoReflect.Descriptor instead.
 func (*DeleteShopRequest) Descriptor() ([]byte, []int) {
-	return file_shop_shop_proto_rawDescGZIP(), []int{8}
+	return file_shop_shop_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteShopRequest) GetShopId() string {
@@ -547,7 +593,7 @@ type DeleteShopResponse struct {
 
 func (x *DeleteShopResponse) Reset() {
 	*x = DeleteShopResponse{}
-	mi := &file_shop_shop_proto_msgTypes[9]
+	mi := &file_shop_shop_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -559,7 +605,7 @@ func (x *DeleteShopResponse) String() string {
 func (*DeleteShopResponse) ProtoMessage() {}
 
 func (x *DeleteShopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shop_shop_proto_msgTypes[9]
+	mi := &file_shop_shop_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +618,7 @@ func (x *DeleteShopResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteShopResponse.ProtoReflect.Descriptor instead.
 func (*DeleteShopResponse) Descriptor() ([]byte, []int) {
-	return file_shop_shop_proto_rawDescGZIP(), []int{9}
+	return file_shop_shop_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteShopResponse) GetSuccess() bool {
@@ -620,11 +666,13 @@ const file_shop_shop_proto_rawDesc = "" +
 	"\ashop_id\x18\x02 \x01(\tR\x06shopId\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
 	"\x04logo\x18\x04 \x01(\tR\x04logo\x12\x1b\n" +
-	"\tis_active\x18\x05 \x01(\bR\bisActive\",\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\")\n" +
+	"\x0eGetShopRequest\x12\x17\n" +
+	"\ashop_id\x18\x01 \x01(\tR\x06shopId\",\n" +
 	"\x11DeleteShopRequest\x12\x17\n" +
 	"\ashop_id\x18\x01 \x01(\tR\x06shopId\".\n" +
 	"\x12DeleteShopResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xed\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xa6\x03\n" +
 	"\vShopService\x12C\n" +
 	"\n" +
 	"CreateShop\x12\x19.shoppb.CreateShopRequest\x1a\x1a.shoppb.CreateShopResponse\x12J\n" +
@@ -633,7 +681,8 @@ const file_shop_shop_proto_rawDesc = "" +
 	"\n" +
 	"UpdateShop\x12\x19.shoppb.UpdateShopRequest\x1a\x14.shoppb.ShopResponse\x12C\n" +
 	"\n" +
-	"DeleteShop\x12\x19.shoppb.DeleteShopRequest\x1a\x1a.shoppb.DeleteShopResponseB\x0eZ\fproto/shoppbb\x06proto3"
+	"DeleteShop\x12\x19.shoppb.DeleteShopRequest\x1a\x1a.shoppb.DeleteShopResponse\x127\n" +
+	"\aGetShop\x12\x16.shoppb.GetShopRequest\x1a\x14.shoppb.ShopResponseB\x0eZ\fproto/shoppbb\x06proto3"
 
 var (
 	file_shop_shop_proto_rawDescOnce sync.Once
@@ -647,7 +696,7 @@ func file_shop_shop_proto_rawDescGZIP() []byte {
 	return file_shop_shop_proto_rawDescData
 }
 
-var file_shop_shop_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_shop_shop_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_shop_shop_proto_goTypes = []any{
 	(*CreateShopRequest)(nil),     // 0: shoppb.CreateShopRequest
 	(*CreateShopResponse)(nil),    // 1: shoppb.CreateShopResponse
@@ -657,27 +706,30 @@ var file_shop_shop_proto_goTypes = []any{
 	(*ValidateShopResponse)(nil),  // 5: shoppb.ValidateShopResponse
 	(*ShopResponse)(nil),          // 6: shoppb.ShopResponse
 	(*UpdateShopRequest)(nil),     // 7: shoppb.UpdateShopRequest
-	(*DeleteShopRequest)(nil),     // 8: shoppb.DeleteShopRequest
-	(*DeleteShopResponse)(nil),    // 9: shoppb.DeleteShopResponse
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*GetShopRequest)(nil),        // 8: shoppb.GetShopRequest
+	(*DeleteShopRequest)(nil),     // 9: shoppb.DeleteShopRequest
+	(*DeleteShopResponse)(nil),    // 10: shoppb.DeleteShopResponse
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
 }
 var file_shop_shop_proto_depIdxs = []int32{
-	10, // 0: shoppb.CreateShopResponse.created_at:type_name -> google.protobuf.Timestamp
+	11, // 0: shoppb.CreateShopResponse.created_at:type_name -> google.protobuf.Timestamp
 	6,  // 1: shoppb.ListShopsResponse.shops:type_name -> shoppb.ShopResponse
-	10, // 2: shoppb.ShopResponse.created_at:type_name -> google.protobuf.Timestamp
-	10, // 3: shoppb.ShopResponse.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 2: shoppb.ShopResponse.created_at:type_name -> google.protobuf.Timestamp
+	11, // 3: shoppb.ShopResponse.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 4: shoppb.ShopService.CreateShop:input_type -> shoppb.CreateShopRequest
 	2,  // 5: shoppb.ShopService.ListOwnedShops:input_type -> shoppb.ListOwnedShopsRequest
 	4,  // 6: shoppb.ShopService.ValidateShop:input_type -> shoppb.ValidateShopRequest
 	7,  // 7: shoppb.ShopService.UpdateShop:input_type -> shoppb.UpdateShopRequest
-	8,  // 8: shoppb.ShopService.DeleteShop:input_type -> shoppb.DeleteShopRequest
-	1,  // 9: shoppb.ShopService.CreateShop:output_type -> shoppb.CreateShopResponse
-	3,  // 10: shoppb.ShopService.ListOwnedShops:output_type -> shoppb.ListShopsResponse
-	5,  // 11: shoppb.ShopService.ValidateShop:output_type -> shoppb.ValidateShopResponse
-	6,  // 12: shoppb.ShopService.UpdateShop:output_type -> shoppb.ShopResponse
-	9,  // 13: shoppb.ShopService.DeleteShop:output_type -> shoppb.DeleteShopResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
+	9,  // 8: shoppb.ShopService.DeleteShop:input_type -> shoppb.DeleteShopRequest
+	8,  // 9: shoppb.ShopService.GetShop:input_type -> shoppb.GetShopRequest
+	1,  // 10: shoppb.ShopService.CreateShop:output_type -> shoppb.CreateShopResponse
+	3,  // 11: shoppb.ShopService.ListOwnedShops:output_type -> shoppb.ListShopsResponse
+	5,  // 12: shoppb.ShopService.ValidateShop:output_type -> shoppb.ValidateShopResponse
+	6,  // 13: shoppb.ShopService.UpdateShop:output_type -> shoppb.ShopResponse
+	10, // 14: shoppb.ShopService.DeleteShop:output_type -> shoppb.DeleteShopResponse
+	6,  // 15: shoppb.ShopService.GetShop:output_type -> shoppb.ShopResponse
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
 	4,  // [4:4] is the sub-list for extension type_name
 	4,  // [4:4] is the sub-list for extension extendee
 	0,  // [0:4] is the sub-list for field type_name
@@ -694,7 +746,7 @@ func file_shop_shop_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shop_shop_proto_rawDesc), len(file_shop_shop_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
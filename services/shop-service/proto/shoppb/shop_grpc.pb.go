@@ -24,6 +24,7 @@ const (
 	ShopService_ValidateShop_FullMethodName   = "/shoppb.ShopService/ValidateShop"
 	ShopService_UpdateShop_FullMethodName     = "/shoppb.ShopService/UpdateShop"
 	ShopService_DeleteShop_FullMethodName     = "/shoppb.ShopService/DeleteShop"
+	ShopService_GetShop_FullMethodName        = "/shoppb.ShopService/GetShop"
 )
 
 // ShopServiceClient is the client API for ShopService service.
@@ -35,6 +36,7 @@ type ShopServiceClient interface {
 	ValidateShop(ctx context.Context, in *ValidateShopRequest, opts ...grpc.CallOption) (*ValidateShopResponse, error)
 	UpdateShop(ctx context.Context, in *UpdateShopRequest, opts ...grpc.CallOption) (*ShopResponse, error)
 	DeleteShop(ctx context.Context, in *DeleteShopRequest, opts ...grpc.CallOption) (*DeleteShopResponse, error)
+	GetShop(ctx context.Context, in *GetShopRequest, opts ...grpc.CallOption) (*ShopResponse, error)
 }
 
 type shopServiceClient struct {
@@ -95,6 +97,16 @@ func (c *shopServiceClient) DeleteShop(ctx context.Context, in *DeleteShopReques
 	return out, nil
 }
 
+func (c *shopServiceClient) GetShop(ctx context.Context, in *GetShopRequest, opts ...grpc.CallOption) (*ShopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShopResponse)
+	err := c.cc.Invoke(ctx, ShopService_GetShop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShopServiceServer is the server API for ShopService service.
 // All implementations must embed UnimplementedShopServiceServer
 // for forward compatibility.
@@ -104,6 +116,7 @@ type ShopServiceServer interface {
 	ValidateShop(context.Context, *ValidateShopRequest) (*ValidateShopResponse, error)
 	UpdateShop(context.Context, *UpdateShopRequest) (*ShopResponse, error)
 	DeleteShop(context.Context, *DeleteShopRequest) (*DeleteShopResponse, error)
+	GetShop(context.Context, *GetShopRequest) (*ShopResponse, error)
 	mustEmbedUnimplementedShopServiceServer()
 }
 
@@ -129,6 +142,9 @@ func (UnimplementedShopServiceServer) UpdateShop(context.Context, *UpdateShopReq
 func (UnimplementedShopServiceServer) DeleteShop(context.Context, *DeleteShopRequest) (*DeleteShopResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteShop not implemented")
 }
+func (UnimplementedShopServiceServer) GetShop(context.Context, *GetShopRequest) (*ShopResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetShop not implemented")
+}
 func (UnimplementedShopServiceServer) mustEmbedUnimplementedShopServiceServer() {}
 func (UnimplementedShopServiceServer) testEmbeddedByValue()                     {}
 
@@ -240,6 +256,24 @@ func _ShopService_DeleteShop_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShopService_GetShop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServiceServer).GetShop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShopService_GetShop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServiceServer).GetShop(ctx, req.(*GetShopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ShopService_ServiceDesc is the grpc.ServiceDesc for ShopService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +301,10 @@ var ShopService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteShop",
 			Handler:    _ShopService_DeleteShop_Handler,
 		},
+		{
+			MethodName: "GetShop",
+			Handler:    _ShopService_GetShop_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shop/shop.proto",